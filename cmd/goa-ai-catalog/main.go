@@ -0,0 +1,145 @@
+// Command goa-ai-catalog merges the tool and completion catalogues generated by
+// goa gen (gen/catalog/openapi.json) and writes them as a single OpenAPI 3.1
+// document or JSON Schema bundle.
+//
+// # Usage
+//
+//	goa-ai-catalog [flags] <catalog.json|gen dir>...
+//
+// Directory arguments are searched recursively for catalog/openapi.json, so a
+// single invocation can combine the catalogues of several services or
+// repositories. Toolsets and completions present in several inputs must be
+// identical.
+//
+// Flags:
+//
+//	-format   Output format: openapi or jsonschema (default: openapi)
+//	-o        Output file (default: stdout)
+//	-title    Catalogue title (default: title of the first input)
+//	-version  Catalogue version (default: version of the first input)
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+
+	"goa.design/goa-ai/runtime/agent/catalog"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	var (
+		format  string
+		out     string
+		title   string
+		version string
+	)
+	fset := flag.NewFlagSet("goa-ai-catalog", flag.ContinueOnError)
+	fset.StringVar(&format, "format", "openapi", "output format: openapi or jsonschema")
+	fset.StringVar(&out, "o", "", "output file (default: stdout)")
+	fset.StringVar(&title, "title", "", "catalogue title (default: title of the first input)")
+	fset.StringVar(&version, "version", "", "catalogue version (default: version of the first input)")
+	fset.Usage = func() {
+		fmt.Fprintln(fset.Output(), "usage: goa-ai-catalog [flags] <catalog.json|gen dir>...")
+		fset.PrintDefaults()
+	}
+	if err := fset.Parse(args); err != nil {
+		return err
+	}
+	if format != "openapi" && format != "jsonschema" {
+		return fmt.Errorf("unknown format %q (want openapi or jsonschema)", format)
+	}
+	if fset.NArg() == 0 {
+		fset.Usage()
+		return errors.New("missing input")
+	}
+
+	paths, err := inputFiles(fset.Args())
+	if err != nil {
+		return err
+	}
+	var doc *catalog.Document
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		in, err := catalog.Parse(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		if doc == nil {
+			doc = catalog.New(in.Info)
+		}
+		if err := doc.Merge(in); err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+	}
+	if title != "" {
+		doc.Info.Title = title
+	}
+	if version != "" {
+		doc.Info.Version = version
+	}
+
+	var v any = doc
+	if format == "jsonschema" {
+		v = doc.Bundle()
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if out == "" {
+		_, err = stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(out, data, 0o644)
+}
+
+// inputFiles expands directory arguments into the catalogue files they
+// contain.
+func inputFiles(args []string) ([]string, error) {
+	var paths []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			paths = append(paths, arg)
+			continue
+		}
+		found := false
+		err = filepath.WalkDir(arg, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && d.Name() == "openapi.json" && filepath.Base(filepath.Dir(p)) == "catalog" {
+				paths = append(paths, p)
+				found = true
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("%s: no catalog/openapi.json found", arg)
+		}
+	}
+	return paths, nil
+}
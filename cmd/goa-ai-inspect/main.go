@@ -0,0 +1,258 @@
+// Command goa-ai-inspect inspects agent sessions and runs stored in MongoDB.
+//
+// It reads the collections written by the Mongo session store
+// (features/session/mongo) and run log store (features/runlog/mongo) and
+// never modifies them.
+//
+// # Usage
+//
+//	goa-ai-inspect [global flags] sessions [-status active,ended] [-limit 50]
+//	goa-ai-inspect [global flags] runs -session <id> [-status failed,...]
+//	goa-ai-inspect [global flags] timeline -run <id> [-tree] [-details]
+//	goa-ai-inspect [global flags] export -run <id> -o <file>
+//
+// Global flags:
+//
+//	-mongo-uri  MongoDB connection URI (default: $MONGO_URI or mongodb://localhost:27017)
+//	-db         Database name (default: $MONGO_DATABASE or "agents")
+//	-format     Output format for sessions, runs, and timeline: text or json (default: text)
+//
+// The export subcommand always writes the run tree (the run, its nested agent
+// runs, and handoff targets) as JSON so it can be attached to bug reports.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	mongodriver "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	runlogmongo "goa.design/goa-ai/features/runlog/mongo"
+	runlogclient "goa.design/goa-ai/features/runlog/mongo/clients/mongo"
+	sessionmongo "goa.design/goa-ai/features/session/mongo"
+	sessionclient "goa.design/goa-ai/features/session/mongo/clients/mongo"
+	"goa.design/goa-ai/runtime/agent/inspect"
+	"goa.design/goa-ai/runtime/agent/session"
+)
+
+type globalFlags struct {
+	mongoURI string
+	database string
+	format   string
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	var g globalFlags
+	fs := flag.NewFlagSet("goa-ai-inspect", flag.ContinueOnError)
+	fs.StringVar(&g.mongoURI, "mongo-uri", envOr("MONGO_URI", "mongodb://localhost:27017"), "MongoDB connection URI")
+	fs.StringVar(&g.database, "db", envOr("MONGO_DATABASE", "agents"), "MongoDB database name")
+	fs.StringVar(&g.format, "format", "text", "output format: text or json")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: goa-ai-inspect [flags] sessions|runs|timeline|export [subcommand flags]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if g.format != "text" && g.format != "json" {
+		return fmt.Errorf("unknown format %q (want text or json)", g.format)
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("missing subcommand")
+	}
+
+	ctx := context.Background()
+	insp, disconnect, err := connect(ctx, g)
+	if err != nil {
+		return err
+	}
+	defer disconnect()
+
+	cmd, rest := fs.Arg(0), fs.Args()[1:]
+	switch cmd {
+	case "sessions":
+		return listSessions(ctx, insp, g, rest, stdout)
+	case "runs":
+		return listRuns(ctx, insp, g, rest, stdout)
+	case "timeline":
+		return showTimeline(ctx, insp, g, rest, stdout)
+	case "export":
+		return exportRun(ctx, insp, rest)
+	default:
+		return fmt.Errorf("unknown subcommand %q", cmd)
+	}
+}
+
+// connect builds an inspector over the Mongo session and run log stores.
+func connect(ctx context.Context, g globalFlags) (*inspect.Inspector, func(), error) {
+	mc, err := mongodriver.Connect(options.Client().ApplyURI(g.mongoURI))
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to mongo: %w", err)
+	}
+	disconnect := func() {
+		if err := mc.Disconnect(context.Background()); err != nil {
+			log.Printf("disconnect mongo: %v", err)
+		}
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := mc.Ping(pingCtx, nil); err != nil {
+		disconnect()
+		return nil, nil, fmt.Errorf("ping mongo: %w", err)
+	}
+
+	sc, err := sessionclient.New(sessionclient.Options{Client: mc, Database: g.database})
+	if err != nil {
+		disconnect()
+		return nil, nil, fmt.Errorf("create session client: %w", err)
+	}
+	sessions, err := sessionmongo.NewStore(sc)
+	if err != nil {
+		disconnect()
+		return nil, nil, fmt.Errorf("create session store: %w", err)
+	}
+	rc, err := runlogclient.New(runlogclient.Options{Client: mc, Database: g.database})
+	if err != nil {
+		disconnect()
+		return nil, nil, fmt.Errorf("create run log client: %w", err)
+	}
+	runs, err := runlogmongo.NewStore(rc)
+	if err != nil {
+		disconnect()
+		return nil, nil, fmt.Errorf("create run log store: %w", err)
+	}
+	return inspect.New(sessions, runs), disconnect, nil
+}
+
+func listSessions(ctx context.Context, insp *inspect.Inspector, g globalFlags, args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("sessions", flag.ContinueOnError)
+	status := fs.String("status", "", "comma-separated session statuses (active, ended)")
+	limit := fs.Int("limit", 50, "maximum number of sessions to list (0 for all)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	statuses := make([]session.SessionStatus, 0)
+	for _, s := range splitList(*status) {
+		statuses = append(statuses, session.SessionStatus(s))
+	}
+	sessions, err := insp.Sessions(ctx, statuses, *limit)
+	if err != nil {
+		return err
+	}
+	if g.format == "json" {
+		return inspect.WriteJSON(w, sessions)
+	}
+	return inspect.WriteSessions(w, sessions)
+}
+
+func listRuns(ctx context.Context, insp *inspect.Inspector, g globalFlags, args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("runs", flag.ContinueOnError)
+	sessionID := fs.String("session", "", "session id (required)")
+	status := fs.String("status", "", "comma-separated run statuses (pending, running, paused, suspended, completed, failed, canceled)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *sessionID == "" {
+		return errors.New("runs: -session is required")
+	}
+	statuses := make([]session.RunStatus, 0)
+	for _, s := range splitList(*status) {
+		statuses = append(statuses, session.RunStatus(s))
+	}
+	runs, err := insp.Runs(ctx, *sessionID, statuses)
+	if err != nil {
+		return err
+	}
+	if g.format == "json" {
+		return inspect.WriteJSON(w, runs)
+	}
+	return inspect.WriteRuns(w, runs)
+}
+
+func showTimeline(ctx context.Context, insp *inspect.Inspector, g globalFlags, args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("timeline", flag.ContinueOnError)
+	runID := fs.String("run", "", "run id (required)")
+	tree := fs.Bool("tree", false, "include nested agent runs and handoff targets")
+	details := fs.Bool("details", false, "print tool arguments and results (text format only)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *runID == "" {
+		return errors.New("timeline: -run is required")
+	}
+	var (
+		t   *inspect.Timeline
+		err error
+	)
+	if *tree {
+		t, err = insp.RunTree(ctx, *runID)
+	} else {
+		t, err = insp.Timeline(ctx, *runID)
+	}
+	if err != nil {
+		return err
+	}
+	if g.format == "json" {
+		return inspect.WriteJSON(w, t)
+	}
+	return inspect.WriteText(w, t, *details)
+}
+
+func exportRun(ctx context.Context, insp *inspect.Inspector, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	runID := fs.String("run", "", "run id (required)")
+	out := fs.String("o", "", "output file (required)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *runID == "" || *out == "" {
+		return errors.New("export: -run and -o are required")
+	}
+	t, err := insp.RunTree(ctx, *runID)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", *out, err)
+	}
+	if err := inspect.WriteJSON(f, t); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write %s: %w", *out, err)
+	}
+	return f.Close()
+}
+
+// splitList splits a comma-separated flag value, dropping empty items.
+func splitList(v string) []string {
+	var out []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// envOr returns the environment variable value or a default.
+func envOr(key, defaultVal string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return defaultVal
+}
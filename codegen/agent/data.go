@@ -6,6 +6,7 @@ import (
 	"time"
 
 	ir "goa.design/goa-ai/codegen/ir"
+	"goa.design/goa-ai/codegen/naming"
 	agentsExpr "goa.design/goa-ai/expr/agent"
 	"goa.design/goa-ai/runtime/agent/engine"
 	"goa.design/goa/v3/codegen"
@@ -153,6 +154,17 @@ type (
 		Runtime RuntimeData
 		// Methods contains the routing strategies for agent methods.
 		Methods []*MethodData
+		// Handoffs lists the agents this agent may hand off to, declared via
+		// `Handoff`.
+		Handoffs []*HandoffData
+	}
+
+	// HandoffData describes a handoff target declared via the Handoff DSL.
+	HandoffData struct {
+		// ID is the service-scoped identifier of the target agent.
+		ID string
+		// Description explains when the target should take over.
+		Description string
 	}
 
 	// MethodData captures the routing strategy for an agent method.
@@ -406,6 +418,34 @@ type (
 		RegistryClientAlias string
 	}
 
+	// ToolRetryData captures the design-time retry policy of a tool.
+	ToolRetryData struct {
+		// MaxAttempts caps the total number of execution attempts.
+		MaxAttempts int
+		// Backoff is the delay before the first retry.
+		Backoff time.Duration
+		// RetryOn lists the planner failure kinds that trigger a retry.
+		RetryOn []string
+	}
+
+	// ToolCacheData captures the design-time result cache policy of a tool.
+	ToolCacheData struct {
+		// TTL is how long a cached result remains valid.
+		TTL time.Duration
+		// Scope is one of "run", "session" or "global".
+		Scope string
+	}
+
+	// ToolAvailabilityData captures the run state a tool requires before it is
+	// advertised or executed.
+	ToolAvailabilityData struct {
+		// ToolSucceeded lists the qualified identifiers of the tools that must
+		// have succeeded earlier in the run.
+		ToolSucceeded []string
+		// Labels maps run label keys to the values they must hold.
+		Labels map[string]string
+	}
+
 	// ToolConfirmationData captures design-time confirmation requirements for a tool.
 	ToolConfirmationData struct {
 		// Title is an optional UI title shown when prompting for confirmation.
@@ -477,6 +517,9 @@ type (
 		Args *goaexpr.AttributeExpr
 		// Return is the Goa attribute describing the tool result.
 		Return *goaexpr.AttributeExpr
+		// Progress is the Goa attribute describing the partial results the tool
+		// reports while executing. Nil when the tool declares no progress.
+		Progress *goaexpr.AttributeExpr
 		// ServerData enumerates server-only payloads emitted alongside the tool
 		// result. Server data is never sent to model providers.
 		ServerData []*ServerDataData
@@ -583,6 +626,30 @@ type (
 		// terminal commits) that does not consume the run-level retrieval budget.
 		Bookkeeping bool
 
+		// ReadOnly indicates the tool does not modify its environment.
+		ReadOnly bool
+
+		// Idempotent indicates repeated calls with the same arguments have no
+		// additional effect, so the runtime may retry failed attempts.
+		Idempotent bool
+
+		// Destructive indicates the tool may perform irreversible updates and
+		// must never be retried after an unknown outcome.
+		Destructive bool
+
+		// Timeout bounds one execution attempt of the tool. Zero means the
+		// agent default applies.
+		Timeout time.Duration
+
+		// Retry configures how failed executions of the tool are retried.
+		Retry *ToolRetryData
+
+		// Cache configures runtime caching of the tool results.
+		Cache *ToolCacheData
+
+		// Availability gates the tool on run state.
+		Availability *ToolAvailabilityData
+
 		// ResultReminder is an optional system reminder injected into the
 		// conversation after the tool result is returned. It provides backstage
 		// guidance to the model about how to interpret or present the result.
@@ -994,6 +1061,13 @@ func newAgentData(genpkg string, agentIR *ir.Agent, servicesData *service.Servic
 		}
 	}
 
+	for _, h := range agentIR.Expr.Handoffs {
+		agent.Handoffs = append(agent.Handoffs, &HandoffData{
+			ID:          naming.Identifier(h.Target.Service.Name, h.Target.Name),
+			Description: h.Description,
+		})
+	}
+
 	return agent, nil
 }
 
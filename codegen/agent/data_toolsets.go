@@ -234,6 +234,7 @@ func newToolData(ts *ToolsetData, expr *agentsExpr.ToolExpr, servicesData *servi
 		Meta:               map[string][]string(expr.Meta),
 		Args:               expr.Args,
 		Return:             expr.Return,
+		Progress:           expr.Progress,
 		Toolset:            ts,
 		IsExportedByAgent:  isExported,
 		ExportingAgentID:   exportingAgentID,
@@ -243,6 +244,10 @@ func newToolData(ts *ToolsetData, expr *agentsExpr.ToolExpr, servicesData *servi
 		Bounds:             boundsData(expr.Bounds, expr.Method),
 		TerminalRun:        expr.TerminalRun,
 		Bookkeeping:        expr.Bookkeeping,
+		ReadOnly:           expr.ReadOnly,
+		Idempotent:         expr.Idempotent,
+		Destructive:        expr.Destructive,
+		Timeout:            expr.Timeout,
 		ResultReminder:     expr.ResultReminder,
 	}
 	if isDedicatedContinuation(expr) {
@@ -262,6 +267,20 @@ func newToolData(ts *ToolsetData, expr *agentsExpr.ToolExpr, servicesData *servi
 			DeniedResultTemplate: expr.Confirmation.DeniedResultTemplate,
 		}
 	}
+	if expr.RetryPolicy != nil {
+		tool.Retry = &ToolRetryData{
+			MaxAttempts: expr.RetryPolicy.MaxAttempts,
+			Backoff:     expr.RetryPolicy.Backoff,
+			RetryOn:     expr.RetryPolicy.RetryOn,
+		}
+	}
+	if expr.ResultCache != nil {
+		tool.Cache = &ToolCacheData{
+			TTL:   expr.ResultCache.TTL,
+			Scope: expr.ResultCache.Scope,
+		}
+	}
+	tool.Availability = availabilityData(expr)
 	if expr.ExportPassthrough != nil {
 		tool.PassthroughService = expr.ExportPassthrough.TargetService
 		tool.PassthroughMethod = expr.ExportPassthrough.TargetMethod
@@ -476,6 +495,23 @@ func continuationToolIdent(tool *agentsExpr.ToolExpr, name string) string {
 	return tool.Toolset.Name + "." + name
 }
 
+// availabilityData qualifies the tool names referenced by the availability
+// conditions of tool. Sibling names resolve within the tool's toolset.
+func availabilityData(tool *agentsExpr.ToolExpr) *ToolAvailabilityData {
+	a := tool.Availability
+	if a == nil {
+		return nil
+	}
+	data := &ToolAvailabilityData{Labels: a.Labels}
+	for _, name := range a.SucceededTools {
+		if !strings.Contains(name, ".") {
+			name = tool.Toolset.Name + "." + name
+		}
+		data.ToolSucceeded = append(data.ToolSucceeded, name)
+	}
+	return data
+}
+
 // boundsFieldData resolves one result-field projection used by tool bounds.
 func boundsFieldData(result *goaexpr.AttributeExpr, name string) *ToolBoundsFieldData {
 	if result == nil || result.Type == nil || result.Type == goaexpr.Empty {
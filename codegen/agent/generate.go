@@ -67,6 +67,14 @@ func Generate(genpkg string, roots []eval.Root, files []*codegen.File) ([]*codeg
 
 	var generated []*codegen.File
 
+	lintJSON, err := lintFile()
+	if err != nil {
+		return nil, err
+	}
+	if lintJSON != nil {
+		generated = append(generated, lintJSON)
+	}
+
 	// Emit owner-scoped toolset specs/codecs once per defining toolset.
 	generated = append(generated, toolsetSpecsFiles(data)...)
 	completionFiles, err := completionSpecsFiles(data)
@@ -74,6 +82,13 @@ func Generate(genpkg string, roots []eval.Root, files []*codegen.File) ([]*codeg
 		return nil, err
 	}
 	generated = append(generated, completionFiles...)
+	catalogJSON, err := catalogFile(data)
+	if err != nil {
+		return nil, err
+	}
+	if catalogJSON != nil {
+		generated = append(generated, catalogJSON)
+	}
 
 	for _, svc := range data.Services {
 		// Emit registry client packages for declared registries.
@@ -307,6 +322,14 @@ func agentRegistryFile(agent *AgentData) *codegen.File {
 			&codegen.ImportSpec{Path: "goa.design/goa-ai/runtime/agent/runtime/hints", Name: "hints"},
 		)
 	}
+	// Registry-backed Used toolsets route calls through their generated
+	// ToolsetRef so pinned versions reach the version discovery selected.
+	for _, ts := range agent.UsedToolsets {
+		if ts.IsRegistryBacked && ts.AgentToolsImportPath == "" {
+			imports = append(imports, &codegen.ImportSpec{Path: "goa.design/goa-ai/runtime/toolregistry"})
+			break
+		}
+	}
 	usedAliases := make(map[string]struct{})
 	for _, imp := range imports {
 		alias := imp.Name
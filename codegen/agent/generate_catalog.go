@@ -0,0 +1,173 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"goa.design/goa-ai/runtime/agent/catalog"
+	"goa.design/goa-ai/runtime/agent/tools"
+	"goa.design/goa/v3/codegen"
+	goaexpr "goa.design/goa/v3/expr"
+)
+
+// catalogFile emits gen/catalog/openapi.json, an OpenAPI 3.1 catalogue of
+// every toolset, tool, and completion contract in the design (see package
+// runtime/agent/catalog). Each toolset appears once, with the schemas recorded
+// in its generated Specs. Registry-backed toolsets are omitted: their contracts
+// are owned by the registry.
+func catalogFile(data *GeneratorData) (*codegen.File, error) {
+	if data == nil {
+		return nil, nil
+	}
+	info := catalog.Info{Title: "goa-ai catalog", Version: "0.0.1"}
+	if api := goaexpr.Root.API; api != nil {
+		if api.Name != "" {
+			info.Title = api.Name
+		}
+		if api.Version != "" {
+			info.Version = api.Version
+		}
+		info.Description = api.Description
+	}
+	doc := catalog.New(info)
+	seen := make(map[string]struct{})
+	for _, svc := range data.Services {
+		for _, ag := range svc.Agents {
+			for _, ts := range ag.AllToolsets {
+				if ts == nil || ts.IsRegistryBacked || len(ts.Tools) == 0 || ts.SpecsImportPath == "" {
+					continue
+				}
+				if _, ok := seen[ts.QualifiedName]; ok {
+					continue
+				}
+				seen[ts.QualifiedName] = struct{}{}
+				specsData, err := buildToolSpecsDataFor(data.Genpkg, ts.SourceService, ts.Tools)
+				if err != nil {
+					return nil, fmt.Errorf("catalog toolset %q: %w", ts.QualifiedName, err)
+				}
+				if specsData == nil {
+					continue
+				}
+				specs := make([]tools.ToolSpec, 0, len(specsData.tools))
+				for _, t := range specsData.tools {
+					specs = append(specs, catalogToolSpec(t))
+				}
+				err = doc.AddToolset(catalog.ToolsetSource{
+					ID:          ts.QualifiedName,
+					Service:     ts.SourceServiceName,
+					Description: ts.Description,
+					Package:     ts.SpecsImportPath,
+					Specs:       specs,
+				})
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		if svc.Service == nil || len(svc.Completions) == 0 {
+			continue
+		}
+		specsData, err := buildCompletionSpecsData(data.Genpkg, svc.Service, svc.Completions)
+		if err != nil {
+			return nil, err
+		}
+		if specsData == nil {
+			continue
+		}
+		pkg := path.Join(data.Genpkg, svc.Service.PathName, "completions")
+		for _, c := range specsData.completions {
+			err := doc.AddCompletion(catalog.CompletionSource{
+				Name:        c.Name,
+				Service:     svc.Service.Name,
+				Description: c.Description,
+				Package:     pkg,
+				Result:      catalogTypeSpec(c.Result),
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(doc.Toolsets) == 0 && len(doc.Completions) == 0 {
+		return nil, nil
+	}
+	payload, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	payload = append(payload, '\n')
+	sections := []*codegen.SectionTemplate{
+		{
+			Name:   "catalog-openapi",
+			Source: "{{ . }}",
+			Data:   string(payload),
+		},
+	}
+	return &codegen.File{
+		Path:             filepath.Join(codegen.Gendir, "catalog", "openapi.json"),
+		SectionTemplates: sections,
+	}, nil
+}
+
+// catalogToolSpec projects the generated tool metadata onto the runtime spec
+// shape consumed by the catalogue builder.
+func catalogToolSpec(t *toolEntry) tools.ToolSpec {
+	spec := tools.ToolSpec{
+		Name:        tools.Ident(t.Name),
+		Service:     t.Service,
+		Toolset:     t.Toolset,
+		Description: t.Description,
+		Tags:        t.Tags,
+		Meta:        t.Meta,
+		TerminalRun: t.TerminalRun,
+		Bookkeeping: t.Bookkeeping,
+		ReadOnly:    t.ReadOnly,
+		Idempotent:  t.Idempotent,
+		Destructive: t.Destructive,
+		IsAgentTool: t.IsExportedByAgent,
+		AgentID:     t.ExportingAgentID,
+		Payload:     catalogTypeSpec(t.Payload),
+		Result:      catalogTypeSpec(t.Result),
+	}
+	if b := t.Bounds; b != nil {
+		spec.Bounds = &tools.BoundsSpec{}
+		if p := b.Paging; p != nil {
+			spec.Bounds.Paging = &tools.PagingSpec{
+				ContinueTool:    tools.Ident(p.ContinueTool),
+				SourceTool:      tools.Ident(p.SourceTool),
+				ReplayPayload:   p.ReplayPayload,
+				CursorField:     p.CursorField,
+				NextCursorField: p.NextCursorField,
+			}
+		}
+	}
+	for _, sd := range t.ServerData {
+		if sd == nil || sd.Type == nil {
+			continue
+		}
+		spec.ServerData = append(spec.ServerData, &tools.ServerDataSpec{
+			Kind:        sd.Kind,
+			Audience:    tools.ServerDataAudience(sd.Audience),
+			Description: sd.Description,
+			Type:        catalogTypeSpec(sd.Type),
+		})
+	}
+	if c := t.Confirmation; c != nil {
+		spec.Confirmation = &tools.ConfirmationSpec{
+			Title:                c.Title,
+			PromptTemplate:       c.PromptTemplate,
+			DeniedResultTemplate: c.DeniedResultTemplate,
+		}
+	}
+	return spec
+}
+
+// catalogTypeSpec returns the name and schema of td.
+func catalogTypeSpec(td *typeData) tools.TypeSpec {
+	if td == nil {
+		return tools.TypeSpec{}
+	}
+	return tools.TypeSpec{Name: td.TypeName, Schema: tools.RawJSON(td.SchemaJSON)}
+}
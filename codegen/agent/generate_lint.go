@@ -0,0 +1,50 @@
+package codegen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	agentsExpr "goa.design/goa-ai/expr/agent"
+	"goa.design/goa-ai/expr/agent/lint"
+	"goa.design/goa/v3/codegen"
+)
+
+// lintFile lints the evaluated agent design (see package expr/agent/lint).
+// Diagnostics are printed to stderr and written to gen/agents_lint.json for CI
+// consumption. It returns an error when a rule configured with severity
+// "error" is violated, which fails generation.
+func lintFile() (*codegen.File, error) {
+	if agentsExpr.Root == nil {
+		return nil, nil
+	}
+	report, err := lint.Run(agentsExpr.Root)
+	if err != nil {
+		return nil, fmt.Errorf("design lint: %w", err)
+	}
+	if len(report.Diagnostics) == 0 {
+		return nil, nil
+	}
+	if err := report.WriteText(os.Stderr); err != nil {
+		return nil, err
+	}
+	if n := report.Count(lint.SeverityError); n > 0 {
+		return nil, fmt.Errorf("design lint: %d error(s)", n)
+	}
+	payload, err := report.JSON()
+	if err != nil {
+		return nil, err
+	}
+	payload = append(payload, '\n')
+	sections := []*codegen.SectionTemplate{
+		{
+			Name:   "agents-lint-json",
+			Source: "{{ . }}",
+			Data:   string(payload),
+		},
+	}
+	return &codegen.File{
+		Path:             filepath.Join(codegen.Gendir, "agents_lint.json"),
+		SectionTemplates: sections,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package codegen
+
+import (
+	"path/filepath"
+
+	"goa.design/goa/v3/codegen"
+)
+
+// toolMockFileData is the template input for a toolset mock executor.
+type toolMockFileData struct {
+	// Toolset is the qualified toolset name.
+	Toolset string
+	// SpecsAlias is the import alias of the toolset specs package.
+	SpecsAlias string
+	// Tools are the toolset tools with their typed payload/result metadata.
+	Tools []*toolEntry
+}
+
+// toolsetMockFile emits `mocks/executor.go` next to the toolset specs package.
+// The generated package (mock<specs package>) exposes a Clue-style mock
+// runtime.ToolCallExecutor whose per-tool expectations use the generated
+// payload and result types, so agent tests can register the toolset against
+// an in-memory runtime without hand-written fakes.
+func toolsetMockFile(ts *ToolsetData, tools []*toolEntry) *codegen.File {
+	if ts == nil || ts.SpecsImportPath == "" || len(tools) == 0 {
+		return nil
+	}
+	// Keep the specs alias distinct from the fixed imports (for example a
+	// toolset named "tools").
+	scope := codegen.NewNameScope()
+	for _, name := range []string{"context", "errors", "fmt", "testing", "mock", "planner", "runtime", "tools"} {
+		scope.Unique(name)
+	}
+	specsAlias := scope.Unique(ts.SpecsPackageName, "specs")
+	imports := []*codegen.ImportSpec{
+		codegen.SimpleImport("context"),
+		codegen.SimpleImport("errors"),
+		codegen.SimpleImport("fmt"),
+		codegen.SimpleImport("testing"),
+		{Path: "goa.design/clue/mock"},
+		{Path: "goa.design/goa-ai/runtime/agent/planner"},
+		{Path: "goa.design/goa-ai/runtime/agent/runtime"},
+		{Path: "goa.design/goa-ai/runtime/agent/tools"},
+		{Path: ts.SpecsImportPath, Name: specsAlias},
+	}
+	pkg := "mock" + ts.SpecsPackageName
+	sections := []*codegen.SectionTemplate{
+		codegen.Header(ts.Name+" tool mocks", pkg, imports),
+		{
+			Name:    "tool-mock",
+			Source:  agentsTemplates.Read(toolMockFileT),
+			Data:    toolMockFileData{Toolset: ts.QualifiedName, SpecsAlias: specsAlias, Tools: tools},
+			FuncMap: templateFuncMap(),
+		},
+	}
+	return &codegen.File{Path: filepath.Join(ts.SpecsDir, "mocks", "executor.go"), SectionTemplates: sections}
+}
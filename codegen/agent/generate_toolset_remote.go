@@ -0,0 +1,88 @@
+package codegen
+
+import (
+	"fmt"
+	"path/filepath"
+
+	agentsExpr "goa.design/goa-ai/expr/agent"
+	"goa.design/goa-ai/runtime/agent/catalog"
+	"goa.design/goa-ai/runtime/agent/tools"
+	"goa.design/goa-ai/runtime/toolremote"
+	"goa.design/goa/v3/codegen"
+)
+
+// toolRemoteFileData is the template input for a toolset remote executor.
+type toolRemoteFileData struct {
+	// Toolset is the qualified toolset name.
+	Toolset string
+	// SpecsAlias is the import alias of the toolset specs package.
+	SpecsAlias string
+}
+
+// toolsetRemoteFiles emits `remote/openapi.json` and `remote/executor.go` next
+// to the specs package of a local toolset. The OpenAPI document is the
+// language-neutral executor contract of the toolset (see package
+// runtime/toolremote); the generated package (remote<specs package>) embeds
+// it and exposes NewExecutor, which forwards tool calls to an out-of-process
+// implementation over a toolremote.Transport. Agent-exported, MCP, and
+// registry-backed toolsets already execute elsewhere and emit nothing.
+func toolsetRemoteFiles(ts *ToolsetData, entries []*toolEntry) ([]*codegen.File, error) {
+	if ts == nil || ts.SpecsImportPath == "" || len(entries) == 0 {
+		return nil, nil
+	}
+	if ts.Kind == ToolsetKindExported || ts.IsRegistryBacked {
+		return nil, nil
+	}
+	if ts.Expr != nil && ts.Expr.Provider != nil && ts.Expr.Provider.Kind != agentsExpr.ProviderLocal {
+		return nil, nil
+	}
+	specs := make([]tools.ToolSpec, 0, len(entries))
+	for _, t := range entries {
+		specs = append(specs, catalogToolSpec(t))
+	}
+	contract, err := toolremote.Contract(catalog.ToolsetSource{
+		ID:          ts.QualifiedName,
+		Service:     ts.SourceServiceName,
+		Description: ts.Description,
+		Package:     ts.SpecsImportPath,
+		Specs:       specs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("remote contract for toolset %q: %w", ts.QualifiedName, err)
+	}
+	contract = append(contract, '\n')
+
+	scope := codegen.NewNameScope()
+	for _, name := range []string{"embed", "runtime", "toolremote", "executor"} {
+		scope.Unique(name)
+	}
+	specsAlias := scope.Unique(ts.SpecsPackageName, "specs")
+	imports := []*codegen.ImportSpec{
+		{Path: "embed", Name: "_"},
+		{Path: "goa.design/goa-ai/runtime/agent/runtime"},
+		{Path: "goa.design/goa-ai/runtime/toolremote"},
+		{Path: "goa.design/goa-ai/runtime/toolremote/executor"},
+		{Path: ts.SpecsImportPath, Name: specsAlias},
+	}
+	dir := filepath.Join(ts.SpecsDir, "remote")
+	return []*codegen.File{
+		{
+			Path: filepath.Join(dir, "openapi.json"),
+			SectionTemplates: []*codegen.SectionTemplate{
+				{Name: "tool-remote-contract", Source: "{{ . }}", Data: string(contract)},
+			},
+		},
+		{
+			Path: filepath.Join(dir, "executor.go"),
+			SectionTemplates: []*codegen.SectionTemplate{
+				codegen.Header(ts.Name+" remote executor", "remote"+ts.SpecsPackageName, imports),
+				{
+					Name:    "tool-remote",
+					Source:  agentsTemplates.Read(toolRemoteFileT),
+					Data:    toolRemoteFileData{Toolset: ts.QualifiedName, SpecsAlias: specsAlias},
+					FuncMap: templateFuncMap(),
+				},
+			},
+		},
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"goa.design/goa-ai/codegen/shared"
+	agentsExpr "goa.design/goa-ai/expr/agent"
 	"goa.design/goa/v3/codegen"
 )
 
@@ -43,6 +44,9 @@ type toolProviderFileData struct {
 //   - `unions.go` for sum-type unions referenced by the tool types (when any)
 //   - `codecs.go` for canonical JSON encoding/decoding and validation helpers
 //   - `specs.go` for runtime tool discovery metadata and schemas
+//   - `mocks/executor.go` for a typed mock executor used in agent unit tests
+//   - `remote/openapi.json` and `remote/executor.go` for out-of-process
+//     executors of local toolsets
 //   - `transforms.go` when method-backed tools can be adapted via GoTransform
 //
 // Registry-backed toolsets are handled separately and emit only `specs.go`
@@ -250,7 +254,11 @@ func toolsetSpecsFiles(data *GeneratorData) []*codegen.File {
 			out = append(out, &codegen.File{Path: filepath.Join(ts.SpecsDir, "codecs.go"), SectionTemplates: codecsSections})
 			// specs.go
 			hasServerData := toolEntriesHaveServerData(specsData.tools)
-			specImports := make([]*codegen.ImportSpec, 0, 4)
+			hasProgress := toolEntriesHaveProgress(specsData.tools)
+			specImports := make([]*codegen.ImportSpec, 0, 6)
+			if hasProgress {
+				specImports = append(specImports, codegen.SimpleImport("context"))
+			}
 			if hasServerData {
 				specImports = append(specImports, codegen.SimpleImport("fmt"))
 			}
@@ -258,6 +266,9 @@ func toolsetSpecsFiles(data *GeneratorData) []*codegen.File {
 				&codegen.ImportSpec{Path: "goa.design/goa-ai/runtime/agent/policy"},
 				&codegen.ImportSpec{Path: "goa.design/goa-ai/runtime/agent/tools"},
 			)
+			if hasProgress {
+				specImports = append(specImports, &codegen.ImportSpec{Path: "goa.design/goa-ai/runtime/agent/runtime", Name: "agentsruntime"})
+			}
 			if hasServerData {
 				specImports = append(specImports, &codegen.ImportSpec{Path: "goa.design/goa-ai/runtime/toolserverdata"})
 			}
@@ -266,6 +277,25 @@ func toolsetSpecsFiles(data *GeneratorData) []*codegen.File {
 				{Name: "tool-specs", Source: agentsTemplates.Read(toolSpecFileT), Data: toolSpecFileData{PackageName: ts.SpecsPackageName, Tools: specsData.tools, Types: specsData.typesList(), RequiredLabels: ts.RequiredLabels}, FuncMap: templateFuncMap()},
 			}
 			out = append(out, &codegen.File{Path: filepath.Join(ts.SpecsDir, "specs.go"), SectionTemplates: specSections})
+			// mocks/executor.go: typed mock executor for agent unit tests,
+			// unless disabled with DisableToolsetMocks.
+			if !agentsExpr.Root.DisableToolsetMocks {
+				if f := toolsetMockFile(ts, specsData.tools); f != nil {
+					out = append(out, f)
+				}
+			}
+			// remote/: executor contract and out-of-process executor adapter,
+			// unless disabled with DisableRemoteExecutors.
+			if !agentsExpr.Root.DisableRemoteExecutors {
+				remoteFiles, err := toolsetRemoteFiles(ts, specsData.tools)
+				if err != nil {
+					// A contract that cannot be built means the tool schemas
+					// are broken; fail generation like the schema catalogue
+					// does.
+					panic(fmt.Errorf("goa-ai: %w", err))
+				}
+				out = append(out, remoteFiles...)
+			}
 			// inject.go: compiled Inject() population, shared by every topology
 			// that executes this toolset's tools.
 			if toolsNeedInject(ts.Tools) {
@@ -304,6 +334,17 @@ func toolEntriesHaveServerData(tools []*toolEntry) bool {
 	return false
 }
 
+// toolEntriesHaveProgress reports whether any tool declares a progress type and
+// thus gets a typed Report<Tool>Progress helper.
+func toolEntriesHaveProgress(tools []*toolEntry) bool {
+	for _, tool := range tools {
+		if tool.Progress != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func toolsetProviderFile(genpkg string, ts *ToolsetData) *codegen.File {
 	if ts == nil || ts.SpecsDir == "" || ts.SourceService == nil || ts.IsRegistryBacked {
 		return nil
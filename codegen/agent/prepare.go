@@ -66,14 +66,17 @@ func Prepare(_ string, _ []eval.Root) error {
 					t.Args = flattenAndHide(t.Args, t.InjectedFields)
 				}
 
-				// Walk Args and Return shapes only. Goa will generate method
-				// payloads and results as part of service generation.
+				// Walk Args, Return, and Progress shapes only. Goa will generate
+				// method payloads and results as part of service generation.
 				if err := collectAndForceTypes(argsForForce, existingByID, existingByName); err != nil {
 					return err
 				}
 				if err := collectAndForceTypes(returnForForce, existingByID, existingByName); err != nil {
 					return err
 				}
+				if err := collectAndForceTypes(t.Progress, existingByID, existingByName); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -236,8 +236,10 @@ func registryClientOptionsFile(data *RegistryClientData) *codegen.File {
 	}
 
 	imports := []*codegen.ImportSpec{
+		{Path: "crypto/tls"},
 		{Path: "net/http"},
 		{Path: "time"},
+		{Path: "goa.design/goa-ai/runtime/toolregistry"},
 	}
 
 	sections := []*codegen.SectionTemplate{
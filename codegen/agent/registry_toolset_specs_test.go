@@ -3,6 +3,7 @@ package codegen_test
 import (
 	"bytes"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -134,7 +135,29 @@ func TestRegistryToolsetSpecsMetadata(t *testing.T) {
 	require.Contains(t, specsContent, "\"test-registry\"")
 	require.Contains(t, specsContent, "\"enterprise-tools\"")
 	require.Contains(t, specsContent, "\"1.2.3\"")
+	require.Contains(t, specsContent, `const ToolsetRef = ToolsetName + "@" + Version`)
+	require.Contains(t, specsContent, "client.GetToolset(ctx, ToolsetRef)")
 	require.Contains(t, specsContent, "BudgetClass: policy.ToolBudgetClassBudgeted")
+
+	var registryContent string
+	for _, f := range files {
+		if filepath.Base(f.Path) != "registry.go" {
+			continue
+		}
+		var buf bytes.Buffer
+		for _, s := range f.SectionTemplates {
+			require.NoError(t, s.Write(&buf))
+		}
+		if strings.Contains(buf.String(), "func RegisterUsedToolsets(") {
+			registryContent = buf.String()
+			break
+		}
+	}
+	require.NotEmpty(t, registryContent, "expected generated agent registry.go")
+	require.Contains(t, registryContent, `"goa.design/goa-ai/runtime/toolregistry"`)
+	require.Contains(t, registryContent, "toolregistry.WithToolsetRef(ctx, ")
+	require.Contains(t, registryContent, ".ToolsetName, ")
+	require.Contains(t, registryContent, ".ToolsetRef)")
 }
 
 // TestRegistryToolsetSpecsGeneratorData verifies generator data identifies registry toolsets.
@@ -46,6 +46,13 @@ func buildToolSpecsDataFor(genpkg string, svc *service.Data, tools []*ToolData)
 		if err != nil {
 			return nil, err
 		}
+		var progress *typeData
+		if tool.Progress != nil && tool.Progress.Type != goaexpr.Empty {
+			progress, err = builder.typeFor(owner, tool.Progress, usageProgress)
+			if err != nil {
+				return nil, err
+			}
+		}
 		serverDataEntries, err := serverDataEntriesForTool(tool, builder)
 		if err != nil {
 			return nil, err
@@ -68,9 +75,17 @@ func buildToolSpecsDataFor(genpkg string, svc *service.Data, tools []*ToolData)
 			ExportingAgentID:  tool.ExportingAgentID,
 			Payload:           payload,
 			Result:            result,
+			Progress:          progress,
 			Bounds:            tool.Bounds,
 			TerminalRun:       tool.TerminalRun,
 			Bookkeeping:       tool.Bookkeeping,
+			ReadOnly:          tool.ReadOnly,
+			Idempotent:        tool.Idempotent,
+			Destructive:       tool.Destructive,
+			Timeout:           tool.Timeout,
+			Retry:             tool.Retry,
+			Cache:             tool.Cache,
+			Availability:      tool.Availability,
 			ResultReminder:    tool.ResultReminder,
 			Confirmation:      tool.Confirmation,
 		}
@@ -166,6 +181,7 @@ func (d *toolSpecsData) addTool(entry *toolEntry) {
 	d.tools = append(d.tools, entry)
 	d.addType(entry.Payload)
 	d.addType(entry.Result)
+	d.addType(entry.Progress)
 	for _, sd := range entry.ServerData {
 		if sd == nil {
 			continue
@@ -150,6 +150,8 @@ func stableTypeKey(owner *contractTypeOwner, usage typeUsage, qualifier string)
 		tn += "Payload"
 	case usageResult:
 		tn += "Result"
+	case usageProgress:
+		tn += "Progress"
 	case usageSidecar:
 		if qualifier != "" {
 			tn += codegen.Goify(qualifier, true)
@@ -77,6 +77,8 @@ func (b *toolSpecBuilder) buildTypeInfo(owner *contractTypeOwner, att *goaexpr.A
 		typeName += "Payload"
 	case usageResult:
 		typeName += "Result"
+	case usageProgress:
+		typeName += "Progress"
 	case usageSidecar:
 		if qualifier != "" {
 			typeName += codegen.Goify(qualifier, true)
@@ -136,13 +138,13 @@ func (b *toolSpecBuilder) buildTypeInfo(owner *contractTypeOwner, att *goaexpr.A
 	// Determine pointer semantics for top-level alias/value.
 	aliasIsPointer := strings.Contains(defLine, "= *")
 	ptr := aliasIsPointer || strings.HasPrefix(fullRef, "*")
-	// Payloads and object-shaped results/sidecars use pointer codecs so decode
+	// Payloads and object-shaped results/progress/sidecars use pointer codecs so decode
 	// paths can validate the tagged transport shape before transforming into the
 	// public local type.
 	if usage == usagePayload {
 		ptr = true
 	}
-	if (usage == usageResult || usage == usageSidecar) && goaexpr.AsObject(baseAttr.Type) != nil {
+	if (usage == usageResult || usage == usageProgress || usage == usageSidecar) && goaexpr.AsObject(baseAttr.Type) != nil {
 		ptr = true
 	}
 
@@ -287,7 +289,7 @@ func (b *toolSpecBuilder) buildTypeInfo(owner *contractTypeOwner, att *goaexpr.A
 		ValidateFunc:                 "",
 		FullRef:                      fullRef,
 		NeedType:                     defLine != "",
-		IsToolType:                   usage == usagePayload || usage == usageResult || usage == usageSidecar,
+		IsToolType:                   usage == usagePayload || usage == usageResult || usage == usageProgress || usage == usageSidecar,
 		PublicType:                   dst,
 		NilError:                     fmt.Sprintf("%s is nil", lowerCamel(typeName)),
 		DecodeError:                  fmt.Sprintf("decode %s", lowerCamel(typeName)),
@@ -1,6 +1,8 @@
 package codegen
 
 import (
+	"time"
+
 	"goa.design/goa/v3/codegen"
 	"goa.design/goa/v3/codegen/service"
 	goaexpr "goa.design/goa/v3/expr"
@@ -84,6 +86,9 @@ type (
 		Payload *typeData
 		// Type metadata for the tool's output result.
 		Result *typeData
+		// Type metadata for the tool's progress updates. Nil when the tool
+		// declares no progress.
+		Progress *typeData
 		// Bounds declares the out-of-band bounded-result contract for this tool.
 		// It is propagated into ToolSpec for runtime consumers.
 		Bounds *ToolBoundsData
@@ -93,6 +98,21 @@ type (
 		// Bookkeeping indicates this tool is a bookkeeping tool (status / findings /
 		// terminal commits) that does not consume the run-level retrieval budget.
 		Bookkeeping bool
+		// ReadOnly indicates the tool does not modify its environment.
+		ReadOnly bool
+		// Idempotent indicates repeated calls with the same arguments have no
+		// additional effect.
+		Idempotent bool
+		// Destructive indicates the tool may perform irreversible updates.
+		Destructive bool
+		// Timeout bounds one execution attempt of the tool.
+		Timeout time.Duration
+		// Retry configures how failed executions of the tool are retried.
+		Retry *ToolRetryData
+		// Cache configures runtime caching of the tool results.
+		Cache *ToolCacheData
+		// Availability gates the tool on run state.
+		Availability *ToolAvailabilityData
 		// ResultReminder is an optional system reminder injected into the
 		// conversation after the tool result is returned.
 		ResultReminder string
@@ -230,7 +250,8 @@ type (
 		// Whether to generate a type definition.
 		NeedType bool
 		// IsToolType is true when this entry represents a top-level tool-facing
-		// payload/result/sidecar type (not a nested helper type or JSON helper).
+		// payload/result/progress/sidecar type (not a nested helper type or JSON
+		// helper).
 		IsToolType bool
 		// Import spec for the type's package (when aliasing external types).
 		Import *codegen.ImportSpec
@@ -303,7 +324,8 @@ const (
 	contractTypeOwnerTool       contractTypeOwnerKind = "tool"
 	contractTypeOwnerCompletion contractTypeOwnerKind = "completion"
 
-	usagePayload typeUsage = "payload"
-	usageResult  typeUsage = "result"
-	usageSidecar typeUsage = "sidecar"
+	usagePayload  typeUsage = "payload"
+	usageResult   typeUsage = "result"
+	usageProgress typeUsage = "progress"
+	usageSidecar  typeUsage = "sidecar"
 )
@@ -25,8 +25,10 @@ const (
 	serviceExecutorFileT       = "service_executor"
 	toolCodecsFileT            = "tool_codecs"
 	toolInjectFileT            = "tool_inject"
+	toolMockFileT              = "tool_mock"
 	toolSpecFileT              = "tool_spec"
 	toolProviderFileT          = "tool_provider"
+	toolRemoteFileT            = "tool_remote"
 	toolSpecsAggregateT        = "specs_aggregate"
 	toolTransformsFileT        = "tool_transforms"
 	toolTransportTypesFileT    = "tool_transport_types"
@@ -67,6 +67,13 @@ func Register{{ .StructName }}(ctx context.Context, rt *agentsruntime.Runtime, c
         {{- else }}
         Specs: nil,
         {{- end }}
+        {{- if .Handoffs }}
+        Handoffs: []planner.HandoffTarget{
+            {{- range .Handoffs }}
+            {Agent: {{ printf "%q" .ID }}, Description: {{ printf "%q" .Description }}},
+            {{- end }}
+        },
+        {{- end }}
         Policy: agentsruntime.RunPolicy{
 {{- if gt .RunPolicy.Caps.MaxToolCalls 0 }}
             MaxToolCalls: {{ .RunPolicy.Caps.MaxToolCalls }},
@@ -234,6 +241,11 @@ func RegisterUsedToolsets(ctx context.Context, rt *agentsruntime.Runtime, opts .
                 if call == nil {
                     return nil, fmt.Errorf("tool request is nil")
                 }
+                {{- if .IsRegistryBacked }}
+                // Route calls through the reference discovery used so a
+                // pinned version serves both.
+                ctx = toolregistry.WithToolsetRef(ctx, {{ .SpecsPackageName }}.ToolsetName, {{ .SpecsPackageName }}.ToolsetRef)
+                {{- end }}
                 meta := &agentsruntime.ToolCallMeta{
                     RunID:            call.RunID,
                     SessionID:        call.SessionID,
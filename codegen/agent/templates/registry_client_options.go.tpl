@@ -94,6 +94,42 @@ func WithEndpoint(endpoint string) Option {
 	}
 }
 
+// WithTLSConfig sets the TLS configuration used to reach the registry, for
+// example a client certificate for a registry that authenticates callers with
+// mutual TLS (see toolregistry.ClientTLSConfig). It replaces any HTTP client
+// set by WithHTTPClient.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *Client) {
+		if cfg == nil {
+			return
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = cfg
+		c.httpClient = &http.Client{Transport: transport}
+	}
+}
+
+// WithTokenSource authenticates every request with a bearer token obtained
+// from src, so short-lived tokens refresh without rebuilding the client.
+func WithTokenSource(src toolregistry.TokenSource) Option {
+	return WithAuth(&tokenSourceAuth{source: src})
+}
+
+// tokenSourceAuth applies bearer tokens from a toolregistry.TokenSource.
+type tokenSourceAuth struct {
+	source toolregistry.TokenSource
+}
+
+// ApplyAuth implements AuthProvider.
+func (a *tokenSourceAuth) ApplyAuth(req *http.Request) error {
+	token, err := a.source.Token(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
 {{- if .SecuritySchemes }}
 {{- range .SecuritySchemes }}
 {{- if isAPIKey .Kind }}
@@ -35,6 +35,18 @@ const ToolsetName = {{ printf "%q" .Registry.ToolsetName }}
 
 // Version is the pinned version for this toolset.
 const Version = {{ printf "%q" .Registry.Version }}
+
+// ToolsetRef is the registry reference used to discover and call this
+// toolset. It pins Version so the registry routes to that side-by-side
+// version, or to a plain-name registration declaring a matching Version. The
+// generated RegisterUsedToolsets applies it to every call of this toolset.
+const ToolsetRef = ToolsetName + "@" + Version
+{{- else }}
+
+// ToolsetRef is the registry reference used to discover and call this
+// toolset. Without a pinned version the registry routes to the toolset's
+// default or canary-weighted version.
+const ToolsetRef = ToolsetName
 {{- end }}
 
 // Specs holds the tool specifications discovered from the registry.
@@ -55,12 +67,12 @@ var (
 // The function is safe to call multiple times; subsequent calls will refresh
 // the cached specifications.
 func DiscoverAndPopulate(ctx context.Context, client RegistryClient) error {
-	toolset, err := client.GetToolset(ctx, ToolsetName)
+	toolset, err := client.GetToolset(ctx, ToolsetRef)
 	if err != nil {
-		return fmt.Errorf("discover toolset %q from registry %q: %w", ToolsetName, RegistryName, err)
+		return fmt.Errorf("discover toolset %q from registry %q: %w", ToolsetRef, RegistryName, err)
 	}
 	if toolset == nil {
-		return fmt.Errorf("toolset %q not found in registry %q", ToolsetName, RegistryName)
+		return fmt.Errorf("toolset %q not found in registry %q", ToolsetRef, RegistryName)
 	}
 
 	mu.Lock()
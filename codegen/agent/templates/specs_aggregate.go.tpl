@@ -23,6 +23,15 @@ var (
             {{- end }}
             },
             BudgetClass: policy.ToolBudgetClass{{ if .Bookkeeping }}Bookkeeping{{ else }}Budgeted{{ end }},
+            {{- if .ReadOnly }}
+            ReadOnly: true,
+            {{- end }}
+            {{- if .Idempotent }}
+            Idempotent: true,
+            {{- end }}
+            {{- if .Destructive }}
+            Destructive: true,
+            {{- end }}
         },
         {{- end }}
     {{- end }}
@@ -125,6 +134,15 @@ func MetadataByName(name tools.Ident) (policy.ToolMetadata, bool) {
             {{- end }}
             },
             BudgetClass: policy.ToolBudgetClass{{ if .Bookkeeping }}Bookkeeping{{ else }}Budgeted{{ end }},
+            {{- if .ReadOnly }}
+            ReadOnly: true,
+            {{- end }}
+            {{- if .Idempotent }}
+            Idempotent: true,
+            {{- end }}
+            {{- if .Destructive }}
+            Destructive: true,
+            {{- end }}
         }, true
         {{- end }}
     {{- end }}
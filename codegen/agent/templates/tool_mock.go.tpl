@@ -0,0 +1,98 @@
+type (
+	// Executor is a mock runtime.ToolCallExecutor for the {{ .Toolset }} toolset.
+	// Register per-tool expectations with the Add<Tool> (consumed once, in
+	// order) and Set<Tool> (used for every call) methods. Calls without a
+	// matching expectation fail the test.
+	Executor struct {
+		m *mock.Mock
+		t testing.TB
+	}
+{{- range .Tools }}
+
+	// {{ .GoName }}Func handles one {{ .Name }} call.
+	{{ .GoName }}Func func(ctx context.Context{{ if .Payload }}, p {{ if .Payload.Pointer }}*{{ end }}{{ $.SpecsAlias }}.{{ .Payload.TypeName }}{{ end }}) ({{ if .Result }}{{ if .Result.Pointer }}*{{ end }}{{ $.SpecsAlias }}.{{ .Result.TypeName }}, {{ end }}error)
+{{- end }}
+)
+
+// NewExecutor returns a mock executor for the {{ .Toolset }} toolset.
+func NewExecutor(t testing.TB) *Executor {
+	var (
+		m                          = &Executor{mock.New(), t}
+		_ runtime.ToolCallExecutor = m
+	)
+	return m
+}
+{{- range .Tools }}
+
+// Add{{ .GoName }} appends an expectation for the next {{ .Name }} call.
+func (m *Executor) Add{{ .GoName }}(f {{ .GoName }}Func) {
+	m.m.Add(string({{ $.SpecsAlias }}.{{ .ConstName }}), f)
+}
+
+// Set{{ .GoName }} sets the handler used for {{ .Name }} calls once added
+// expectations are consumed.
+func (m *Executor) Set{{ .GoName }}(f {{ .GoName }}Func) {
+	m.m.Set(string({{ $.SpecsAlias }}.{{ .ConstName }}), f)
+}
+{{- end }}
+
+// HasMore reports whether some added expectations were not consumed.
+func (m *Executor) HasMore() bool {
+	return m.m.HasMore()
+}
+
+// Execute decodes the call payload with the generated codec and dispatches it
+// to the next expectation registered for the tool. Errors returned by an
+// expectation become tool failures; errors implementing
+// planner.ToolFailureProvider keep their own classification.
+func (m *Executor) Execute(ctx context.Context, _ *runtime.ToolCallMeta, call *planner.ToolRequest) (*runtime.ToolExecutionResult, error) {
+	f := m.m.Next(string(call.Name))
+	if f == nil {
+		m.t.Helper()
+		m.t.Errorf("unexpected %s call", call.Name)
+		return runtime.Executed(failedMockResult(call.Name, fmt.Errorf("unexpected %s call", call.Name))), nil
+	}
+	switch call.Name {
+{{- range .Tools }}
+	case {{ $.SpecsAlias }}.{{ .ConstName }}:
+	{{- if .Payload }}
+		p, err := {{ $.SpecsAlias }}.{{ .Payload.ExportedCodec }}.FromJSON(call.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s payload: %w", call.Name, err)
+		}
+	{{- end }}
+	{{- if .Result }}
+		res, err := f.({{ .GoName }}Func)(ctx{{ if .Payload }}, p{{ end }})
+		if err != nil {
+			return runtime.Executed(failedMockResult(call.Name, err)), nil
+		}
+		return runtime.Executed(&planner.ToolResult{Name: call.Name, Result: res}), nil
+	{{- else }}
+		if err := f.({{ .GoName }}Func)(ctx{{ if .Payload }}, p{{ end }}); err != nil {
+			return runtime.Executed(failedMockResult(call.Name, err)), nil
+		}
+		return runtime.Executed(&planner.ToolResult{Name: call.Name}), nil
+	{{- end }}
+{{- end }}
+	default:
+		return nil, fmt.Errorf("unknown tool %q for toolset %q", call.Name, {{ printf "%q" .Toolset }})
+	}
+}
+
+// failedMockResult converts an expectation error into a tool failure.
+func failedMockResult(name tools.Ident, err error) *planner.ToolResult {
+	var provider planner.ToolFailureProvider
+	if errors.As(err, &provider) {
+		return &planner.ToolResult{Name: name, Failure: planner.CloneToolFailure(provider.ToolFailure(name))}
+	}
+	return &planner.ToolResult{
+		Name: name,
+		Failure: &planner.ToolFailure{
+			Kind:  planner.FailureDomainRejection,
+			Error: planner.ToolErrorFromError(err),
+			Recovery: planner.RecoveryDirective{
+				Action: planner.RecoveryReplan,
+			},
+		},
+	}
+}
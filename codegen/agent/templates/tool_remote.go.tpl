@@ -0,0 +1,15 @@
+// Contract is the OpenAPI 3.1 executor contract of the {{ .Toolset }} toolset.
+// Out-of-process executors implement it over HTTP/JSON, or serve the
+// payload and result documents it describes through the gRPC ToolExecutor
+// service of goa.design/goa-ai/runtime/toolremote/toolremote.proto.
+//
+//go:embed openapi.json
+var Contract []byte
+
+// NewExecutor returns a runtime.ToolCallExecutor that forwards {{ .Toolset }}
+// tool calls to an out-of-process executor through transport. Payloads and
+// results are validated with the generated codecs on this side of the
+// transport.
+func NewExecutor(transport toolremote.Transport) runtime.ToolCallExecutor {
+	return executor.New(transport, executor.SpecFunc({{ .SpecsAlias }}.Spec))
+}
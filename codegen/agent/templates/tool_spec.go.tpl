@@ -44,6 +44,15 @@ var (
         {{- if .Bookkeeping }}
         Bookkeeping: true,
         {{- end }}
+        {{- if .ReadOnly }}
+        ReadOnly: true,
+        {{- end }}
+        {{- if .Idempotent }}
+        Idempotent: true,
+        {{- end }}
+        {{- if .Destructive }}
+        Destructive: true,
+        {{- end }}
         {{- if .Bounds }}
         Bounds: &tools.BoundsSpec{
             {{- if .Bounds.Paging }}
@@ -90,6 +99,36 @@ var (
         {{- if .ResultReminder }}
         ResultReminder: {{ printf "%q" .ResultReminder }},
         {{- end }}
+        {{- if .Timeout }}
+        Timeout: {{ printf "%d" .Timeout }}, // {{ .Timeout.String }}
+        {{- end }}
+        {{- if .Retry }}
+        Retry: &tools.RetrySpec{
+            MaxAttempts: {{ .Retry.MaxAttempts }},
+            {{- if .Retry.Backoff }}
+            Backoff: {{ printf "%d" .Retry.Backoff }}, // {{ .Retry.Backoff.String }}
+            {{- end }}
+            {{- if .Retry.RetryOn }}
+            RetryOn: []string{ {{- range $i, $k := .Retry.RetryOn }}{{ if $i }}, {{ end }}{{ printf "%q" $k }}{{ end -}} },
+            {{- end }}
+        },
+        {{- end }}
+        {{- if .Cache }}
+        Cache: &tools.CacheSpec{
+            TTL: {{ printf "%d" .Cache.TTL }}, // {{ .Cache.TTL.String }}
+            Scope: tools.CacheScope({{ printf "%q" .Cache.Scope }}),
+        },
+        {{- end }}
+        {{- if .Availability }}
+        Availability: &tools.AvailabilitySpec{
+            {{- if .Availability.ToolSucceeded }}
+            ToolSucceeded: []tools.Ident{ {{- range $i, $n := .Availability.ToolSucceeded }}{{ if $i }}, {{ end }}{{ printf "%q" $n }}{{ end -}} },
+            {{- end }}
+            {{- if .Availability.Labels }}
+            Labels: map[string]string{ {{- range $k, $v := .Availability.Labels }}{{ printf "%q" $k }}: {{ printf "%q" $v }}, {{ end -}} },
+            {{- end }}
+        },
+        {{- end }}
         {{- if .Confirmation }}
         Confirmation: &tools.ConfirmationSpec{
             Title: {{ printf "%q" .Confirmation.Title }},
@@ -130,6 +169,16 @@ var (
             Codec:  tools.JSONCodec[any]{},
             {{- end }}
         },
+        {{- if .Progress }}
+        Progress: &tools.TypeSpec{
+            Name: {{ printf "%q" .Progress.TypeName }},
+            Schema: {{- if gt (len .Progress.SchemaJSON) 0 }}tools.RawJSON({{ printf "%q" .Progress.SchemaJSON }}){{ else }}nil{{ end }},
+            SchemaWithoutRootExample: {{- if gt (len .Progress.SchemaWithoutRootExampleJSON) 0 }}tools.RawJSON({{ printf "%q" .Progress.SchemaWithoutRootExampleJSON }}){{ else }}nil{{ end }},
+            FieldDescriptions: {{- if .Progress.FieldDescs }}{{ .Progress.TypeName }}FieldDescs{{ else }}nil{{ end }},
+            FieldJSONTypes: {{- if .Progress.FieldJSONTypes }}{{ .Progress.TypeName }}FieldJSONTypes{{ else }}nil{{ end }},
+            Codec:  {{ .Progress.GenericCodec }},
+        },
+        {{- end }}
     }
 {{- end }}
 )
@@ -148,6 +197,19 @@ var {{ .TypedToolVar }} = tools.TypedTool[{{ if .Payload.Pointer }}*{{ end }}{{
 {{- end }}
 {{- end }}
 
+{{- range .Tools }}
+{{- if .Progress }}
+
+// Report{{ .GoName }}Progress publishes p as a progress update of the
+// {{ .Name }} call executing in ctx. It is the typed form of
+// ReportToolProgress in goa.design/goa-ai/runtime/agent/runtime and is a no-op
+// outside a tool call executed by the runtime.
+func Report{{ .GoName }}Progress(ctx context.Context, p {{ if .Progress.Pointer }}*{{ end }}{{ .Progress.FullRef }}) error {
+    return agentsruntime.ReportToolProgress(ctx, p)
+}
+{{- end }}
+{{- end }}
+
 {{- range .Tools }}
 {{- if .ServerData }}
 
@@ -196,6 +258,15 @@ var (
             {{- end }}
             },
             BudgetClass: policy.ToolBudgetClass{{ if .Bookkeeping }}Bookkeeping{{ else }}Budgeted{{ end }},
+            {{- if .ReadOnly }}
+            ReadOnly: true,
+            {{- end }}
+            {{- if .Idempotent }}
+            Idempotent: true,
+            {{- end }}
+            {{- if .Destructive }}
+            Destructive: true,
+            {{- end }}
         },
     {{- end }}
     }
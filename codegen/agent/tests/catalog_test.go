@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"goa.design/goa-ai/codegen/agent/tests/testscenarios"
+	"goa.design/goa-ai/runtime/agent/catalog"
+)
+
+// The design catalogue lists every toolset with schemas addressed by stable ids.
+func TestCatalog_Minimal(t *testing.T) {
+	files := buildAndGenerate(t, testscenarios.ToolSpecsMinimal())
+	doc, err := catalog.Parse([]byte(fileContent(t, files, "gen/catalog/openapi.json")))
+	require.NoError(t, err)
+
+	assert.Equal(t, "calc", doc.Info.Title)
+	require.Len(t, doc.Toolsets, 1)
+	ts := doc.Toolsets[0]
+	assert.Equal(t, "helpers", ts.ID)
+	require.Len(t, ts.Tools, 1)
+	tool := ts.Tools[0]
+	assert.Equal(t, "helpers.summarize_doc", tool.ID)
+	require.NotNil(t, tool.Payload)
+	assert.Equal(t, "urn:goa-ai:tool:helpers.summarize_doc:payload", tool.Payload.Ref)
+	assert.True(t, strings.HasSuffix(tool.Payload.GoType, "/calc/toolsets/helpers.SummarizeDocPayload"), tool.Payload.GoType)
+	assert.Contains(t, doc.Components.Schemas, catalog.SchemaKey(tool.Payload.Ref))
+	assert.Contains(t, doc.Components.Schemas, catalog.SchemaKey(tool.Result.Ref))
+}
@@ -0,0 +1,31 @@
+package testscenarios
+
+import (
+	. "goa.design/goa-ai/dsl"
+	. "goa.design/goa/v3/dsl"
+)
+
+// ToolAvailability returns a DSL design function for tools gated on run state.
+func ToolAvailability() func() {
+	return func() {
+		API("shop", func() {})
+		var CartArgs = Type("CartArgs", func() {
+			Attribute("cart_id", String, "Cart identifier")
+			Required("cart_id")
+		})
+		Service("shop", func() {
+			Agent("clerk", "Checkout helper", func() {
+				Use("checkout", func() {
+					Tool("validate_cart", "Validate the cart", func() {
+						Args(CartArgs)
+					})
+					Tool("submit_order", "Submit the cart", func() {
+						Args(CartArgs)
+						AvailableWhen(ToolSucceeded("validate_cart"))
+						RequiresLabel("tier", "admin")
+					})
+				})
+			})
+		})
+	}
+}
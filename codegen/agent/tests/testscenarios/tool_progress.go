@@ -0,0 +1,40 @@
+package testscenarios
+
+import (
+	. "goa.design/goa-ai/dsl"
+	. "goa.design/goa/v3/dsl"
+)
+
+// ToolProgress returns a DSL design function for a tool that reports typed
+// progress while it executes.
+func ToolProgress() func() {
+	return func() {
+		API("reports", func() {})
+		var ExportArgs = Type("ExportArgs", func() {
+			Attribute("query", String, "Row filter")
+			Required("query")
+		})
+		Service("reports", func() {
+			Agent("exporter", "Export helper", func() {
+				Use("exports", func() {
+					Tool("export_rows", "Export matching rows", func() {
+						Args(ExportArgs)
+						Return(func() {
+							Attribute("url", String, "Download URL")
+							Required("url")
+						})
+						Progress(func() {
+							Attribute("processed", Int, "Rows processed so far")
+							Attribute("total", Int, "Total rows to process")
+							Required("processed")
+						})
+					})
+					Tool("count_rows", "Count matching rows", func() {
+						Args(ExportArgs)
+						Return(Int)
+					})
+				})
+			})
+		})
+	}
+}
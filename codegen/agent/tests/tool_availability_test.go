@@ -0,0 +1,21 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"goa.design/goa-ai/codegen/agent/tests/testscenarios"
+)
+
+// Availability conditions compile into qualified tool specs.
+func TestToolAvailability(t *testing.T) {
+	files := buildAndGenerate(t, testscenarios.ToolAvailability())
+
+	specs := fileContent(t, files, "gen/shop/toolsets/checkout/specs.go")
+	assert.Contains(t, specs, "Availability: &tools.AvailabilitySpec{")
+	assert.Contains(t, specs, `ToolSucceeded: []tools.Ident{"checkout.validate_cart"}`)
+	assert.Contains(t, specs, `map[string]string{"tier": "admin"}`)
+	assert.Equal(t, 1, strings.Count(specs, "AvailabilitySpec"))
+}
@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"goa.design/goa-ai/codegen/agent/tests/testscenarios"
+	. "goa.design/goa-ai/dsl"
+	goadsl "goa.design/goa/v3/dsl"
+)
+
+// Every toolset emits a typed mock executor next to its specs package.
+func TestToolMocks_Minimal(t *testing.T) {
+	files := buildAndGenerate(t, testscenarios.ToolSpecsMinimal())
+	mocks := fileContent(t, files, "gen/calc/toolsets/helpers/mocks/executor.go")
+	assert.Contains(t, mocks, "package mockhelpers")
+	assert.Contains(t, mocks, "SummarizeDocFunc func(ctx context.Context, p *helpers.SummarizeDocPayload) (*helpers.SummarizeDocResult, error)")
+	assert.Contains(t, mocks, "func NewExecutor(t testing.TB) *Executor")
+	assert.Contains(t, mocks, "func (m *Executor) AddSummarizeDoc(f SummarizeDocFunc)")
+	assert.Contains(t, mocks, "func (m *Executor) SetSummarizeDoc(f SummarizeDocFunc)")
+	assert.Contains(t, mocks, "helpers.SummarizeDocPayloadCodec.FromJSON(call.Payload)")
+}
+
+// DisableToolsetMocks and DisableRemoteExecutors drop the mocks/ and remote/
+// packages and leave the specs package untouched.
+func TestToolMocks_Disabled(t *testing.T) {
+	files := buildAndGenerate(t, func() {
+		goadsl.API("calc", func() {
+			DisableToolsetMocks()
+			DisableRemoteExecutors()
+		})
+		goadsl.Service("calc", func() {
+			Agent("scribe", "Doc helper", func() {
+				Use("helpers", func() {
+					Tool("summarize_doc", "Summarize a document", func() {
+						Args(goadsl.String)
+						Return(goadsl.String)
+					})
+				})
+			})
+		})
+	})
+
+	assert.NotEmpty(t, fileContent(t, files, "gen/calc/toolsets/helpers/specs.go"))
+	for _, f := range files {
+		path := filepath.ToSlash(f.Path)
+		assert.False(t, strings.HasPrefix(path, "gen/calc/toolsets/helpers/mocks/"), "unexpected %s", path)
+		assert.False(t, strings.HasPrefix(path, "gen/calc/toolsets/helpers/remote/"), "unexpected %s", path)
+	}
+}
+
+// TestToolMocksDriveAgentRun compiles the generated mock executor together
+// with the generated agent package and runs the agent on the in-memory engine
+// with a plannertest.Script, the way application tests use them.
+func TestToolMocksDriveAgentRun(t *testing.T) {
+	files := buildWithPrepareAndPkg(t, "generated.local/gen", testscenarios.ToolSpecsMinimal())
+	root := writeGeneratedModuleKeepingGen(t, "generated.local", files)
+
+	writeGeneratedPackageTest(t, root, "gen/calc/agents/scribe/mock_run_test.go", `package scribe_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	scribe "generated.local/gen/calc/agents/scribe"
+	scribehelpers "generated.local/gen/calc/agents/scribe/helpers"
+	helpers "generated.local/gen/calc/toolsets/helpers"
+	mockhelpers "generated.local/gen/calc/toolsets/helpers/mocks"
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/planner/plannertest"
+	"goa.design/goa-ai/runtime/agent/runtime"
+)
+
+func TestScribeRunsWithMockExecutor(t *testing.T) {
+	ctx := context.Background()
+	rt := runtime.New()
+
+	exec := mockhelpers.NewExecutor(t)
+	exec.AddSummarizeDoc(func(_ context.Context, p *helpers.SummarizeDocPayload) (*helpers.SummarizeDocResult, error) {
+		if p.DocID != "d1" {
+			t.Errorf("DocID = %q, want %q", p.DocID, "d1")
+		}
+		return &helpers.SummarizeDocResult{Title: "Quarterly report"}, nil
+	})
+	script := plannertest.New(
+		plannertest.CallTools(scribehelpers.NewSummarizeDocCall(&scribehelpers.SummarizeDocPayload{DocID: "d1"})),
+		plannertest.Final("done"),
+	)
+
+	if err := scribe.RegisterUsedToolsets(ctx, rt, scribe.WithHelpersExecutor(exec)); err != nil {
+		t.Fatalf("register toolsets: %v", err)
+	}
+	if err := scribe.RegisterScribeAgent(ctx, rt, scribe.ScribeAgentConfig{Planner: script}); err != nil {
+		t.Fatalf("register agent: %v", err)
+	}
+
+	out, err := scribe.NewClient(rt).OneShotRun(ctx, []*model.Message{{
+		Role:  model.ConversationRoleUser,
+		Parts: []model.Part{model.TextPart{Text: "summarize d1"}},
+	}})
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if out.Final == nil || len(out.Final.Parts) != 1 || out.Final.Parts[0] != (model.TextPart{Text: "done"}) {
+		t.Fatalf("final = %+v, want the scripted response", out.Final)
+	}
+	if exec.HasMore() {
+		t.Fatal("summarize_doc expectation not consumed")
+	}
+	if script.HasMore() {
+		t.Fatal("script not played to the end")
+	}
+	turns := script.Turns()
+	if len(turns) != 2 || len(turns[1].ToolOutputs) != 1 {
+		t.Fatalf("turns = %d, want 2 with one tool output", len(turns))
+	}
+	output := turns[1].ToolOutputs[0]
+	if output.Name != helpers.SummarizeDoc || !strings.Contains(string(output.Result), "Quarterly report") {
+		t.Fatalf("tool output = %s %s, want the mock result", output.Name, output.Result)
+	}
+}
+`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	defer cancel()
+	runGeneratedGoTestCommand(t, root, exec.CommandContext(ctx, "go", "test", "-mod=mod", "-count=1",
+		"./gen/calc/agents/scribe", "./gen/calc/toolsets/helpers/mocks"))
+}
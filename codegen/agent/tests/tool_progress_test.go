@@ -0,0 +1,27 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"goa.design/goa-ai/codegen/agent/tests/testscenarios"
+)
+
+// Progress declarations compile into a typed progress spec and codec.
+func TestToolProgress(t *testing.T) {
+	files := buildAndGenerate(t, testscenarios.ToolProgress())
+
+	specs := fileContent(t, files, "gen/reports/toolsets/exports/specs.go")
+	assert.Contains(t, specs, "Progress: &tools.TypeSpec{")
+	assert.Regexp(t, `Name:\s+"ExportRowsProgress"`, specs)
+	assert.Regexp(t, `Codec:\s+exportRowsProgressCodec,`, specs)
+	assert.Equal(t, 1, strings.Count(specs, "Progress: &tools.TypeSpec{"))
+	assert.Contains(t, specs, "func ReportExportRowsProgress(ctx context.Context, p *ExportRowsProgress) error {")
+	assert.Contains(t, specs, "return agentsruntime.ReportToolProgress(ctx, p)")
+	assert.NotContains(t, specs, "func ReportCountRowsProgress(")
+
+	codecs := fileContent(t, files, "gen/reports/toolsets/exports/codecs.go")
+	assert.Regexp(t, `ExportRowsProgressCodec\s+= tools\.JSONCodec\[\*ExportRowsProgress\]\{`, codecs)
+}
@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"goa.design/goa-ai/codegen/agent/tests/testscenarios"
+	"goa.design/goa-ai/runtime/agent/catalog"
+)
+
+// Local toolsets emit an executor contract and a remote executor adapter.
+func TestToolRemote_Minimal(t *testing.T) {
+	files := buildAndGenerate(t, testscenarios.ToolSpecsMinimal())
+
+	exec := fileContent(t, files, "gen/calc/toolsets/helpers/remote/executor.go")
+	assert.Contains(t, exec, "package remotehelpers")
+	assert.Contains(t, exec, "//go:embed openapi.json")
+	assert.Contains(t, exec, "func NewExecutor(transport toolremote.Transport) runtime.ToolCallExecutor")
+	assert.Contains(t, exec, "executor.SpecFunc(helpers.Spec)")
+
+	var doc struct {
+		Paths      map[string]json.RawMessage `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(fileContent(t, files, "gen/calc/toolsets/helpers/remote/openapi.json")), &doc))
+	assert.Contains(t, doc.Paths, "/tools/helpers.summarize_doc")
+	assert.Contains(t, doc.Components.Schemas, catalog.SchemaKey("urn:goa-ai:tool:helpers.summarize_doc:payload"))
+}
@@ -70,6 +70,9 @@ type (
 		ResultType    string
 		InputSchema   string
 		ExampleArgs   string
+		ReadOnly      bool
+		Idempotent    bool
+		Destructive   bool
 	}
 
 	// ToolAdapter represents a tool adapter
@@ -85,6 +88,10 @@ type (
 		IsStreaming        bool
 		StreamInterface    string
 		StreamEventType    string
+		// Side-effect annotations advertised as MCP tool hints
+		ReadOnly    bool
+		Idempotent  bool
+		Destructive bool
 		// Simple validations (top-level only)
 		RequiredFields []string
 		EnumFields     map[string][]string
@@ -308,6 +315,9 @@ func (g *adapterGenerator) buildRegisterData(data *AdapterData) *RegisterData {
 			ResultType:    resultType,
 			InputSchema:   schema,
 			ExampleArgs:   tool.ExampleArguments,
+			ReadOnly:      tool.ReadOnly,
+			Idempotent:    tool.Idempotent,
+			Destructive:   tool.Destructive,
 		})
 	}
 	return reg
@@ -368,6 +378,9 @@ func (g *adapterGenerator) buildToolAdapters() ([]*ToolAdapter, error) {
 			HasPayload:         hasRealPayload,
 			HasResult:          tool.Method.Result != nil,
 			IsStreaming:        tool.Method.Stream == expr.ServerStreamKind,
+			ReadOnly:           tool.ReadOnly,
+			Idempotent:         tool.Idempotent,
+			Destructive:        tool.Destructive,
 		}
 
 		// Set streaming interface and event types for server-streaming methods
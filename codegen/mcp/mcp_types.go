@@ -223,6 +223,10 @@ func (b *mcpExprBuilder) buildToolInfoType() *expr.AttributeExpr {
 				Type:        expr.Any,
 				Description: "JSON Schema for tool input",
 			}},
+			{Name: "annotations", Attribute: &expr.AttributeExpr{
+				Type:        b.getOrCreateType("ToolAnnotations", b.buildToolAnnotationsType),
+				Description: "Hints describing the tool side effects",
+			}},
 		},
 		Validation: &expr.ValidationExpr{
 			Required: []string{"name"},
@@ -230,6 +234,25 @@ func (b *mcpExprBuilder) buildToolInfoType() *expr.AttributeExpr {
 	}
 }
 
+func (b *mcpExprBuilder) buildToolAnnotationsType() *expr.AttributeExpr {
+	return &expr.AttributeExpr{
+		Type: &expr.Object{
+			{Name: "readOnlyHint", Attribute: &expr.AttributeExpr{
+				Type:        expr.Boolean,
+				Description: "Tool does not modify its environment",
+			}},
+			{Name: "destructiveHint", Attribute: &expr.AttributeExpr{
+				Type:        expr.Boolean,
+				Description: "Tool may perform destructive updates",
+			}},
+			{Name: "idempotentHint", Attribute: &expr.AttributeExpr{
+				Type:        expr.Boolean,
+				Description: "Repeated calls with the same arguments have no additional effect",
+			}},
+		},
+	}
+}
+
 func (b *mcpExprBuilder) buildToolsCallPayloadType() *expr.AttributeExpr {
 	return &expr.AttributeExpr{
 		Type: &expr.Object{
@@ -149,6 +149,10 @@ func stringPtr(s string) *string {
     return &s
 }
 
+func boolPtr(b bool) *bool {
+    return &b
+}
+
 func isLikelyJSON(s string) bool {
     return json.Valid([]byte(s))
 }
@@ -16,6 +16,19 @@ func (a *MCPAdapter) ToolsList(ctx context.Context, p *ToolsListPayload) (*Tools
             {{- else }}
             InputSchema: json.RawMessage(`{"type":"object","properties":{},"additionalProperties":false}`),
             {{- end }}
+            {{- if or .ReadOnly .Idempotent .Destructive }}
+            Annotations: &ToolAnnotations{
+                {{- if .ReadOnly }}
+                ReadOnlyHint: boolPtr(true),
+                {{- end }}
+                {{- if .Destructive }}
+                DestructiveHint: boolPtr(true),
+                {{- end }}
+                {{- if .Idempotent }}
+                IdempotentHint: boolPtr(true),
+                {{- end }}
+            },
+            {{- end }}
         },
         {{- end }}
     }
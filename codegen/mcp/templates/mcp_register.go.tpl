@@ -62,6 +62,15 @@ var {{ .Register.HelperName }}ToolSpecs = []tools.ToolSpec{
 				},
 			},
 		},
+		{{- if .ReadOnly }}
+		ReadOnly: true,
+		{{- end }}
+		{{- if .Idempotent }}
+		Idempotent: true,
+		{{- end }}
+		{{- if .Destructive }}
+		Destructive: true,
+		{{- end }}
 	},
 {{- end }}
 }
@@ -74,6 +83,15 @@ var {{ .Register.HelperName }}ToolMetadata = []policy.ToolMetadata{
 		Title:       {{ printf "%q" .Title }},
 		Description: {{ printf "%q" .Description }},
 		BudgetClass: policy.ToolBudgetClassBudgeted,
+		{{- if .ReadOnly }}
+		ReadOnly: true,
+		{{- end }}
+		{{- if .Idempotent }}
+		Idempotent: true,
+		{{- end }}
+		{{- if .Destructive }}
+		Destructive: true,
+		{{- end }}
 	},
 {{- end }}
 }
@@ -0,0 +1,135 @@
+package typescript
+
+import (
+	"path/filepath"
+
+	agentir "goa.design/goa-ai/codegen/ir"
+	agentsExpr "goa.design/goa-ai/expr/agent"
+	goacodegen "goa.design/goa/v3/codegen"
+	"goa.design/goa/v3/eval"
+	goaexpr "goa.design/goa/v3/expr"
+)
+
+type (
+	// toolsData is the template input for tools.ts.
+	toolsData struct {
+		// Types are the named declarations referenced by tool contracts.
+		Types []*typeDecl
+		// Tools are the tool contracts in toolset order.
+		Tools []*toolData
+		// HasServerData reports whether any tool declares server data.
+		HasServerData bool
+	}
+
+	// toolData describes the TypeScript contract of one tool.
+	toolData struct {
+		// ID is the canonical tool identifier ("toolset.tool").
+		ID string
+		// Description is the design description of the tool.
+		Description string
+		// Payload is the name of the payload type alias.
+		Payload string
+		// Result is the name of the result type alias.
+		Result string
+		// Progress is the name of the progress type alias, or empty when the
+		// tool declares no progress.
+		Progress string
+		// ServerData lists the typed server-data items emitted with results.
+		ServerData []*serverData
+	}
+
+	// serverData describes one typed server-data item of a tool.
+	serverData struct {
+		// Kind is the server-data kind discriminator.
+		Kind string
+		// Audience is the declared audience of the item.
+		Audience string
+		// Type is the name of the data type alias.
+		Type string
+	}
+)
+
+// Generate emits TypeScript definitions for the agent stream wire format,
+// the typed tool contracts declared in the design, and a small client that
+// decodes SSE-bridged stream envelopes into typed events. Files are written
+// under gen/ts. Designs without toolsets leave files unchanged.
+func Generate(genpkg string, roots []eval.Root, files []*goacodegen.File) ([]*goacodegen.File, error) {
+	if !hasAgentsRoot(roots) {
+		return files, nil
+	}
+	design, err := agentir.Build(genpkg, roots)
+	if err != nil {
+		return nil, err
+	}
+	data := buildToolsData(design)
+	if len(data.Tools) == 0 {
+		return files, nil
+	}
+	dir := filepath.Join(goacodegen.Gendir, "ts")
+	return append(files,
+		&goacodegen.File{
+			Path:             filepath.Join(dir, "stream.ts"),
+			SectionTemplates: tsSections("Agent stream event types", "stream", streamTemplate, nil),
+		},
+		&goacodegen.File{
+			Path:             filepath.Join(dir, "tools.ts"),
+			SectionTemplates: tsSections("Typed tool contracts", "tools", toolsTemplate, data),
+		},
+		&goacodegen.File{
+			Path:             filepath.Join(dir, "client.ts"),
+			SectionTemplates: tsSections("Agent stream client", "client", clientTemplate, nil),
+		},
+	), nil
+}
+
+// buildToolsData collects the tool contracts of every defining toolset.
+func buildToolsData(design *agentir.Design) *toolsData {
+	scope := newTypeScope()
+	data := &toolsData{}
+	for _, ts := range design.Toolsets {
+		if ts.Expr == nil {
+			continue
+		}
+		for _, tool := range ts.Expr.Tools {
+			td := buildToolData(scope, ts.Name, tool)
+			data.Tools = append(data.Tools, td)
+			data.HasServerData = data.HasServerData || len(td.ServerData) > 0
+		}
+	}
+	data.Types = scope.declarations()
+	return data
+}
+
+// buildToolData declares the payload, result, progress, and server-data
+// aliases of tool.
+func buildToolData(scope *typeScope, toolset string, tool *agentsExpr.ToolExpr) *toolData {
+	base := goacodegen.Goify(toolset, true) + goacodegen.Goify(tool.Name, true)
+	td := &toolData{
+		ID:          toolset + "." + tool.Name,
+		Description: tool.Description,
+		Payload:     scope.declare(base+"Payload", "Payload of the "+toolset+"."+tool.Name+" tool.", tool.Args),
+		Result:      scope.declare(base+"Result", "Result of the "+toolset+"."+tool.Name+" tool.", tool.Return),
+	}
+	if tool.Progress != nil && tool.Progress.Type != goaexpr.Empty {
+		td.Progress = scope.declare(base+"Progress", "Progress update of the "+toolset+"."+tool.Name+" tool.", tool.Progress)
+	}
+	for _, sd := range tool.ServerData {
+		td.ServerData = append(td.ServerData, &serverData{
+			Kind:     sd.Kind,
+			Audience: sd.Audience,
+			Type:     scope.declare(base+goacodegen.Goify(sd.Kind, true)+"ServerData", sd.Description, sd.Schema),
+		})
+	}
+	return td
+}
+
+// hasAgentsRoot reports whether roots include the goa-ai root. Designs that
+// import this plugin without declaring agents or toolsets generate nothing.
+func hasAgentsRoot(roots []eval.Root) bool {
+	for _, root := range roots {
+		if _, ok := root.(*agentsExpr.RootExpr); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package typescript_test
+
+import (
+	"bytes"
+	"maps"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tscodegen "goa.design/goa-ai/codegen/typescript"
+	aidsl "goa.design/goa-ai/dsl"
+	agentexpr "goa.design/goa-ai/expr/agent"
+	goacodegen "goa.design/goa/v3/codegen"
+	goadsl "goa.design/goa/v3/dsl"
+	"goa.design/goa/v3/eval"
+	goaexpr "goa.design/goa/v3/expr"
+)
+
+func TestGenerateStreamToolsAndClient(t *testing.T) {
+	roots := runDesign(t, func() {
+		doc := goadsl.Type("Doc", func() {
+			goadsl.Description("A search hit.")
+			goadsl.Attribute("id", goadsl.String, "Document identifier.")
+			goadsl.Attribute("score", goadsl.Float64)
+			goadsl.Attribute("children", goadsl.ArrayOf("Doc"))
+			goadsl.Required("id")
+		})
+		goadsl.Service("search", func() {
+			aidsl.Agent("finder", "Finds documents.", func() {
+				aidsl.Use("search", func() {
+					aidsl.Tool("find", "Find matching documents.", func() {
+						aidsl.Args(func() {
+							goadsl.Attribute("query", goadsl.String)
+							goadsl.Attribute("mode", goadsl.String, func() {
+								goadsl.Enum("fast", "deep")
+							})
+							goadsl.Required("query")
+						})
+						aidsl.Return(goadsl.ArrayOf(doc))
+						aidsl.ServerData("search.chart", goadsl.ArrayOf(goadsl.Int))
+					})
+				})
+			})
+		})
+	})
+
+	files, err := tscodegen.Generate("example.com/project/gen", roots, nil)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	assert.Equal(t, filepath.Join("gen", "ts", "stream.ts"), files[0].Path)
+	assert.Equal(t, filepath.Join("gen", "ts", "tools.ts"), files[1].Path)
+	assert.Equal(t, filepath.Join("gen", "ts", "client.ts"), files[2].Path)
+
+	stream := render(t, files[0])
+	assert.Contains(t, stream, "// Code generated by goa-ai, DO NOT EDIT.")
+	assert.Contains(t, stream, `| "await_external_tools"`)
+	assert.Contains(t, stream, "export type StreamEvent = {")
+	assert.Contains(t, stream, "cached?: boolean;")
+
+	tools := render(t, files[1])
+	assert.Contains(t, tools, `import type { EventOf, ServerDataItem } from "./stream";`)
+	assert.Contains(t, tools, "export interface Doc {")
+	assert.Contains(t, tools, "  /** Document identifier. */\n  id: string;")
+	assert.Contains(t, tools, "  score?: number;")
+	assert.Contains(t, tools, "  children?: Doc[];")
+	assert.Contains(t, tools, `export type SearchFindPayload = { query: string; mode?: "fast" | "deep" };`)
+	assert.Contains(t, tools, "export type SearchFindResult = Doc[];")
+	assert.Contains(t, tools, "export type SearchFindSearchChartServerData = number[];")
+	assert.Contains(t, tools, `  "search.find": {`)
+	assert.Contains(t, tools, `serverData: ServerDataItem<"search.chart", SearchFindSearchChartServerData>;`)
+
+	client := render(t, files[2])
+	assert.Contains(t, client, "export async function* streamEvents(")
+	assert.Contains(t, client, "${res.status}")
+}
+
+func TestGenerateToolProgress(t *testing.T) {
+	roots := runDesign(t, func() {
+		goadsl.Service("reports", func() {
+			aidsl.Agent("exporter", "Exports rows.", func() {
+				aidsl.Use("exports", func() {
+					aidsl.Tool("export_rows", "Export matching rows.", func() {
+						aidsl.Args(goadsl.String)
+						aidsl.Return(goadsl.String)
+						aidsl.Progress(func() {
+							goadsl.Attribute("processed", goadsl.Int)
+							goadsl.Required("processed")
+						})
+					})
+					aidsl.Tool("count_rows", "Count matching rows.", func() {
+						aidsl.Args(goadsl.String)
+						aidsl.Return(goadsl.Int)
+					})
+				})
+			})
+		})
+	})
+
+	files, err := tscodegen.Generate("example.com/project/gen", roots, nil)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+
+	tools := render(t, files[1])
+	assert.Contains(t, tools, "export type ExportsExportRowsProgress = { processed: number };")
+	assert.Contains(t, tools, "    progress: ExportsExportRowsProgress;")
+	assert.Contains(t, tools, "    progress: never;")
+	assert.Contains(t, tools, `export type ToolProgress<N extends ToolName> = ToolTypes[N]["progress"];`)
+	assert.Contains(t, tools, "export function isToolProgress<N extends ToolName>(")
+}
+
+func TestGenerateWithoutAgentsRootDoesNothing(t *testing.T) {
+	existing := []*goacodegen.File{{Path: "gen/existing.go"}}
+	files, err := tscodegen.Generate("example.com/project/gen", nil, existing)
+	require.NoError(t, err)
+	assert.Equal(t, existing, files)
+}
+
+func runDesign(t *testing.T, design func()) []eval.Root {
+	t.Helper()
+	eval.Reset()
+	goaexpr.Root = new(goaexpr.RootExpr)
+	goaexpr.GeneratedResultTypes = new(goaexpr.ResultTypesRoot)
+	require.NoError(t, eval.Register(goaexpr.Root))
+	require.NoError(t, eval.Register(goaexpr.GeneratedResultTypes))
+	agentexpr.Root = new(agentexpr.RootExpr)
+	require.NoError(t, eval.Register(agentexpr.Root))
+	goaexpr.Root.API = goaexpr.NewAPIExpr("test", func() {})
+	goaexpr.Root.API.Servers = []*goaexpr.ServerExpr{goaexpr.Root.API.DefaultServer()}
+	require.True(t, eval.Execute(design, nil), eval.Context.Error())
+	require.NoError(t, eval.RunDSL())
+	return []eval.Root{goaexpr.Root, agentexpr.Root}
+}
+
+func render(t *testing.T, file *goacodegen.File) string {
+	t.Helper()
+	var result bytes.Buffer
+	for _, section := range file.SectionTemplates {
+		functions := template.FuncMap{
+			"commandLine": func() string {
+				return "goa gen example.com/project/design"
+			},
+		}
+		maps.Copy(functions, section.FuncMap)
+		parsed, err := template.New(section.Name).Funcs(functions).Parse(section.Source)
+		require.NoError(t, err)
+		require.NoError(t, parsed.Execute(&result, section.Data))
+	}
+	return result.String()
+}
@@ -0,0 +1,11 @@
+package typescript
+
+import (
+	goacodegen "goa.design/goa/v3/codegen"
+)
+
+// Register the TypeScript generator with Goa. It runs last so the agent
+// plugin has already validated the design it reads.
+func init() {
+	goacodegen.RegisterPluginLast("typescript", "gen", nil, Generate)
+}
@@ -0,0 +1,607 @@
+package typescript
+
+import (
+	"maps"
+	"strings"
+	"text/template"
+
+	goacodegen "goa.design/goa/v3/codegen"
+)
+
+// tsSections renders one TypeScript file: a generated-code header followed by
+// the file body.
+func tsSections(title, name, source string, data any) []*goacodegen.SectionTemplate {
+	funcs := templateFuncs()
+	return []*goacodegen.SectionTemplate{
+		{
+			Name:    "ts-header",
+			Source:  headerTemplate,
+			Data:    title,
+			FuncMap: funcs,
+		},
+		{
+			Name:    "ts-" + name,
+			Source:  source,
+			Data:    data,
+			FuncMap: funcs,
+		},
+	}
+}
+
+// templateFuncs returns Goa's template helpers plus the TypeScript specific
+// ones.
+func templateFuncs() template.FuncMap {
+	funcs := goacodegen.TemplateFuncs()
+	maps.Copy(funcs, template.FuncMap{
+		"jsdoc": jsdoc,
+	})
+	return funcs
+}
+
+// jsdoc renders text as a JSDoc block indented with indent. It returns an
+// empty string when text is empty.
+func jsdoc(indent, text string) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return ""
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) == 1 {
+		return indent + "/** " + strings.ReplaceAll(lines[0], "*/", "*\\/") + " */\n"
+	}
+	var b strings.Builder
+	b.WriteString(indent + "/**\n")
+	for _, line := range lines {
+		b.WriteString(strings.TrimRight(indent+" * "+strings.ReplaceAll(line, "*/", "*\\/"), " ") + "\n")
+	}
+	b.WriteString(indent + " */\n")
+	return b.String()
+}
+
+const headerTemplate = `// Code generated by goa-ai, DO NOT EDIT.
+//
+// {{ . }}
+//
+// Command:
+// {{ commandLine }}
+
+`
+
+// streamTemplate mirrors runtime/agent/stream and the Pulse sink envelope.
+// Keep it in sync with stream.EventType and the *Payload wire structs;
+// TestStreamTemplateMatchesRuntimeStream fails when they drift apart.
+const streamTemplate = `/** EventType enumerates the stream event kinds published by the runtime. */
+export type EventType =
+  | "planner_thought"
+  | "prompt_rendered"
+  | "tool_start"
+  | "tool_end"
+  | "tool_update"
+  | "tool_progress"
+  | "tool_call_args_delta"
+  | "tool_output_delta"
+  | "assistant_reply"
+  | "assistant_turn"
+  | "await_clarification"
+  | "await_confirmation"
+  | "await_questions"
+  | "await_external_tools"
+  | "tool_authorization"
+  | "usage"
+  | "workflow"
+  | "child_run_linked"
+  | "agent_handoff"
+  | "session_stream_started"
+  | "session_stream_end"
+  | "run_stream_end";
+
+/**
+ * Envelope is the JSON value published for every stream event. P is the
+ * event payload type.
+ */
+export interface Envelope<T extends EventType = EventType, P = unknown> {
+  /** Event kind. */
+  type: T;
+  /** Stable logical identity of the originating hook event, when any. */
+  event_key?: string;
+  /** Workflow run that produced the event. */
+  run_id: string;
+  /** Session that owns the run. */
+  session_id?: string;
+  /** RFC 3339 publication time (UTC). */
+  timestamp: string;
+  /** Event payload. */
+  payload?: P;
+  /** Server-only data emitted alongside tool results (tool_end only). */
+  server_data?: ServerDataItem[];
+}
+
+/** ServerDataItem is one server-only data item attached to a tool result. */
+export interface ServerDataItem<K extends string = string, D = unknown> {
+  kind: K;
+  audience: string;
+  data: D;
+}
+
+export interface PlannerThoughtPayload {
+  note?: string;
+  text?: string;
+  signature?: string;
+  /** Base64-encoded redacted thinking block. */
+  redacted?: string;
+  content_index?: number;
+  final?: boolean;
+}
+
+export interface PromptRenderedPayload {
+  prompt_id: string;
+  version: string;
+  scope: { SessionID: string; Labels: Record<string, string> | null };
+}
+
+export interface ToolStartPayload {
+  tool_call_id: string;
+  tool_name: string;
+  payload?: unknown;
+  display_hint?: string;
+  queue?: string;
+  parent_tool_call_id?: string;
+  expected_children_total?: number;
+  extra?: Record<string, unknown>;
+}
+
+export interface Bounds {
+  Returned: number;
+  Total: number | null;
+  Truncated: boolean;
+  NextCursor: string | null;
+  RefinementHint: string;
+}
+
+export interface ToolTelemetry {
+  DurationMs: number;
+  TokensUsed: number;
+  Model: string;
+  Extra: Record<string, unknown> | null;
+}
+
+export interface ToolError {
+  message: string;
+  cause?: ToolError;
+}
+
+export interface FieldIssue {
+  field: string;
+  constraint: string;
+  allowed?: string[];
+  min_len?: number;
+  max_len?: number;
+  pattern?: string;
+  format?: string;
+}
+
+export interface ToolFailure {
+  kind: "invalid_call" | "domain_rejection" | "unavailable" | (string & {});
+  error: ToolError | null;
+  recovery: {
+    action: string;
+    issues?: FieldIssue[];
+    prior_input?: unknown;
+    example_json?: unknown;
+  };
+}
+
+export interface ToolEndPayload {
+  call_run_id: string;
+  tool_call_id: string;
+  parent_tool_call_id?: string;
+  tool_name: string;
+  result?: unknown;
+  result_bytes?: number;
+  result_omitted?: boolean;
+  result_omitted_reason?: string;
+  result_preview?: string;
+  bounds?: Bounds;
+  /** Execution duration in nanoseconds. */
+  duration: number;
+  telemetry?: ToolTelemetry;
+  failure?: ToolFailure;
+  /** True when the result was served from the tool result cache. */
+  cached?: boolean;
+  extra?: Record<string, unknown>;
+}
+
+export interface ToolUpdatePayload {
+  tool_call_id: string;
+  expected_children_total: number;
+}
+
+export interface ToolProgressPayload {
+  tool_call_id: string;
+  parent_tool_call_id?: string;
+  tool_name: string;
+  /** Typed partial result declared with the Progress DSL. */
+  progress: unknown;
+}
+
+export interface ToolCallArgsDeltaPayload {
+  tool_call_id: string;
+  tool_name: string;
+  delta: string;
+}
+
+export interface ToolOutputDeltaPayload {
+  tool_call_id: string;
+  parent_tool_call_id?: string;
+  tool_name: string;
+  stream: string;
+  delta: string;
+}
+
+export interface AssistantReplyPayload {
+  text: string;
+}
+
+/** MessagePart is one transcript part discriminated by kind. */
+export interface MessagePart {
+  kind: string;
+  [field: string]: unknown;
+}
+
+export interface Message {
+  role: string;
+  parts: MessagePart[] | null;
+  meta: Record<string, unknown> | null;
+}
+
+export interface AssistantTurnPayload {
+  message: Message | null;
+}
+
+export interface AwaitClarificationPayload {
+  id: string;
+  question: string;
+  missing_fields?: string[];
+  restrict_to_tool?: string;
+  example_json?: unknown;
+}
+
+export interface AwaitConfirmationPayload {
+  id: string;
+  title?: string;
+  prompt: string;
+  tool_name: string;
+  tool_call_id: string;
+  payload?: unknown;
+}
+
+export interface AwaitQuestionOptionPayload {
+  id: string;
+  label: string;
+}
+
+export interface AwaitQuestionPayload {
+  id: string;
+  prompt: string;
+  options: AwaitQuestionOptionPayload[];
+  allow_multiple?: boolean;
+}
+
+export interface AwaitQuestionsPayload {
+  id: string;
+  tool_name: string;
+  tool_call_id: string;
+  title?: string;
+  questions: AwaitQuestionPayload[];
+}
+
+export interface AwaitToolPayload {
+  tool_name: string;
+  tool_call_id?: string;
+  payload?: unknown;
+}
+
+export interface AwaitExternalToolsPayload {
+  id: string;
+  items: AwaitToolPayload[];
+}
+
+export interface ToolAuthorizationPayload {
+  tool_name: string;
+  tool_call_id: string;
+  approved: boolean;
+  summary: string;
+  approved_by: string;
+}
+
+export interface UsagePayload {
+  Model: string;
+  ModelClass: string;
+  InputTokens: number;
+  OutputTokens: number;
+  TotalTokens: number;
+  CacheReadTokens: number;
+  CacheWriteTokens: number;
+}
+
+export interface RunFailure {
+  message?: string;
+  debug_message?: string;
+  provider?: string;
+  operation?: string;
+  kind?: string;
+  code?: string;
+  http_status?: number;
+  retryable: boolean;
+}
+
+export interface WorkflowPayload {
+  name?: string;
+  phase: string;
+  status?: string;
+  failure?: RunFailure;
+  cancellation?: { reason: string };
+}
+
+export interface ChildRunLinkedPayload {
+  tool_name: string;
+  tool_call_id: string;
+  child_run_id: string;
+  child_agent_id: string;
+}
+
+export interface AgentHandoffPayload {
+  from_agent_id: string;
+  target_agent_id: string;
+  target_run_id: string;
+  reason?: string;
+}
+
+/** EventPayloads maps each event kind to its payload type. */
+export interface EventPayloads {
+  planner_thought: PlannerThoughtPayload;
+  prompt_rendered: PromptRenderedPayload;
+  tool_start: ToolStartPayload;
+  tool_end: ToolEndPayload;
+  tool_update: ToolUpdatePayload;
+  tool_progress: ToolProgressPayload;
+  tool_call_args_delta: ToolCallArgsDeltaPayload;
+  tool_output_delta: ToolOutputDeltaPayload;
+  assistant_reply: AssistantReplyPayload;
+  assistant_turn: AssistantTurnPayload;
+  await_clarification: AwaitClarificationPayload;
+  await_confirmation: AwaitConfirmationPayload;
+  await_questions: AwaitQuestionsPayload;
+  await_external_tools: AwaitExternalToolsPayload;
+  tool_authorization: ToolAuthorizationPayload;
+  usage: UsagePayload;
+  workflow: WorkflowPayload;
+  child_run_linked: ChildRunLinkedPayload;
+  agent_handoff: AgentHandoffPayload;
+  session_stream_started: Record<string, never>;
+  session_stream_end: Record<string, never>;
+  run_stream_end: Record<string, never>;
+}
+
+/** StreamEvent is the discriminated union of all stream envelopes. */
+export type StreamEvent = {
+  [T in EventType]: Envelope<T, EventPayloads[T]>;
+}[EventType];
+
+/** EventOf narrows StreamEvent to the envelope of kind T. */
+export type EventOf<T extends EventType> = Extract<StreamEvent, { type: T }>;
+
+const eventTypes: ReadonlySet<string> = new Set<EventType>([
+  "planner_thought",
+  "prompt_rendered",
+  "tool_start",
+  "tool_end",
+  "tool_update",
+  "tool_progress",
+  "tool_call_args_delta",
+  "tool_output_delta",
+  "assistant_reply",
+  "assistant_turn",
+  "await_clarification",
+  "await_confirmation",
+  "await_questions",
+  "await_external_tools",
+  "tool_authorization",
+  "usage",
+  "workflow",
+  "child_run_linked",
+  "agent_handoff",
+  "session_stream_started",
+  "session_stream_end",
+  "run_stream_end",
+]);
+
+/** isEventType reports whether value names an event kind known to this client. */
+export function isEventType(value: unknown): value is EventType {
+  return typeof value === "string" && eventTypes.has(value);
+}
+`
+
+// toolsTemplate renders the typed tool contracts of the design.
+const toolsTemplate = `import type { EventOf{{ if .HasServerData }}, ServerDataItem{{ end }} } from "./stream";
+{{- range .Types }}
+
+{{ jsdoc "" .Description }}
+{{- if .Fields }}export interface {{ .Name }} {
+{{- range .Fields }}
+{{ jsdoc "  " .Description }}  {{ .Name }}{{ if .Optional }}?{{ end }}: {{ .Type }};
+{{- end }}
+}
+{{- else }}export type {{ .Name }} = {{ .Type }};
+{{- end }}
+{{- end }}
+
+/** ToolTypes maps each canonical tool identifier to its typed contract. */
+export interface ToolTypes {
+{{- range .Tools }}
+{{ jsdoc "  " .Description }}  {{ printf "%q" .ID }}: {
+    payload: {{ .Payload }};
+    result: {{ .Result }};
+    progress: {{ if .Progress }}{{ .Progress }}{{ else }}never{{ end }};
+    serverData: {{ if .ServerData }}{{ range $i, $sd := .ServerData }}{{ if $i }} | {{ end }}ServerDataItem<{{ printf "%q" $sd.Kind }}, {{ $sd.Type }}>{{ end }}{{ else }}never{{ end }};
+  };
+{{- end }}
+}
+
+/** ToolName is the union of canonical tool identifiers declared in the design. */
+export type ToolName = keyof ToolTypes;
+
+/** ToolPayload is the payload type of tool N. */
+export type ToolPayload<N extends ToolName> = ToolTypes[N]["payload"];
+
+/** ToolResult is the result type of tool N. */
+export type ToolResult<N extends ToolName> = ToolTypes[N]["result"];
+
+/** ToolProgress is the progress type of tool N, never when it reports none. */
+export type ToolProgress<N extends ToolName> = ToolTypes[N]["progress"];
+
+/** ToolServerData is the union of server-data items emitted by tool N. */
+export type ToolServerData<N extends ToolName> = ToolTypes[N]["serverData"];
+
+/** toolNames lists the canonical tool identifiers declared in the design. */
+export const toolNames: readonly ToolName[] = [
+{{- range .Tools }}
+  {{ printf "%q" .ID }},
+{{- end }}
+];
+
+/** isToolName reports whether name is a tool declared in the design. */
+export function isToolName(name: string): name is ToolName {
+  return (toolNames as readonly string[]).includes(name);
+}
+
+/** TypedToolStart is a tool_start event whose payload is typed for tool N. */
+export type TypedToolStart<N extends ToolName> = EventOf<"tool_start"> & {
+  payload: EventOf<"tool_start">["payload"] & { tool_name: N; payload?: ToolPayload<N> };
+};
+
+/** TypedToolEnd is a tool_end event whose result and server data are typed for tool N. */
+export type TypedToolEnd<N extends ToolName> = EventOf<"tool_end"> & {
+  payload: EventOf<"tool_end">["payload"] & { tool_name: N; result?: ToolResult<N> };
+  server_data?: ToolServerData<N>[];
+};
+
+/** TypedToolProgress is a tool_progress event whose progress is typed for tool N. */
+export type TypedToolProgress<N extends ToolName> = EventOf<"tool_progress"> & {
+  payload: EventOf<"tool_progress">["payload"] & { tool_name: N; progress: ToolProgress<N> };
+};
+
+/** isToolStart narrows ev to a tool_start event for tool name. */
+export function isToolStart<N extends ToolName>(ev: { type: string; payload?: unknown }, name: N): ev is TypedToolStart<N> {
+  return ev.type === "tool_start" && (ev.payload as { tool_name?: string } | undefined)?.tool_name === name;
+}
+
+/** isToolEnd narrows ev to a tool_end event for tool name. */
+export function isToolEnd<N extends ToolName>(ev: { type: string; payload?: unknown }, name: N): ev is TypedToolEnd<N> {
+  return ev.type === "tool_end" && (ev.payload as { tool_name?: string } | undefined)?.tool_name === name;
+}
+
+/** isToolProgress narrows ev to a tool_progress event for tool name. */
+export function isToolProgress<N extends ToolName>(ev: { type: string; payload?: unknown }, name: N): ev is TypedToolProgress<N> {
+  return ev.type === "tool_progress" && (ev.payload as { tool_name?: string } | undefined)?.tool_name === name;
+}
+`
+
+// clientTemplate renders a dependency-free client that reads an SSE response
+// whose data lines carry stream envelopes.
+const clientTemplate = `import { isEventType, type EventOf, type EventType, type StreamEvent } from "./stream";
+
+/** StreamOptions configures streamEvents. */
+export interface StreamOptions {
+  /** Extra request headers, for example Authorization. */
+  headers?: Record<string, string>;
+  /** Aborts the request and ends iteration. */
+  signal?: AbortSignal;
+  /** Fetch implementation; defaults to globalThis.fetch. */
+  fetch?: typeof fetch;
+  /** Stop after the run_stream_end event. Defaults to true. */
+  stopAtRunEnd?: boolean;
+}
+
+/**
+ * parseEvent decodes one envelope JSON document. It returns undefined for
+ * event kinds unknown to this client so newer servers stay compatible.
+ */
+export function parseEvent(data: string): StreamEvent | undefined {
+  const value: unknown = JSON.parse(data);
+  if (typeof value !== "object" || value === null) {
+    throw new Error("stream: envelope must be a JSON object");
+  }
+  const envelope = value as { type?: unknown; run_id?: unknown };
+  if (typeof envelope.run_id !== "string") {
+    throw new Error("stream: envelope is missing run_id");
+  }
+  if (!isEventType(envelope.type)) {
+    return undefined;
+  }
+  return value as StreamEvent;
+}
+
+/**
+ * streamEvents issues a GET request to url and yields the typed events carried
+ * by the server-sent events response. Each SSE message data field holds one
+ * envelope; SSE event names and comments are ignored.
+ */
+export async function* streamEvents(url: string, options: StreamOptions = {}): AsyncGenerator<StreamEvent> {
+  const doFetch = options.fetch ?? globalThis.fetch;
+  const res = await doFetch(url, {
+    headers: { Accept: "text/event-stream", ...options.headers },
+    signal: options.signal,
+  });
+  if (!res.ok || res.body === null) {
+    throw new Error(` + "`" + `stream: unexpected response ${res.status} ${res.statusText}` + "`" + `);
+  }
+  const stopAtRunEnd = options.stopAtRunEnd ?? true;
+  const reader = res.body.pipeThrough(new TextDecoderStream()).getReader();
+  let buffer = "";
+  let data: string[] = [];
+  try {
+    for (;;) {
+      const { value, done } = await reader.read();
+      if (done) {
+        return;
+      }
+      buffer += value;
+      let newline: number;
+      while ((newline = buffer.search(/\r\n|\r|\n/)) >= 0) {
+        const line = buffer.slice(0, newline);
+        buffer = buffer.slice(newline + (buffer.startsWith("\r\n", newline) ? 2 : 1));
+        if (line === "") {
+          if (data.length > 0) {
+            const ev = parseEvent(data.join("\n"));
+            data = [];
+            if (ev !== undefined) {
+              yield ev;
+              if (stopAtRunEnd && ev.type === "run_stream_end") {
+                return;
+              }
+            }
+          }
+          continue;
+        }
+        if (line.startsWith("data:")) {
+          data.push(line.slice(line.startsWith("data: ") ? 6 : 5));
+        }
+      }
+    }
+  } finally {
+    await reader.cancel().catch(() => undefined);
+  }
+}
+
+/** EventHandlers are per-kind callbacks invoked by dispatch. */
+export type EventHandlers = {
+  [T in EventType]?: (ev: EventOf<T>) => void;
+};
+
+/** dispatch invokes the handler registered for the kind of ev, if any. */
+export function dispatch(ev: StreamEvent, handlers: EventHandlers): void {
+  const handler = handlers[ev.type] as ((ev: StreamEvent) => void) | undefined;
+  handler?.(ev);
+}
+`
@@ -0,0 +1,133 @@
+package typescript
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	tsInterfacePattern = regexp.MustCompile(`(?s)export interface (\w+)(?:<[^>]*>)? \{\n(.*?)\n\}`)
+	tsFieldPattern     = regexp.MustCompile(`(?m)^  (\w+)(\?)?: `)
+	tsQuotedPattern    = regexp.MustCompile(`"(\w+)"`)
+)
+
+// TestStreamTemplateMatchesRuntimeStream checks the hand-written stream.ts
+// template against the Go definitions it mirrors: every stream.EventType
+// constant, and the JSON fields of every *Payload wire struct, with optional
+// TypeScript fields matching omitempty tags.
+func TestStreamTemplateMatchesRuntimeStream(t *testing.T) {
+	events, payloads := parseRuntimeStream(t)
+	require.NotEmpty(t, events)
+
+	assert.ElementsMatch(t, events, tsQuoted(tsSection(t, "export type EventType =", ";")), "EventType union")
+	assert.ElementsMatch(t, events, tsQuoted(tsSection(t, "new Set<EventType>([", "]);")), "eventTypes set")
+	assert.ElementsMatch(t, events, tsFieldNames(tsSection(t, "export interface EventPayloads {", "\n}")), "EventPayloads keys")
+
+	for _, m := range tsInterfacePattern.FindAllStringSubmatch(streamTemplate, -1) {
+		name := m[1]
+		if name == "EventPayloads" || !strings.HasSuffix(name, "Payload") {
+			continue
+		}
+		fields, ok := payloads[name]
+		require.True(t, ok, "stream.%s does not exist", name)
+		if fields == nil {
+			// The Go payload embeds its fields from another type.
+			continue
+		}
+		ts := make(map[string]bool)
+		for _, fm := range tsFieldPattern.FindAllStringSubmatch(m[2], -1) {
+			ts[fm[1]] = fm[2] == "?"
+		}
+		assert.Equal(t, fields, ts, "%s fields and optionality", name)
+	}
+}
+
+// parseRuntimeStream returns the EventType values declared in
+// runtime/agent/stream and the JSON fields of its *Payload structs, mapped to
+// whether they are omitempty. Payloads with embedded fields map to nil.
+func parseRuntimeStream(t *testing.T) ([]string, map[string]map[string]bool) {
+	t.Helper()
+	path := filepath.Join("..", "..", "runtime", "agent", "stream", "stream.go")
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	require.NoError(t, err)
+
+	var events []string
+	payloads := make(map[string]map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			if id, ok := n.Type.(*ast.Ident); ok && id.Name == "EventType" {
+				for _, v := range n.Values {
+					lit, ok := v.(*ast.BasicLit)
+					require.True(t, ok, "EventType constants must be string literals")
+					value, err := strconv.Unquote(lit.Value)
+					require.NoError(t, err)
+					events = append(events, value)
+				}
+			}
+		case *ast.TypeSpec:
+			st, ok := n.Type.(*ast.StructType)
+			if !ok || !strings.HasSuffix(n.Name.Name, "Payload") {
+				return true
+			}
+			fields := make(map[string]bool)
+			for _, field := range st.Fields.List {
+				if len(field.Names) == 0 {
+					fields = nil
+					break
+				}
+				if field.Tag == nil {
+					continue
+				}
+				tag, err := strconv.Unquote(field.Tag.Value)
+				require.NoError(t, err)
+				name, opts, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+				if name == "" || name == "-" {
+					continue
+				}
+				fields[name] = strings.Contains(opts, "omitempty")
+			}
+			payloads[n.Name.Name] = fields
+		}
+		return true
+	})
+	return events, payloads
+}
+
+// tsSection returns the text of the stream template between start and end.
+func tsSection(t *testing.T, start, end string) string {
+	t.Helper()
+	_, rest, ok := strings.Cut(streamTemplate, start)
+	require.True(t, ok, "stream template has no %q", start)
+	section, _, ok := strings.Cut(rest, end)
+	require.True(t, ok, "stream template has no %q after %q", end, start)
+	return section
+}
+
+// tsQuoted returns the double-quoted identifiers of s in order.
+func tsQuoted(s string) []string {
+	var out []string
+	for _, m := range tsQuotedPattern.FindAllStringSubmatch(s, -1) {
+		out = append(out, m[1])
+	}
+	return out
+}
+
+// tsFieldNames returns the names of the top-level interface fields of s.
+func tsFieldNames(s string) []string {
+	var out []string
+	for _, m := range tsFieldPattern.FindAllStringSubmatch(s, -1) {
+		out = append(out, m[1])
+	}
+	return out
+}
@@ -0,0 +1,238 @@
+package typescript
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	goacodegen "goa.design/goa/v3/codegen"
+	goaexpr "goa.design/goa/v3/expr"
+)
+
+type (
+	// typeScope renders Goa attributes as TypeScript type expressions and
+	// collects the named declarations referenced along the way. User types
+	// are emitted once as named declarations keyed by type hash, which keeps
+	// recursive types representable.
+	typeScope struct {
+		names *goacodegen.NameScope
+		byKey map[string]string
+		decls []*typeDecl
+	}
+
+	// typeDecl is one named TypeScript declaration.
+	typeDecl struct {
+		// Name is the exported TypeScript identifier.
+		Name string
+		// Description is the design description rendered as a doc comment.
+		Description string
+		// Fields is set when the declaration is an interface.
+		Fields []*fieldDecl
+		// Type is set when the declaration is a type alias.
+		Type string
+	}
+
+	// fieldDecl is one interface property.
+	fieldDecl struct {
+		// Name is the JSON property name, quoted when it is not a valid
+		// identifier.
+		Name string
+		// Description is the design description rendered as a doc comment.
+		Description string
+		// Optional reports whether the property may be absent on the wire.
+		Optional bool
+		// Type is the rendered TypeScript type expression.
+		Type string
+	}
+)
+
+// reservedNames are the identifiers declared or imported by tools.ts itself.
+var reservedNames = []string{
+	"EventOf", "ServerDataItem", "ToolTypes", "ToolName", "ToolPayload",
+	"ToolResult", "ToolProgress", "ToolServerData", "TypedToolStart",
+	"TypedToolEnd", "TypedToolProgress",
+}
+
+// newTypeScope returns a scope with the tools.ts identifiers reserved.
+func newTypeScope() *typeScope {
+	names := goacodegen.NewNameScope()
+	for _, name := range reservedNames {
+		names.Unique(name)
+	}
+	return &typeScope{
+		names: names,
+		byKey: make(map[string]string),
+	}
+}
+
+// declare registers a type alias named after base for att and returns the
+// unique name it was assigned.
+func (s *typeScope) declare(base, description string, att *goaexpr.AttributeExpr) string {
+	name := s.names.Unique(base)
+	s.decls = append(s.decls, &typeDecl{
+		Name:        name,
+		Description: description,
+		Type:        s.typeOf(att),
+	})
+	return name
+}
+
+// declarations returns the collected declarations sorted by name.
+func (s *typeScope) declarations() []*typeDecl {
+	out := make([]*typeDecl, len(s.decls))
+	copy(out, s.decls)
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// typeOf renders the TypeScript type expression that matches the JSON
+// encoding of att produced by the generated tool codecs.
+func (s *typeScope) typeOf(att *goaexpr.AttributeExpr) string {
+	if att == nil || att.Type == nil || att.Type == goaexpr.Empty {
+		return "Record<string, never>"
+	}
+	if lits := enumLiterals(att); lits != "" {
+		return lits
+	}
+	switch t := att.Type.(type) {
+	case goaexpr.UserType:
+		return s.userType(t)
+	case goaexpr.Primitive:
+		return primitiveType(t)
+	case *goaexpr.Array:
+		elem := s.typeOf(t.ElemType)
+		if strings.ContainsAny(elem, " |&") {
+			return "Array<" + elem + ">"
+		}
+		return elem + "[]"
+	case *goaexpr.Map:
+		return "Record<string, " + s.typeOf(t.ElemType) + ">"
+	case *goaexpr.Object:
+		fields := s.fields(t, att.Validation)
+		if len(fields) == 0 {
+			return "Record<string, never>"
+		}
+		parts := make([]string, 0, len(fields))
+		for _, f := range fields {
+			opt := ""
+			if f.Optional {
+				opt = "?"
+			}
+			parts = append(parts, fmt.Sprintf("%s%s: %s", f.Name, opt, f.Type))
+		}
+		return "{ " + strings.Join(parts, "; ") + " }"
+	case *goaexpr.Union:
+		variants := make([]string, 0, len(t.Values))
+		for _, v := range t.Values {
+			variants = append(variants, fmt.Sprintf("{ type: %q; value: %s }", v.Name, s.typeOf(v.Attribute)))
+		}
+		if len(variants) == 0 {
+			return "never"
+		}
+		return strings.Join(variants, " | ")
+	default:
+		return "unknown"
+	}
+}
+
+// userType declares ut on first use and returns its TypeScript name.
+func (s *typeScope) userType(ut goaexpr.UserType) string {
+	key := ut.Hash()
+	if name, ok := s.byKey[key]; ok {
+		return name
+	}
+	name := s.names.HashedUnique(ut, goacodegen.Goify(ut.Name(), true))
+	s.byKey[key] = name
+	decl := &typeDecl{Name: name, Description: ut.Attribute().Description}
+	s.decls = append(s.decls, decl)
+	att := ut.Attribute()
+	if obj, ok := att.Type.(*goaexpr.Object); ok && enumLiterals(att) == "" {
+		decl.Fields = s.fields(obj, att.Validation)
+		if len(decl.Fields) > 0 {
+			return name
+		}
+	}
+	decl.Type = s.typeOf(att)
+	return name
+}
+
+// fields renders the visible properties of obj. Properties hidden from the
+// wire with a "-" JSON tag are omitted.
+func (s *typeScope) fields(obj *goaexpr.Object, validation *goaexpr.ValidationExpr) []*fieldDecl {
+	out := make([]*fieldDecl, 0, len(*obj))
+	for _, nat := range *obj {
+		if nat == nil || nat.Attribute == nil || hiddenField(nat.Attribute) {
+			continue
+		}
+		out = append(out, &fieldDecl{
+			Name:        propertyName(nat.Name),
+			Description: nat.Attribute.Description,
+			Optional:    validation == nil || !validation.IsRequired(nat.Name),
+			Type:        s.typeOf(nat.Attribute),
+		})
+	}
+	return out
+}
+
+// primitiveType maps a Goa primitive to its JSON representation in
+// TypeScript. Bytes are base64-encoded strings on the wire.
+func primitiveType(p goaexpr.Primitive) string {
+	switch p.Kind() {
+	case goaexpr.BooleanKind:
+		return "boolean"
+	case goaexpr.IntKind, goaexpr.Int32Kind, goaexpr.Int64Kind,
+		goaexpr.UIntKind, goaexpr.UInt32Kind, goaexpr.UInt64Kind,
+		goaexpr.Float32Kind, goaexpr.Float64Kind:
+		return "number"
+	case goaexpr.StringKind, goaexpr.BytesKind:
+		return "string"
+	default:
+		return "unknown"
+	}
+}
+
+// enumLiterals renders the enum validation of att as a union of literal
+// types, or returns "" when att declares no enum.
+func enumLiterals(att *goaexpr.AttributeExpr) string {
+	if att.Validation == nil || len(att.Validation.Values) == 0 {
+		return ""
+	}
+	lits := make([]string, 0, len(att.Validation.Values))
+	for _, v := range att.Validation.Values {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		lits = append(lits, string(b))
+	}
+	return strings.Join(lits, " | ")
+}
+
+// hiddenField reports whether att carries a "-" JSON tag.
+func hiddenField(att *goaexpr.AttributeExpr) bool {
+	if tags := att.Meta["struct:tag:json"]; len(tags) > 0 {
+		return strings.Split(tags[0], ",")[0] == "-"
+	}
+	if names := att.Meta["struct:tag:json:name"]; len(names) > 0 {
+		return names[0] == "-"
+	}
+	return false
+}
+
+// propertyName quotes name when it is not a valid TypeScript identifier.
+func propertyName(name string) string {
+	for i, r := range name {
+		switch {
+		case r == '_' || r == '$':
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && r >= '0' && r <= '9':
+		default:
+			return fmt.Sprintf("%q", name)
+		}
+	}
+	if name == "" {
+		return `""`
+	}
+	return name
+}
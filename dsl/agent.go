@@ -179,6 +179,42 @@ func DisableAgentDocs() {
 	expragents.Root.DisableAgentDocs = true
 }
 
+// DisableToolsetMocks disables generation of the typed mock executors.
+//
+// By default each toolset specs package has a generated mocks sub-package
+// (package mock<toolset>) with a mock executor for agent unit tests. Call
+// DisableToolsetMocks() inside your API design when the application does not
+// use them, for example to keep goa.design/clue/mock out of its dependencies.
+//
+// Example:
+//
+//	var _ = API("assistant", func() {
+//	    // ...
+//	    DisableToolsetMocks()
+//	})
+func DisableToolsetMocks() {
+	expragents.Root.DisableToolsetMocks = true
+}
+
+// DisableRemoteExecutors disables generation of the out-of-process executor
+// packages.
+//
+// By default each local toolset specs package has a generated remote
+// sub-package (package remote<toolset>) holding the OpenAPI executor contract
+// and NewExecutor, which forwards tool calls to an executor running in another
+// process. Call DisableRemoteExecutors() inside your API design when every
+// toolset runs in process.
+//
+// Example:
+//
+//	var _ = API("assistant", func() {
+//	    // ...
+//	    DisableRemoteExecutors()
+//	})
+func DisableRemoteExecutors() {
+	expragents.Root.DisableRemoteExecutors = true
+}
+
 // Passthrough defines deterministic forwarding for an exported tool to a Goa
 // service method. It must appear within the DSL of a Tool nested under
 // Export.
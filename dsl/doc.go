@@ -43,6 +43,9 @@
 //
 //	API("name", func() {})           // Top-level API definition (Goa)
 //	DisableAgentDocs()               // Inside API - disable quickstart doc generation
+//	DisableToolsetMocks()            // Inside API - disable mock executor generation
+//	DisableRemoteExecutors()         // Inside API - disable remote executor generation
+//	LintRule("arg-example", "off")   // Inside API - configure a design lint rule
 //
 //	var MyTools = Toolset("...", func() {...})  // Top-level toolset definition
 //	var MCPTools = Toolset(FromMCP(...))        // MCP-backed toolset
@@ -75,6 +78,9 @@
 //   - [Use] declares toolset consumption
 //   - [Export] declares toolset export for agent-as-tool
 //   - [DisableAgentDocs] opts out of AGENTS_QUICKSTART.md generation
+//   - [DisableToolsetMocks] opts out of the toolset mocks packages
+//   - [DisableRemoteExecutors] opts out of the toolset remote packages
+//   - [LintRule] sets the severity of a design lint rule
 //   - [Passthrough] forwards exported tools to service methods
 //
 // Toolset Functions:
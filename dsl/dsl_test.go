@@ -114,6 +114,251 @@ func TestTerminalRunImpliesBookkeeping(t *testing.T) {
 	require.True(t, tool.Bookkeeping)
 }
 
+func TestReadOnlyImpliesIdempotent(t *testing.T) {
+	runDSL(t, func() {
+		API("test", func() {})
+		Service("orders", func() {
+			Agent("planner", "Planner agent", func() {
+				Use("orders.lookup", func() {
+					Tool("get_order", "Get order", func() {
+						ReadOnly()
+					})
+					Tool("delete_order", "Delete order", func() {
+						Destructive()
+					})
+				})
+			})
+		})
+	})
+
+	tools := agentsexpr.Root.Agents[0].Used.Toolsets[0].Tools
+	require.True(t, tools[0].ReadOnly)
+	require.True(t, tools[0].Idempotent)
+	require.False(t, tools[0].Destructive)
+	require.True(t, tools[1].Destructive)
+	require.False(t, tools[1].Idempotent)
+}
+
+func TestReadOnlyRejectsDestructive(t *testing.T) {
+	err := runDSLWithError(t, func() {
+		API("test", func() {})
+		Service("orders", func() {
+			Agent("planner", "Planner agent", func() {
+				Use("orders.lookup", func() {
+					Tool("get_order", "Get order", func() {
+						ReadOnly()
+						Destructive()
+					})
+				})
+			})
+		})
+	})
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, "cannot be both ReadOnly and Destructive")
+}
+
+func TestToolTimeoutAndRetryPolicy(t *testing.T) {
+	runDSL(t, func() {
+		API("test", func() {})
+		Service("quotes", func() {
+			Agent("planner", "Planner agent", func() {
+				Use("quotes.pricing", func() {
+					Tool("get_quote", "Get quote", func() {
+						Timeout("10s")
+						RetryPolicy(3, "500ms", "unavailable", "timeout")
+					})
+				})
+			})
+		})
+	})
+
+	tool := agentsexpr.Root.Agents[0].Used.Toolsets[0].Tools[0]
+	require.Equal(t, 10*time.Second, tool.Timeout)
+	require.Equal(t, &agentsexpr.ToolRetryPolicyExpr{
+		MaxAttempts: 3,
+		Backoff:     500 * time.Millisecond,
+		RetryOn:     []string{"unavailable", "timeout"},
+	}, tool.RetryPolicy)
+}
+
+func TestDestructiveToolRejectsAmbiguousRetryKinds(t *testing.T) {
+	err := runDSLWithError(t, func() {
+		API("test", func() {})
+		Service("orders", func() {
+			Agent("planner", "Planner agent", func() {
+				Use("orders.admin", func() {
+					Tool("delete_order", "Delete order", func() {
+						Destructive()
+						RetryPolicy(3, "1s", "unavailable", "timeout")
+					})
+				})
+			})
+		})
+	})
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, `cannot retry on failure kind "timeout"`)
+}
+
+func TestCacheResult(t *testing.T) {
+	runDSL(t, func() {
+		API("test", func() {})
+		Service("catalog", func() {
+			Agent("planner", "Planner agent", func() {
+				Use("catalog.search", func() {
+					Tool("search", "Search catalog", func() {
+						ReadOnly()
+						CacheResult("10m", CacheScopeSession)
+					})
+				})
+			})
+		})
+	})
+
+	tool := agentsexpr.Root.Agents[0].Used.Toolsets[0].Tools[0]
+	require.Equal(t, &agentsexpr.ToolResultCacheExpr{
+		TTL:   10 * time.Minute,
+		Scope: "session",
+	}, tool.ResultCache)
+}
+
+func TestCacheResultRequiresIdempotentTool(t *testing.T) {
+	err := runDSLWithError(t, func() {
+		API("test", func() {})
+		Service("orders", func() {
+			Agent("planner", "Planner agent", func() {
+				Use("orders.admin", func() {
+					Tool("update_order", "Update order", func() {
+						CacheResult("1m", CacheScopeRun)
+					})
+				})
+			})
+		})
+	})
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, "CacheResult requires an idempotent")
+}
+
+func TestCacheResultRejectsScopeWiderThanInjectedMeta(t *testing.T) {
+	err := runDSLWithError(t, func() {
+		API("test", func() {})
+		Service("notes", func() {
+			Agent("scribe", "Scribe agent", func() {
+				Use("notes.lookup", func() {
+					Tool("recent", "Recent notes", func() {
+						Args(func() {
+							Attribute("session_id", String, "Server-injected session identifier.")
+							Required("session_id")
+						})
+						Inject("session_id")
+						ReadOnly()
+						CacheResult("1m", CacheScopeGlobal)
+					})
+				})
+			})
+		})
+	})
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, `would share results across values of injected field "session_id"`)
+}
+
+func TestAvailableWhen(t *testing.T) {
+	runDSL(t, func() {
+		API("test", func() {})
+		Toolset("payments", func() {
+			Tool("authorize", "Authorize payment", func() {})
+		})
+		Service("shop", func() {
+			Agent("clerk", "Checkout agent", func() {
+				Use("checkout", func() {
+					Tool("validate_cart", "Validate cart", func() {})
+					Tool("submit_order", "Submit order", func() {
+						AvailableWhen(ToolSucceeded("validate_cart"), ToolSucceeded("payments.authorize"))
+						RequiresLabel("tier", "admin")
+					})
+				})
+			})
+		})
+	})
+
+	tool := agentsexpr.Root.Agents[0].Used.Toolsets[0].Tools[1]
+	require.Equal(t, &agentsexpr.ToolAvailabilityExpr{
+		SucceededTools: []string{"validate_cart", "payments.authorize"},
+		Labels:         map[string]string{"tier": "admin"},
+	}, tool.Availability)
+}
+
+func TestAvailableWhenRejectsUnknownTool(t *testing.T) {
+	err := runDSLWithError(t, func() {
+		API("test", func() {})
+		Service("shop", func() {
+			Agent("clerk", "Checkout agent", func() {
+				Use("checkout", func() {
+					Tool("submit_order", "Submit order", func() {
+						AvailableWhen(ToolSucceeded("validate_cart"))
+					})
+				})
+			})
+		})
+	})
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, `ToolSucceeded references unknown tool "validate_cart"`)
+}
+
+func TestAvailableWhenRejectsUnknownQualifiedTool(t *testing.T) {
+	err := runDSLWithError(t, func() {
+		API("test", func() {})
+		Toolset("payments", func() {
+			Tool("authorize", "Authorize payment", func() {})
+		})
+		Service("shop", func() {
+			Agent("clerk", "Checkout agent", func() {
+				Use("checkout", func() {
+					Tool("submit_order", "Submit order", func() {
+						AvailableWhen(ToolSucceeded("payments.capture"), ToolSucceeded("billing.authorize"))
+					})
+				})
+			})
+		})
+	})
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, `ToolSucceeded references unknown tool "payments.capture"`)
+	require.ErrorContains(t, err, `ToolSucceeded references unknown tool "billing.authorize"`)
+}
+
+func TestProgress(t *testing.T) {
+	runDSL(t, func() {
+		API("test", func() {})
+		Service("reports", func() {
+			Agent("exporter", "Export agent", func() {
+				Use("exports", func() {
+					Tool("export_rows", "Export rows", func() {
+						Return(String)
+						Progress(func() {
+							Attribute("processed", Int, "Rows processed")
+							Attribute("total", Int, "Total rows")
+							Required("processed")
+						})
+					})
+				})
+			})
+		})
+	})
+
+	tool := agentsexpr.Root.Agents[0].Used.Toolsets[0].Tools[0]
+	require.NotNil(t, tool.Progress)
+	obj := goaexpr.AsObject(tool.Progress.Type)
+	require.NotNil(t, obj)
+	require.NotNil(t, obj.Attribute("processed"))
+	require.NotNil(t, obj.Attribute("total"))
+	require.True(t, tool.Progress.IsRequired("processed"))
+}
+
 func TestToolsetReferenceReuse(t *testing.T) {
 	runDSL(t, func() {
 		API("test", func() {})
@@ -545,3 +790,44 @@ func TestOnMissingFields(t *testing.T) {
 	require.NotNil(t, policy)
 	require.Equal(t, "await_clarification", policy.OnMissingFields)
 }
+
+func TestHandoffResolvesTargets(t *testing.T) {
+	runDSL(t, func() {
+		API("example", func() {})
+		var billing *agentsexpr.AgentExpr
+		Service("support", func() {
+			Agent("escalations", "Human escalations", func() {})
+		})
+		Service("billing", func() {
+			billing = Agent("billing", "Invoices and refunds", func() {})
+		})
+		Service("front", func() {
+			Agent("triage", "Routes customer requests", func() {
+				Handoff(billing, "Billing questions")
+				Handoff("support.escalations")
+			})
+		})
+	})
+
+	triage := agentsexpr.Root.Agents[2]
+	require.Len(t, triage.Handoffs, 2)
+	require.Equal(t, "billing", triage.Handoffs[0].Target.Name)
+	require.Equal(t, "Billing questions", triage.Handoffs[0].Description)
+	require.Equal(t, "escalations", triage.Handoffs[1].Target.Name)
+}
+
+func TestHandoffRejectsUnknownAndSelfTargets(t *testing.T) {
+	err := runDSLWithError(t, func() {
+		API("example", func() {})
+		Service("front", func() {
+			Agent("triage", "Routes customer requests", func() {
+				Handoff("missing")
+				Handoff("triage")
+			})
+		})
+	})
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, `handoff target "missing" is not a declared agent`)
+	require.ErrorContains(t, err, "agent cannot hand off to itself")
+}
@@ -0,0 +1,63 @@
+package dsl
+
+import (
+	expragents "goa.design/goa-ai/expr/agent"
+	"goa.design/goa/v3/eval"
+)
+
+// Handoff declares that the current agent may transfer the conversation to
+// another agent. Unlike agent-as-tool composition (Use of a toolset exported
+// by another agent), the current agent does not resume: the target agent owns
+// the session's subsequent turns and starts from the carried-over transcript.
+// Planners request a handoff at runtime by returning planner.PlanResult with
+// Handoff set to one of the declared targets.
+//
+// Handoff must appear in an Agent expression.
+//
+// Handoff takes the target agent and an optional description explaining when
+// the target should take over. The target may be either:
+//   - The *expragents.AgentExpr returned by Agent
+//   - A string naming an agent of the same service, or "service.agent" for an
+//     agent of another service
+//
+// Example:
+//
+//	var Billing = Agent("billing", "Handles invoices and refunds", func() { ... })
+//
+//	Agent("triage", "Routes customer requests", func() {
+//		Handoff(Billing, "Questions about invoices, charges, or refunds")
+//		Handoff("support.escalations", "Customers asking for a human")
+//	})
+func Handoff(target any, description ...string) {
+	agent, ok := eval.Current().(*expragents.AgentExpr)
+	if !ok {
+		eval.IncompatibleDSL()
+		return
+	}
+	if len(description) > 1 {
+		eval.ReportError("Handoff accepts at most one description")
+		return
+	}
+	h := &expragents.HandoffExpr{Agent: agent}
+	if len(description) > 0 {
+		h.Description = description[0]
+	}
+	switch t := target.(type) {
+	case *expragents.AgentExpr:
+		if t == nil {
+			eval.ReportError("Handoff target cannot be nil")
+			return
+		}
+		h.Target = t
+	case string:
+		if t == "" {
+			eval.ReportError("Handoff target name cannot be empty")
+			return
+		}
+		h.TargetName = t
+	default:
+		eval.InvalidArgError("agent expression or agent name", target)
+		return
+	}
+	agent.Handoffs = append(agent.Handoffs, h)
+}
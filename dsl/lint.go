@@ -0,0 +1,51 @@
+package dsl
+
+import (
+	expragents "goa.design/goa-ai/expr/agent"
+	"goa.design/goa-ai/expr/agent/lint"
+	"goa.design/goa/v3/eval"
+	goaexpr "goa.design/goa/v3/expr"
+)
+
+// LintRule sets the severity of a design lint rule. goa gen lints the tool
+// contracts of the design after evaluation: "warning" violations are printed,
+// "error" violations fail generation, and "off" disables the rule. All rules
+// default to "warning".
+//
+// LintRule must appear in the API expression.
+//
+// Rules:
+//   - "tool-description": tool descriptions must have at least 3 words
+//   - "arg-description": argument fields must have a description
+//   - "arg-example": argument fields must have an example (or the arguments
+//     an example as a whole)
+//   - "unbounded-string": string arguments must declare an enum, format,
+//     pattern, or maximum length
+//   - "bounded-result": tools returning collections must use BoundedResult
+//   - "destructive-confirmation": tools marked Destructive or tagged
+//     "destructive" must declare Confirmation
+//
+// Example:
+//
+//	var _ = API("assistant", func() {
+//	    LintRule("arg-example", "off")
+//	    LintRule("destructive-confirmation", "error")
+//	})
+func LintRule(rule, severity string) {
+	if _, ok := eval.Current().(*goaexpr.APIExpr); !ok {
+		eval.IncompatibleDSL()
+		return
+	}
+	if !lint.KnownRule(rule) {
+		eval.ReportError("LintRule: unknown rule %q", rule)
+		return
+	}
+	if _, err := lint.ParseSeverity(severity); err != nil {
+		eval.ReportError("LintRule: %s", err)
+		return
+	}
+	if expragents.Root.LintRules == nil {
+		expragents.Root.LintRules = make(map[string]string)
+	}
+	expragents.Root.LintRules[rule] = severity
+}
@@ -2,6 +2,7 @@ package dsl
 
 import (
 	"strings"
+	"time"
 
 	"goa.design/goa/v3/eval"
 	goaexpr "goa.design/goa/v3/expr"
@@ -26,12 +27,15 @@ import (
 // Tool takes two required arguments and one optional DSL function:
 //   - name: the tool identifier
 //   - description: a concise summary presented to the LLM
-//   - dsl (optional): configuration block (only for toolset tools, ignored for method tools)
+//   - dsl (optional): configuration block; method tools accept only the
+//     side-effect annotations ReadOnly, Idempotent and Destructive
 //
 // Inside toolsets, the DSL function can use:
 //   - Args: defines the input parameter schema
 //   - Return: defines the output result schema
 //   - Tags: attaches metadata labels
+//   - Timeout and RetryPolicy: bound and retry tool executions
+//   - CacheResult: reuses results of idempotent tools
 //   - BindTo: binds to a service method for implementation (optional)
 //   - Inject: marks fields as server-populated from ToolCallMeta or run labels (hidden from LLM)
 //
@@ -133,6 +137,9 @@ func Tool(name string, args ...any) {
 		}
 		tool.Expression = parent
 		mcp.Tools = append(mcp.Tools, tool)
+		if dslf != nil {
+			eval.Execute(dslf, tool)
+		}
 	default:
 		eval.IncompatibleDSL()
 		return
@@ -260,6 +267,44 @@ func Return(val any, args ...any) {
 	}
 }
 
+// Progress declares the schema of the partial results a tool reports while it
+// executes (progress counters, partial rows, intermediate findings). Progress
+// accepts the same arguments as Return.
+//
+// Tool implementations report progress with the generated
+// Report<Tool>Progress helper of the toolset specs package, the typed form of
+// runtime.ReportToolProgress; the runtime validates each value against the
+// declared schema and streams it as a tool_progress event. Progress is never
+// sent to model providers and does not replace the final result, which still
+// flows through Return.
+//
+// Progress is only supported by local tools, including tools bound to service
+// methods with BindTo.
+//
+// Example:
+//
+//	Tool("export_rows", "Export matching rows", func() {
+//	    Args(ExportArgs)
+//	    Return(ExportResult)
+//	    Progress(func() {
+//	        Attribute("processed", Int, "Rows processed so far")
+//	        Attribute("total", Int, "Total rows to process")
+//	        Required("processed")
+//	    })
+//	})
+func Progress(val any, args ...any) {
+	if len(args) > 2 {
+		eval.TooManyArgError()
+		return
+	}
+	tool, ok := eval.Current().(*agentsexpr.ToolExpr)
+	if !ok {
+		eval.IncompatibleDSL()
+		return
+	}
+	tool.Progress = toolDSL(tool, "Progress", val, args...)
+}
+
 // ServerData declares typed server-only data emitted alongside a tool result.
 // Server data is never sent to model providers; it exists for UIs, observability,
 // and persistence layers.
@@ -857,6 +902,267 @@ func Bookkeeping() {
 	tool.Bookkeeping = true
 }
 
+// ReadOnly marks the current tool as free of side effects: it only reads its
+// environment. Read-only tools are also idempotent. Generated MCP servers
+// advertise the tool with the readOnlyHint annotation.
+//
+// ReadOnly must appear in a Tool expression and cannot be combined with
+// Destructive.
+//
+// Example:
+//
+//	Tool("get_order", "Get an order", func() {
+//	    Args(GetOrderArgs)
+//	    Return(Order)
+//	    ReadOnly()
+//	})
+func ReadOnly() {
+	switch tool := eval.Current().(type) {
+	case *agentsexpr.ToolExpr:
+		tool.ReadOnly = true
+	case *mcpexpr.ToolExpr:
+		tool.ReadOnly = true
+	default:
+		eval.IncompatibleDSL()
+	}
+}
+
+// Idempotent marks the current tool as safe to repeat: calling it again with
+// the same arguments has no additional effect. The runtime retries failed
+// activity attempts of idempotent tools automatically. Generated MCP servers
+// advertise the tool with the idempotentHint annotation.
+//
+// Idempotent must appear in a Tool expression.
+//
+// Example:
+//
+//	Tool("set_status", "Set the order status", func() {
+//	    Args(SetStatusArgs)
+//	    Idempotent()
+//	})
+func Idempotent() {
+	switch tool := eval.Current().(type) {
+	case *agentsexpr.ToolExpr:
+		tool.Idempotent = true
+	case *mcpexpr.ToolExpr:
+		tool.Idempotent = true
+	default:
+		eval.IncompatibleDSL()
+	}
+}
+
+// Destructive marks the current tool as performing irreversible updates to its
+// environment. The runtime never retries a destructive call whose outcome is
+// unknown: failed activity attempts surface to the planner instead of running
+// the tool again. Generated MCP servers advertise the tool with the
+// destructiveHint annotation.
+//
+// Destructive must appear in a Tool expression and cannot be combined with
+// ReadOnly.
+//
+// Example:
+//
+//	Tool("delete_order", "Delete an order", func() {
+//	    Args(DeleteOrderArgs)
+//	    Destructive()
+//	})
+func Destructive() {
+	switch tool := eval.Current().(type) {
+	case *agentsexpr.ToolExpr:
+		tool.Destructive = true
+	case *mcpexpr.ToolExpr:
+		tool.Destructive = true
+	default:
+		eval.IncompatibleDSL()
+	}
+}
+
+// RetryPolicy declares how the runtime retries failed executions of the
+// current tool.
+//
+// RetryPolicy must appear in a Tool expression.
+//
+// RetryPolicy takes:
+//   - maxAttempts: the total number of execution attempts, including the first
+//   - backoff: a Go duration string for the delay before the first retry; later
+//     retries double it
+//   - retryOn: optional planner failure kinds that re-run the call when its
+//     result fails with them ("unavailable", "rate_limited", "timeout",
+//     "malformed_result" or "internal")
+//
+// The workflow schedules every attempt as a separate tool activity, replacing
+// the agent-wide ExecuteTool retry policy for this tool, so the Goa Timeout
+// function bounds each attempt rather than the whole sequence. Failed
+// activities are retried unless the tool is destructive. Destructive tools may
+// only retry on "unavailable" and "rate_limited", the kinds that prove the call
+// had no effect.
+//
+// Example:
+//
+//	Tool("get_quote", "Get a price quote", func() {
+//	    Args(GetQuoteArgs)
+//	    Return(Quote)
+//	    Timeout("10s")
+//	    RetryPolicy(3, "500ms", "unavailable", "timeout")
+//	})
+func RetryPolicy(maxAttempts int, backoff string, retryOn ...string) {
+	tool, ok := eval.Current().(*agentsexpr.ToolExpr)
+	if !ok {
+		eval.IncompatibleDSL()
+		return
+	}
+	d, err := time.ParseDuration(backoff)
+	if err != nil {
+		eval.ReportError("invalid retry backoff duration %q: %s", backoff, err)
+		return
+	}
+	tool.RetryPolicy = &agentsexpr.ToolRetryPolicyExpr{
+		MaxAttempts: maxAttempts,
+		Backoff:     d,
+		RetryOn:     retryOn,
+	}
+}
+
+// CacheScope identifies which calls share cached tool results.
+type CacheScope string
+
+const (
+	// CacheScopeRun shares cached results between calls of the same run.
+	CacheScopeRun CacheScope = "run"
+	// CacheScopeSession shares cached results between runs of the same session.
+	CacheScopeSession CacheScope = "session"
+	// CacheScopeGlobal shares cached results between all runs.
+	CacheScopeGlobal CacheScope = "global"
+)
+
+// CacheResult enables runtime caching of the current tool's results.
+//
+// CacheResult must appear in a Tool expression. The tool must be idempotent
+// (declare ReadOnly or Idempotent) and must not be Destructive.
+//
+// CacheResult takes:
+//   - ttl: a Go duration string bounding how long a result is reused
+//   - scope: which calls share results; CacheScopeSession falls back to run
+//     scope for runs without a session
+//
+// The runtime keys cached results on the tool identifier, the canonical JSON
+// arguments and the run labels, so calls that differ only in key order or
+// whitespace share one result while runs with different labels (and therefore
+// different label-backed Inject values) never do. Tools injecting SessionID
+// must use run or session scope, tools injecting RunID must use run scope, and
+// tools injecting TurnID, ToolCallID or ParentToolCallID cannot be cached. Only successful results are cached. Results served from the
+// cache are marked as cached on planner tool results and tool_end stream
+// events.
+//
+// Example:
+//
+//	Tool("search", "Search the catalog", func() {
+//	    Args(SearchArgs)
+//	    Return(SearchResult)
+//	    ReadOnly()
+//	    CacheResult("10m", CacheScopeSession)
+//	})
+func CacheResult(ttl string, scope CacheScope) {
+	tool, ok := eval.Current().(*agentsexpr.ToolExpr)
+	if !ok {
+		eval.IncompatibleDSL()
+		return
+	}
+	d, err := time.ParseDuration(ttl)
+	if err != nil {
+		eval.ReportError("invalid cache ttl %q: %s", ttl, err)
+		return
+	}
+	tool.ResultCache = &agentsexpr.ToolResultCacheExpr{
+		TTL:   d,
+		Scope: string(scope),
+	}
+}
+
+// ToolCondition is a run-state condition passed to AvailableWhen.
+type ToolCondition struct {
+	succeeded string
+}
+
+// ToolSucceeded returns a condition that holds once the named tool returned a
+// successful result earlier in the run. name is either a tool of the same
+// toolset or a qualified "toolset.tool" identifier. Design validation rejects
+// names that do not resolve to a declared tool; toolsets discovered from an
+// MCP server or a registry accept any tool name.
+func ToolSucceeded(name string) ToolCondition {
+	return ToolCondition{succeeded: name}
+}
+
+// AvailableWhen makes the current tool available only when all conditions
+// hold.
+//
+// AvailableWhen must appear in a Tool expression. It may be called multiple
+// times; conditions accumulate.
+//
+// The runtime evaluates availability before each planner turn and omits
+// unavailable tools from the advertised tool definitions. Calls to a tool
+// whose conditions do not hold when the call is scheduled are answered with
+// a tool_unavailable result instead of being executed.
+//
+// Example:
+//
+//	Tool("submit_order", "Submit the cart", func() {
+//	    Args(SubmitArgs)
+//	    Return(SubmitResult)
+//	    AvailableWhen(ToolSucceeded("validate_cart"))
+//	})
+func AvailableWhen(conds ...ToolCondition) {
+	tool, ok := eval.Current().(*agentsexpr.ToolExpr)
+	if !ok {
+		eval.IncompatibleDSL()
+		return
+	}
+	if len(conds) == 0 {
+		eval.ReportError("AvailableWhen requires at least one condition")
+		return
+	}
+	a := toolAvailability(tool)
+	for _, c := range conds {
+		a.SucceededTools = append(a.SucceededTools, c.succeeded)
+	}
+}
+
+// RequiresLabel makes the current tool available only in runs whose label
+// key is set to value.
+//
+// RequiresLabel must appear in a Tool expression. Like AvailableWhen, it
+// hides the tool from planners and rejects calls while the label does not
+// match. Labels set by the runtime policy engine during the run are taken
+// into account from the next planner turn.
+//
+// Example:
+//
+//	Tool("refund", "Refund an order", func() {
+//	    Args(RefundArgs)
+//	    RequiresLabel("tier", "admin")
+//	})
+func RequiresLabel(key, value string) {
+	tool, ok := eval.Current().(*agentsexpr.ToolExpr)
+	if !ok {
+		eval.IncompatibleDSL()
+		return
+	}
+	a := toolAvailability(tool)
+	if a.Labels == nil {
+		a.Labels = make(map[string]string)
+	}
+	a.Labels[key] = value
+}
+
+// toolAvailability returns the availability expression of tool, creating it
+// on first use.
+func toolAvailability(tool *agentsexpr.ToolExpr) *agentsexpr.ToolAvailabilityExpr {
+	if tool.Availability == nil {
+		tool.Availability = &agentsexpr.ToolAvailabilityExpr{}
+	}
+	return tool.Availability
+}
+
 // toolDSL mirrors Goa's method DSL helpers to define tool shapes.
 func toolDSL(m *agentsexpr.ToolExpr, suffix string, p any, args ...any) *goaexpr.AttributeExpr {
 	return dslshape.Build(m.Name, suffix, p, args...)
@@ -32,6 +32,9 @@ type (
 		// RunPolicy defines runtime execution and resource constraints
 		// for this agent.
 		RunPolicy *RunPolicyExpr
+		// Handoffs lists the agents this agent may transfer the
+		// conversation to.
+		Handoffs []*HandoffExpr
 	}
 
 	// ToolsetGroupExpr represents a logical group of toolsets, as exposed
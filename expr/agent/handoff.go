@@ -0,0 +1,77 @@
+package agent
+
+import (
+	"fmt"
+	"strings"
+
+	"goa.design/goa/v3/eval"
+)
+
+// HandoffExpr declares that an agent may transfer the conversation to another
+// agent. The target takes over the session's subsequent turns; the declaring
+// agent does not resume.
+type HandoffExpr struct {
+	// Agent is the agent that hands off.
+	Agent *AgentExpr
+	// Target is the agent that takes over. When the handoff is declared by
+	// name, Target is resolved during validation.
+	Target *AgentExpr
+	// TargetName is the target reference used in the design: either the
+	// agent name (same service) or "service.agent". Empty when the target
+	// was given as an agent expression.
+	TargetName string
+	// Description explains when the target should take over.
+	Description string
+}
+
+// EvalName is part of eval.Expression allowing descriptive error messages.
+func (h *HandoffExpr) EvalName() string {
+	target := h.TargetName
+	if h.Target != nil {
+		target = h.Target.Name
+	}
+	return fmt.Sprintf("handoff to %q of %s", target, h.Agent.EvalName())
+}
+
+// validateHandoffs resolves handoff targets declared by name and enforces
+// that targets exist, differ from the declaring agent, and are unique per
+// agent.
+func (r *RootExpr) validateHandoffs(verr *eval.ValidationErrors) {
+	for _, a := range r.Agents {
+		seen := make(map[*AgentExpr]struct{}, len(a.Handoffs))
+		for _, h := range a.Handoffs {
+			if h.Target == nil {
+				h.Target = r.findHandoffTarget(a, h.TargetName)
+				if h.Target == nil {
+					verr.Add(h, "handoff target %q is not a declared agent", h.TargetName)
+					continue
+				}
+			}
+			if h.Target == a {
+				verr.Add(h, "agent cannot hand off to itself")
+				continue
+			}
+			if _, dup := seen[h.Target]; dup {
+				verr.Add(h, "duplicate handoff to agent %q", h.Target.Name)
+				continue
+			}
+			seen[h.Target] = struct{}{}
+		}
+	}
+}
+
+// findHandoffTarget resolves a handoff target name. Unqualified names refer
+// to agents of the declaring agent's service; "service.agent" names refer to
+// agents of other services.
+func (r *RootExpr) findHandoffTarget(from *AgentExpr, name string) *AgentExpr {
+	svc, agentName := from.Service.Name, name
+	if s, n, ok := strings.Cut(name, "."); ok {
+		svc, agentName = s, n
+	}
+	for _, a := range r.Agents {
+		if a.Service.Name == svc && a.Name == agentName {
+			return a
+		}
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+// Package lint checks agent designs for tool contracts that are hard for
+// models to use correctly: terse descriptions, undocumented arguments,
+// unconstrained strings, unbounded collections, and destructive tools without
+// confirmation.
+//
+// Each rule has a default severity that designs override with the LintRule
+// DSL. goa gen runs the linter after evaluating the design: warnings are
+// printed, errors fail generation, and the report is written to
+// gen/agents_lint.json. Run exposes the same report to tests and tools.
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	agentsexpr "goa.design/goa-ai/expr/agent"
+	goaexpr "goa.design/goa/v3/expr"
+)
+
+type (
+	// Severity is the severity of a rule violation.
+	Severity string
+
+	// Rule describes one lint rule.
+	Rule struct {
+		// ID identifies the rule in diagnostics and LintRule.
+		ID string `json:"id"`
+		// Description explains what the rule checks.
+		Description string `json:"description"`
+		// Default is the severity used unless the design overrides it.
+		Default Severity `json:"default"`
+	}
+
+	// Diagnostic reports one rule violation.
+	Diagnostic struct {
+		// Rule is the violated rule ID.
+		Rule string `json:"rule"`
+		// Severity is the effective rule severity.
+		Severity Severity `json:"severity"`
+		// Toolset is the name of the toolset declaring the tool.
+		Toolset string `json:"toolset"`
+		// Tool is the tool name.
+		Tool string `json:"tool"`
+		// Field is the dotted path of the offending argument field, if any.
+		Field string `json:"field,omitempty"`
+		// Message describes the violation.
+		Message string `json:"message"`
+	}
+
+	// Report lists the diagnostics of a lint run.
+	Report struct {
+		// Diagnostics are sorted by toolset, tool, field, and rule.
+		Diagnostics []*Diagnostic `json:"diagnostics"`
+	}
+
+	// linter accumulates the diagnostics of one run.
+	linter struct {
+		severities map[string]Severity
+		diags      []*Diagnostic
+	}
+)
+
+const (
+	// SeverityOff disables a rule.
+	SeverityOff Severity = "off"
+	// SeverityWarning reports violations without failing generation.
+	SeverityWarning Severity = "warning"
+	// SeverityError reports violations and fails generation.
+	SeverityError Severity = "error"
+)
+
+const (
+	// RuleToolDescription flags tools with empty or terse descriptions.
+	RuleToolDescription = "tool-description"
+	// RuleArgDescription flags argument fields without descriptions.
+	RuleArgDescription = "arg-description"
+	// RuleArgExample flags argument fields without examples.
+	RuleArgExample = "arg-example"
+	// RuleUnboundedString flags string arguments without enum, format,
+	// pattern, or maximum length.
+	RuleUnboundedString = "unbounded-string"
+	// RuleBoundedResult flags tools returning collections without
+	// BoundedResult.
+	RuleBoundedResult = "bounded-result"
+	// RuleDestructiveConfirmation flags destructive tools without
+	// Confirmation.
+	RuleDestructiveConfirmation = "destructive-confirmation"
+)
+
+// minDescriptionWords is the number of words below which a tool description
+// is considered terse.
+const minDescriptionWords = 3
+
+var rules = []Rule{
+	{RuleToolDescription, "tool descriptions must have at least 3 words", SeverityWarning},
+	{RuleArgDescription, "argument fields must have a description", SeverityWarning},
+	{RuleArgExample, "argument fields must have an example", SeverityWarning},
+	{RuleUnboundedString, "string arguments must declare an enum, format, pattern, or maximum length", SeverityWarning},
+	{RuleBoundedResult, "tools returning collections must declare BoundedResult", SeverityWarning},
+	{RuleDestructiveConfirmation, "destructive tools must declare Confirmation", SeverityWarning},
+}
+
+// Rules returns the lint rules.
+func Rules() []Rule {
+	return slices.Clone(rules)
+}
+
+// KnownRule reports whether id identifies a lint rule.
+func KnownRule(id string) bool {
+	return slices.ContainsFunc(rules, func(r Rule) bool { return r.ID == id })
+}
+
+// ParseSeverity parses a severity name.
+func ParseSeverity(s string) (Severity, error) {
+	switch sev := Severity(s); sev {
+	case SeverityOff, SeverityWarning, SeverityError:
+		return sev, nil
+	default:
+		return "", fmt.Errorf("unknown lint severity %q (want off, warning, or error)", s)
+	}
+}
+
+// Run lints the toolsets declared in root using the rule severities set with
+// LintRule. Registry-backed toolsets are skipped since their contracts are
+// not owned by the design.
+func Run(root *agentsexpr.RootExpr) (*Report, error) {
+	l := &linter{severities: make(map[string]Severity, len(rules))}
+	for _, r := range rules {
+		l.severities[r.ID] = r.Default
+	}
+	for id, s := range root.LintRules {
+		if !KnownRule(id) {
+			return nil, fmt.Errorf("unknown lint rule %q", id)
+		}
+		sev, err := ParseSeverity(s)
+		if err != nil {
+			return nil, err
+		}
+		l.severities[id] = sev
+	}
+	for _, ts := range definingToolsets(root) {
+		for _, t := range ts.Tools {
+			l.lintTool(ts, t)
+		}
+	}
+	slices.SortStableFunc(l.diags, func(a, b *Diagnostic) int {
+		return strings.Compare(
+			a.Toolset+"\x00"+a.Tool+"\x00"+a.Field+"\x00"+a.Rule,
+			b.Toolset+"\x00"+b.Tool+"\x00"+b.Field+"\x00"+b.Rule,
+		)
+	})
+	return &Report{Diagnostics: l.diags}, nil
+}
+
+// Count returns the number of diagnostics with the given severity.
+func (r *Report) Count(sev Severity) int {
+	n := 0
+	for _, d := range r.Diagnostics {
+		if d.Severity == sev {
+			n++
+		}
+	}
+	return n
+}
+
+// JSON returns the indented JSON encoding of the report.
+func (r *Report) JSON() ([]byte, error) {
+	if r.Diagnostics == nil {
+		r = &Report{Diagnostics: []*Diagnostic{}}
+	}
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// WriteText writes one line per diagnostic to w.
+func (r *Report) WriteText(w io.Writer) error {
+	for _, d := range r.Diagnostics {
+		if _, err := fmt.Fprintln(w, d.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String returns a single-line description of the diagnostic.
+func (d *Diagnostic) String() string {
+	loc := fmt.Sprintf("tool %q of toolset %q", d.Tool, d.Toolset)
+	if d.Field != "" {
+		loc += fmt.Sprintf(" field %q", d.Field)
+	}
+	return fmt.Sprintf("%s [%s] %s: %s", d.Severity, d.Rule, loc, d.Message)
+}
+
+// lintTool applies the tool-level rules and walks the tool arguments.
+func (l *linter) lintTool(ts *agentsexpr.ToolsetExpr, t *agentsexpr.ToolExpr) {
+	report := func(rule, field, format string, args ...any) {
+		l.report(rule, ts, t, field, fmt.Sprintf(format, args...))
+	}
+	if desc := strings.TrimSpace(t.Description); desc == "" {
+		report(RuleToolDescription, "", "missing description")
+	} else if n := len(strings.Fields(desc)); n < minDescriptionWords {
+		report(RuleToolDescription, "", "description %q is too terse to guide tool selection", desc)
+	}
+	if t.Confirmation == nil && (t.Destructive || slices.Contains(t.Tags, "destructive")) {
+		report(RuleDestructiveConfirmation, "", "destructive tool without Confirmation")
+	}
+	if t.Bounds == nil && returnsCollection(t.Return) {
+		report(RuleBoundedResult, "", "result contains a collection but the tool does not declare BoundedResult")
+	}
+	if t.Args == nil || t.Args.Type == nil || t.Args.Type == goaexpr.Empty {
+		return
+	}
+	rootExamples := len(t.Args.UserExamples) > 0
+	if def := definition(t.Args); def != nil && len(def.UserExamples) > 0 {
+		rootExamples = true
+	}
+	l.walkArgs(report, t, "", t.Args, rootExamples, make(map[string]struct{}))
+}
+
+// walkArgs applies the field-level rules to the fields of att and its nested
+// objects. Fields hidden from the model with Inject are skipped.
+func (l *linter) walkArgs(report func(rule, field, format string, args ...any), t *agentsexpr.ToolExpr, prefix string, att *goaexpr.AttributeExpr, hasExamples bool, seen map[string]struct{}) {
+	if ut, ok := att.Type.(goaexpr.UserType); ok {
+		if _, done := seen[ut.ID()]; done {
+			return
+		}
+		seen[ut.ID()] = struct{}{}
+		defer delete(seen, ut.ID())
+	}
+	if arr := goaexpr.AsArray(att.Type); arr != nil {
+		l.walkArgs(report, t, prefix+"[]", arr.ElemType, hasExamples, seen)
+		return
+	}
+	obj := goaexpr.AsObject(att.Type)
+	if obj == nil {
+		return
+	}
+	for _, nat := range *obj {
+		if prefix == "" && slices.Contains(t.InjectedFields, nat.Name) {
+			continue
+		}
+		path := nat.Name
+		if prefix != "" {
+			path = prefix + "." + nat.Name
+		}
+		field := nat.Attribute
+		def := definition(field)
+		if field.Description == "" && (def == nil || def.Description == "") {
+			report(RuleArgDescription, path, "missing description")
+		}
+		if !hasExamples && len(field.UserExamples) == 0 && (def == nil || len(def.UserExamples) == 0) {
+			report(RuleArgExample, path, "missing example")
+		}
+		if field.Type.Kind() == goaexpr.StringKind && !constrained(field.Validation) && (def == nil || !constrained(def.Validation)) {
+			report(RuleUnboundedString, path, "string without enum, format, pattern, or maximum length")
+		}
+		l.walkArgs(report, t, path, field, hasExamples, seen)
+	}
+}
+
+// report records a diagnostic unless the rule is disabled.
+func (l *linter) report(rule string, ts *agentsexpr.ToolsetExpr, t *agentsexpr.ToolExpr, field, msg string) {
+	sev := l.severities[rule]
+	if sev == SeverityOff {
+		return
+	}
+	l.diags = append(l.diags, &Diagnostic{
+		Rule:     rule,
+		Severity: sev,
+		Toolset:  ts.Name,
+		Tool:     t.Name,
+		Field:    field,
+		Message:  msg,
+	})
+}
+
+// definingToolsets returns the toolsets declared in root that define their
+// tools, once each.
+func definingToolsets(root *agentsexpr.RootExpr) []*agentsexpr.ToolsetExpr {
+	var all []*agentsexpr.ToolsetExpr
+	for _, a := range root.Agents {
+		if a.Used != nil {
+			all = append(all, a.Used.Toolsets...)
+		}
+		if a.Exported != nil {
+			all = append(all, a.Exported.Toolsets...)
+		}
+	}
+	for _, se := range root.ServiceExports {
+		if se != nil {
+			all = append(all, se.Toolsets...)
+		}
+	}
+	all = append(all, root.Toolsets...)
+
+	seen := make(map[*agentsexpr.ToolsetExpr]struct{}, len(all))
+	out := make([]*agentsexpr.ToolsetExpr, 0, len(all))
+	for _, ts := range all {
+		if ts == nil || ts.Origin != nil {
+			continue
+		}
+		if ts.Provider != nil && ts.Provider.Kind == agentsexpr.ProviderRegistry {
+			continue
+		}
+		if _, ok := seen[ts]; ok {
+			continue
+		}
+		seen[ts] = struct{}{}
+		out = append(out, ts)
+	}
+	return out
+}
+
+// returnsCollection reports whether att is an array or map, or an object with
+// an array or map field.
+func returnsCollection(att *goaexpr.AttributeExpr) bool {
+	if att == nil || att.Type == nil || att.Type == goaexpr.Empty {
+		return false
+	}
+	if goaexpr.IsArray(att.Type) || goaexpr.IsMap(att.Type) {
+		return true
+	}
+	obj := goaexpr.AsObject(att.Type)
+	if obj == nil {
+		return false
+	}
+	for _, nat := range *obj {
+		if goaexpr.IsArray(nat.Attribute.Type) || goaexpr.IsMap(nat.Attribute.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// definition returns the attribute of the user type of att, if any.
+func definition(att *goaexpr.AttributeExpr) *goaexpr.AttributeExpr {
+	if ut, ok := att.Type.(goaexpr.UserType); ok {
+		return ut.Attribute()
+	}
+	return nil
+}
+
+// constrained reports whether v bounds the accepted string values.
+func constrained(v *goaexpr.ValidationExpr) bool {
+	if v == nil {
+		return false
+	}
+	return len(v.Values) > 0 || v.Format != "" || v.Pattern != "" || v.MaxLength != nil
+}
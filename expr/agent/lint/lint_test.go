@@ -0,0 +1,83 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	goaexpr "goa.design/goa/v3/expr"
+
+	agentsexpr "goa.design/goa-ai/expr/agent"
+)
+
+func field(name string, att *goaexpr.AttributeExpr) *goaexpr.NamedAttributeExpr {
+	return &goaexpr.NamedAttributeExpr{Name: name, Attribute: att}
+}
+
+func lintRoot(tools ...*agentsexpr.ToolExpr) *agentsexpr.RootExpr {
+	ts := &agentsexpr.ToolsetExpr{Name: "docs", Tools: tools}
+	return &agentsexpr.RootExpr{Toolsets: []*agentsexpr.ToolsetExpr{ts}}
+}
+
+func TestRunReportsModelUnfriendlyContracts(t *testing.T) {
+	search := &agentsexpr.ToolExpr{
+		Name:        "search",
+		Description: "Search",
+		Args: &goaexpr.AttributeExpr{Type: &goaexpr.Object{
+			field("query", &goaexpr.AttributeExpr{Type: goaexpr.String}),
+			field("mode", &goaexpr.AttributeExpr{
+				Type:         goaexpr.String,
+				Description:  "Search mode",
+				UserExamples: []*goaexpr.ExampleExpr{{Value: "fast"}},
+				Validation:   &goaexpr.ValidationExpr{Values: []any{"fast", "deep"}},
+			}),
+			field("session_id", &goaexpr.AttributeExpr{Type: goaexpr.String}),
+		}},
+		Return: &goaexpr.AttributeExpr{Type: &goaexpr.Object{
+			field("hits", &goaexpr.AttributeExpr{Type: &goaexpr.Array{ElemType: &goaexpr.AttributeExpr{Type: goaexpr.String}}}),
+		}},
+		InjectedFields: []string{"session_id"},
+	}
+	purge := &agentsexpr.ToolExpr{
+		Name:        "purge",
+		Description: "Delete every document permanently",
+		Destructive: true,
+		Args:        &goaexpr.AttributeExpr{Type: goaexpr.Empty},
+	}
+
+	report, err := Run(lintRoot(search, purge))
+	require.NoError(t, err)
+
+	var got []string
+	for _, d := range report.Diagnostics {
+		got = append(got, d.Tool+"/"+d.Field+"/"+d.Rule)
+	}
+	assert.Equal(t, []string{
+		"purge//destructive-confirmation",
+		"search//bounded-result",
+		"search//tool-description",
+		"search/query/arg-description",
+		"search/query/arg-example",
+		"search/query/unbounded-string",
+	}, got)
+	assert.Equal(t, 6, report.Count(SeverityWarning))
+}
+
+func TestRunAppliesConfiguredSeverities(t *testing.T) {
+	tool := &agentsexpr.ToolExpr{Name: "purge", Tags: []string{"destructive"}}
+	root := lintRoot(tool)
+	root.LintRules = map[string]string{
+		RuleToolDescription:         "off",
+		RuleDestructiveConfirmation: "error",
+	}
+
+	report, err := Run(root)
+	require.NoError(t, err)
+	require.Len(t, report.Diagnostics, 1)
+	assert.Equal(t, SeverityError, report.Diagnostics[0].Severity)
+	assert.Equal(t, `error [destructive-confirmation] tool "purge" of toolset "docs": destructive tool without Confirmation`, report.Diagnostics[0].String())
+
+	root.LintRules = map[string]string{"no-such-rule": "error"}
+	_, err = Run(root)
+	assert.EqualError(t, err, `unknown lint rule "no-such-rule"`)
+}
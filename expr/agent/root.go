@@ -29,6 +29,15 @@ type RootExpr struct {
 	// DisableAgentDocs controls whether agent-specific documentation
 	// generation is suppressed.
 	DisableAgentDocs bool
+	// DisableToolsetMocks controls whether the typed mock executor package
+	// (mocks/) of each toolset is generated.
+	DisableToolsetMocks bool
+	// DisableRemoteExecutors controls whether the out-of-process executor
+	// package (remote/) of each local toolset is generated.
+	DisableRemoteExecutors bool
+	// LintRules maps design lint rule IDs to the severity configured with
+	// the LintRule DSL.
+	LintRules map[string]string
 }
 
 type (
@@ -143,10 +152,12 @@ func (r *RootExpr) WalkSets(walk eval.SetWalker) {
 //   - Tool names must be unique within a defining toolset (Origin == nil)
 //     but may be reused across different toolsets. Qualified tool IDs are
 //     derived as "toolset.tool".
+//   - Handoff targets must resolve to other declared agents.
 func (r *RootExpr) Validate() error {
 	verr := new(eval.ValidationErrors)
 	r.validateSanitizedAgentSlugs(verr)
 	r.validateCompletionNames(verr)
+	r.validateHandoffs(verr)
 
 	// Validate registry name uniqueness.
 	registries := make(map[string]*RegistryExpr)
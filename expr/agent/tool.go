@@ -2,6 +2,7 @@ package agent
 
 import (
 	"fmt"
+	"time"
 
 	"goa.design/goa-ai/boundedresult"
 	"goa.design/goa/v3/codegen"
@@ -38,6 +39,10 @@ type (
 		// Return defines the output result schema for this tool.
 		Return *goaexpr.AttributeExpr
 
+		// Progress defines the schema of the partial results the tool
+		// reports while it executes. Nil means the tool reports no progress.
+		Progress *goaexpr.AttributeExpr
+
 		// ServerData declares typed server-only data emitted alongside the canonical
 		// tool result. Server data is never serialized into model provider requests.
 		//
@@ -84,6 +89,36 @@ type (
 		// cost. It is set via the Bookkeeping DSL helper.
 		Bookkeeping bool
 
+		// ReadOnly indicates the tool does not modify its environment. Read-only
+		// tools are always idempotent. It is set via the ReadOnly DSL helper.
+		ReadOnly bool
+
+		// Idempotent indicates that repeating a call with the same arguments has
+		// no additional effect, so runtimes may retry the tool activity when an
+		// attempt fails. It is set via the Idempotent DSL helper.
+		Idempotent bool
+
+		// Destructive indicates the tool may perform irreversible updates to its
+		// environment. Runtimes never retry destructive calls whose outcome is
+		// unknown. It is set via the Destructive DSL helper.
+		Destructive bool
+
+		// Timeout bounds one execution attempt of the tool. Zero keeps the
+		// agent tool timeout. It is set via the Goa Timeout DSL function.
+		Timeout time.Duration
+
+		// RetryPolicy configures how the runtime retries failed executions of
+		// the tool. It is set via the RetryPolicy DSL helper.
+		RetryPolicy *ToolRetryPolicyExpr
+
+		// ResultCache configures runtime caching of the tool results. It is set
+		// via the CacheResult DSL helper.
+		ResultCache *ToolResultCacheExpr
+
+		// Availability gates the tool on run state. It is set via the
+		// AvailableWhen and RequiresLabel DSL helpers.
+		Availability *ToolAvailabilityExpr
+
 		// ResultReminder is an optional system reminder that is injected into
 		// the conversation after the tool result is returned. It provides
 		// backstage guidance to the model about how to interpret or present
@@ -292,6 +327,9 @@ func (t *ToolExpr) Prepare() {
 	if t.TerminalRun {
 		t.Bookkeeping = true
 	}
+	if t.ReadOnly {
+		t.Idempotent = true
+	}
 }
 
 // Validate checks that any recorded binding can be resolved to an existing
@@ -299,8 +337,11 @@ func (t *ToolExpr) Prepare() {
 // required, String field on every attribute set the generated code resolves
 // them against (see injectTargets).
 func (t *ToolExpr) Validate() error {
+	verr := new(eval.ValidationErrors)
+	if t.ReadOnly && t.Destructive {
+		verr.Add(t, "tool cannot be both ReadOnly and Destructive")
+	}
 	if t.bindMethodName == "" {
-		verr := new(eval.ValidationErrors)
 		validateInjectedFields(t, injectTargets(t, nil), verr)
 		if err := t.validateShapes(); err != nil {
 			verr.AddError(t, err)
@@ -310,7 +351,6 @@ func (t *ToolExpr) Validate() error {
 		}
 		return nil
 	}
-	verr := new(eval.ValidationErrors)
 	var svc *goaexpr.ServiceExpr
 	if t.bindServiceName != "" {
 		svc = goaexpr.Root.Service(t.bindServiceName)
@@ -509,11 +549,16 @@ func validateNoLabelBackedInjectOnBoundTool(t *ToolExpr, verr *eval.ValidationEr
 func (t *ToolExpr) validateShapes() error {
 	verr := new(eval.ValidationErrors)
 	validateToolConfirmation(t, verr)
+	validateToolReliability(t, verr)
+	validateToolResultCache(t, verr)
+	validateToolAvailability(t, verr)
+	validateToolProgress(t, verr)
 	check := func(where string, att *goaexpr.AttributeExpr) {
 		validateContractShape(t, where, att, verr)
 	}
 	check("Args", t.Args)
 	check("Return", t.Return)
+	check("Progress", t.Progress)
 	validateServerDataShapes(t, verr, check)
 	validateBoundsShape(t, verr)
 	if len(verr.Errors) == 0 {
@@ -814,12 +859,13 @@ func canonicalBoundedResultFieldNames(bounds *ToolBoundsExpr) []string {
 // Finalize materializes tool shapes and resolves method bindings.
 //
 // Contract:
-//   - Args/Return are finalized before codegen so Extend-composed fields are
+//   - Args/Return/Progress are finalized before codegen so Extend-composed fields are
 //     materialized once at the expression layer.
 //   - Method bindings are resolved after validation and must be deterministic.
 func (t *ToolExpr) Finalize() {
 	finalizeToolShape(t.Args)
 	finalizeToolShape(t.Return)
+	finalizeToolShape(t.Progress)
 
 	if t.bindMethodName == "" {
 		return
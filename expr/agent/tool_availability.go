@@ -0,0 +1,78 @@
+package agent
+
+import (
+	"strings"
+
+	"goa.design/goa/v3/eval"
+)
+
+type (
+	// ToolAvailabilityExpr captures the run state a tool requires before it
+	// is advertised to planners or executed. All conditions must hold.
+	ToolAvailabilityExpr struct {
+		// SucceededTools lists tools that must have returned a successful
+		// result earlier in the run. Names are either sibling tool names or
+		// qualified "toolset.tool" identifiers.
+		SucceededTools []string
+
+		// Labels maps run label keys to the values they must hold.
+		Labels map[string]string
+	}
+)
+
+// EvalName implements eval.Expression.
+func (a *ToolAvailabilityExpr) EvalName() string {
+	return "tool availability"
+}
+
+func validateToolAvailability(tool *ToolExpr, verr *eval.ValidationErrors) {
+	a := tool.Availability
+	if a == nil {
+		return
+	}
+	for _, name := range a.SucceededTools {
+		toolset, local, qualified := strings.Cut(name, ".")
+		switch {
+		case name == "":
+			verr.Add(tool, "ToolSucceeded: tool name must not be empty")
+		case qualified && (toolset == "" || local == ""):
+			verr.Add(tool, "ToolSucceeded: %q must be a sibling tool name or a qualified \"toolset.tool\" identifier", name)
+		case name == tool.Name || (qualified && tool.Toolset != nil && toolset == tool.Toolset.Name && local == tool.Name):
+			verr.Add(tool, "ToolSucceeded: tool %q cannot require its own success", tool.Name)
+		case !qualified && findSiblingTool(tool, name) == nil:
+			verr.Add(tool, "ToolSucceeded references unknown tool %q", name)
+		case qualified && !qualifiedToolExists(tool, toolset, local):
+			verr.Add(tool, "ToolSucceeded references unknown tool %q", name)
+		}
+	}
+	for key := range a.Labels {
+		if key == "" {
+			verr.Add(tool, "RequiresLabel: label key must not be empty")
+		}
+	}
+}
+
+// qualifiedToolExists reports whether the design declares tool local in the
+// toolset named toolset. Toolsets served by an MCP server or a registry that
+// declare no tools in the design are resolved at runtime and accept any tool
+// name.
+func qualifiedToolExists(tool *ToolExpr, toolset, local string) bool {
+	if tool.Toolset != nil && tool.Toolset.Name == toolset {
+		return findSiblingTool(tool, local) != nil
+	}
+	for _, ts := range Root.definingToolsetsForOwnerValidation() {
+		if ts.Name != toolset {
+			continue
+		}
+		if len(ts.Tools) == 0 && ts.Provider != nil && ts.Provider.Kind != ProviderLocal {
+			return true
+		}
+		for _, candidate := range ts.Tools {
+			if candidate.Name == local {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
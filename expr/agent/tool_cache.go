@@ -0,0 +1,64 @@
+package agent
+
+import (
+	"slices"
+	"time"
+
+	"goa.design/goa/v3/codegen"
+	"goa.design/goa/v3/eval"
+)
+
+type (
+	// ToolResultCacheExpr captures the design-time result cache policy of a
+	// tool.
+	ToolResultCacheExpr struct {
+		// TTL is how long a cached result remains valid.
+		TTL time.Duration
+
+		// Scope is one of "run", "session" or "global".
+		Scope string
+	}
+)
+
+// resultCacheScopes lists the valid result cache scopes.
+var resultCacheScopes = []string{"run", "session", "global"}
+
+// cacheableMetaInjectScopes lists, for each ToolCallMeta-backed Inject() field
+// (post-Goify), the cache scopes whose key already pins the injected value.
+// Label-backed fields need no entry: the runtime keys cached results on the run
+// labels. Fields absent from this map (turn and tool call identifiers) differ on
+// every call, so caching a tool that injects them is rejected.
+var cacheableMetaInjectScopes = map[string][]string{
+	"RunID":     {"run"},
+	"SessionID": {"run", "session"},
+}
+
+// EvalName implements eval.Expression.
+func (c *ToolResultCacheExpr) EvalName() string {
+	return "tool result cache"
+}
+
+func validateToolResultCache(tool *ToolExpr, verr *eval.ValidationErrors) {
+	c := tool.ResultCache
+	if c == nil {
+		return
+	}
+	if !tool.Idempotent || tool.Destructive {
+		verr.Add(tool, "CacheResult requires an idempotent, non-destructive tool; declare ReadOnly or Idempotent")
+	}
+	if c.TTL <= 0 {
+		verr.Add(tool, "CacheResult: ttl must be positive")
+	}
+	if !slices.Contains(resultCacheScopes, c.Scope) {
+		verr.Add(tool, "CacheResult: scope must be one of %v, got %q", resultCacheScopes, c.Scope)
+	}
+	for _, name := range tool.InjectedFields {
+		gn := codegen.Goify(name, true)
+		if _, ok := runtimeMetaFieldNames[gn]; !ok {
+			continue
+		}
+		if scopes := cacheableMetaInjectScopes[gn]; !slices.Contains(scopes, c.Scope) {
+			verr.Add(tool, "CacheResult: scope %q would share results across values of injected field %q", c.Scope, name)
+		}
+	}
+}
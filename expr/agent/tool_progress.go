@@ -0,0 +1,19 @@
+package agent
+
+import (
+	"goa.design/goa/v3/eval"
+	goaexpr "goa.design/goa/v3/expr"
+)
+
+func validateToolProgress(tool *ToolExpr, verr *eval.ValidationErrors) {
+	p := tool.Progress
+	if p == nil {
+		return
+	}
+	if p.Type == nil || p.Type == goaexpr.Empty {
+		verr.Add(tool, "Progress must declare a type")
+	}
+	if ts := tool.Toolset; ts != nil && ts.Provider != nil && ts.Provider.Kind != ProviderLocal {
+		verr.Add(tool, "Progress is only supported by local tools, not %s toolsets", ts.Provider.Kind)
+	}
+}
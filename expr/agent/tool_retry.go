@@ -0,0 +1,77 @@
+package agent
+
+import (
+	"slices"
+	"time"
+
+	"goa.design/goa/v3/eval"
+)
+
+type (
+	// ToolRetryPolicyExpr captures design-time retry requirements for a tool.
+	// The runtime applies MaxAttempts and Backoff to the tool execution activity
+	// and re-runs calls whose result fails with one of the RetryOn kinds.
+	ToolRetryPolicyExpr struct {
+		// MaxAttempts caps the total number of execution attempts, including
+		// the first one.
+		MaxAttempts int
+
+		// Backoff is the delay before the first retry. Later retries double it.
+		Backoff time.Duration
+
+		// RetryOn lists the planner failure kinds that trigger a retry.
+		RetryOn []string
+	}
+)
+
+var (
+	// retryableFailureKinds lists the failure kinds a tool may retry on.
+	// Invalid calls and domain rejections are excluded: repeating the same
+	// arguments cannot change their outcome.
+	retryableFailureKinds = []string{"unavailable", "rate_limited", "timeout", "malformed_result", "internal"}
+
+	// destructiveRetryableFailureKinds lists the failure kinds that prove a
+	// call had no effect, the only ones a destructive tool may retry on.
+	destructiveRetryableFailureKinds = []string{"unavailable", "rate_limited"}
+)
+
+// EvalName implements eval.Expression.
+func (r *ToolRetryPolicyExpr) EvalName() string {
+	return "tool retry policy"
+}
+
+// SetTimeout implements expr.TimeoutHolder, allowing the Goa Timeout() DSL
+// function to bound one execution attempt of the tool.
+func (t *ToolExpr) SetTimeout(duration string) error {
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return err
+	}
+	t.Timeout = d
+	return nil
+}
+
+func validateToolReliability(tool *ToolExpr, verr *eval.ValidationErrors) {
+	if tool.Timeout < 0 {
+		verr.Add(tool, "Timeout must be non-negative")
+	}
+	r := tool.RetryPolicy
+	if r == nil {
+		return
+	}
+	if r.MaxAttempts < 1 {
+		verr.Add(tool, "RetryPolicy: maxAttempts must be at least 1")
+	}
+	if r.Backoff < 0 {
+		verr.Add(tool, "RetryPolicy: backoff must be non-negative")
+	}
+	allowed := retryableFailureKinds
+	if tool.Destructive {
+		allowed = destructiveRetryableFailureKinds
+	}
+	for _, kind := range r.RetryOn {
+		if !slices.Contains(allowed, kind) {
+			verr.Add(tool, "RetryPolicy: cannot retry on failure kind %q, allowed kinds are %v", kind, allowed)
+		}
+	}
+}
@@ -102,6 +102,14 @@ type (
 		Method *expr.MethodExpr
 		// InputSchema defines the parameter schema for this tool.
 		InputSchema *expr.AttributeExpr
+		// ReadOnly indicates the tool does not modify its environment.
+		ReadOnly bool
+		// Idempotent indicates that repeated calls with the same
+		// arguments have no additional effect.
+		Idempotent bool
+		// Destructive indicates the tool may perform irreversible
+		// updates.
+		Destructive bool
 	}
 
 	// ResourceExpr defines an MCP resource that the server exposes for access.
@@ -218,6 +226,11 @@ func (m *MCPExpr) Finalize() {
 	if len(m.Tools) > 0 {
 		m.Capabilities.EnableTools = true
 	}
+	for _, t := range m.Tools {
+		if t.ReadOnly {
+			t.Idempotent = true
+		}
+	}
 	if len(m.Resources) > 0 {
 		m.Capabilities.EnableResources = true
 	}
@@ -274,6 +287,9 @@ func (t *ToolExpr) Validate() error {
 	if t.Description == "" {
 		verr.Add(t, "tool description is required")
 	}
+	if t.ReadOnly && t.Destructive {
+		verr.Add(t, "tool cannot be both ReadOnly and Destructive")
+	}
 	if len(verr.Errors) > 0 {
 		return verr
 	}
@@ -38,10 +38,12 @@ type Client interface {
 
 	UpsertRun(ctx context.Context, run session.RunMeta) error
 	LinkChildRun(ctx context.Context, parentRunID string, child session.RunMeta) error
+	LinkHandoffRun(ctx context.Context, fromRunID string, target session.RunMeta) error
 	LoadRun(ctx context.Context, runID string) (session.RunMeta, error)
 	SaveRunSuspension(ctx context.Context, runID string, suspension session.RunSuspension) error
 	LoadRunSuspension(ctx context.Context, runID string) (session.RunSuspension, error)
 	ListRunsBySession(ctx context.Context, sessionID string, statuses []session.RunStatus) ([]session.RunMeta, error)
+	ListSessions(ctx context.Context, statuses []session.SessionStatus, limit int) ([]session.Session, error)
 }
 
 // Options configures the Mongo session client.
@@ -259,18 +261,27 @@ func (c *client) UpsertRun(ctx context.Context, run session.RunMeta) error {
 	defer cancel()
 
 	filter := bson.M{"run_id": run.RunID}
+	set := bson.M{
+		"run_id":        doc.RunID,
+		"agent_id":      doc.AgentID,
+		"session_id":    doc.SessionID,
+		"status":        doc.Status,
+		"updated_at":    doc.UpdatedAt,
+		"labels":        doc.Labels,
+		"prompt_refs":   doc.PromptRefs,
+		"child_run_ids": doc.ChildRunIDs,
+		"metadata":      doc.Metadata,
+	}
+	// Handoff lineage is write-once: only set it when provided so lifecycle
+	// upserts never clear links recorded by LinkHandoffRun.
+	if doc.HandoffFromRunID != "" {
+		set["handoff_from_run_id"] = doc.HandoffFromRunID
+	}
+	if doc.HandoffToRunID != "" {
+		set["handoff_to_run_id"] = doc.HandoffToRunID
+	}
 	update := bson.M{
-		"$set": bson.M{
-			"run_id":        doc.RunID,
-			"agent_id":      doc.AgentID,
-			"session_id":    doc.SessionID,
-			"status":        doc.Status,
-			"updated_at":    doc.UpdatedAt,
-			"labels":        doc.Labels,
-			"prompt_refs":   doc.PromptRefs,
-			"child_run_ids": doc.ChildRunIDs,
-			"metadata":      doc.Metadata,
-		},
+		"$set": set,
 		"$setOnInsert": bson.M{
 			"started_at": doc.StartedAt,
 		},
@@ -335,6 +346,64 @@ func (c *client) linkChildRun(ctx context.Context, parentRunID string, child ses
 	return c.UpsertRun(ctx, parent)
 }
 
+// LinkHandoffRun records handoff lineage between two runs atomically.
+func (c *client) LinkHandoffRun(ctx context.Context, fromRunID string, target session.RunMeta) error {
+	if err := session.ValidateHandoffRunLink(fromRunID, target); err != nil {
+		return err
+	}
+	if c.mongo == nil {
+		return c.linkHandoffRun(ctx, fromRunID, target)
+	}
+	sessionCtx, err := c.mongo.StartSession()
+	if err != nil {
+		return err
+	}
+	defer sessionCtx.EndSession(ctx)
+	_, err = sessionCtx.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		return nil, c.linkHandoffRun(txCtx, fromRunID, target)
+	})
+	return err
+}
+
+// linkHandoffRun applies the handoff-link mutation set under a single
+// caller-owned context (transactional or non-transactional).
+//
+// Contract:
+//   - Source run must already exist.
+//   - Source and target runs must belong to the same session.
+//   - A source run links to at most one target run.
+func (c *client) linkHandoffRun(ctx context.Context, fromRunID string, target session.RunMeta) error {
+	from, err := c.LoadRun(ctx, fromRunID)
+	if err != nil {
+		return err
+	}
+	if from.SessionID != target.SessionID {
+		return session.ErrRunSessionMismatch
+	}
+	if from.HandoffToRunID != "" && from.HandoffToRunID != target.RunID {
+		return session.ErrHandoffConflict
+	}
+
+	existing, err := c.LoadRun(ctx, target.RunID)
+	switch {
+	case err == nil:
+		if existing.SessionID != from.SessionID {
+			return session.ErrRunSessionMismatch
+		}
+		target = existing
+	case errors.Is(err, session.ErrRunNotFound):
+	default:
+		return err
+	}
+	target.HandoffFromRunID = fromRunID
+	if err := c.UpsertRun(ctx, target); err != nil {
+		return err
+	}
+
+	from.HandoffToRunID = target.RunID
+	return c.UpsertRun(ctx, from)
+}
+
 func (c *client) LoadRun(ctx context.Context, runID string) (session.RunMeta, error) {
 	if runID == "" {
 		return session.RunMeta{}, errors.New("run id is required")
@@ -445,6 +514,38 @@ func (c *client) ListRunsBySession(ctx context.Context, sessionID string, status
 	return out, nil
 }
 
+func (c *client) ListSessions(ctx context.Context, statuses []session.SessionStatus, limit int) ([]session.Session, error) {
+	filter := bson.M{}
+	if len(statuses) > 0 {
+		filter["status"] = bson.M{"$in": statuses}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "session_id", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	cur, err := c.sessions.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var out []session.Session
+	for cur.Next(ctx) {
+		var doc sessionDocument
+		if err := cur.Decode(&doc); err != nil {
+			return nil, err
+		}
+		out = append(out, doc.toSession())
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -467,6 +568,9 @@ type runDocument struct {
 	ChildRunIDs []string               `bson:"child_run_ids,omitempty"`
 	Metadata    map[string]any         `bson:"metadata,omitempty"`
 	Suspension  *runSuspensionDocument `bson:"run_suspension,omitempty"`
+
+	HandoffFromRunID string `bson:"handoff_from_run_id,omitempty"`
+	HandoffToRunID   string `bson:"handoff_to_run_id,omitempty"`
 }
 
 // runSuspensionDocument preserves the runtime-owned JSON bytes without
@@ -496,6 +600,9 @@ func fromRunMeta(run session.RunMeta) runDocument {
 		PromptRefs:  clonePromptRefs(run.PromptRefs),
 		ChildRunIDs: cloneChildRunIDs(run.ChildRunIDs),
 		Metadata:    cloneMetadata(run.Metadata),
+
+		HandoffFromRunID: run.HandoffFromRunID,
+		HandoffToRunID:   run.HandoffToRunID,
 	}
 }
 
@@ -511,6 +618,9 @@ func (doc runDocument) toRunMeta() session.RunMeta {
 		PromptRefs:  clonePromptRefs(doc.PromptRefs),
 		ChildRunIDs: cloneChildRunIDs(doc.ChildRunIDs),
 		Metadata:    cloneMetadata(doc.Metadata),
+
+		HandoffFromRunID: doc.HandoffFromRunID,
+		HandoffToRunID:   doc.HandoffToRunID,
 	}
 }
 
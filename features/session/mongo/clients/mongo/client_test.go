@@ -3,6 +3,8 @@ package mongo
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -164,6 +166,35 @@ func TestLinkChildRunSessionMismatchError(t *testing.T) {
 	require.ErrorIs(t, err, session.ErrRunSessionMismatch)
 }
 
+func TestLinkHandoffRun(t *testing.T) {
+	client := mustNewTestClient()
+	ctx := context.Background()
+	require.NoError(t, client.UpsertRun(ctx, session.RunMeta{
+		RunID:     "run-triage",
+		AgentID:   "svc.triage",
+		SessionID: "sess-1",
+		Status:    session.RunStatusCompleted,
+	}))
+	target := session.RunMeta{
+		RunID:     "run-billing",
+		AgentID:   "svc.billing",
+		SessionID: "sess-1",
+		Status:    session.RunStatusPending,
+	}
+	require.NoError(t, client.LinkHandoffRun(ctx, "run-triage", target))
+	require.NoError(t, client.UpsertRun(ctx, target))
+
+	from, err := client.LoadRun(ctx, "run-triage")
+	require.NoError(t, err)
+	require.Equal(t, "run-billing", from.HandoffToRunID)
+	to, err := client.LoadRun(ctx, "run-billing")
+	require.NoError(t, err)
+	require.Equal(t, "run-triage", to.HandoffFromRunID, "upserts must preserve handoff lineage")
+
+	target.RunID = "run-other"
+	require.ErrorIs(t, client.LinkHandoffRun(ctx, "run-triage", target), session.ErrHandoffConflict)
+}
+
 func TestListRunsBySession(t *testing.T) {
 	client := mustNewTestClient()
 	now := time.Now().UTC()
@@ -198,6 +229,29 @@ func TestListRunsBySession(t *testing.T) {
 	require.Equal(t, "run-1", out[0].RunID)
 }
 
+func TestListSessions(t *testing.T) {
+	client := mustNewTestClient()
+	ctx := context.Background()
+	now := time.Now().UTC()
+	for i, id := range []string{"sess-1", "sess-2", "sess-3"} {
+		_, err := client.CreateSession(ctx, id, now.Add(time.Duration(i)*time.Minute))
+		require.NoError(t, err)
+	}
+	_, err := client.EndSession(ctx, "sess-2", now.Add(time.Hour))
+	require.NoError(t, err)
+
+	all, err := client.ListSessions(ctx, nil, 0)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, "sess-3", all[0].ID)
+	require.Equal(t, "sess-1", all[2].ID)
+
+	active, err := client.ListSessions(ctx, []session.SessionStatus{session.StatusActive}, 1)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	require.Equal(t, "sess-3", active[0].ID)
+}
+
 func TestUpsertValidation(t *testing.T) {
 	client := mustNewTestClient()
 	err := client.UpsertRun(context.Background(), session.RunMeta{AgentID: "agent"})
@@ -419,6 +473,12 @@ func (c *fakeRunsCollection) UpdateOne(ctx context.Context, filter any, update a
 		if v, ok := set["child_run_ids"].([]string); ok {
 			doc.ChildRunIDs = v
 		}
+		if v, ok := set["handoff_from_run_id"].(string); ok {
+			doc.HandoffFromRunID = v
+		}
+		if v, ok := set["handoff_to_run_id"].(string); ok {
+			doc.HandoffToRunID = v
+		}
 		if v, ok := set["run_suspension"].(runSuspensionDocument); ok {
 			doc.Suspension = &v
 		}
@@ -513,7 +573,42 @@ func (c *fakeSessionsCollection) FindOne(ctx context.Context, filter any, opts .
 }
 
 func (c *fakeSessionsCollection) Find(ctx context.Context, filter any, opts ...options.Lister[options.FindOptions]) (cursor, error) {
-	return newFakeCursor(nil), nil
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var allowed []session.SessionStatus
+	if raw, ok := filter.(bson.M)["status"].(bson.M); ok {
+		allowed, _ = raw["$in"].([]session.SessionStatus)
+	}
+	matched := make([]sessionDocument, 0, len(c.docs))
+	for _, doc := range c.docs {
+		if len(allowed) > 0 && !slices.Contains(allowed, doc.Status) {
+			continue
+		}
+		matched = append(matched, doc)
+	}
+	slices.SortFunc(matched, func(a, b sessionDocument) int {
+		if cmp := b.CreatedAt.Compare(a.CreatedAt); cmp != 0 {
+			return cmp
+		}
+		return strings.Compare(a.SessionID, b.SessionID)
+	})
+	findOpts := new(options.FindOptions)
+	for _, opt := range opts {
+		for _, apply := range opt.List() {
+			if err := apply(findOpts); err != nil {
+				panic(err)
+			}
+		}
+	}
+	if findOpts.Limit != nil && int(*findOpts.Limit) < len(matched) {
+		matched = matched[:*findOpts.Limit]
+	}
+	docs := make([]any, 0, len(matched))
+	for i := range matched {
+		docs = append(docs, &matched[i])
+	}
+	return newFakeCursor(docs), nil
 }
 
 func (c *fakeSessionsCollection) UpdateOne(ctx context.Context, filter any, update any,
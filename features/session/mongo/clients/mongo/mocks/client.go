@@ -30,10 +30,12 @@ type (
 	ClientPurgeSessionFunc      func(ctx context.Context, sessionID string) error
 	ClientUpsertRunFunc         func(ctx context.Context, run session.RunMeta) error
 	ClientLinkChildRunFunc      func(ctx context.Context, parentRunID string, child session.RunMeta) error
+	ClientLinkHandoffRunFunc    func(ctx context.Context, fromRunID string, target session.RunMeta) error
 	ClientLoadRunFunc           func(ctx context.Context, runID string) (session.RunMeta, error)
 	ClientSaveRunSuspensionFunc func(ctx context.Context, runID string, suspension session.RunSuspension) error
 	ClientLoadRunSuspensionFunc func(ctx context.Context, runID string) (session.RunSuspension, error)
 	ClientListRunsBySessionFunc func(ctx context.Context, sessionID string, statuses []session.RunStatus) ([]session.RunMeta, error)
+	ClientListSessionsFunc      func(ctx context.Context, statuses []session.SessionStatus, limit int) ([]session.Session, error)
 )
 
 func NewClient(t *testing.T) *Client {
@@ -180,6 +182,23 @@ func (m *Client) LinkChildRun(ctx context.Context, parentRunID string, child ses
 	return nil
 }
 
+func (m *Client) AddLinkHandoffRun(f ClientLinkHandoffRunFunc) {
+	m.m.Add("LinkHandoffRun", f)
+}
+
+func (m *Client) SetLinkHandoffRun(f ClientLinkHandoffRunFunc) {
+	m.m.Set("LinkHandoffRun", f)
+}
+
+func (m *Client) LinkHandoffRun(ctx context.Context, fromRunID string, target session.RunMeta) error {
+	if f := m.m.Next("LinkHandoffRun"); f != nil {
+		return f.(ClientLinkHandoffRunFunc)(ctx, fromRunID, target)
+	}
+	m.t.Helper()
+	m.t.Error("unexpected LinkHandoffRun call")
+	return nil
+}
+
 func (m *Client) AddLoadRun(f ClientLoadRunFunc) {
 	m.m.Add("LoadRun", f)
 }
@@ -248,6 +267,23 @@ func (m *Client) ListRunsBySession(ctx context.Context, sessionID string, status
 	return nil, nil
 }
 
+func (m *Client) AddListSessions(f ClientListSessionsFunc) {
+	m.m.Add("ListSessions", f)
+}
+
+func (m *Client) SetListSessions(f ClientListSessionsFunc) {
+	m.m.Set("ListSessions", f)
+}
+
+func (m *Client) ListSessions(ctx context.Context, statuses []session.SessionStatus, limit int) ([]session.Session, error) {
+	if f := m.m.Next("ListSessions"); f != nil {
+		return f.(ClientListSessionsFunc)(ctx, statuses, limit)
+	}
+	m.t.Helper()
+	m.t.Error("unexpected ListSessions call")
+	return nil, nil
+}
+
 func (m *Client) HasMore() bool {
 	return m.m.HasMore()
 }
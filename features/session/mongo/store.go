@@ -52,6 +52,11 @@ func (s *Store) LinkChildRun(ctx context.Context, parentRunID string, child sess
 	return s.client.LinkChildRun(ctx, parentRunID, child)
 }
 
+// LinkHandoffRun implements session.Store.
+func (s *Store) LinkHandoffRun(ctx context.Context, fromRunID string, target session.RunMeta) error {
+	return s.client.LinkHandoffRun(ctx, fromRunID, target)
+}
+
 // LoadRun implements session.Store.
 func (s *Store) LoadRun(ctx context.Context, runID string) (session.RunMeta, error) {
 	return s.client.LoadRun(ctx, runID)
@@ -71,3 +76,8 @@ func (s *Store) LoadRunSuspension(ctx context.Context, runID string) (session.Ru
 func (s *Store) ListRunsBySession(ctx context.Context, sessionID string, statuses []session.RunStatus) ([]session.RunMeta, error) {
 	return s.client.ListRunsBySession(ctx, sessionID, statuses)
 }
+
+// ListSessions implements session.Lister.
+func (s *Store) ListSessions(ctx context.Context, statuses []session.SessionStatus, limit int) ([]session.Session, error) {
+	return s.client.ListSessions(ctx, statuses, limit)
+}
@@ -0,0 +1,82 @@
+// Package redis provides a Redis-backed toolcache.Store so workers share
+// cached tool results.
+//
+// Entries are stored as JSON strings under "<prefix><key>" and expire through
+// Redis key TTLs.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"goa.design/goa-ai/runtime/agent/toolcache"
+)
+
+// DefaultKeyPrefix namespaces cache entries when Options.KeyPrefix is empty.
+const DefaultKeyPrefix = "goa-ai:toolcache:"
+
+type (
+	// Options configures the Redis tool result cache.
+	Options struct {
+		// Redis is the client used to store entries. Required.
+		Redis redis.UniversalClient
+		// KeyPrefix namespaces cache entries. Defaults to DefaultKeyPrefix.
+		KeyPrefix string
+	}
+
+	// Store implements toolcache.Store on Redis.
+	Store struct {
+		rdb    redis.UniversalClient
+		prefix string
+	}
+)
+
+// NewStore builds a Redis-backed tool result cache.
+func NewStore(opts Options) (*Store, error) {
+	if opts.Redis == nil {
+		return nil, errors.New("redis client is required")
+	}
+	prefix := opts.KeyPrefix
+	if prefix == "" {
+		prefix = DefaultKeyPrefix
+	}
+	return &Store{rdb: opts.Redis, prefix: prefix}, nil
+}
+
+// Get implements toolcache.Store.
+func (s *Store) Get(ctx context.Context, key string) (*toolcache.Entry, bool, error) {
+	b, err := s.rdb.Get(ctx, s.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("get cached tool result: %w", err)
+	}
+	var e toolcache.Entry
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, false, fmt.Errorf("decode cached tool result: %w", err)
+	}
+	return &e, true, nil
+}
+
+// Set implements toolcache.Store.
+func (s *Store) Set(ctx context.Context, key string, entry *toolcache.Entry, ttl time.Duration) error {
+	if entry == nil {
+		return errors.New("entry is required")
+	}
+	if ttl <= 0 {
+		return errors.New("ttl must be positive")
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encode cached tool result: %w", err)
+	}
+	if err := s.rdb.Set(ctx, s.prefix+key, b, ttl).Err(); err != nil {
+		return fmt.Errorf("set cached tool result: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+// Package registry implements the operator admin API.
+//
+// Admin methods read the authoritative catalog record, call hashes, and health
+// history that routing itself uses, and intervene only through the catalog
+// operations providers already rely on: a forced drain is a provider drain
+// that keeps the current lease expiry, and retirement is Unregister. Callers
+// need admin rights on the toolset when the registry enforces a policy.
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"goa.design/goa-ai/registry/audit"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/toolregistry"
+)
+
+// ListProviders returns the catalog admission of one toolset with its
+// unexpired provider-incarnation leases.
+func (s *Service) ListProviders(
+	ctx context.Context,
+	p *genregistry.ListProvidersPayload,
+) (*genregistry.ListProvidersResult, error) {
+	if err := s.authorizeAdmin(ctx, p.Name); err != nil {
+		return nil, err
+	}
+	entry, now, err := s.catalog.Admission(ctx, p.Name)
+	if err != nil {
+		if errors.Is(err, errToolsetNotFound) {
+			return nil, genregistry.MakeNotFound(fmt.Errorf("toolset %q not found", p.Name))
+		}
+		return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("read toolset admission: %w", err))
+	}
+	res := &genregistry.ListProvidersResult{
+		Name:              p.Name,
+		State:             string(entry.State),
+		RegistrationToken: entry.RegistrationToken,
+		HealthEpoch:       entry.HealthEpoch,
+		Providers:         make([]*genregistry.ProviderLeaseInfo, 0, len(entry.ProviderLeases)),
+	}
+	if entry.LastPongUnixNano != 0 {
+		lastPong := entry.LastPongUnixNano / 1e6
+		res.LastPongUnixMilli = &lastPong
+	}
+	for key, lease := range entry.ProviderLeases {
+		if lease.ExpiresAtUnixMilli <= now.UnixMilli() {
+			continue
+		}
+		providerID, incarnationID, err := parseProviderLeaseKey(key)
+		if err != nil {
+			return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("read provider lease: %w", err))
+		}
+		info := &genregistry.ProviderLeaseInfo{
+			ProviderID:              providerID,
+			ProviderIncarnationID:   incarnationID,
+			LeaseExpiresAtUnixMilli: lease.ExpiresAtUnixMilli,
+			Draining:                lease.Draining,
+		}
+		if s.callInspector != nil {
+			claimed, err := s.callInspector.ClaimedCallCount(ctx, entry.RegistrationToken, key)
+			if err != nil {
+				return nil, genregistry.MakeServiceUnavailable(err)
+			}
+			info.ClaimedCalls = claimed
+		}
+		res.Providers = append(res.Providers, info)
+	}
+	sort.Slice(res.Providers, func(i, j int) bool {
+		if res.Providers[i].ProviderID != res.Providers[j].ProviderID {
+			return res.Providers[i].ProviderID < res.Providers[j].ProviderID
+		}
+		return res.Providers[i].ProviderIncarnationID < res.Providers[j].ProviderIncarnationID
+	})
+	if entry.State == catalogEntryActive {
+		health, err := s.healthTracker.Health(ctx, p.Name, entry.RegistrationToken)
+		switch {
+		case err == nil:
+			res.Healthy = health.Healthy
+		case !errors.Is(err, errToolsetNotFound):
+			return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("read toolset health: %w", err))
+		}
+	}
+	return res, nil
+}
+
+// ForceDrainProvider marks one provider-incarnation lease draining on behalf
+// of an operator.
+func (s *Service) ForceDrainProvider(ctx context.Context, p *genregistry.ForceDrainProviderPayload) error {
+	caller, err := s.authenticate(ctx)
+	if err == nil {
+		err = s.forceDrainProvider(ctx, caller, p)
+	}
+	return s.recordAudit(ctx, caller, audit.Event{
+		Type:       audit.EventForceDrainProvider,
+		Toolset:    p.Name,
+		ProviderID: p.ProviderID,
+	}, err)
+}
+
+// forceDrainProvider implements ForceDrainProvider. A zero settlement duration
+// keeps the lease expiry the provider last renewed.
+func (s *Service) forceDrainProvider(ctx context.Context, caller Caller, p *genregistry.ForceDrainProviderPayload) error {
+	if err := s.allowAdmin(caller, p.Name); err != nil {
+		return err
+	}
+	if err := s.catalog.DrainProvider(
+		ctx,
+		p.Name,
+		p.ProviderID,
+		p.ProviderIncarnationID,
+		p.RegistrationToken,
+		0,
+	); err != nil {
+		return genregistry.MakeServiceUnavailable(fmt.Errorf("drain provider lease: %w", err))
+	}
+	return nil
+}
+
+// RetireRegistration retires the exact admission on behalf of an operator.
+func (s *Service) RetireRegistration(ctx context.Context, p *genregistry.RetireRegistrationPayload) error {
+	caller, err := s.authenticate(ctx)
+	if err == nil {
+		err = s.retireRegistration(ctx, caller, p)
+	}
+	return s.recordAudit(ctx, caller, audit.Event{Type: audit.EventRetireRegistration, Toolset: p.Name}, err)
+}
+
+// retireRegistration implements RetireRegistration.
+func (s *Service) retireRegistration(ctx context.Context, caller Caller, p *genregistry.RetireRegistrationPayload) error {
+	if err := s.allowAdmin(caller, p.Name); err != nil {
+		return err
+	}
+	err := s.catalog.Retire(ctx, p.Name, p.RegistrationToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, errAdmissionConflict):
+			return genregistry.MakeAdmissionConflict(err)
+		default:
+			return genregistry.MakeServiceUnavailable(fmt.Errorf("retire toolset admission: %w", err))
+		}
+	}
+	return nil
+}
+
+// ListToolCalls returns the unsettled claimed calls of the toolset's current
+// admission, earliest execution deadline first.
+func (s *Service) ListToolCalls(
+	ctx context.Context,
+	p *genregistry.ListToolCallsPayload,
+) (*genregistry.ListToolCallsResult, error) {
+	if err := s.authorizeAdmin(ctx, p.Toolset); err != nil {
+		return nil, err
+	}
+	if s.callInspector == nil {
+		return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("call inspection is not configured"))
+	}
+	entry, _, err := s.catalog.Admission(ctx, p.Toolset)
+	if err != nil {
+		if errors.Is(err, errToolsetNotFound) {
+			return &genregistry.ListToolCallsResult{Calls: []*genregistry.ToolCallInfo{}}, nil
+		}
+		return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("read toolset admission: %w", err))
+	}
+	leases := make([]string, 0, len(entry.ProviderLeases))
+	for key := range entry.ProviderLeases {
+		leases = append(leases, key)
+	}
+	calls, err := s.callInspector.ClaimedCalls(ctx, p.Toolset, entry.RegistrationToken, leases, p.Limit)
+	if err != nil {
+		return nil, genregistry.MakeServiceUnavailable(err)
+	}
+	return &genregistry.ListToolCallsResult{Calls: calls}, nil
+}
+
+// InspectToolCall returns the authoritative state of one admitted call.
+func (s *Service) InspectToolCall(
+	ctx context.Context,
+	p *genregistry.InspectToolCallPayload,
+) (*genregistry.ToolCallInfo, error) {
+	if err := s.authorizeAdmin(ctx, p.Toolset); err != nil {
+		return nil, err
+	}
+	if err := toolregistry.ValidateToolUseID(p.ToolUseID); err != nil {
+		return nil, genregistry.MakeValidationError(err)
+	}
+	if s.callInspector == nil {
+		return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("call inspection is not configured"))
+	}
+	call, err := s.callInspector.InspectCall(ctx, p.Toolset, p.ToolUseID)
+	if err != nil {
+		if errors.Is(err, errCallAdmissionNotFound) {
+			return nil, genregistry.MakeNotFound(fmt.Errorf(
+				"tool call %q of toolset %q not found",
+				p.ToolUseID,
+				p.Toolset,
+			))
+		}
+		return nil, genregistry.MakeServiceUnavailable(err)
+	}
+	return call, nil
+}
+
+// ListHealthTransitions returns the recorded health transitions of one
+// toolset, newest first.
+func (s *Service) ListHealthTransitions(
+	ctx context.Context,
+	p *genregistry.ListHealthTransitionsPayload,
+) (*genregistry.ListHealthTransitionsResult, error) {
+	if err := s.authorizeAdmin(ctx, p.Name); err != nil {
+		return nil, err
+	}
+	if s.healthHistory == nil {
+		return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("health history is not configured"))
+	}
+	transitions, err := s.healthHistory.Transitions(ctx, p.Name, p.Limit)
+	if err != nil {
+		return nil, genregistry.MakeServiceUnavailable(err)
+	}
+	return &genregistry.ListHealthTransitionsResult{Transitions: transitions}, nil
+}
+
+// GetToolsetRoutes returns the canary route table of one toolset, highest
+// version first.
+func (s *Service) GetToolsetRoutes(
+	ctx context.Context,
+	p *genregistry.GetToolsetRoutesPayload,
+) (*genregistry.ToolsetRoutesResult, error) {
+	if err := s.authorizeAdmin(ctx, p.Name); err != nil {
+		return nil, err
+	}
+	routes, err := s.catalog.ToolsetRoutes(ctx, p.Name)
+	if err != nil {
+		return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("read toolset routes: %w", err))
+	}
+	res := &genregistry.ToolsetRoutesResult{
+		Name:   p.Name,
+		Routes: make([]*genregistry.ToolsetRoute, 0, len(routes)),
+	}
+	versions := make([]toolregistry.SemVer, 0, len(routes))
+	for version := range routes {
+		v, err := toolregistry.ParseSemVer(version)
+		if err != nil {
+			return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("read toolset routes: %w", err))
+		}
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Compare(versions[j]) > 0 })
+	for _, v := range versions {
+		res.Routes = append(res.Routes, &genregistry.ToolsetRoute{
+			Version: genregistry.SemVer(v.String()),
+			Weight:  routes[v.String()],
+		})
+	}
+	return res, nil
+}
+
+// SetToolsetRoutes replaces the canary route table of one toolset on behalf
+// of an operator.
+func (s *Service) SetToolsetRoutes(ctx context.Context, p *genregistry.SetToolsetRoutesPayload) error {
+	caller, err := s.authenticate(ctx)
+	if err == nil {
+		err = s.setToolsetRoutes(ctx, caller, p)
+	}
+	return s.recordAudit(ctx, caller, audit.Event{Type: audit.EventSetToolsetRoutes, Toolset: p.Name}, err)
+}
+
+// setToolsetRoutes implements SetToolsetRoutes. Versions are canonicalized so
+// "v1.2.0" and "1.2.0" name the same registration; naming one version twice
+// is rejected rather than silently merged.
+func (s *Service) setToolsetRoutes(ctx context.Context, caller Caller, p *genregistry.SetToolsetRoutesPayload) error {
+	if err := s.allowAdmin(caller, p.Name); err != nil {
+		return err
+	}
+	routes := make(toolregistry.ToolsetRoutes, len(p.Routes))
+	for _, route := range p.Routes {
+		v, err := toolregistry.ParseSemVer(string(route.Version))
+		if err != nil {
+			return genregistry.MakeValidationError(fmt.Errorf("route version: %w", err))
+		}
+		version := v.String()
+		if _, dup := routes[version]; dup {
+			return genregistry.MakeValidationError(fmt.Errorf("route version %s is listed more than once", version))
+		}
+		routes[version] = route.Weight
+	}
+	if err := toolregistry.ValidateToolsetRoutes(routes); err != nil {
+		return genregistry.MakeValidationError(err)
+	}
+	if err := s.catalog.SetToolsetRoutes(ctx, p.Name, routes); err != nil {
+		return genregistry.MakeServiceUnavailable(fmt.Errorf("write toolset routes: %w", err))
+	}
+	return nil
+}
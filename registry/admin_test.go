@@ -0,0 +1,326 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goa.design/goa-ai/registry/audit"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/agent/telemetry"
+	goa "goa.design/goa/v3/pkg"
+)
+
+type (
+	// fixedCallInspector serves canned calls and claim counts per lease.
+	fixedCallInspector struct {
+		calls   map[string]*genregistry.ToolCallInfo
+		claimed map[string]int64
+		leases  []string
+	}
+
+	// fixedHealthHistory serves canned transitions.
+	fixedHealthHistory struct {
+		transitions []*genregistry.HealthTransition
+		limit       int
+	}
+)
+
+func TestServiceAdminRequiresAdminRights(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	catalog := newToolsetCatalog(newTestCatalogMap(), newTestTimeSource(time.Unix(1_700_000_000, 0)))
+	admission, err := catalog.Register(
+		ctx,
+		testCatalogToolset("data.tools", "admin", nil),
+		testAdmissionRevisionA,
+		"provider",
+		testIncarnationA,
+		time.Hour,
+	)
+	require.NoError(t, err)
+	secret := []byte("registry-test-secret")
+	sink := &recordingAuditSink{}
+	svc := &Service{
+		catalog:       catalog,
+		healthTracker: unitHealthTracker{},
+		authenticator: &JWTAuthenticator{Keys: map[string]any{"": secret}},
+		policy: &Policy{Rules: []PolicyRule{
+			{Callers: []string{"agent"}, Call: []string{"data.*"}, Register: []string{"data.*"}},
+			{Callers: []string{"ops"}, Admin: []string{"data.*"}},
+		}},
+		auditSink: sink,
+		logger:    telemetry.NewNoopLogger(),
+	}
+	as := func(subject string) context.Context {
+		return bearerContext(signHS256(t, secret, map[string]any{
+			"sub": subject,
+			"exp": time.Now().Add(time.Minute).Unix(),
+		}))
+	}
+
+	_, err = svc.ListProviders(ctx, &genregistry.ListProvidersPayload{Name: "data.tools"})
+	requireAdminError(t, err, "unauthenticated")
+	_, err = svc.ListProviders(as("agent"), &genregistry.ListProvidersPayload{Name: "data.tools"})
+	requireAdminError(t, err, "permission_denied")
+	err = svc.RetireRegistration(as("agent"), &genregistry.RetireRegistrationPayload{
+		Name:              "data.tools",
+		RegistrationToken: admission.RegistrationToken,
+	})
+	requireAdminError(t, err, "permission_denied")
+
+	listed, err := svc.ListProviders(as("ops"), &genregistry.ListProvidersPayload{Name: "data.tools"})
+	require.NoError(t, err)
+	assert.Equal(t, admission.RegistrationToken, listed.RegistrationToken)
+	require.NoError(t, svc.ForceDrainProvider(as("ops"), &genregistry.ForceDrainProviderPayload{
+		Name:                  "data.tools",
+		ProviderID:            "provider",
+		ProviderIncarnationID: testIncarnationA,
+		RegistrationToken:     admission.RegistrationToken,
+	}))
+
+	require.Len(t, sink.events, 2)
+	assert.Equal(t, audit.EventRetireRegistration, sink.events[0].Type)
+	assert.Equal(t, "permission_denied", sink.events[0].Outcome)
+	assert.Equal(t, audit.EventForceDrainProvider, sink.events[1].Type)
+	assert.Equal(t, "ops", sink.events[1].Caller)
+	assert.Equal(t, "provider", sink.events[1].ProviderID)
+	assert.Equal(t, audit.OutcomeOK, sink.events[1].Outcome)
+}
+
+func TestServiceListProvidersReportsLeasesAndRetirement(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Unix(1_700_000_000, 0)
+	catalog := newToolsetCatalog(newTestCatalogMap(), newTestTimeSource(now))
+	var admission catalogEntry
+	for _, incarnation := range []string{testIncarnationB, testIncarnationA} {
+		var err error
+		admission, err = catalog.Register(
+			ctx,
+			testCatalogToolset("data.tools", "admin", nil),
+			testAdmissionRevisionA,
+			"provider",
+			incarnation,
+			time.Minute,
+		)
+		require.NoError(t, err)
+	}
+	inspector := &fixedCallInspector{claimed: map[string]int64{
+		providerLeaseKey("provider", testIncarnationB): 3,
+	}}
+	svc := &Service{
+		catalog:       catalog,
+		healthTracker: unitHealthTracker{},
+		callInspector: inspector,
+		logger:        telemetry.NewNoopLogger(),
+	}
+	require.NoError(t, svc.ForceDrainProvider(ctx, &genregistry.ForceDrainProviderPayload{
+		Name:                  "data.tools",
+		ProviderID:            "provider",
+		ProviderIncarnationID: testIncarnationB,
+		RegistrationToken:     admission.RegistrationToken,
+	}))
+
+	listed, err := svc.ListProviders(ctx, &genregistry.ListProvidersPayload{Name: "data.tools"})
+	require.NoError(t, err)
+	assert.Equal(t, "active", listed.State)
+	assert.Equal(t, admission.HealthEpoch, listed.HealthEpoch)
+	assert.True(t, listed.Healthy)
+	assert.Equal(t, []*genregistry.ProviderLeaseInfo{
+		{
+			ProviderID:              "provider",
+			ProviderIncarnationID:   testIncarnationA,
+			LeaseExpiresAtUnixMilli: now.Add(time.Minute).UnixMilli(),
+		},
+		{
+			ProviderID:              "provider",
+			ProviderIncarnationID:   testIncarnationB,
+			LeaseExpiresAtUnixMilli: now.Add(time.Minute).UnixMilli(),
+			Draining:                true,
+			ClaimedCalls:            3,
+		},
+	}, listed.Providers)
+
+	err = svc.RetireRegistration(ctx, &genregistry.RetireRegistrationPayload{
+		Name:              "data.tools",
+		RegistrationToken: "stale",
+	})
+	requireAdminError(t, err, "admission_conflict")
+	require.NoError(t, svc.RetireRegistration(ctx, &genregistry.RetireRegistrationPayload{
+		Name:              "data.tools",
+		RegistrationToken: admission.RegistrationToken,
+	}))
+	listed, err = svc.ListProviders(ctx, &genregistry.ListProvidersPayload{Name: "data.tools"})
+	require.NoError(t, err)
+	assert.Equal(t, "retired", listed.State)
+	assert.False(t, listed.Healthy, "retired admissions never report health")
+	assert.Len(t, listed.Providers, 2, "retirement preserves provider leases")
+
+	calls, err := svc.ListToolCalls(ctx, &genregistry.ListToolCallsPayload{Toolset: "data.tools", Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, calls.Calls)
+	assert.ElementsMatch(t, []string{
+		providerLeaseKey("provider", testIncarnationA),
+		providerLeaseKey("provider", testIncarnationB),
+	}, inspector.leases)
+
+	_, err = svc.ListProviders(ctx, &genregistry.ListProvidersPayload{Name: "missing.tools"})
+	requireAdminError(t, err, "not_found")
+}
+
+func TestServiceInspectToolCallAndHealthTransitions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	providerID := "provider"
+	history := &fixedHealthHistory{transitions: []*genregistry.HealthTransition{
+		{AtUnixMilli: 2, Healthy: false, RegistrationToken: "token", HealthEpoch: 2},
+		{AtUnixMilli: 1, Healthy: true, RegistrationToken: "token", HealthEpoch: 1, ProviderCount: 1},
+	}}
+	svc := &Service{
+		callInspector: &fixedCallInspector{calls: map[string]*genregistry.ToolCallInfo{
+			"call-1": {ToolUseID: "call-1", State: "claimed", ProviderID: &providerID},
+		}},
+		healthHistory: history,
+	}
+
+	call, err := svc.InspectToolCall(ctx, &genregistry.InspectToolCallPayload{Toolset: "data.tools", ToolUseID: "call-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "claimed", call.State)
+	_, err = svc.InspectToolCall(ctx, &genregistry.InspectToolCallPayload{Toolset: "data.tools", ToolUseID: "call-2"})
+	requireAdminError(t, err, "not_found")
+	_, err = svc.InspectToolCall(ctx, &genregistry.InspectToolCallPayload{Toolset: "data.tools", ToolUseID: "bad\x00id"})
+	requireAdminError(t, err, "validation_error")
+
+	transitions, err := svc.ListHealthTransitions(ctx, &genregistry.ListHealthTransitionsPayload{
+		Name:  "data.tools",
+		Limit: 50,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, history.transitions, transitions.Transitions)
+	assert.Equal(t, 50, history.limit)
+
+	_, err = (&Service{}).ListHealthTransitions(ctx, &genregistry.ListHealthTransitionsPayload{Name: "data.tools"})
+	requireAdminError(t, err, "service_unavailable")
+}
+
+func TestServiceToolsetRoutes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sink := &recordingAuditSink{}
+	svc := &Service{
+		catalog:   newToolsetCatalog(newTestCatalogMap(), newTestTimeSource(time.Unix(1_700_000_000, 0))),
+		auditSink: sink,
+		logger:    telemetry.NewNoopLogger(),
+	}
+
+	empty, err := svc.GetToolsetRoutes(ctx, &genregistry.GetToolsetRoutesPayload{Name: "data.tools"})
+	require.NoError(t, err)
+	assert.Equal(t, "data.tools", empty.Name)
+	assert.Empty(t, empty.Routes)
+
+	require.NoError(t, svc.SetToolsetRoutes(ctx, &genregistry.SetToolsetRoutesPayload{
+		Name: "data.tools",
+		Routes: []*genregistry.ToolsetRoute{
+			{Version: "1.2.0", Weight: 95},
+			{Version: "v1.10.0", Weight: 5},
+			{Version: "1.3.0", Weight: 0},
+		},
+	}))
+	routes, err := svc.GetToolsetRoutes(ctx, &genregistry.GetToolsetRoutesPayload{Name: "data.tools"})
+	require.NoError(t, err)
+	assert.Equal(t, []*genregistry.ToolsetRoute{
+		{Version: "1.10.0", Weight: 5},
+		{Version: "1.3.0", Weight: 0},
+		{Version: "1.2.0", Weight: 95},
+	}, routes.Routes)
+
+	err = svc.SetToolsetRoutes(ctx, &genregistry.SetToolsetRoutesPayload{
+		Name:   "data.tools",
+		Routes: []*genregistry.ToolsetRoute{{Version: "1.2.0", Weight: 1}, {Version: "v1.2.0", Weight: 2}},
+	})
+	requireAdminError(t, err, "validation_error")
+	err = svc.SetToolsetRoutes(ctx, &genregistry.SetToolsetRoutesPayload{
+		Name:   "data.tools",
+		Routes: []*genregistry.ToolsetRoute{{Version: "1.2.0", Weight: 10001}},
+	})
+	requireAdminError(t, err, "validation_error")
+
+	require.NoError(t, svc.SetToolsetRoutes(ctx, &genregistry.SetToolsetRoutesPayload{Name: "data.tools"}))
+	cleared, err := svc.GetToolsetRoutes(ctx, &genregistry.GetToolsetRoutesPayload{Name: "data.tools"})
+	require.NoError(t, err)
+	assert.Empty(t, cleared.Routes)
+
+	require.Len(t, sink.events, 4)
+	assert.Equal(t, audit.EventSetToolsetRoutes, sink.events[0].Type)
+	assert.Equal(t, audit.OutcomeOK, sink.events[0].Outcome)
+	assert.Equal(t, "validation_error", sink.events[1].Outcome)
+}
+
+func TestServiceFailsRequestsWhoseAuditEventIsNotRecorded(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc := &Service{
+		catalog:   newToolsetCatalog(newTestCatalogMap(), newTestTimeSource(time.Unix(1_700_000_000, 0))),
+		auditSink: failingAuditSink{err: errors.New("sink down")},
+		logger:    telemetry.NewNoopLogger(),
+	}
+
+	err := svc.SetToolsetRoutes(ctx, &genregistry.SetToolsetRoutesPayload{
+		Name:   "data.tools",
+		Routes: []*genregistry.ToolsetRoute{{Version: "1.2.0", Weight: 1}},
+	})
+	requireAdminError(t, err, "service_unavailable")
+
+	err = svc.SetToolsetRoutes(ctx, &genregistry.SetToolsetRoutesPayload{
+		Name:   "data.tools",
+		Routes: []*genregistry.ToolsetRoute{{Version: "1.2.0", Weight: 10001}},
+	})
+	requireAdminError(t, err, "validation_error")
+}
+
+func requireAdminError(t *testing.T, err error, name string) {
+	t.Helper()
+	var serviceErr *goa.ServiceError
+	require.ErrorAs(t, err, &serviceErr)
+	assert.Equal(t, name, serviceErr.Name)
+}
+
+func (i *fixedCallInspector) InspectCall(_ context.Context, _, toolUseID string) (*genregistry.ToolCallInfo, error) {
+	call, ok := i.calls[toolUseID]
+	if !ok {
+		return nil, errCallAdmissionNotFound
+	}
+	return call, nil
+}
+
+func (i *fixedCallInspector) ClaimedCalls(
+	_ context.Context,
+	_, _ string,
+	providerLeases []string,
+	_ int,
+) ([]*genregistry.ToolCallInfo, error) {
+	i.leases = providerLeases
+	return []*genregistry.ToolCallInfo{}, nil
+}
+
+func (i *fixedCallInspector) ClaimedCallCount(_ context.Context, _, providerLease string) (int64, error) {
+	return i.claimed[providerLease], nil
+}
+
+func (h *fixedHealthHistory) Record(context.Context, string, healthTransition) error {
+	return nil
+}
+
+func (h *fixedHealthHistory) Transitions(_ context.Context, _ string, limit int) ([]*genregistry.HealthTransition, error) {
+	h.limit = limit
+	return h.transitions, nil
+}
@@ -0,0 +1,241 @@
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"goa.design/goa-ai/registry/audit"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/toolregistry"
+)
+
+// toolCallAudit records the audit event of one CallTool or RetryTool request:
+// write-ahead, just before the call is first published, or once the request
+// ends without publishing.
+type toolCallAudit struct {
+	s        *Service
+	caller   Caller
+	typ      audit.EventType
+	toolset  string
+	tool     string
+	payload  []byte
+	meta     *genregistry.ToolCallMeta
+	recorded bool
+}
+
+// newToolCallAudit returns the audit of one CallTool or RetryTool request.
+func (s *Service) newToolCallAudit(
+	caller Caller,
+	typ audit.EventType,
+	toolset, tool string,
+	payload []byte,
+	meta *genregistry.ToolCallMeta,
+) *toolCallAudit {
+	return &toolCallAudit{s: s, caller: caller, typ: typ, toolset: toolset, tool: tool, payload: payload, meta: meta}
+}
+
+// record records the accepted request before its call is published. It
+// records at most once; an error rejects the call before publication.
+func (a *toolCallAudit) record(ctx context.Context) error {
+	if a.recorded {
+		return nil
+	}
+	if err := a.s.auditToolCall(ctx, a.caller, a.typ, a.toolset, a.tool, a.payload, a.meta, nil); err != nil {
+		return err
+	}
+	a.recorded = true
+	return nil
+}
+
+// finish records a request that ended without publishing a call and returns
+// the error the request must report. Once record succeeded it returns err
+// unchanged: the call may already be running, so the audit never turns a
+// dispatched call into a failure.
+func (a *toolCallAudit) finish(ctx context.Context, err error) error {
+	if a.recorded {
+		return err
+	}
+	return a.s.auditToolCall(ctx, a.caller, a.typ, a.toolset, a.tool, a.payload, a.meta, err)
+}
+
+// recordAudit completes e with the caller the request authenticated as, the
+// trace context, and the outcome of one handled request and hands it to the
+// audit sink. caller is the zero Caller when authentication failed or is not
+// enforced. It returns the error the audited request must report: err when the
+// request failed, otherwise a service_unavailable error when the sink could
+// not record the event, so no accepted request goes unaudited.
+func (s *Service) recordAudit(ctx context.Context, caller Caller, e audit.Event, err error) error {
+	if s.auditSink == nil {
+		return err
+	}
+	e.Caller = caller.Subject
+	e.CallerScheme = string(caller.Scheme)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		e.TraceID = sc.TraceID().String()
+		e.SpanID = sc.SpanID().String()
+	}
+	e.Time = time.Now().UTC()
+	e.Outcome = audit.OutcomeOK
+	if err != nil {
+		e.Outcome = callAdmissionOutcome(err)
+		e.Error = err.Error()
+	}
+	recordErr := s.auditSink.Record(context.WithoutCancel(ctx), e)
+	if recordErr == nil {
+		return err
+	}
+	s.logger.Error(ctx, "registry audit record failed",
+		"type", string(e.Type),
+		"toolset", e.Toolset,
+		"tool_use_id", e.ToolUseID,
+		"error", recordErr,
+	)
+	if err != nil {
+		return err
+	}
+	return genregistry.MakeServiceUnavailable(fmt.Errorf("record audit event: %w", recordErr))
+}
+
+// auditToolCall records one CallTool or RetryTool request.
+func (s *Service) auditToolCall(
+	ctx context.Context,
+	caller Caller,
+	typ audit.EventType,
+	toolset, tool string,
+	payload []byte,
+	meta *genregistry.ToolCallMeta,
+	err error,
+) error {
+	if s.auditSink == nil {
+		return err
+	}
+	e := audit.Event{
+		Type:      typ,
+		Toolset:   toolset,
+		Tool:      tool,
+		Arguments: s.auditArguments(ctx, toolset, tool, payload),
+	}
+	if meta != nil {
+		e.ToolUseID = toolUseIDForCall(meta)
+		e.RunID = meta.RunID
+		e.SessionID = meta.SessionID
+		e.ToolCallID = meta.ToolCallID
+	}
+	return s.recordAudit(ctx, caller, e, err)
+}
+
+// auditArguments returns payload redacted with the payload schema of tool in
+// every active registration toolset may route to. It returns nil when no
+// schema is found or the payload cannot be redacted, so unredacted arguments
+// are never recorded.
+func (s *Service) auditArguments(ctx context.Context, toolset, tool string, payload []byte) json.RawMessage {
+	var entries []catalogEntry
+	entry, err := s.catalog.ActiveRegistration(ctx, toolset)
+	switch {
+	case err == nil:
+		entries = []catalogEntry{entry}
+	case errors.Is(err, errToolsetNotFound):
+		name, _ := toolregistry.SplitToolsetRef(toolset)
+		if entries, err = s.catalog.ActiveVersions(ctx, name); err != nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+	var (
+		args  = json.RawMessage(payload)
+		found bool
+	)
+	for _, entry := range entries {
+		for _, schema := range entry.Toolset.Tools {
+			if schema.Name != tool {
+				continue
+			}
+			if args, err = audit.Redact(schema.PayloadSchema, args); err != nil {
+				return nil
+			}
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+	return args
+}
+
+// auditCompletion records one CompleteToolCall settlement of tool with the
+// result the provider returned: the tool error code of failed calls, and for
+// successful calls the result payload redacted with the result schema of tool
+// in the settling registration together with its size and SHA-256 digest, so
+// auditors can match the event to the terminal stored in the call record. tool
+// is empty when the settlement failed or the call record does not name it.
+func (s *Service) auditCompletion(
+	ctx context.Context,
+	caller Caller,
+	p *genregistry.CompleteToolCallPayload,
+	tool string,
+	err error,
+) error {
+	if s.auditSink == nil {
+		return err
+	}
+	e := audit.Event{
+		Type:       audit.EventCompleteToolCall,
+		Toolset:    p.Toolset,
+		Tool:       tool,
+		ToolUseID:  p.ToolUseID,
+		ProviderID: p.ProviderID,
+	}
+	var result toolregistry.ToolResultMessage
+	if json.Unmarshal(p.ResultJSON, &result) == nil {
+		if result.Error != nil {
+			e.ResultErrorCode = result.Error.Code
+			if f := result.Error.Failure; f != nil {
+				e.ResultFailureKind = string(f.Kind)
+				if f.Error != nil {
+					e.ResultError = f.Error.Error()
+				}
+			}
+		}
+		if len(result.Result) > 0 {
+			sum := sha256.Sum256(result.Result)
+			e.ResultSize = len(result.Result)
+			e.ResultSHA256 = hex.EncodeToString(sum[:])
+			e.Result = s.auditResult(ctx, p.Toolset, tool, result.Result)
+		}
+	}
+	return s.recordAudit(ctx, caller, e, err)
+}
+
+// auditResult returns result redacted with the result schema of tool in the
+// registration that settled the call, in any admission state since the
+// registration may be draining. It returns nil when the tool, the registration
+// or its result schema is unavailable or the result cannot be redacted, so
+// unredacted results are never recorded.
+func (s *Service) auditResult(ctx context.Context, toolset, tool string, result []byte) json.RawMessage {
+	if tool == "" {
+		return nil
+	}
+	entry, _, err := s.catalog.Admission(ctx, toolset)
+	if err != nil || entry.Toolset == nil {
+		return nil
+	}
+	for _, schema := range entry.Toolset.Tools {
+		if schema.Name != tool || len(schema.ResultSchema) == 0 {
+			continue
+		}
+		out, err := audit.Redact(schema.ResultSchema, result)
+		if err != nil {
+			return nil
+		}
+		return out
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// DefaultAsyncBuffer is the number of events an AsyncSink queues when
+// NewAsyncSink is given a non-positive buffer size.
+const DefaultAsyncBuffer = 1024
+
+var (
+	// ErrBufferFull is returned by AsyncSink.Record when the wrapped sink has
+	// fallen so far behind that the buffer is full. The event is not recorded.
+	ErrBufferFull = errors.New("audit buffer full")
+	// ErrSinkClosed is returned by AsyncSink.Record after Close.
+	ErrSinkClosed = errors.New("audit sink closed")
+)
+
+type (
+	// AsyncSink records events to a wrapped sink on a background goroutine so
+	// a slow backend (a remote MongoDB, a saturated Redis) never adds latency
+	// to the audited request. Record only enqueues the event in a bounded
+	// buffer and fails fast with ErrBufferFull instead of blocking when the
+	// backend falls behind. Close drains the buffer.
+	AsyncSink struct {
+		sink    Sink
+		onError func(Event, error)
+		queue   chan queuedEvent
+		done    chan struct{}
+
+		mu     sync.RWMutex
+		closed bool
+	}
+
+	// queuedEvent keeps the request context values (trace, logging) with the
+	// event it was recorded for.
+	queuedEvent struct {
+		ctx context.Context
+		e   Event
+	}
+)
+
+// NewAsyncSink returns a sink buffering up to size events for sink. onError,
+// when not nil, receives each event the wrapped sink failed to record; the
+// registry only sees enqueue failures.
+func NewAsyncSink(sink Sink, size int, onError func(Event, error)) *AsyncSink {
+	if size <= 0 {
+		size = DefaultAsyncBuffer
+	}
+	s := &AsyncSink{
+		sink:    sink,
+		onError: onError,
+		queue:   make(chan queuedEvent, size),
+		done:    make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Record implements Sink.
+func (s *AsyncSink) Record(ctx context.Context, e Event) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return ErrSinkClosed
+	}
+	select {
+	case s.queue <- queuedEvent{ctx: context.WithoutCancel(ctx), e: e}:
+		return nil
+	default:
+		return ErrBufferFull
+	}
+}
+
+// Close stops accepting events and returns once every queued event has been
+// handed to the wrapped sink. It does not close the wrapped sink.
+func (s *AsyncSink) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+	<-s.done
+	return nil
+}
+
+// run records queued events in order until the queue is closed and drained.
+func (s *AsyncSink) run() {
+	defer close(s.done)
+	for q := range s.queue {
+		if err := s.sink.Record(q.ctx, q.e); err != nil && s.onError != nil {
+			s.onError(q.e, err)
+		}
+	}
+}
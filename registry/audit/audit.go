@@ -0,0 +1,145 @@
+// Package audit records who invoked which registry tool, when, and with what
+// outcome.
+//
+// The registry Service emits one Event per audited request (tool calls,
+// retries, provider settlements, provider lifecycle changes, and operator
+// interventions) to a Sink.
+// Sinks are pluggable: JSONLSink appends events to a file or writer,
+// PulseSink publishes them on a Pulse stream, and the audit/mongo package
+// stores them in MongoDB. The registry records events synchronously and fails
+// a request whose event cannot be recorded; tool calls and retries are
+// recorded before they are published, so a call whose event cannot be
+// recorded never reaches a provider. AsyncSink is an explicit opt-in
+// that moves recording off the request path and drops events the wrapped sink
+// rejects after logging them.
+// Tool arguments and results are redacted with Redact before they reach any
+// sink.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+type (
+	// Sink receives audit events. Implementations must be safe for
+	// concurrent use; the registry records events from every request
+	// goroutine.
+	Sink interface {
+		// Record persists or forwards one event. The registry fails an
+		// otherwise successful audited request with service_unavailable when
+		// Record returns an error, so the audit trail never misses an
+		// accepted request. Tool calls and retries are recorded before they
+		// are published and are not published when Record fails.
+		Record(ctx context.Context, e Event) error
+	}
+
+	// EventType identifies the registry operation an Event describes.
+	EventType string
+
+	// Event is one audited registry request.
+	Event struct {
+		// Time is when the registry finished handling the request, or for
+		// accepted tool calls and retries when it was about to publish the
+		// call.
+		Time time.Time `json:"time"`
+		// Type is the audited operation.
+		Type EventType `json:"type"`
+		// Caller is the authenticated subject that issued the request. It is
+		// empty when the registry does not enforce authentication or the
+		// request failed authentication.
+		Caller string `json:"caller,omitempty"`
+		// CallerScheme records which credential established Caller.
+		CallerScheme string `json:"caller_scheme,omitempty"`
+		// TraceID and SpanID identify the request span when tracing is
+		// enabled.
+		TraceID string `json:"trace_id,omitempty"`
+		SpanID  string `json:"span_id,omitempty"`
+		// Toolset is the toolset (or toolset reference) named by the request.
+		Toolset string `json:"toolset"`
+		// Tool is the invoked tool for call and retry events, and the settled
+		// tool recorded in the call record for completion events.
+		Tool string `json:"tool,omitempty"`
+		// ToolUseID is the registry transport identity of the call.
+		ToolUseID string `json:"tool_use_id,omitempty"`
+		// RunID, SessionID, and ToolCallID carry the caller's call metadata.
+		RunID      string `json:"run_id,omitempty"`
+		SessionID  string `json:"session_id,omitempty"`
+		ToolCallID string `json:"tool_call_id,omitempty"`
+		// ProviderID identifies the provider process for settlement and
+		// lifecycle events.
+		ProviderID string `json:"provider_id,omitempty"`
+		// Arguments is the redacted tool payload. It is omitted when the
+		// payload schema is unavailable, so unredacted arguments are never
+		// recorded.
+		Arguments json.RawMessage `json:"arguments,omitempty"`
+		// Outcome is OutcomeOK when the registry accepted the request, or the
+		// registry error name (for example "permission_denied") otherwise.
+		Outcome string `json:"outcome"`
+		// Error is the registry error message when the request was rejected.
+		Error string `json:"error,omitempty"`
+		// ResultErrorCode is the tool error code of a settled call that
+		// failed. It is empty for successful results and other events.
+		ResultErrorCode string `json:"result_error_code,omitempty"`
+		// ResultFailureKind and ResultError are the planner failure kind and
+		// error message of a settled call that failed.
+		ResultFailureKind string `json:"result_failure_kind,omitempty"`
+		ResultError       string `json:"result_error,omitempty"`
+		// Result is the redacted result payload of a settled call. Like
+		// Arguments it is omitted when the settled tool's result schema is
+		// unavailable, so unredacted results are never recorded.
+		Result json.RawMessage `json:"result,omitempty"`
+		// ResultSize and ResultSHA256 are the byte length and hex SHA-256
+		// digest of the unredacted result payload of a settled call, so the
+		// event can be matched to the terminal stored in the call record.
+		// Both are empty for error results and other events.
+		ResultSize   int    `json:"result_size,omitempty"`
+		ResultSHA256 string `json:"result_sha256,omitempty"`
+	}
+
+	multiSink []Sink
+)
+
+const (
+	// EventCallTool records a CallTool request.
+	EventCallTool EventType = "call_tool"
+	// EventRetryTool records a RetryTool request.
+	EventRetryTool EventType = "retry_tool"
+	// EventCompleteToolCall records a provider settling a call with
+	// CompleteToolCall.
+	EventCompleteToolCall EventType = "complete_tool_call"
+	// EventRegister records a provider Register request.
+	EventRegister EventType = "register"
+	// EventUnregister records an Unregister request.
+	EventUnregister EventType = "unregister"
+	// EventDrainProvider records a DrainProvider request.
+	EventDrainProvider EventType = "drain_provider"
+	// EventForceDrainProvider records an operator ForceDrainProvider request.
+	EventForceDrainProvider EventType = "force_drain_provider"
+	// EventRetireRegistration records an operator RetireRegistration request.
+	EventRetireRegistration EventType = "retire_registration"
+	// EventSetToolsetRoutes records an operator SetToolsetRoutes request.
+	EventSetToolsetRoutes EventType = "set_toolset_routes"
+
+	// OutcomeOK is the Outcome of requests the registry accepted.
+	OutcomeOK = "ok"
+)
+
+// Multi returns a Sink that records every event to each of sinks in order.
+// It reports the joined errors of the sinks that failed.
+func Multi(sinks ...Sink) Sink {
+	return multiSink(sinks)
+}
+
+// Record implements Sink.
+func (m multiSink) Record(ctx context.Context, e Event) error {
+	var errs []error
+	for _, s := range m {
+		if err := s.Record(ctx, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,201 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	failingSink struct{ err error }
+
+	// gatedSink records events once release is closed.
+	gatedSink struct {
+		release chan struct{}
+		mu      sync.Mutex
+		events  []Event
+	}
+)
+
+func (s failingSink) Record(context.Context, Event) error { return s.err }
+
+func (s *gatedSink) Record(_ context.Context, e Event) error {
+	<-s.release
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, e)
+	return nil
+}
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		schema string
+		doc    string
+		want   string
+	}{
+		{
+			name:   "marked properties",
+			schema: `{"type":"object","properties":{"user":{"type":"string"},"password":{"type":"string","format":"password"},"token":{"type":"string","writeOnly":true},"key":{"type":"string","x-redact":true}}}`,
+			doc:    `{"user":"ada","password":"p","token":"t","key":"k"}`,
+			want:   `{"user":"ada","password":"[REDACTED]","token":"[REDACTED]","key":"[REDACTED]"}`,
+		},
+		{
+			name:   "nested refs and arrays",
+			schema: `{"type":"object","properties":{"accounts":{"type":"array","items":{"$ref":"#/$defs/Account"}}},"$defs":{"Account":{"type":"object","properties":{"id":{"type":"integer"},"secret":{"$ref":"#/definitions/Secret"}}}},"definitions":{"Secret":{"type":"string","writeOnly":true}}}`,
+			doc:    `{"accounts":[{"id":12345678901234567890,"secret":"s1"},{"id":2,"secret":"s2"}]}`,
+			want:   `{"accounts":[{"id":12345678901234567890,"secret":"[REDACTED]"},{"id":2,"secret":"[REDACTED]"}]}`,
+		},
+		{
+			name:   "composition and additional properties",
+			schema: `{"allOf":[{"properties":{"a":{"type":"string"}}},{"properties":{"a":{"x-redact":true}}}],"additionalProperties":{"type":"object","properties":{"pin":{"format":"password"}}}}`,
+			doc:    `{"a":"x","extra":{"pin":"1234","note":"n"}}`,
+			want:   `{"a":"[REDACTED]","extra":{"pin":"[REDACTED]","note":"n"}}`,
+		},
+		{
+			name:   "whole value",
+			schema: `{"type":"string","writeOnly":true}`,
+			doc:    `"secret"`,
+			want:   `"[REDACTED]"`,
+		},
+		{
+			name:   "cyclic refs",
+			schema: `{"$ref":"#/$defs/A","$defs":{"A":{"$ref":"#/$defs/A"}}}`,
+			doc:    `{"a":1}`,
+			want:   `{"a":1}`,
+		},
+		{
+			name: "empty schema",
+			doc:  `{"password":"p"}`,
+			want: `{"password":"p"}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Redact([]byte(tc.schema), []byte(tc.doc))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(got))
+		})
+	}
+
+	_, err := Redact([]byte(`{}`), []byte(`{`))
+	assert.Error(t, err)
+	_, err = Redact([]byte(`{`), []byte(`{}`))
+	assert.Error(t, err)
+}
+
+func TestJSONLSink(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	sink := NewJSONLSink(&buf)
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, sink.Record(context.Background(), Event{
+		Time:      at,
+		Type:      EventCallTool,
+		Caller:    "agent",
+		Toolset:   "data.tools",
+		Tool:      "lookup",
+		Arguments: json.RawMessage(`{"q":"x"}`),
+		Outcome:   OutcomeOK,
+	}))
+	require.NoError(t, sink.Record(context.Background(), Event{Type: EventUnregister, Toolset: "data.tools", Outcome: "permission_denied"}))
+	require.NoError(t, sink.Close())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var got Event
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &got))
+	assert.Equal(t, at, got.Time)
+	assert.Equal(t, EventCallTool, got.Type)
+	assert.Equal(t, "agent", got.Caller)
+	assert.JSONEq(t, `{"q":"x"}`, string(got.Arguments))
+	assert.Contains(t, lines[1], `"outcome":"permission_denied"`)
+}
+
+func TestOpenJSONLFileAppends(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	for range 2 {
+		sink, err := OpenJSONLFile(path)
+		require.NoError(t, err)
+		require.NoError(t, sink.Record(context.Background(), Event{Type: EventRegister, Toolset: "t", Outcome: OutcomeOK}))
+		require.NoError(t, sink.Close())
+	}
+	body, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(body), "\n"))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestMultiRecordsToEverySink(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	boom := errors.New("boom")
+	err := Multi(failingSink{err: boom}, NewJSONLSink(&buf)).Record(context.Background(), Event{Type: EventRegister})
+	require.ErrorIs(t, err, boom)
+	assert.NotEmpty(t, buf.String(), "a failing sink does not stop later sinks")
+}
+
+func TestAsyncSinkBuffersAndDrainsOnClose(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	inner := &gatedSink{release: make(chan struct{})}
+	sink := NewAsyncSink(inner, 2, nil)
+
+	// The worker holds the first event while the buffer fills.
+	require.NoError(t, sink.Record(ctx, Event{Type: EventRegister, Toolset: "a"}))
+	require.Eventually(t, func() bool { return len(sink.queue) == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, sink.Record(ctx, Event{Type: EventRegister, Toolset: "b"}))
+	require.NoError(t, sink.Record(ctx, Event{Type: EventRegister, Toolset: "c"}))
+	require.ErrorIs(t, sink.Record(ctx, Event{Type: EventRegister, Toolset: "d"}), ErrBufferFull)
+
+	close(inner.release)
+	require.NoError(t, sink.Close())
+	require.ErrorIs(t, sink.Record(ctx, Event{Type: EventRegister}), ErrSinkClosed)
+	require.NoError(t, sink.Close())
+
+	toolsets := make([]string, 0, len(inner.events))
+	for _, e := range inner.events {
+		toolsets = append(toolsets, e.Toolset)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, toolsets)
+}
+
+func TestAsyncSinkReportsSinkErrors(t *testing.T) {
+	t.Parallel()
+
+	boom := errors.New("boom")
+	var (
+		mu     sync.Mutex
+		failed []Event
+	)
+	sink := NewAsyncSink(failingSink{err: boom}, 0, func(e Event, err error) {
+		assert.ErrorIs(t, err, boom)
+		mu.Lock()
+		defer mu.Unlock()
+		failed = append(failed, e)
+	})
+	require.NoError(t, sink.Record(context.Background(), Event{Type: EventUnregister, Toolset: "t"}))
+	require.NoError(t, sink.Close())
+
+	require.Len(t, failed, 1)
+	assert.Equal(t, "t", failed[0].Toolset)
+}
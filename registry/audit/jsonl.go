@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// JSONLSink writes each event as one JSON line. It is the simplest durable
+// backend: point it at a file and ship the file with the log pipeline already
+// collecting registry logs.
+type JSONLSink struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+// NewJSONLSink returns a sink writing events to w. Writes are serialized so
+// lines never interleave.
+func NewJSONLSink(w io.Writer) *JSONLSink {
+	return &JSONLSink{w: w}
+}
+
+// OpenJSONLFile returns a sink appending events to the file at path, creating
+// it with owner-only permissions when it does not exist. Close the sink to
+// close the file.
+func OpenJSONLFile(path string) (*JSONLSink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+	return &JSONLSink{w: f, closer: f}, nil
+}
+
+// Record implements Sink.
+func (s *JSONLSink) Record(_ context.Context, e Event) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("encode audit event: %w", err)
+	}
+	line = append(line, '\n')
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.w.Write(line); err != nil {
+		return fmt.Errorf("write audit event: %w", err)
+	}
+	return nil
+}
+
+// Close closes the file opened by OpenJSONLFile. It is a no-op for sinks
+// created with NewJSONLSink.
+func (s *JSONLSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
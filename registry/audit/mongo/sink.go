@@ -0,0 +1,182 @@
+// Package mongo stores registry audit events in MongoDB.
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mongodriver "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+
+	"goa.design/clue/health"
+
+	"goa.design/goa-ai/registry/audit"
+)
+
+type (
+	// Sink is an audit.Sink inserting one document per event.
+	Sink struct {
+		mongo   *mongodriver.Client
+		coll    collection
+		timeout time.Duration
+	}
+
+	// Options configures the Mongo sink.
+	Options struct {
+		Client     *mongodriver.Client
+		Database   string
+		Collection string
+		Timeout    time.Duration
+		// Retention expires events this long after they were recorded using
+		// a TTL index. Zero keeps events forever.
+		Retention time.Duration
+	}
+
+	eventDocument struct {
+		Time              time.Time `bson:"time"`
+		Type              string    `bson:"type"`
+		Caller            string    `bson:"caller,omitempty"`
+		CallerScheme      string    `bson:"caller_scheme,omitempty"`
+		TraceID           string    `bson:"trace_id,omitempty"`
+		SpanID            string    `bson:"span_id,omitempty"`
+		Toolset           string    `bson:"toolset"`
+		Tool              string    `bson:"tool,omitempty"`
+		ToolUseID         string    `bson:"tool_use_id,omitempty"`
+		RunID             string    `bson:"run_id,omitempty"`
+		SessionID         string    `bson:"session_id,omitempty"`
+		ToolCallID        string    `bson:"tool_call_id,omitempty"`
+		ProviderID        string    `bson:"provider_id,omitempty"`
+		Arguments         string    `bson:"arguments,omitempty"`
+		Outcome           string    `bson:"outcome"`
+		Error             string    `bson:"error,omitempty"`
+		ResultErrorCode   string    `bson:"result_error_code,omitempty"`
+		ResultFailureKind string    `bson:"result_failure_kind,omitempty"`
+		ResultError       string    `bson:"result_error,omitempty"`
+		Result            string    `bson:"result,omitempty"`
+		ResultSize        int       `bson:"result_size,omitempty"`
+		ResultSHA256      string    `bson:"result_sha256,omitempty"`
+	}
+
+	// collection is the subset of the Mongo collection API used by the sink.
+	collection interface {
+		InsertOne(ctx context.Context, document any, opts ...options.Lister[options.InsertOneOptions]) (*mongodriver.InsertOneResult, error)
+		CreateIndexes(ctx context.Context, models []mongodriver.IndexModel) error
+	}
+
+	mongoCollection struct {
+		coll *mongodriver.Collection
+	}
+)
+
+const (
+	defaultCollection = "registry_audit_events"
+	defaultTimeout    = 5 * time.Second
+	clientName        = "registry-audit-mongo"
+)
+
+// Compile-time checks.
+var (
+	_ audit.Sink    = (*Sink)(nil)
+	_ health.Pinger = (*Sink)(nil)
+)
+
+// New returns a Sink writing to the configured collection and ensures the
+// indexes used to query events by time, caller, toolset, and run.
+func New(opts Options) (*Sink, error) {
+	if opts.Client == nil {
+		return nil, errors.New("mongo client is required")
+	}
+	if opts.Database == "" {
+		return nil, errors.New("database name is required")
+	}
+	if opts.Retention < 0 {
+		return nil, errors.New("retention must not be negative")
+	}
+	collection := opts.Collection
+	if collection == "" {
+		collection = defaultCollection
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	coll := mongoCollection{coll: opts.Client.Database(opts.Database).Collection(collection)}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := coll.CreateIndexes(ctx, indexes(opts.Retention)); err != nil {
+		return nil, fmt.Errorf("ensure audit indexes: %w", err)
+	}
+	return &Sink{mongo: opts.Client, coll: coll, timeout: timeout}, nil
+}
+
+// Name implements health.Pinger.
+func (s *Sink) Name() string {
+	return clientName
+}
+
+// Ping implements health.Pinger.
+func (s *Sink) Ping(ctx context.Context) error {
+	return s.mongo.Ping(ctx, readpref.Primary())
+}
+
+// Record implements audit.Sink.
+func (s *Sink) Record(ctx context.Context, e audit.Event) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	doc := eventDocument{
+		Time:              e.Time.UTC(),
+		Type:              string(e.Type),
+		Caller:            e.Caller,
+		CallerScheme:      e.CallerScheme,
+		TraceID:           e.TraceID,
+		SpanID:            e.SpanID,
+		Toolset:           e.Toolset,
+		Tool:              e.Tool,
+		ToolUseID:         e.ToolUseID,
+		RunID:             e.RunID,
+		SessionID:         e.SessionID,
+		ToolCallID:        e.ToolCallID,
+		ProviderID:        e.ProviderID,
+		Arguments:         string(e.Arguments),
+		Outcome:           e.Outcome,
+		Error:             e.Error,
+		ResultErrorCode:   e.ResultErrorCode,
+		ResultFailureKind: e.ResultFailureKind,
+		ResultError:       e.ResultError,
+		Result:            string(e.Result),
+		ResultSize:        e.ResultSize,
+		ResultSHA256:      e.ResultSHA256,
+	}
+	if _, err := s.coll.InsertOne(ctx, doc); err != nil {
+		return fmt.Errorf("insert audit event: %w", err)
+	}
+	return nil
+}
+
+// indexes returns the audit collection indexes.
+func indexes(retention time.Duration) []mongodriver.IndexModel {
+	timeIndex := mongodriver.IndexModel{Keys: bson.D{{Key: "time", Value: 1}}}
+	if retention > 0 {
+		timeIndex.Options = options.Index().SetExpireAfterSeconds(int32(retention / time.Second))
+	}
+	return []mongodriver.IndexModel{
+		timeIndex,
+		{Keys: bson.D{{Key: "caller", Value: 1}, {Key: "time", Value: 1}}},
+		{Keys: bson.D{{Key: "toolset", Value: 1}, {Key: "time", Value: 1}}},
+		{Keys: bson.D{{Key: "run_id", Value: 1}, {Key: "time", Value: 1}}},
+	}
+}
+
+func (c mongoCollection) InsertOne(ctx context.Context, document any, opts ...options.Lister[options.InsertOneOptions]) (*mongodriver.InsertOneResult, error) {
+	return c.coll.InsertOne(ctx, document, opts...)
+}
+
+func (c mongoCollection) CreateIndexes(ctx context.Context, models []mongodriver.IndexModel) error {
+	_, err := c.coll.Indexes().CreateMany(ctx, models)
+	return err
+}
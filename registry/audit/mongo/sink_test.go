@@ -0,0 +1,81 @@
+package mongo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mongodriver "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"goa.design/goa-ai/registry/audit"
+)
+
+type fakeCollection struct {
+	inserted []any
+	err      error
+}
+
+func (c *fakeCollection) InsertOne(_ context.Context, document any, _ ...options.Lister[options.InsertOneOptions]) (*mongodriver.InsertOneResult, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.inserted = append(c.inserted, document)
+	return &mongodriver.InsertOneResult{}, nil
+}
+
+func (c *fakeCollection) CreateIndexes(context.Context, []mongodriver.IndexModel) error {
+	return nil
+}
+
+func TestSinkRecordInsertsEventDocument(t *testing.T) {
+	t.Parallel()
+
+	coll := &fakeCollection{}
+	sink := &Sink{coll: coll, timeout: time.Second}
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("x", 3600))
+	require.NoError(t, sink.Record(context.Background(), audit.Event{
+		Time:      at,
+		Type:      audit.EventCallTool,
+		Caller:    "agent",
+		TraceID:   "trace",
+		Toolset:   "data.tools",
+		Tool:      "lookup",
+		RunID:     "run-1",
+		Arguments: json.RawMessage(`{"q":"x"}`),
+		Outcome:   audit.OutcomeOK,
+	}))
+	require.Len(t, coll.inserted, 1)
+	doc := coll.inserted[0].(eventDocument)
+	assert.Equal(t, at.UTC(), doc.Time)
+	assert.Equal(t, "call_tool", doc.Type)
+	assert.Equal(t, "agent", doc.Caller)
+	assert.Equal(t, "data.tools", doc.Toolset)
+	assert.Equal(t, "run-1", doc.RunID)
+	assert.JSONEq(t, `{"q":"x"}`, doc.Arguments)
+
+	coll.err = errors.New("boom")
+	assert.Error(t, sink.Record(context.Background(), audit.Event{Type: audit.EventRegister}))
+}
+
+func TestNewValidatesOptions(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(Options{Database: "db"})
+	assert.Error(t, err)
+	_, err = New(Options{Client: &mongodriver.Client{}})
+	assert.Error(t, err)
+	_, err = New(Options{Client: &mongodriver.Client{}, Database: "db", Retention: -time.Second})
+	assert.Error(t, err)
+}
+
+func TestIndexesExpireWithRetention(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, indexes(0)[0].Options)
+	require.NotNil(t, indexes(time.Hour)[0].Options)
+}
@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	clientspulse "goa.design/goa-ai/features/stream/pulse/clients/pulse"
+)
+
+// PulseSink publishes events on a Pulse stream so downstream consumers (SIEM
+// forwarders, compliance archivers) can subscribe with their own consumer
+// groups. Each event is added under its EventType name with the JSON-encoded
+// Event as payload.
+type PulseSink struct {
+	stream clientspulse.Stream
+}
+
+// NewPulseSink returns a sink publishing to the named Pulse stream.
+func NewPulseSink(client clientspulse.Client, stream string) (*PulseSink, error) {
+	if client == nil {
+		return nil, errors.New("pulse client is required")
+	}
+	if stream == "" {
+		return nil, errors.New("audit stream name is required")
+	}
+	s, err := client.Stream(stream)
+	if err != nil {
+		return nil, fmt.Errorf("open audit stream %q: %w", stream, err)
+	}
+	return &PulseSink{stream: s}, nil
+}
+
+// Record implements Sink.
+func (s *PulseSink) Record(ctx context.Context, e Event) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("encode audit event: %w", err)
+	}
+	if _, err := s.stream.Add(ctx, string(e.Type), payload); err != nil {
+		return fmt.Errorf("publish audit event: %w", err)
+	}
+	return nil
+}
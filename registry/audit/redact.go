@@ -0,0 +1,172 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// RedactedValue replaces the value of every sensitive field in redacted
+// arguments.
+const RedactedValue = "[REDACTED]"
+
+// maxRefDepth bounds consecutive $ref resolutions so a schema whose
+// definitions refer to each other without consuming data cannot loop.
+const maxRefDepth = 32
+
+// Redact returns doc with the values of sensitive fields replaced by
+// RedactedValue. A field is sensitive when its schema (after resolving local
+// "#/$defs/..." and "#/definitions/..." references and the branches of
+// allOf, anyOf, and oneOf) declares "writeOnly": true, "format": "password",
+// or "x-redact": true. Redaction follows object properties,
+// additionalProperties, items, and prefixItems; values the schema does not
+// describe are kept. An empty schema leaves doc unchanged.
+func Redact(schema, doc []byte) (json.RawMessage, error) {
+	var value any
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+	if err := dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("decode arguments: %w", err)
+	}
+	if len(bytes.TrimSpace(schema)) == 0 {
+		return json.RawMessage(doc), nil
+	}
+	var root any
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, fmt.Errorf("decode schema: %w", err)
+	}
+	r := redactor{root: root}
+	out, err := json.Marshal(r.redact([]any{root}, value))
+	if err != nil {
+		return nil, fmt.Errorf("encode arguments: %w", err)
+	}
+	return out, nil
+}
+
+// redactor walks a decoded document alongside the schemas describing it.
+type redactor struct {
+	root any
+}
+
+// redact returns value with sensitive fields replaced, where schemas are all
+// the schemas value must satisfy.
+func (r redactor) redact(schemas []any, value any) any {
+	nodes := r.expand(schemas)
+	for _, node := range nodes {
+		if sensitive(node) {
+			return RedactedValue
+		}
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if sub := propertySchemas(nodes, key); len(sub) > 0 {
+				v[key] = r.redact(sub, field)
+			}
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			if sub := itemSchemas(nodes, i); len(sub) > 0 {
+				v[i] = r.redact(sub, item)
+			}
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+// expand resolves references and flattens composition keywords so every
+// returned node is a schema object constraining the same value.
+func (r redactor) expand(schemas []any) []map[string]any {
+	var nodes []map[string]any
+	var visit func(schema any, depth int)
+	visit = func(schema any, depth int) {
+		node, ok := schema.(map[string]any)
+		if !ok || depth > maxRefDepth {
+			return
+		}
+		nodes = append(nodes, node)
+		if ref, ok := node["$ref"].(string); ok {
+			visit(r.resolve(ref), depth+1)
+		}
+		for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+			branches, _ := node[keyword].([]any)
+			for _, branch := range branches {
+				visit(branch, depth)
+			}
+		}
+	}
+	for _, schema := range schemas {
+		visit(schema, 0)
+	}
+	return nodes
+}
+
+// resolve returns the schema a local reference points to, or nil when the
+// reference is not local or does not resolve.
+func (r redactor) resolve(ref string) any {
+	pointer, ok := strings.CutPrefix(ref, "#")
+	if !ok {
+		return nil
+	}
+	current := r.root
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		obj, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = obj[token]
+	}
+	return current
+}
+
+// sensitive reports whether a schema node marks its value as secret.
+func sensitive(node map[string]any) bool {
+	if writeOnly, _ := node["writeOnly"].(bool); writeOnly {
+		return true
+	}
+	if redact, _ := node["x-redact"].(bool); redact {
+		return true
+	}
+	format, _ := node["format"].(string)
+	return format == "password"
+}
+
+// propertySchemas returns the schemas of the object property key.
+func propertySchemas(nodes []map[string]any, key string) []any {
+	var out []any
+	for _, node := range nodes {
+		if props, ok := node["properties"].(map[string]any); ok {
+			if prop, ok := props[key]; ok {
+				out = append(out, prop)
+				continue
+			}
+		}
+		if additional, ok := node["additionalProperties"].(map[string]any); ok {
+			out = append(out, additional)
+		}
+	}
+	return out
+}
+
+// itemSchemas returns the schemas of the array element at index i.
+func itemSchemas(nodes []map[string]any, i int) []any {
+	var out []any
+	for _, node := range nodes {
+		if prefix, ok := node["prefixItems"].([]any); ok && i < len(prefix) {
+			out = append(out, prefix[i])
+			continue
+		}
+		if items, ok := node["items"].(map[string]any); ok {
+			out = append(out, items)
+		}
+	}
+	return out
+}
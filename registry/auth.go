@@ -0,0 +1,419 @@
+// Package registry authenticates registry callers.
+//
+// Credentials travel on the transport rather than in Goa payloads: providers
+// and consumers present a verified mTLS client certificate, a bearer JWT in
+// the "authorization" gRPC metadata entry, or both. An Authenticator turns
+// those credentials into a Caller; the service then checks the Caller against
+// the configured Policy before touching catalog or call state.
+package registry
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rsa"
+	_ "crypto/sha256" // register SHA-256 for crypto.Hash.New
+	_ "crypto/sha512" // register SHA-384 and SHA-512 for crypto.Hash.New
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"goa.design/goa-ai/runtime/toolregistry"
+)
+
+type (
+	// Caller is the authenticated identity behind one registry request.
+	Caller struct {
+		// Subject is the identity policies match: the JWT "sub" claim, or the
+		// first URI SAN (for example a SPIFFE ID) of a verified client
+		// certificate, falling back to its common name.
+		Subject string
+		// Scheme records which credential established Subject.
+		Scheme CallerScheme
+	}
+
+	// CallerScheme names the credential kind that authenticated a Caller.
+	CallerScheme string
+
+	// Authenticator derives the Caller of one registry request from the
+	// transport credentials carried by ctx. It returns ErrNoCredentials when the
+	// request carries none of the credentials it understands, and any other
+	// error when credentials are present but invalid.
+	Authenticator interface {
+		Authenticate(ctx context.Context) (Caller, error)
+	}
+
+	// AuthConfig enables caller authentication and per-caller authorization.
+	AuthConfig struct {
+		// Authenticator identifies callers. Required. Combine credential kinds
+		// with AnyAuthenticator.
+		Authenticator Authenticator
+		// Policy grants callers discovery, invocation, and registration rights.
+		// Required; callers matched by no rule are denied.
+		Policy *Policy
+	}
+
+	// MTLSAuthenticator authenticates callers by their verified TLS client
+	// certificate. The gRPC server must verify client certificates, for
+	// example with ServerTLSConfig.
+	MTLSAuthenticator struct{}
+
+	// JWTAuthenticator authenticates callers by a bearer JWT carried in the
+	// "authorization" gRPC metadata entry. Tokens must be signed by one of Keys
+	// and must carry "sub" and "exp" claims.
+	JWTAuthenticator struct {
+		// Keys maps key IDs (the JWT "kid" header) to verification keys:
+		// []byte for HS256/HS384/HS512, *rsa.PublicKey for RS256/RS384/RS512,
+		// *ecdsa.PublicKey for ES256/ES384, and ed25519.PublicKey for EdDSA.
+		// When Keys holds a single key, tokens may omit "kid".
+		Keys map[string]any
+		// Issuer, when set, must equal the token "iss" claim.
+		Issuer string
+		// Audience, when set, must appear in the token "aud" claim.
+		Audience string
+		// Leeway tolerates clock skew when checking "exp" and "nbf".
+		Leeway time.Duration
+		// Now returns the current time. Defaults to time.Now.
+		Now func() time.Time
+	}
+
+	// anyAuthenticator tries authenticators in order.
+	anyAuthenticator []Authenticator
+
+	// jwtHeader is the subset of the JOSE header the registry verifies.
+	jwtHeader struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+
+	// jwtClaims is the subset of registered claims the registry verifies.
+	jwtClaims struct {
+		Subject   string      `json:"sub"`
+		Issuer    string      `json:"iss"`
+		Audience  jwtAudience `json:"aud"`
+		ExpiresAt *float64    `json:"exp"`
+		NotBefore *float64    `json:"nbf"`
+	}
+
+	// jwtAudience accepts both the string and array forms of "aud".
+	jwtAudience []string
+)
+
+const (
+	// CallerSchemeMTLS identifies callers authenticated by client certificate.
+	CallerSchemeMTLS CallerScheme = "mtls"
+	// CallerSchemeJWT identifies callers authenticated by bearer JWT.
+	CallerSchemeJWT CallerScheme = "jwt"
+)
+
+// ErrNoCredentials reports that a request carries no credentials an
+// Authenticator understands.
+var ErrNoCredentials = errors.New("request carries no registry credentials")
+
+// AnyAuthenticator returns an Authenticator that tries each authenticator in
+// order and returns the first Caller. Authenticators reporting
+// ErrNoCredentials are skipped; any other failure rejects the request so a
+// bad token is never masked by a valid certificate or vice versa.
+func AnyAuthenticator(authenticators ...Authenticator) Authenticator {
+	return anyAuthenticator(authenticators)
+}
+
+// Authenticate implements Authenticator.
+func (a anyAuthenticator) Authenticate(ctx context.Context) (Caller, error) {
+	for _, authenticator := range a {
+		caller, err := authenticator.Authenticate(ctx)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		return caller, err
+	}
+	return Caller{}, ErrNoCredentials
+}
+
+// Authenticate implements Authenticator.
+func (MTLSAuthenticator) Authenticate(ctx context.Context) (Caller, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return Caller{}, ErrNoCredentials
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return Caller{}, ErrNoCredentials
+	}
+	subject := certificateSubject(info.State.VerifiedChains[0][0])
+	if subject == "" {
+		return Caller{}, errors.New("client certificate has neither a URI SAN nor a common name")
+	}
+	return Caller{Subject: subject, Scheme: CallerSchemeMTLS}, nil
+}
+
+// Authenticate implements Authenticator.
+func (a *JWTAuthenticator) Authenticate(ctx context.Context) (Caller, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Caller{}, ErrNoCredentials
+	}
+	values := md.Get(toolregistry.AuthorizationMetadataKey)
+	switch len(values) {
+	case 0:
+		return Caller{}, ErrNoCredentials
+	case 1:
+	default:
+		return Caller{}, errors.New("request carries more than one authorization value")
+	}
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return Caller{}, errors.New("authorization must use the Bearer scheme")
+	}
+	claims, err := a.verify(strings.TrimSpace(token))
+	if err != nil {
+		return Caller{}, fmt.Errorf("invalid bearer token: %w", err)
+	}
+	return Caller{Subject: claims.Subject, Scheme: CallerSchemeJWT}, nil
+}
+
+// verify checks the token signature and registered claims.
+func (a *JWTAuthenticator) verify(token string) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return jwtClaims{}, errors.New("token must have three segments")
+	}
+	var header jwtHeader
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return jwtClaims{}, fmt.Errorf("decode header: %w", err)
+	}
+	key, err := a.key(header.Kid)
+	if err != nil {
+		return jwtClaims{}, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return jwtClaims{}, fmt.Errorf("decode signature: %w", err)
+	}
+	if err := verifyJWTSignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), sig); err != nil {
+		return jwtClaims{}, err
+	}
+	var claims jwtClaims
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return jwtClaims{}, fmt.Errorf("decode claims: %w", err)
+	}
+	if claims.Subject == "" {
+		return jwtClaims{}, errors.New(`token has no "sub" claim`)
+	}
+	if claims.ExpiresAt == nil {
+		return jwtClaims{}, errors.New(`token has no "exp" claim`)
+	}
+	now := time.Now()
+	if a.Now != nil {
+		now = a.Now()
+	}
+	if !now.Before(unixSeconds(*claims.ExpiresAt).Add(a.Leeway)) {
+		return jwtClaims{}, errors.New("token expired")
+	}
+	if claims.NotBefore != nil && now.Add(a.Leeway).Before(unixSeconds(*claims.NotBefore)) {
+		return jwtClaims{}, errors.New("token not yet valid")
+	}
+	if a.Issuer != "" && claims.Issuer != a.Issuer {
+		return jwtClaims{}, fmt.Errorf("token issuer %q is not trusted", claims.Issuer)
+	}
+	if a.Audience != "" && !claims.Audience.contains(a.Audience) {
+		return jwtClaims{}, fmt.Errorf("token audience does not include %q", a.Audience)
+	}
+	return claims, nil
+}
+
+// key selects the verification key named by kid.
+func (a *JWTAuthenticator) key(kid string) (any, error) {
+	if key, ok := a.Keys[kid]; ok {
+		return key, nil
+	}
+	if len(a.Keys) == 1 {
+		for _, key := range a.Keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// UnmarshalJSON accepts a single audience string or an array of audiences.
+func (a *jwtAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return errors.New(`"aud" must be a string or an array of strings`)
+	}
+	*a = many
+	return nil
+}
+
+// contains reports whether audience is listed.
+func (a jwtAudience) contains(audience string) bool {
+	for _, aud := range a {
+		if aud == audience {
+			return true
+		}
+	}
+	return false
+}
+
+// ServerTLSConfig loads the registry server TLS configuration. certFile and
+// keyFile hold the PEM-encoded server certificate. When clientCAFile is set,
+// client certificates presented by providers and consumers are verified
+// against that bundle so MTLSAuthenticator can identify them; clients without
+// a certificate may still authenticate with a bearer JWT.
+func ServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load registry server certificate: %w", err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile != "" {
+		pool, err := toolregistry.LoadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return cfg, nil
+}
+
+// LoadJWTKey reads a JWT verification key from file. A PEM "PUBLIC KEY" block
+// yields an RSA, ECDSA, or Ed25519 public key; any other content is used as an
+// HMAC secret with surrounding whitespace removed.
+func LoadJWTKey(file string) (any, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read JWT key: %w", err)
+	}
+	if block, _ := pem.Decode(data); block != nil {
+		if block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("JWT key PEM block must be PUBLIC KEY, got %s", block.Type)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse JWT public key: %w", err)
+		}
+		return key, nil
+	}
+	secret := []byte(strings.TrimSpace(string(data)))
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("JWT key file %q is empty", file)
+	}
+	return secret, nil
+}
+
+// certificateSubject returns the identity of a verified client certificate.
+func certificateSubject(cert *x509.Certificate) string {
+	if len(cert.URIs) > 0 {
+		return cert.URIs[0].String()
+	}
+	return cert.Subject.CommonName
+}
+
+// decodeJWTSegment decodes one base64url JSON segment into v.
+func decodeJWTSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// verifyJWTSignature checks sig over signed with key. The algorithm must
+// match the key type so a token cannot downgrade, for example, an RSA public
+// key into an HMAC secret.
+func verifyJWTSignature(alg string, key any, signed, sig []byte) error {
+	switch alg {
+	case "HS256", "HS384", "HS512":
+		secret, ok := key.([]byte)
+		if !ok {
+			return fmt.Errorf("signing key does not support %s", alg)
+		}
+		mac := hmac.New(jwtHash(alg).New, secret)
+		mac.Write(signed)
+		if !hmac.Equal(mac.Sum(nil), sig) {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	case "RS256", "RS384", "RS512":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("signing key does not support %s", alg)
+		}
+		hash := jwtHash(alg)
+		h := hash.New()
+		h.Write(signed)
+		if err := rsa.VerifyPKCS1v15(pub, hash, h.Sum(nil), sig); err != nil {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	case "ES256", "ES384":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("signing key does not support %s", alg)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("signature mismatch")
+		}
+		hash := jwtHash(alg)
+		h := hash.New()
+		h.Write(signed)
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, h.Sum(nil), r, s) {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	case "EdDSA":
+		pub, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return fmt.Errorf("signing key does not support %s", alg)
+		}
+		if !ed25519.Verify(pub, signed, sig) {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported signing algorithm %q", alg)
+	}
+}
+
+// jwtHash returns the digest named by a JWS algorithm suffix.
+func jwtHash(alg string) crypto.Hash {
+	switch alg[len(alg)-3:] {
+	case "384":
+		return crypto.SHA384
+	case "512":
+		return crypto.SHA512
+	default:
+		return crypto.SHA256
+	}
+}
+
+// unixSeconds converts a NumericDate claim to a time.
+func unixSeconds(v float64) time.Time {
+	return time.UnixMilli(int64(v * 1000))
+}
@@ -0,0 +1,196 @@
+package registry
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"goa.design/goa-ai/runtime/toolregistry"
+)
+
+func TestJWTAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	secret := []byte("registry-test-secret")
+	auth := &JWTAuthenticator{
+		Keys:     map[string]any{"": secret},
+		Issuer:   "https://issuer.example.com",
+		Audience: "registry",
+		Now:      func() time.Time { return now },
+	}
+	claims := map[string]any{
+		"sub": "svc-data",
+		"iss": "https://issuer.example.com",
+		"aud": []string{"registry", "other"},
+		"exp": now.Add(time.Minute).Unix(),
+	}
+
+	caller, err := auth.Authenticate(bearerContext(signHS256(t, secret, claims)))
+	require.NoError(t, err)
+	assert.Equal(t, Caller{Subject: "svc-data", Scheme: CallerSchemeJWT}, caller)
+
+	_, err = auth.Authenticate(context.Background())
+	require.ErrorIs(t, err, ErrNoCredentials)
+
+	for name, tc := range map[string]struct {
+		ctx  context.Context
+		want string
+	}{
+		"wrong secret":   {ctx: bearerContext(signHS256(t, []byte("other"), claims)), want: "signature mismatch"},
+		"expired":        {ctx: bearerContext(signHS256(t, secret, withClaim(claims, "exp", now.Add(-time.Second).Unix()))), want: "token expired"},
+		"not yet valid":  {ctx: bearerContext(signHS256(t, secret, withClaim(claims, "nbf", now.Add(time.Minute).Unix()))), want: "not yet valid"},
+		"wrong issuer":   {ctx: bearerContext(signHS256(t, secret, withClaim(claims, "iss", "https://evil.example.com"))), want: "not trusted"},
+		"wrong audience": {ctx: bearerContext(signHS256(t, secret, withClaim(claims, "aud", "other"))), want: "audience"},
+		"missing exp":    {ctx: bearerContext(signHS256(t, secret, withClaim(claims, "exp", nil))), want: `no "exp"`},
+		"basic scheme": {
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(toolregistry.AuthorizationMetadataKey, "Basic abc")),
+			want: "Bearer scheme",
+		},
+		"two tokens": {
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				toolregistry.AuthorizationMetadataKey, "Bearer a",
+				toolregistry.AuthorizationMetadataKey, "Bearer b",
+			)),
+			want: "more than one",
+		},
+	} {
+		_, err := auth.Authenticate(tc.ctx)
+		require.ErrorContains(t, err, tc.want, name)
+		assert.NotErrorIs(t, err, ErrNoCredentials, name)
+	}
+}
+
+func TestJWTAuthenticatorRejectsAlgorithmConfusion(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	now := time.Unix(1_700_000_000, 0)
+	auth := &JWTAuthenticator{
+		Keys: map[string]any{"ec": &key.PublicKey},
+		Now:  func() time.Time { return now },
+	}
+	claims := map[string]any{"sub": "agent", "exp": now.Add(time.Minute).Unix()}
+
+	caller, err := auth.Authenticate(bearerContext(signES256(t, key, "ec", claims)))
+	require.NoError(t, err)
+	assert.Equal(t, "agent", caller.Subject)
+
+	// An HMAC token must not verify against the public key bytes.
+	pub, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	_, err = auth.Authenticate(bearerContext(signHS256(t, pub, claims)))
+	require.ErrorContains(t, err, "does not support HS256")
+}
+
+func TestMTLSAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	spiffe, err := url.Parse("spiffe://prod/ns/agents/sa/planner")
+	require.NoError(t, err)
+	for name, tc := range map[string]struct {
+		cert *x509.Certificate
+		want string
+	}{
+		"uri san":     {cert: &x509.Certificate{URIs: []*url.URL{spiffe}, Subject: pkix.Name{CommonName: "planner"}}, want: spiffe.String()},
+		"common name": {cert: &x509.Certificate{Subject: pkix.Name{CommonName: "svc-data"}}, want: "svc-data"},
+	} {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{tc.cert}}},
+		}})
+		caller, err := MTLSAuthenticator{}.Authenticate(ctx)
+		require.NoError(t, err, name)
+		assert.Equal(t, Caller{Subject: tc.want, Scheme: CallerSchemeMTLS}, caller, name)
+	}
+
+	unverified := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{}})
+	_, err = MTLSAuthenticator{}.Authenticate(unverified)
+	require.ErrorIs(t, err, ErrNoCredentials)
+}
+
+func TestAnyAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("registry-test-secret")
+	auth := AnyAuthenticator(MTLSAuthenticator{}, &JWTAuthenticator{Keys: map[string]any{"": secret}})
+	claims := map[string]any{"sub": "svc-data", "exp": time.Now().Add(time.Minute).Unix()}
+
+	caller, err := auth.Authenticate(bearerContext(signHS256(t, secret, claims)))
+	require.NoError(t, err)
+	assert.Equal(t, CallerSchemeJWT, caller.Scheme)
+
+	_, err = auth.Authenticate(bearerContext(signHS256(t, []byte("other"), claims)))
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNoCredentials)
+
+	_, err = auth.Authenticate(context.Background())
+	require.ErrorIs(t, err, ErrNoCredentials)
+}
+
+func bearerContext(token string) context.Context {
+	return metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(toolregistry.AuthorizationMetadataKey, "Bearer "+token),
+	)
+}
+
+func withClaim(claims map[string]any, name string, value any) map[string]any {
+	out := make(map[string]any, len(claims))
+	for k, v := range claims {
+		out[k] = v
+	}
+	if value == nil {
+		delete(out, name)
+	} else {
+		out[name] = value
+	}
+	return out
+}
+
+func signHS256(t *testing.T, secret []byte, claims map[string]any) string {
+	t.Helper()
+	signing := jwtSigningInput(t, map[string]any{"alg": "HS256", "typ": "JWT"}, claims)
+	mac := hmac.New(crypto.SHA256.New, secret)
+	mac.Write([]byte(signing))
+	return signing + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signES256(t *testing.T, key *ecdsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+	signing := jwtSigningInput(t, map[string]any{"alg": "ES256", "kid": kid}, claims)
+	h := crypto.SHA256.New()
+	h.Write([]byte(signing))
+	r, s, err := ecdsa.Sign(rand.Reader, key, h.Sum(nil))
+	require.NoError(t, err)
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return signing + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func jwtSigningInput(t *testing.T, header, claims map[string]any) string {
+	t.Helper()
+	h, err := json.Marshal(header)
+	require.NoError(t, err)
+	c, err := json.Marshal(claims)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c)
+}
@@ -115,12 +115,12 @@ or redis.call("HGET", KEYS[1], "dispatch_request_event_id") ~= ARGV[6] then
 end
 if redis.call("HGET", KEYS[1], "terminal") == "1" then
   if redis.call("HGET", KEYS[1], "terminal_cause") == "execution_deadline" then
-    return {2, redis.call("HGET", KEYS[1], "terminal_event_id") or ""}
+    return {2, redis.call("HGET", KEYS[1], "terminal_event_id") or "", redis.call("HGET", KEYS[1], "tool") or ""}
   end
   if redis.call("HGET", KEYS[1], "terminal_digest") ~= ARGV[7] then
     return redis.error_reply("TERMINALCONFLICT")
   end
-  return {0, redis.call("HGET", KEYS[1], "terminal_event_id") or ""}
+  return {0, redis.call("HGET", KEYS[1], "terminal_event_id") or "", redis.call("HGET", KEYS[1], "tool") or ""}
 end
 local execution_deadline = tonumber(redis.call("HGET", KEYS[1], "execution_deadline_unix_milli"))
 if not execution_deadline then
@@ -144,7 +144,7 @@ if execution_deadline <= now_millis then
   redis.call("ZREM", KEYS[4], KEYS[1])
   redis.call("ZREM", KEYS[5], KEYS[1])
   redis.call("HDEL", KEYS[6], KEYS[1])
-  return {2, id}
+  return {2, id, redis.call("HGET", KEYS[1], "tool") or ""}
 end
 local id = redis.call("XADD", KEYS[2], "MAXLEN", "=", ARGV[10], "*", "n", ARGV[8], "p", ARGV[9])
 redis.call("PEXPIREAT", KEYS[2], expires)
@@ -159,7 +159,7 @@ redis.call(
 redis.call("ZREM", KEYS[4], KEYS[1])
 redis.call("ZREM", KEYS[5], KEYS[1])
 redis.call("HDEL", KEYS[6], KEYS[1])
-return {1, id}
+return {1, id, redis.call("HGET", KEYS[1], "tool") or ""}
 `)
 	claimCallAdmissionScript = redis.NewScript(`
 local raw = redis.call("HGET", KEYS[2], ARGV[3])
@@ -492,16 +492,18 @@ func (s *callAdmissionStore) InitializeResultStream(
 
 // Complete atomically appends one terminal result and commits terminal state.
 // Ordinary completion uses the call token for provider authorization; stale
-// rejection supplies a distinct current provider token.
+// rejection supplies a distinct current provider token. It returns the tool
+// the call was admitted for, empty for calls admitted before call records
+// named their tool.
 func (s *callAdmissionStore) Complete(
 	ctx context.Context,
 	toolset, toolUseID, callRegistrationToken, providerRegistrationToken,
 	providerLease, requestEventID, resultStreamID string,
 	payload []byte,
-) error {
+) (string, error) {
 	key := s.callKey(toolUseID)
 	digest := sha256.Sum256(payload)
-	_, err := completeCallAdmissionScript.Run(
+	value, err := completeCallAdmissionScript.Run(
 		ctx,
 		s.redis,
 		[]string{
@@ -526,20 +528,24 @@ func (s *callAdmissionStore) Complete(
 	if err != nil {
 		switch {
 		case redis.HasErrorPrefix(err, "CALLADMISSIONCHANGED"):
-			return errCallAdmissionNotFound
+			return "", errCallAdmissionNotFound
 		case redis.HasErrorPrefix(err, "CALLCLAIMCHANGED"):
-			return errCallAdmissionConflict
+			return "", errCallAdmissionConflict
 		case redis.HasErrorPrefix(err, "DISPATCHCLAIMCHANGED"):
-			return errCallAdmissionConflict
+			return "", errCallAdmissionConflict
 		case redis.HasErrorPrefix(err, "PROVIDERLEASECHANGED"):
-			return errToolsetNotFound
+			return "", errToolsetNotFound
 		case redis.HasErrorPrefix(err, "TERMINALCONFLICT"):
-			return errCallTerminalConflict
+			return "", errCallTerminalConflict
 		default:
-			return fmt.Errorf("complete call admission: %w", err)
+			return "", fmt.Errorf("complete call admission: %w", err)
 		}
 	}
-	return nil
+	if len(value) < 3 {
+		return "", fmt.Errorf("complete call admission returned %d values", len(value))
+	}
+	tool, _ := value[2].(string)
+	return tool, nil
 }
 
 // Claim atomically grants immutable dispatch ownership or returns the
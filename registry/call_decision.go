@@ -331,7 +331,8 @@ if redis.call("EXISTS", KEYS[1]) == 0 then
     "overload_retry_after_ms", "0",
     "dispatch_provider_token", "",
     "dispatch_provider_lease", "",
-    "dispatch_request_event_id", ""
+    "dispatch_request_event_id", "",
+    "tool", ARGV[8]
   )
   redis.call("PEXPIREAT", KEYS[1], expires)
   return {1, ARGV[1], tostring(execution_deadline), tostring(expires), "0", "", ARGV[3], "0", "", "0", ARGV[6], "", "", ""}
@@ -475,10 +476,11 @@ func (s *callAdmissionStore) Attach(
 // publication, a concurrent caller may move the decision to the currently
 // healthy provider because Redis still proves that no external effect began.
 // Published decisions remain immutable. A rejected decision returns
-// callRejectedError instead.
+// callRejectedError instead. A new decision records tool so settlements can
+// report the tool they settle.
 func (s *callAdmissionStore) Ensure(
 	ctx context.Context,
-	toolset, toolUseID, registrationToken, digest string,
+	toolset, tool, toolUseID, registrationToken, digest string,
 	executionTimeout, ttl time.Duration,
 	outcomeUnknownPayload []byte,
 ) (callAdmission, bool, error) {
@@ -494,6 +496,7 @@ func (s *callAdmissionStore) Ensure(
 		ttl.Milliseconds(),
 		outcomeUnknownPayload,
 		toolsetCatalogKey(toolset),
+		tool,
 	).Slice()
 	if err != nil {
 		return callAdmission{}, false, fmt.Errorf("ensure call admission: %w", err)
@@ -0,0 +1,195 @@
+// Package registry exposes read-only views of admitted tool calls to operators.
+//
+// Inspection reads the same Redis hash that admission, dispatch, and settlement
+// mutate, so it reports exactly the state the registry acts on. It never
+// changes a call or its indexes.
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/toolregistry"
+)
+
+// callInspector reads admitted call state for the admin API. Production uses
+// callAdmissionStore; service tests may substitute fixed calls.
+type callInspector interface {
+	// InspectCall returns the admitted call toolUseID of toolset or
+	// errCallAdmissionNotFound.
+	InspectCall(ctx context.Context, toolset, toolUseID string) (*genregistry.ToolCallInfo, error)
+	// ClaimedCalls returns at most limit unsettled calls claimed by the given
+	// provider leases of one admission generation, earliest deadline first.
+	ClaimedCalls(
+		ctx context.Context,
+		toolset, registrationToken string,
+		providerLeases []string,
+		limit int,
+	) ([]*genregistry.ToolCallInfo, error)
+	// ClaimedCallCount returns the number of unsettled calls claimed by one
+	// provider lease.
+	ClaimedCallCount(ctx context.Context, registrationToken, providerLease string) (int64, error)
+}
+
+// InspectCall implements callInspector. Rejected decisions and calls admitted
+// by another toolset report errCallAdmissionNotFound.
+func (s *callAdmissionStore) InspectCall(
+	ctx context.Context,
+	toolset, toolUseID string,
+) (*genregistry.ToolCallInfo, error) {
+	return s.inspectCallKey(ctx, toolset, s.callKey(toolUseID))
+}
+
+// ClaimedCalls implements callInspector.
+func (s *callAdmissionStore) ClaimedCalls(
+	ctx context.Context,
+	toolset, registrationToken string,
+	providerLeases []string,
+	limit int,
+) ([]*genregistry.ToolCallInfo, error) {
+	var members []redis.Z
+	for _, lease := range providerLeases {
+		indexed, err := s.redis.ZRangeWithScores(
+			ctx,
+			s.leaseSettlementKey(registrationToken, lease),
+			0,
+			int64(limit)-1,
+		).Result()
+		if err != nil {
+			return nil, fmt.Errorf("read provider claims: %w", err)
+		}
+		members = append(members, indexed...)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Score != members[j].Score {
+			return members[i].Score < members[j].Score
+		}
+		return fmt.Sprint(members[i].Member) < fmt.Sprint(members[j].Member)
+	})
+	if len(members) > limit {
+		members = members[:limit]
+	}
+	calls := make([]*genregistry.ToolCallInfo, 0, len(members))
+	for _, member := range members {
+		key, ok := member.Member.(string)
+		if !ok {
+			continue
+		}
+		call, err := s.inspectCallKey(ctx, toolset, key)
+		if errors.Is(err, errCallAdmissionNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, call)
+	}
+	return calls, nil
+}
+
+// ClaimedCallCount implements callInspector.
+func (s *callAdmissionStore) ClaimedCallCount(
+	ctx context.Context,
+	registrationToken, providerLease string,
+) (int64, error) {
+	count, err := s.redis.ZCard(ctx, s.leaseSettlementKey(registrationToken, providerLease)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("count provider claims: %w", err)
+	}
+	return count, nil
+}
+
+// inspectCallKey projects one call hash and its result stream onto the admin
+// API type.
+func (s *callAdmissionStore) inspectCallKey(
+	ctx context.Context,
+	toolset, key string,
+) (*genregistry.ToolCallInfo, error) {
+	fields, err := s.redis.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("read call admission: %w", err)
+	}
+	if len(fields) == 0 ||
+		fields["decision"] == "rejected" ||
+		fields["catalog_field"] != toolsetCatalogKey(toolset) {
+		return nil, errCallAdmissionNotFound
+	}
+	toolUseID := fields["tool_use_id"]
+	resultStreamID := toolregistry.ResultStreamID(toolUseID)
+	info := &genregistry.ToolCallInfo{
+		ToolUseID:         toolUseID,
+		State:             callInspectionState(fields),
+		RegistrationToken: fields["registration_token"],
+		ResultStreamID:    resultStreamID,
+	}
+	if info.ExecutionDeadlineUnixMilli, err = parseCallField(fields, "execution_deadline_unix_milli"); err != nil {
+		return nil, err
+	}
+	if info.ExpiresAtUnixMilli, err = parseCallField(fields, "expires_at_unix_milli"); err != nil {
+		return nil, err
+	}
+	if info.OutputDeltaCount, err = parseCallField(fields, "output_delta_count"); err != nil {
+		return nil, err
+	}
+	if lease := fields["dispatch_provider_lease"]; lease != "" {
+		providerID, incarnationID, err := parseProviderLeaseKey(lease)
+		if err != nil {
+			return nil, err
+		}
+		info.ProviderID = &providerID
+		info.ProviderIncarnationID = &incarnationID
+	}
+	if requestEventID := fields["dispatch_request_event_id"]; requestEventID != "" {
+		info.RequestEventID = &requestEventID
+	}
+	if cause := fields["terminal_cause"]; cause != "" {
+		info.TerminalCause = &cause
+	}
+
+	streamKey := pulseStreamKeyPrefix + resultStreamID
+	pipe := s.redis.Pipeline()
+	length := pipe.XLen(ctx, streamKey)
+	newest := pipe.XRevRangeN(ctx, streamKey, "+", "-", 1)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("read call result stream: %w", err)
+	}
+	info.ResultStreamLength = length.Val()
+	if events := newest.Val(); len(events) > 0 {
+		lastEventID := events[0].ID
+		info.LastEventID = &lastEventID
+	}
+	return info, nil
+}
+
+// callInspectionState names the lifecycle stage recorded in a call hash.
+func callInspectionState(fields map[string]string) string {
+	switch {
+	case fields["terminal"] == "1":
+		return "terminal"
+	case fields["dispatch_provider_token"] != "":
+		return "claimed"
+	case fields["published"] == "1":
+		return "queued"
+	default:
+		return "admitted"
+	}
+}
+
+// parseCallField reads an optional integer call field; missing fields are 0.
+func parseCallField(fields map[string]string, name string) (int64, error) {
+	raw := fields[name]
+	if raw == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse call %s: %w", name, err)
+	}
+	return value, nil
+}
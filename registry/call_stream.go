@@ -0,0 +1,112 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/toolregistry"
+	streamopts "goa.design/pulse/streaming/options"
+)
+
+// callStreamBlockDuration bounds each result-stream poll so a disconnected
+// HTTP client releases its reader promptly.
+const callStreamBlockDuration = 100 * time.Millisecond
+
+// StreamToolCall forwards the result stream of one routed call to an HTTP
+// client: output deltas and retry control as they arrive, then the canonical
+// terminal result, after which the stream ends. Only events stamped with the
+// payload registration token are forwarded, so a reused tool-use identity
+// never leaks output from an older admission.
+func (s *Service) StreamToolCall(
+	ctx context.Context,
+	p *genregistry.StreamToolCallPayload,
+	stream genregistry.StreamToolCallServerStream,
+) error {
+	if err := s.authorizeCall(ctx, p.Toolset, p.Tool); err != nil {
+		return err
+	}
+	if err := toolregistry.ValidateToolUseID(p.ToolUseID); err != nil {
+		return genregistry.MakeValidationError(err)
+	}
+	resultStreamID := toolregistry.ResultStreamID(p.ToolUseID)
+	rs, err := s.pulseClient.Stream(
+		resultStreamID,
+		streamopts.WithStreamMaxLen(toolregistry.ResultStreamMaxLen),
+	)
+	if err != nil {
+		return genregistry.MakeServiceUnavailable(fmt.Errorf("open result stream %q: %w", resultStreamID, err))
+	}
+
+	// Every admitted call settles within the execution timeout, so a reader
+	// that has seen no terminal by then is waiting on a call that no longer
+	// exists.
+	ctx, cancel := context.WithTimeout(ctx, s.executionTimeout)
+	defer cancel()
+	start := streamopts.WithReaderStartAtOldest()
+	if p.LastEventID != nil {
+		start = streamopts.WithReaderStartAfter(*p.LastEventID)
+	}
+	// CallTool and RetryTool establish the result stream before returning, so
+	// a reader can only fail to attach when the call is unknown or its stream
+	// expired.
+	reader, err := rs.NewReader(ctx, start, streamopts.WithReaderBlockDuration(callStreamBlockDuration))
+	if err != nil {
+		return genregistry.MakeNotFound(fmt.Errorf("result stream for tool use %q: %w", p.ToolUseID, err))
+	}
+	defer reader.Close()
+
+	events := reader.Subscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok := <-events:
+			if !ok {
+				return genregistry.MakeServiceUnavailable(fmt.Errorf("result stream %q subscription closed", resultStreamID))
+			}
+			switch ev.EventName {
+			case toolregistry.OutputDeltaEventKey:
+				var msg toolregistry.ToolOutputDeltaMessage
+				if err := json.Unmarshal(ev.Payload, &msg); err != nil {
+					continue
+				}
+				if msg.ToolUseID != p.ToolUseID || msg.RegistrationToken != p.RegistrationToken {
+					continue
+				}
+				if err := stream.SendWithContext(ctx, &genregistry.ToolCallEvent{
+					ID:     ev.ID,
+					Type:   "output_delta",
+					Stream: &msg.Stream,
+					Delta:  &msg.Delta,
+				}); err != nil {
+					return err
+				}
+			case toolregistry.ResultEventKey:
+				var msg toolregistry.ToolResultMessage
+				if err := json.Unmarshal(ev.Payload, &msg); err != nil {
+					return genregistry.MakeServiceUnavailable(fmt.Errorf("decode result event %s: %w", ev.ID, err))
+				}
+				if msg.ToolUseID != p.ToolUseID || msg.RegistrationToken != p.RegistrationToken {
+					continue
+				}
+				typ := "result"
+				if msg.Retry != nil {
+					typ = "retry"
+				}
+				if err := stream.SendWithContext(ctx, &genregistry.ToolCallEvent{
+					ID:      ev.ID,
+					Type:    typ,
+					Message: json.RawMessage(ev.Payload),
+				}); err != nil {
+					return err
+				}
+				if msg.Retry == nil {
+					return nil
+				}
+			}
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -77,11 +78,25 @@ type (
 		clock registryTimeSource
 	}
 
+	// toolsetRoutesRecord is the CAS-owned canary route table for the
+	// side-by-side versions of one toolset name.
+	toolsetRoutesRecord struct {
+		Routes toolregistry.ToolsetRoutes `json:"routes"`
+	}
+
+	// toolsetVersionsRecord indexes the side-by-side versions registered under
+	// one toolset name, in ascending order, so routing never scans the catalog.
+	toolsetVersionsRecord struct {
+		Versions []string `json:"versions"`
+	}
+
 	catalogEntryState string
 )
 
 const (
-	toolsetCatalogKeyPrefix = "registry:toolset:"
+	toolsetCatalogKeyPrefix  = "registry:toolset:"
+	toolsetRoutesKeyPrefix   = "registry:toolset-routes:"
+	toolsetVersionsKeyPrefix = "registry:toolset-versions:"
 
 	catalogEntryActive  catalogEntryState = "active"
 	catalogEntryRetired catalogEntryState = "retired"
@@ -114,7 +129,9 @@ func (c *toolsetCatalog) validatePersistedEntries(ctx context.Context) error {
 	sort.Strings(keys)
 	var invalid []error
 	for _, key := range keys {
-		if !strings.HasPrefix(key, toolsetCatalogKeyPrefix) {
+		routes := strings.HasPrefix(key, toolsetRoutesKeyPrefix)
+		versions := strings.HasPrefix(key, toolsetVersionsKeyPrefix)
+		if !routes && !versions && !strings.HasPrefix(key, toolsetCatalogKeyPrefix) {
 			invalid = append(invalid, fmt.Errorf("catalog key %q has invalid prefix", key))
 			continue
 		}
@@ -126,6 +143,18 @@ func (c *toolsetCatalog) validatePersistedEntries(ctx context.Context) error {
 		if !exists {
 			continue
 		}
+		if routes {
+			if _, err := parseToolsetRoutes(strings.TrimPrefix(key, toolsetRoutesKeyPrefix), raw); err != nil {
+				invalid = append(invalid, fmt.Errorf("catalog key %q: %w", key, err))
+			}
+			continue
+		}
+		if versions {
+			if _, err := parseToolsetVersions(strings.TrimPrefix(key, toolsetVersionsKeyPrefix), raw); err != nil {
+				invalid = append(invalid, fmt.Errorf("catalog key %q: %w", key, err))
+			}
+			continue
+		}
 		name := strings.TrimPrefix(key, toolsetCatalogKeyPrefix)
 		if _, err := parseCatalogEntry(name, raw); err != nil {
 			invalid = append(invalid, fmt.Errorf("catalog key %q: %w", key, err))
@@ -136,7 +165,9 @@ func (c *toolsetCatalog) validatePersistedEntries(ctx context.Context) error {
 
 // Register atomically creates, renews, or replaces one admission and provider
 // lease. Different admissions remain blocked until Redis TIME proves all
-// current leases expired.
+// current leases expired. Side-by-side versions are added to the version index
+// of their name before the admission is written, so ActiveVersions never
+// misses a committed version.
 func (c *toolsetCatalog) Register(
 	ctx context.Context,
 	toolset *genregistry.Toolset,
@@ -163,6 +194,12 @@ func (c *toolsetCatalog) Register(
 	if err != nil {
 		return catalogEntry{}, fmt.Errorf("derive toolset %q admission token: %w", toolset.Name, err)
 	}
+	name, _ := toolregistry.SplitToolsetRef(toolset.Name)
+	if version, ok := registrationVersion(name, toolset.Name); ok {
+		if err := c.indexToolsetVersion(ctx, name, version); err != nil {
+			return catalogEntry{}, err
+		}
+	}
 	key := toolsetCatalogKey(toolset.Name)
 	for {
 		raw, exists, err := c.exactRaw(ctx, key)
@@ -212,8 +249,15 @@ func (c *toolsetCatalog) Register(
 		}
 		if existing.RegistrationToken == token {
 			hadRoutable := routableProviderCount(existing, now) > 0
-			existing.ProviderLeases[providerLeaseKey(providerID, incarnationID)] = lease
-			if !hadRoutable {
+			leaseKey := providerLeaseKey(providerID, incarnationID)
+			if current, exists := existing.ProviderLeases[leaseKey]; exists && current.Draining {
+				// A drained incarnation stays non-routable for the rest of
+				// its lifecycle; renewal only extends settlement authority.
+				lease.Draining = true
+				lease.ExpiresAtUnixMilli = max(lease.ExpiresAtUnixMilli, current.ExpiresAtUnixMilli)
+			}
+			existing.ProviderLeases[leaseKey] = lease
+			if !hadRoutable && !lease.Draining {
 				existing.HealthEpoch++
 				existing.LastPongUnixNano = 0
 			}
@@ -456,6 +500,164 @@ func (c *toolsetCatalog) ActiveRegistration(ctx context.Context, name string) (c
 	return entry, nil
 }
 
+// Admission returns the authoritative record of name in any state together
+// with the Redis time of the read. It never mutates the record, so expired
+// leases are still present; callers compare them with the returned time.
+func (c *toolsetCatalog) Admission(ctx context.Context, name string) (catalogEntry, time.Time, error) {
+	raw, exists, err := c.exactRaw(ctx, toolsetCatalogKey(name))
+	if err != nil {
+		return catalogEntry{}, time.Time{}, err
+	}
+	if !exists {
+		return catalogEntry{}, time.Time{}, errToolsetNotFound
+	}
+	entry, err := parseCatalogEntry(name, raw)
+	if err != nil {
+		return catalogEntry{}, time.Time{}, err
+	}
+	now, err := c.clock.Now(ctx)
+	if err != nil {
+		return catalogEntry{}, time.Time{}, err
+	}
+	return entry, now, nil
+}
+
+// ActiveVersions returns the active side-by-side registrations of name, that
+// is every active entry registered as name@<version>, in ascending version
+// order. It reads the authoritative version index of name, so a version
+// becomes routable the moment Register commits it without scanning the
+// catalog.
+func (c *toolsetCatalog) ActiveVersions(ctx context.Context, name string) ([]catalogEntry, error) {
+	raw, exists, err := c.exactRaw(ctx, toolsetVersionsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	versions, err := parseToolsetVersions(name, raw)
+	if err != nil {
+		return nil, err
+	}
+	var entries []catalogEntry
+	for _, version := range versions {
+		entry, err := c.ActiveRegistration(ctx, toolregistry.VersionedToolset(name, version))
+		if errors.Is(err, errToolsetNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// indexToolsetVersion adds version to the version index of name. Catalog
+// entries are never deleted, so the index only grows; ActiveVersions skips
+// versions whose registration is retired.
+func (c *toolsetCatalog) indexToolsetVersion(ctx context.Context, name string, version toolregistry.SemVer) error {
+	key := toolsetVersionsKey(name)
+	for {
+		raw, exists, err := c.exactRaw(ctx, key)
+		if err != nil {
+			return err
+		}
+		var versions []toolregistry.SemVer
+		if exists {
+			if versions, err = parseToolsetVersions(name, raw); err != nil {
+				return err
+			}
+		}
+		i, found := slices.BinarySearchFunc(versions, version, toolregistry.SemVer.Compare)
+		if found {
+			return nil
+		}
+		versions = slices.Insert(versions, i, version)
+		record := toolsetVersionsRecord{Versions: make([]string, len(versions))}
+		for i, v := range versions {
+			record.Versions[i] = v.String()
+		}
+		body, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("marshal toolset %q versions: %w", name, err)
+		}
+		if !exists {
+			inserted, err := c.m.SetIfNotExists(ctx, key, string(body))
+			if err != nil {
+				return fmt.Errorf("insert toolset %q versions: %w", name, err)
+			}
+			if inserted {
+				return nil
+			}
+			continue
+		}
+		_, _, updated, err := c.m.TestAndSetEx(ctx, key, raw, string(body))
+		if err != nil {
+			return fmt.Errorf("replace catalog key %q: %w", key, err)
+		}
+		if updated {
+			return nil
+		}
+	}
+}
+
+// ToolsetRoutes returns the canary route table of name. A name without a
+// route table returns nil.
+func (c *toolsetCatalog) ToolsetRoutes(ctx context.Context, name string) (toolregistry.ToolsetRoutes, error) {
+	raw, exists, err := c.exactRaw(ctx, toolsetRoutesKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	return parseToolsetRoutes(name, raw)
+}
+
+// SetToolsetRoutes replaces the canary route table of name. An empty table
+// restores the default of routing unpinned calls to the highest version.
+func (c *toolsetCatalog) SetToolsetRoutes(
+	ctx context.Context,
+	name string,
+	routes toolregistry.ToolsetRoutes,
+) error {
+	if err := toolregistry.ValidateToolsetRoutes(routes); err != nil {
+		return err
+	}
+	if routes == nil {
+		routes = toolregistry.ToolsetRoutes{}
+	}
+	body, err := json.Marshal(toolsetRoutesRecord{Routes: routes})
+	if err != nil {
+		return fmt.Errorf("marshal toolset %q routes: %w", name, err)
+	}
+	key := toolsetRoutesKey(name)
+	for {
+		raw, exists, err := c.exactRaw(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			inserted, err := c.m.SetIfNotExists(ctx, key, string(body))
+			if err != nil {
+				return fmt.Errorf("insert toolset %q routes: %w", name, err)
+			}
+			if inserted {
+				return nil
+			}
+			continue
+		}
+		_, _, updated, err := c.m.TestAndSetEx(ctx, key, raw, string(body))
+		if err != nil {
+			return fmt.Errorf("replace catalog key %q: %w", key, err)
+		}
+		if updated {
+			return nil
+		}
+	}
+}
+
 // RegistrationToken returns the current active admission token.
 func (c *toolsetCatalog) RegistrationToken(ctx context.Context, name string) (string, error) {
 	entry, err := c.ActiveRegistration(ctx, name)
@@ -1010,6 +1212,68 @@ func toolsetCatalogKey(name string) string {
 	return toolsetCatalogKeyPrefix + name
 }
 
+func toolsetRoutesKey(name string) string {
+	return toolsetRoutesKeyPrefix + name
+}
+
+func toolsetVersionsKey(name string) string {
+	return toolsetVersionsKeyPrefix + name
+}
+
+// registrationVersion returns the version of a side-by-side registration of
+// name. Only canonical versions identify a versioned registration.
+func registrationVersion(name, registration string) (toolregistry.SemVer, bool) {
+	base, version := toolregistry.SplitToolsetRef(registration)
+	if base != name || version == "" {
+		return toolregistry.SemVer{}, false
+	}
+	v, err := toolregistry.ParseSemVer(version)
+	if err != nil || v.String() != version {
+		return toolregistry.SemVer{}, false
+	}
+	return v, true
+}
+
+// parseToolsetRoutes validates a persisted canary route table.
+func parseToolsetRoutes(name, body string) (toolregistry.ToolsetRoutes, error) {
+	var record toolsetRoutesRecord
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&record); err != nil {
+		return nil, fmt.Errorf("unmarshal toolset %q routes: %w", name, err)
+	}
+	if record.Routes == nil {
+		return nil, fmt.Errorf("toolset %q routes missing route table", name)
+	}
+	if err := toolregistry.ValidateToolsetRoutes(record.Routes); err != nil {
+		return nil, fmt.Errorf("toolset %q routes: %w", name, err)
+	}
+	return record.Routes, nil
+}
+
+// parseToolsetVersions validates a persisted version index and returns its
+// versions in ascending order.
+func parseToolsetVersions(name, body string) ([]toolregistry.SemVer, error) {
+	var record toolsetVersionsRecord
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&record); err != nil {
+		return nil, fmt.Errorf("unmarshal toolset %q versions: %w", name, err)
+	}
+	versions := make([]toolregistry.SemVer, 0, len(record.Versions))
+	for _, raw := range record.Versions {
+		v, err := toolregistry.ParseSemVer(raw)
+		if err != nil || v.String() != raw {
+			return nil, fmt.Errorf("toolset %q versions: invalid version %q", name, raw)
+		}
+		if n := len(versions); n > 0 && versions[n-1].Compare(v) >= 0 {
+			return nil, fmt.Errorf("toolset %q versions are not in ascending order", name)
+		}
+		versions = append(versions, v)
+	}
+	return versions, nil
+}
+
 func catalogMatchesTags(toolsetTags, filterTags []string) bool {
 	if len(filterTags) == 0 {
 		return true
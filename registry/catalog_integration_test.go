@@ -265,13 +265,13 @@ func TestCallAdmissionAtomicallyPublishesInitialAndOverloadOnce(t *testing.T) {
 	require.NoError(t, err)
 	token := registration.RegistrationToken
 	first, created, err := firstStore.Ensure(
-		ctx, toolset, toolUseID, token, digest, time.Second, 5*time.Second,
+		ctx, toolset, "lookup", toolUseID, token, digest, time.Second, 5*time.Second,
 		outcomeUnknownPayload(token, toolUseID),
 	)
 	require.NoError(t, err)
 	require.True(t, created)
 	second, created, err := secondStore.Ensure(
-		ctx, toolset, toolUseID, token, digest, time.Second, 5*time.Second,
+		ctx, toolset, "lookup", toolUseID, token, digest, time.Second, 5*time.Second,
 		outcomeUnknownPayload(token, toolUseID),
 	)
 	require.NoError(t, err)
@@ -361,9 +361,10 @@ func TestCallAdmissionAtomicallyPublishesInitialAndOverloadOnce(t *testing.T) {
 		toolUseID,
 	))
 	completions := make(chan error, 2)
+	completedTools := make(chan string, 2)
 	for _, store := range []*callAdmissionStore{firstStore, secondStore} {
 		go func(store *callAdmissionStore) {
-			completions <- store.Complete(
+			tool, err := store.Complete(
 				ctx,
 				toolset,
 				toolUseID,
@@ -374,12 +375,16 @@ func TestCallAdmissionAtomicallyPublishesInitialAndOverloadOnce(t *testing.T) {
 				resultStreamID,
 				terminal,
 			)
+			completedTools <- tool
+			completions <- err
 		}(store)
 	}
 	require.NoError(t, <-completions)
 	require.NoError(t, <-completions)
+	assert.Equal(t, "lookup", <-completedTools)
+	assert.Equal(t, "lookup", <-completedTools)
 	assert.EqualValues(t, 2, testRedisClient.XLen(ctx, pulseStreamKeyPrefix+resultStreamID).Val())
-	err = firstStore.Complete(
+	_, err = firstStore.Complete(
 		ctx,
 		toolset,
 		toolUseID,
@@ -393,7 +398,7 @@ func TestCallAdmissionAtomicallyPublishesInitialAndOverloadOnce(t *testing.T) {
 	require.ErrorIs(t, err, errCallTerminalConflict)
 	assert.EqualValues(t, 2, testRedisClient.XLen(ctx, pulseStreamKeyPrefix+resultStreamID).Val())
 	replayed, _, err := firstStore.Ensure(
-		ctx, toolset, toolUseID, token, digest, time.Second, 5*time.Second,
+		ctx, toolset, "lookup", toolUseID, token, digest, time.Second, 5*time.Second,
 		outcomeUnknownPayload(token, toolUseID),
 	)
 	require.NoError(t, err)
@@ -401,6 +406,7 @@ func TestCallAdmissionAtomicallyPublishesInitialAndOverloadOnce(t *testing.T) {
 	_, _, err = firstStore.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		toolUseID,
 		strings.Repeat("b", 64),
 		"different-generation-request",
@@ -414,6 +420,7 @@ func TestCallAdmissionAtomicallyPublishesInitialAndOverloadOnce(t *testing.T) {
 	drainingAdmission, _, err := firstStore.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		drainingToolUseID,
 		token,
 		"draining-request-digest",
@@ -506,6 +513,7 @@ func TestUnpublishedCallMovesToReplacementProvider(t *testing.T) {
 	oldAdmission, created, err := store.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		toolUseID,
 		oldRegistration.RegistrationToken,
 		digest,
@@ -554,6 +562,7 @@ func TestUnpublishedCallMovesToReplacementProvider(t *testing.T) {
 	rebound, created, err := store.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		toolUseID,
 		newRegistration.RegistrationToken,
 		digest,
@@ -608,6 +617,7 @@ func TestUnpublishedCallMovesToReplacementProvider(t *testing.T) {
 	replayed, _, err := store.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		toolUseID,
 		oldRegistration.RegistrationToken,
 		digest,
@@ -667,6 +677,7 @@ func TestCallDecisionAtomicallyAdmitsOrRejects(t *testing.T) {
 	_, _, err = secondStore.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		"reject-first",
 		token,
 		digest,
@@ -681,6 +692,7 @@ func TestCallDecisionAtomicallyAdmitsOrRejects(t *testing.T) {
 	admitted, _, err := firstStore.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		"admit-first",
 		token,
 		digest,
@@ -722,6 +734,7 @@ func TestCallDecisionAtomicallyAdmitsOrRejects(t *testing.T) {
 	_, _, err = firstStore.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		fractionalLegacyID,
 		token,
 		digest,
@@ -781,6 +794,7 @@ func TestCallDecisionAtomicallyAdmitsOrRejects(t *testing.T) {
 	_, _, err = firstStore.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		missingOutcomeID,
 		token,
 		digest,
@@ -801,6 +815,7 @@ func TestCallDecisionAtomicallyAdmitsOrRejects(t *testing.T) {
 	_, _, err = firstStore.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		missingTTLID,
 		token,
 		digest,
@@ -822,6 +837,7 @@ func TestCallDecisionAtomicallyAdmitsOrRejects(t *testing.T) {
 	_, _, err = firstStore.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		orphanOverloadID,
 		token,
 		digest,
@@ -844,6 +860,7 @@ func TestCallDecisionAtomicallyAdmitsOrRejects(t *testing.T) {
 	_, _, err = firstStore.Ensure(
 		ctx,
 		toolset,
+		"lookup",
 		orphanDispatchID,
 		token,
 		digest,
@@ -947,6 +964,7 @@ func TestCallDecisionAtomicallyAdmitsOrRejects(t *testing.T) {
 			_, _, err := firstStore.Ensure(
 				ctx,
 				toolset,
+				"lookup",
 				toolUseID,
 				token,
 				digest,
@@ -128,6 +128,83 @@ func TestCatalogDrainFencesRoutingButPreservesSettlementLease(t *testing.T) {
 	assert.Equal(t, entry.HealthEpoch, released.HealthEpoch)
 }
 
+func TestCatalogRenewalKeepsDrainedLeaseDraining(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Unix(1_700_000_000, 0)
+	clock := newTestTimeSource(now)
+	catalog := newToolsetCatalog(newTestCatalogMap(), clock)
+	admission, err := catalog.Register(
+		ctx,
+		testCatalogToolset("test.toolset", "test", nil),
+		testAdmissionRevisionA,
+		"provider",
+		testIncarnationA,
+		time.Minute,
+	)
+	require.NoError(t, err)
+	require.NoError(t, catalog.DrainProvider(
+		ctx,
+		"test.toolset",
+		"provider",
+		testIncarnationA,
+		admission.RegistrationToken,
+		0,
+	))
+	drained, _, err := catalog.healthEntry(ctx, "test.toolset")
+	require.NoError(t, err)
+	lease := drained.ProviderLeases[providerLeaseKey("provider", testIncarnationA)]
+	assert.True(t, lease.Draining)
+	assert.Equal(t, now.Add(time.Minute).UnixMilli(), lease.ExpiresAtUnixMilli, "a zero drain duration keeps the lease expiry")
+
+	clock.Set(now.Add(30 * time.Second))
+	renewed, err := catalog.Register(
+		ctx,
+		testCatalogToolset("test.toolset", "test", nil),
+		testAdmissionRevisionA,
+		"provider",
+		testIncarnationA,
+		time.Minute,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, providerLease{
+		ExpiresAtUnixMilli: now.Add(90 * time.Second).UnixMilli(),
+		Draining:           true,
+	}, renewed.ProviderLeases[providerLeaseKey("provider", testIncarnationA)])
+	assert.Equal(t, drained.HealthEpoch, renewed.HealthEpoch)
+	assert.Zero(t, routableProviderCount(renewed, now.Add(30*time.Second)))
+}
+
+func TestCatalogAdmissionReadsRetiredRecordWithoutPruning(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Unix(1_700_000_000, 0)
+	clock := newTestTimeSource(now)
+	catalog := newToolsetCatalog(newTestCatalogMap(), clock)
+	admission, err := catalog.Register(
+		ctx,
+		testCatalogToolset("test.toolset", "test", nil),
+		testAdmissionRevisionA,
+		"provider",
+		testIncarnationA,
+		time.Minute,
+	)
+	require.NoError(t, err)
+	require.NoError(t, catalog.Retire(ctx, "test.toolset", admission.RegistrationToken))
+	clock.Set(now.Add(2 * time.Minute))
+
+	entry, at, err := catalog.Admission(ctx, "test.toolset")
+	require.NoError(t, err)
+	assert.Equal(t, catalogEntryRetired, entry.State)
+	assert.Equal(t, now.Add(2*time.Minute), at)
+	assert.Contains(t, entry.ProviderLeases, providerLeaseKey("provider", testIncarnationA))
+
+	_, _, err = catalog.Admission(ctx, "missing.toolset")
+	require.ErrorIs(t, err, errToolsetNotFound)
+}
+
 func TestCatalogReleasePrunesExpiredRoutableEpochOnce(t *testing.T) {
 	t.Parallel()
 
@@ -261,6 +338,33 @@ func TestCatalogValidatesEveryPersistedEntry(t *testing.T) {
 			},
 			wantErr: "unknown field",
 		},
+		{
+			name: "toolset routes",
+			content: map[string]string{
+				toolsetCatalogKey("valid.toolset"): validBody,
+				toolsetRoutesKey("valid.toolset"):  `{"routes":{"1.2.0":90,"1.3.0":10}}`,
+			},
+		},
+		{
+			name: "invalid toolset routes",
+			content: map[string]string{
+				toolsetRoutesKey("valid.toolset"): `{"routes":{"v1.2.0":90}}`,
+			},
+			wantErr: "must be canonical",
+		},
+		{
+			name: "toolset versions",
+			content: map[string]string{
+				toolsetVersionsKey("valid.toolset"): `{"versions":["1.2.0","1.3.0"]}`,
+			},
+		},
+		{
+			name: "unordered toolset versions",
+			content: map[string]string{
+				toolsetVersionsKey("valid.toolset"): `{"versions":["1.3.0","1.2.0"]}`,
+			},
+			wantErr: "ascending order",
+		},
 		{
 			name: "unexpected key",
 			content: map[string]string{
@@ -481,6 +585,47 @@ func TestCatalogRetirementAndFreshRevisionReturn(t *testing.T) {
 	assert.Contains(t, a2.RetiredTokens, b.RegistrationToken)
 }
 
+func TestCatalogIndexesSideBySideVersions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	m := newTestCatalogMap()
+	catalog := newToolsetCatalog(m, newTestTimeSource(time.Unix(1_700_000_000, 0)))
+	tokens := make(map[string]string)
+	for i, name := range []string{"data.tools@2.0.0", "data.tools@1.2.0", "data.tools", "data.tools.extra@1.0.0"} {
+		registration, err := catalog.Register(
+			ctx,
+			testCatalogToolset(name, "versioned", nil),
+			fmt.Sprintf("2026-07-23.%d", i+1),
+			"provider-"+name,
+			testIncarnationA,
+			time.Minute,
+		)
+		require.NoError(t, err)
+		tokens[name] = registration.RegistrationToken
+	}
+	// Renewals leave the index unchanged.
+	_, err := catalog.Register(ctx, testCatalogToolset("data.tools@2.0.0", "versioned", nil), "2026-07-23.1", "provider-data.tools@2.0.0", testIncarnationA, time.Minute)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"versions":["1.2.0","2.0.0"]}`, m.content[toolsetVersionsKey("data.tools")])
+
+	entries, err := catalog.ActiveVersions(ctx, "data.tools")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "data.tools@1.2.0", entries[0].Toolset.Name)
+	assert.Equal(t, "data.tools@2.0.0", entries[1].Toolset.Name)
+
+	require.NoError(t, catalog.Retire(ctx, "data.tools@1.2.0", tokens["data.tools@1.2.0"]))
+	entries, err = catalog.ActiveVersions(ctx, "data.tools")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "data.tools@2.0.0", entries[0].Toolset.Name)
+
+	entries, err = catalog.ActiveVersions(ctx, "missing.tools")
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
 func TestCatalogConcurrentCandidatesSerialize(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,60 @@
+// Package cmdenv reads the environment variables shared by the registry
+// commands.
+package cmdenv
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Or returns the value of the environment variable key or defaultVal when it
+// is unset or empty.
+func Or(key, defaultVal string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return defaultVal
+}
+
+// List returns the comma-separated entries of the environment variable key,
+// dropping empty entries.
+func List(key string) []string {
+	var out []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// Duration returns the environment variable key parsed as a duration,
+// defaultVal when it is unset, or an error when it is not a valid duration.
+func Duration(key string, defaultVal time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultVal, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("parse %s: %w", key, err)
+	}
+	return d, nil
+}
+
+// Bool returns the environment variable key parsed as a boolean, defaultVal
+// when it is unset, or an error when it is not a valid boolean.
+func Bool(key string, defaultVal bool) (bool, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultVal, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("parse %s: %w", key, err)
+	}
+	return b, nil
+}
@@ -0,0 +1,137 @@
+// Command registry-admin inspects and administers toolsets of a running
+// registry.
+//
+// It wraps the generated registry gRPC CLI and accepts only the admin
+// commands: list-providers, force-drain-provider, retire-registration,
+// list-tool-calls, inspect-tool-call, list-health-transitions,
+// get-toolset-routes, and set-toolset-routes. Results are printed as indented
+// JSON. When the registry enforces a policy, the caller
+// needs admin rights on the toolset.
+//
+// # Configuration
+//
+// Environment variables:
+//
+//	REGISTRY_ADDR     - Registry gRPC address (default: "localhost:9090")
+//
+// Security (optional):
+//
+//	TLS_CERT_FILE     - Client certificate PEM for mTLS; enables TLS with TLS_KEY_FILE
+//	TLS_KEY_FILE      - Client private key PEM
+//	TLS_CA_FILE       - CA bundle verifying the registry (default: system roots)
+//	REGISTRY_TOKEN    - Bearer token (JWT) presented to the registry; requires TLS
+//
+// # Example
+//
+// List the provider leases of a toolset, then drain one incarnation:
+//
+//	go run ./registry/cmd/registry-admin registry list-providers --message '{"name": "data-tools"}'
+//	go run ./registry/cmd/registry-admin registry force-drain-provider --message '{
+//	    "name": "data-tools",
+//	    "provider_id": "data-7cd8949c8f-k2nrp",
+//	    "provider_incarnation_id": "8af45fe9-5c32-4b46-8da5-d350e98b68f3",
+//	    "registration_token": "..."
+//	}'
+//
+// Send 5% of unpinned calls to a canary version:
+//
+//	go run ./registry/cmd/registry-admin registry set-toolset-routes --message '{
+//	    "name": "data-tools",
+//	    "routes": [{"version": "1.3.0", "weight": 5}, {"version": "1.2.0", "weight": 95}]
+//	}'
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"time"
+
+	"goa.design/goa-ai/registry/cmd/internal/cmdenv"
+	cli "goa.design/goa-ai/registry/gen/grpc/cli/registry"
+	"goa.design/goa-ai/runtime/toolregistry"
+	"google.golang.org/grpc"
+)
+
+// adminCommands lists the generated CLI commands this tool exposes.
+var adminCommands = []string{
+	"list-providers",
+	"force-drain-provider",
+	"retire-registration",
+	"list-tool-calls",
+	"inspect-tool-call",
+	"list-health-transitions",
+	"get-toolset-routes",
+	"set-toolset-routes",
+}
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	timeout := flag.Duration("timeout", 30*time.Second, "Maximum duration of the request")
+	flag.Usage = usage
+
+	// Load configuration from environment.
+	registryAddr := cmdenv.Or("REGISTRY_ADDR", "localhost:9090")
+	dialOpts, err := toolregistry.DialOptions(
+		os.Getenv("TLS_CERT_FILE"),
+		os.Getenv("TLS_KEY_FILE"),
+		os.Getenv("TLS_CA_FILE"),
+		os.Getenv("REGISTRY_TOKEN"),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Connect to the registry.
+	conn, err := grpc.NewClient(registryAddr, dialOpts...)
+	if err != nil {
+		return fmt.Errorf("dial registry: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Printf("close registry connection: %v", err)
+		}
+	}()
+
+	endpoint, payload, err := cli.ParseEndpoint(conn)
+	if err != nil {
+		usage()
+		return err
+	}
+	if command := flag.Arg(1); !slices.Contains(adminCommands, command) {
+		usage()
+		return fmt.Errorf("%q is not an admin command", command)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	res, err := endpoint(ctx, payload)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return nil
+	}
+	out := json.NewEncoder(os.Stdout)
+	out.SetIndent("", "  ")
+	return out.Encode(res)
+}
+
+// usage prints the global flags and the admin commands.
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [-timeout DURATION] registry COMMAND --message JSON\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	for _, command := range adminCommands {
+		fmt.Fprintf(os.Stderr, "    %s\n", command)
+	}
+	fmt.Fprintf(os.Stderr, "\nAdditional help:\n    %s registry COMMAND --help\n", os.Args[0])
+}
@@ -0,0 +1,207 @@
+// Command registry-federation mirrors the toolsets of an upstream registry
+// into a local registry.
+//
+// The worker lists the upstream toolsets every SYNC_INTERVAL, keeps those
+// matching FEDERATION_INCLUDE and not FEDERATION_EXCLUDE, and registers them
+// with the local registry as "<UPSTREAM_NAMESPACE>.<toolset>", tagged
+// "origin:<UPSTREAM_NAME>". Local calls to imported tools are proxied to the
+// upstream registry. When the upstream stays unreachable for longer than
+// CACHE_TTL, the imported toolsets are withdrawn until it recovers. Run one
+// worker per upstream; run several replicas with the same PROVIDER_ID prefix
+// for availability.
+//
+// # Configuration
+//
+// Environment variables:
+//
+//	UPSTREAM_NAME           - Upstream registry name used in origin tags (required)
+//	UPSTREAM_NAMESPACE      - Prefix of imported toolset names (default: UPSTREAM_NAME)
+//	UPSTREAM_ADDR           - Upstream registry gRPC address (required)
+//	UPSTREAM_REDIS_URL      - Upstream registry Redis connection URL (required)
+//	UPSTREAM_REDIS_PASSWORD - Upstream Redis password (optional)
+//	UPSTREAM_TOKEN          - Bearer token (JWT) presented to the upstream; requires TLS
+//	FEDERATION_INCLUDE      - Comma-separated toolset name globs to import (default: all)
+//	FEDERATION_EXCLUDE      - Comma-separated toolset name globs to skip (optional)
+//	SYNC_INTERVAL           - Upstream catalog refresh interval (default: "1m")
+//	CACHE_TTL               - How long imports outlive a failing upstream (default: "1h")
+//	PROVIDER_ID             - Provider identity prefix (default: "$HOSTNAME/federation-$UPSTREAM_NAME")
+//	REGISTRY_ADDR           - Local registry gRPC address (default: "localhost:9090")
+//	REDIS_URL               - Local registry Redis connection URL (default: "localhost:6379")
+//	REDIS_PASSWORD          - Local Redis password (optional)
+//
+// Security (optional, applied to both registries):
+//
+//	TLS_CERT_FILE           - Client certificate PEM for mTLS; enables TLS with TLS_KEY_FILE
+//	TLS_KEY_FILE            - Client private key PEM
+//	TLS_CA_FILE             - CA bundle verifying the registries (default: system roots)
+//	REGISTRY_TOKEN          - Bearer token (JWT) presented to the local registry; requires TLS
+//
+// # Example
+//
+// Mirror the data toolsets of the "east" registry:
+//
+//	UPSTREAM_NAME=east UPSTREAM_ADDR=registry.east:9090 \
+//	UPSTREAM_REDIS_URL=redis.east:6379 FEDERATION_INCLUDE='data-*' \
+//	  go run ./registry/cmd/registry-federation
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	pulsec "goa.design/goa-ai/features/stream/pulse/clients/pulse"
+	"goa.design/goa-ai/registry/cmd/internal/cmdenv"
+	"goa.design/goa-ai/registry/federation"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	runtimeregistry "goa.design/goa-ai/runtime/registry"
+	"goa.design/goa-ai/runtime/toolregistry"
+	"goa.design/goa-ai/runtime/toolregistry/executor"
+	"goa.design/goa-ai/runtime/toolregistry/provider"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Load configuration from environment.
+	upstreamName := os.Getenv("UPSTREAM_NAME")
+	if upstreamName == "" {
+		return errors.New("UPSTREAM_NAME is required")
+	}
+	upstreamAddr := os.Getenv("UPSTREAM_ADDR")
+	if upstreamAddr == "" {
+		return errors.New("UPSTREAM_ADDR is required")
+	}
+	upstreamRedis := os.Getenv("UPSTREAM_REDIS_URL")
+	if upstreamRedis == "" {
+		return errors.New("UPSTREAM_REDIS_URL is required")
+	}
+	providerID := os.Getenv("PROVIDER_ID")
+	if providerID == "" {
+		host, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("PROVIDER_ID is unset and hostname is unavailable: %w", err)
+		}
+		providerID = host + "/federation-" + upstreamName
+	}
+	syncInterval, err := cmdenv.Duration("SYNC_INTERVAL", time.Minute)
+	if err != nil {
+		return err
+	}
+	cacheTTL, err := cmdenv.Duration("CACHE_TTL", time.Hour)
+	if err != nil {
+		return err
+	}
+	registryAddr := cmdenv.Or("REGISTRY_ADDR", "localhost:9090")
+
+	// Connect to the local and upstream registries.
+	local, closeLocal, err := connectRegistry(registryAddr, os.Getenv("REGISTRY_TOKEN"))
+	if err != nil {
+		return err
+	}
+	defer closeLocal()
+	upstream, closeUpstream, err := connectRegistry(upstreamAddr, os.Getenv("UPSTREAM_TOKEN"))
+	if err != nil {
+		return err
+	}
+	defer closeUpstream()
+
+	// Connect to Redis for the local toolset streams and the upstream
+	// result streams.
+	localPulse, closeLocalPulse, err := connectPulse(ctx, cmdenv.Or("REDIS_URL", "localhost:6379"), os.Getenv("REDIS_PASSWORD"))
+	if err != nil {
+		return err
+	}
+	defer closeLocalPulse()
+	upstreamPulse, closeUpstreamPulse, err := connectPulse(ctx, upstreamRedis, os.Getenv("UPSTREAM_REDIS_PASSWORD"))
+	if err != nil {
+		return err
+	}
+	defer closeUpstreamPulse()
+
+	worker, err := federation.New(localPulse, federation.Options{
+		Registry: local,
+		Provider: provider.Options{ProviderID: providerID},
+	}, federation.Upstream{
+		Name:      upstreamName,
+		Namespace: os.Getenv("UPSTREAM_NAMESPACE"),
+		Catalog:   upstream,
+		Client:    executor.NewRegistryClient(upstream),
+		Pulse:     upstreamPulse,
+		Config: runtimeregistry.RegistryConfig{
+			SyncInterval: syncInterval,
+			CacheTTL:     cacheTTL,
+			Federation: &runtimeregistry.FederationConfig{
+				Include: cmdenv.List("FEDERATION_INCLUDE"),
+				Exclude: cmdenv.List("FEDERATION_EXCLUDE"),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("mirroring registry %s (%s) into %s (provider=%s)", upstreamName, upstreamAddr, registryAddr, providerID)
+	return worker.Run(ctx)
+}
+
+// connectRegistry dials the registry at addr and returns its client and a
+// function closing the connection.
+func connectRegistry(addr, token string) (*genregistry.Client, func(), error) {
+	dialOpts, err := toolregistry.DialOptions(
+		os.Getenv("TLS_CERT_FILE"),
+		os.Getenv("TLS_KEY_FILE"),
+		os.Getenv("TLS_CA_FILE"),
+		token,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.NewClient(addr, dialOpts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial registry %s: %w", addr, err)
+	}
+	client := toolregistry.NewClient(conn)
+	return client, func() {
+		if err := conn.Close(); err != nil {
+			log.Printf("close registry connection %s: %v", addr, err)
+		}
+	}, nil
+}
+
+// connectPulse connects to the registry Redis at addr and returns a Pulse
+// client and a function closing the connection.
+func connectPulse(ctx context.Context, addr, password string) (pulsec.Client, func(), error) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: password,
+	})
+	closeRedis := func() {
+		if err := rdb.Close(); err != nil {
+			log.Printf("close redis %s: %v", addr, err)
+		}
+	}
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		closeRedis()
+		return nil, nil, fmt.Errorf("connect to redis %s: %w", addr, err)
+	}
+	pulse, err := pulsec.New(pulsec.Options{Redis: rdb})
+	if err != nil {
+		closeRedis()
+		return nil, nil, fmt.Errorf("create pulse client: %w", err)
+	}
+	return pulse, closeRedis, nil
+}
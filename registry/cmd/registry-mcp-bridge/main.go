@@ -0,0 +1,135 @@
+// Command registry-mcp-bridge serves registry toolsets as an MCP server.
+//
+// MCP clients list the tools of the selected toolsets and call them; calls are
+// routed through the registry gateway and tool output deltas are reported as
+// MCP progress notifications. The bridge reads tool results from the registry
+// result streams, so it needs the registry's Redis as well as its gRPC
+// endpoint.
+//
+// # Configuration
+//
+// Environment variables:
+//
+//	REGISTRY_ADDR     - Registry gRPC address (default: "localhost:9090")
+//	REDIS_URL         - Registry Redis connection URL (default: "localhost:6379")
+//	REDIS_PASSWORD    - Redis password (optional)
+//	BRIDGE_TOOLSETS   - Comma-separated toolsets or toolset references to serve
+//	                    (default: every toolset matching BRIDGE_TAGS)
+//	BRIDGE_TAGS       - Comma-separated tags filtering toolsets when
+//	                    BRIDGE_TOOLSETS is empty (optional)
+//	BRIDGE_HTTP_ADDR  - Serve MCP over streamable HTTP at /mcp on this address
+//	                    instead of stdio (optional)
+//
+// Security (optional):
+//
+//	TLS_CERT_FILE     - Client certificate PEM for mTLS; enables TLS with TLS_KEY_FILE
+//	TLS_KEY_FILE      - Client private key PEM
+//	TLS_CA_FILE       - CA bundle verifying the registry (default: system roots)
+//	REGISTRY_TOKEN    - Bearer token (JWT) presented to the registry; requires TLS
+//
+// # Example
+//
+// Serve two toolsets to a local MCP client over stdio:
+//
+//	BRIDGE_TOOLSETS=docs,data-tools@^1.2 go run ./registry/cmd/registry-mcp-bridge
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	pulsec "goa.design/goa-ai/features/stream/pulse/clients/pulse"
+	"goa.design/goa-ai/registry/cmd/internal/cmdenv"
+	"goa.design/goa-ai/registry/mcpbridge"
+	"goa.design/goa-ai/runtime/toolregistry"
+	"goa.design/goa-ai/runtime/toolregistry/executor"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Load configuration from environment.
+	registryAddr := cmdenv.Or("REGISTRY_ADDR", "localhost:9090")
+	redisURL := cmdenv.Or("REDIS_URL", "localhost:6379")
+	redisPassword := os.Getenv("REDIS_PASSWORD")
+	httpAddr := os.Getenv("BRIDGE_HTTP_ADDR")
+	dialOpts, err := toolregistry.DialOptions(
+		os.Getenv("TLS_CERT_FILE"),
+		os.Getenv("TLS_KEY_FILE"),
+		os.Getenv("TLS_CA_FILE"),
+		os.Getenv("REGISTRY_TOKEN"),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Connect to the registry.
+	conn, err := grpc.NewClient(registryAddr, dialOpts...)
+	if err != nil {
+		return fmt.Errorf("dial registry: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Printf("close registry connection: %v", err)
+		}
+	}()
+	client := toolregistry.NewClient(conn)
+
+	// Connect to Redis for the result streams.
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     redisURL,
+		Password: redisPassword,
+	})
+	defer func() {
+		if err := rdb.Close(); err != nil {
+			log.Printf("close redis: %v", err)
+		}
+	}()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("connect to redis: %w", err)
+	}
+	pulse, err := pulsec.New(pulsec.Options{Redis: rdb})
+	if err != nil {
+		return fmt.Errorf("create pulse client: %w", err)
+	}
+
+	bridge := mcpbridge.New(client, executor.NewRegistryClient(client), pulse, mcpbridge.Options{
+		Toolsets: cmdenv.List("BRIDGE_TOOLSETS"),
+		Tags:     cmdenv.List("BRIDGE_TAGS"),
+	})
+
+	if httpAddr == "" {
+		log.Printf("serving registry MCP bridge on stdio (registry=%s)", registryAddr)
+		return bridge.ServeStdio(ctx, os.Stdin, os.Stdout)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/mcp", bridge)
+	srv := &http.Server{Addr: httpAddr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf("shutdown http server: %v", err)
+		}
+	}()
+	log.Printf("serving registry MCP bridge on %s/mcp (registry=%s)", httpAddr, registryAddr)
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("serve http: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+// Command registry-mcp-provider registers an MCP server as a registry
+// provider.
+//
+// The provider connects to the MCP server over stdio or HTTP, imports its
+// tools/list schemas into a registry toolset, answers registry health pings,
+// and forwards claimed tool calls to the server. Run one provider per MCP
+// server and toolset; run several replicas with the same TOOLSET for
+// availability.
+//
+// # Configuration
+//
+// Environment variables:
+//
+//	TOOLSET              - Registry toolset name for the MCP server's tools (required)
+//	TOOLSET_DESCRIPTION  - Toolset description shown in the catalog (optional)
+//	TOOLSET_TAGS         - Comma-separated catalog tags (optional)
+//	MCP_COMMAND          - MCP server command, served over stdio
+//	MCP_ARGS             - Space-separated MCP server arguments (optional)
+//	MCP_URL              - MCP server JSON-RPC endpoint, used when MCP_COMMAND is empty
+//	MCP_TRANSPORT        - "http" (default) or "sse" for MCP_URL
+//	PROVIDER_ID          - Stable provider identity (default: "$HOSTNAME/$TOOLSET")
+//	ADMISSION_REVISION   - Admission revision (default: derived from the imported schemas)
+//	MAX_CONCURRENT_TOOL_CALLS - Concurrent calls forwarded to the server (default: provider default)
+//	REGISTRY_ADDR        - Registry gRPC address (default: "localhost:9090")
+//	REDIS_URL            - Registry Redis connection URL (default: "localhost:6379")
+//	REDIS_PASSWORD       - Redis password (optional)
+//
+// Security (optional):
+//
+//	TLS_CERT_FILE        - Client certificate PEM for mTLS; enables TLS with TLS_KEY_FILE
+//	TLS_KEY_FILE         - Client private key PEM
+//	TLS_CA_FILE          - CA bundle verifying the registry (default: system roots)
+//	REGISTRY_TOKEN       - Bearer token (JWT) presented to the registry; requires TLS
+//
+// # Example
+//
+// Register the reference filesystem MCP server as toolset "files":
+//
+//	TOOLSET=files MCP_COMMAND=npx \
+//	MCP_ARGS="-y @modelcontextprotocol/server-filesystem /srv/data" \
+//	  go run ./registry/cmd/registry-mcp-provider
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/redis/go-redis/v9"
+	pulsec "goa.design/goa-ai/features/stream/pulse/clients/pulse"
+	"goa.design/goa-ai/registry/cmd/internal/cmdenv"
+	"goa.design/goa-ai/registry/mcpprovider"
+	"goa.design/goa-ai/runtime/mcp"
+	"goa.design/goa-ai/runtime/toolregistry"
+	"goa.design/goa-ai/runtime/toolregistry/provider"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Load configuration from environment.
+	toolset := os.Getenv("TOOLSET")
+	if toolset == "" {
+		return errors.New("TOOLSET is required")
+	}
+	providerID := os.Getenv("PROVIDER_ID")
+	if providerID == "" {
+		host, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("PROVIDER_ID is unset and hostname is unavailable: %w", err)
+		}
+		providerID = host + "/" + toolset
+	}
+	maxConcurrent := 0
+	if v := os.Getenv("MAX_CONCURRENT_TOOL_CALLS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse MAX_CONCURRENT_TOOL_CALLS: %w", err)
+		}
+		maxConcurrent = n
+	}
+	registryAddr := cmdenv.Or("REGISTRY_ADDR", "localhost:9090")
+	dialOpts, err := toolregistry.DialOptions(
+		os.Getenv("TLS_CERT_FILE"),
+		os.Getenv("TLS_KEY_FILE"),
+		os.Getenv("TLS_CA_FILE"),
+		os.Getenv("REGISTRY_TOKEN"),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Connect to the MCP server.
+	server, err := connectMCP(ctx)
+	if err != nil {
+		return err
+	}
+	if closer, ok := server.(io.Closer); ok {
+		defer func() {
+			if err := closer.Close(); err != nil {
+				log.Printf("close mcp server: %v", err)
+			}
+		}()
+	}
+
+	// Connect to the registry.
+	conn, err := grpc.NewClient(registryAddr, dialOpts...)
+	if err != nil {
+		return fmt.Errorf("dial registry: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Printf("close registry connection: %v", err)
+		}
+	}()
+	client := toolregistry.NewClient(conn)
+
+	// Connect to Redis for the toolset request stream.
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     cmdenv.Or("REDIS_URL", "localhost:6379"),
+		Password: os.Getenv("REDIS_PASSWORD"),
+	})
+	defer func() {
+		if err := rdb.Close(); err != nil {
+			log.Printf("close redis: %v", err)
+		}
+	}()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("connect to redis: %w", err)
+	}
+	pulse, err := pulsec.New(pulsec.Options{Redis: rdb})
+	if err != nil {
+		return fmt.Errorf("create pulse client: %w", err)
+	}
+
+	log.Printf("serving MCP tools as toolset %s (provider=%s, registry=%s)", toolset, providerID, registryAddr)
+	err = mcpprovider.Serve(ctx, pulse, client, server, mcpprovider.Options{
+		Toolset:           toolset,
+		Description:       os.Getenv("TOOLSET_DESCRIPTION"),
+		Tags:              cmdenv.List("TOOLSET_TAGS"),
+		AdmissionRevision: os.Getenv("ADMISSION_REVISION"),
+		Provider: provider.Options{
+			ProviderID:             providerID,
+			MaxConcurrentToolCalls: maxConcurrent,
+		},
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("serve provider: %w", err)
+	}
+	return nil
+}
+
+// connectMCP starts or connects to the configured MCP server.
+func connectMCP(ctx context.Context) (mcpprovider.Server, error) {
+	if command := os.Getenv("MCP_COMMAND"); command != "" {
+		caller, err := mcp.NewStdioCaller(ctx, mcp.StdioOptions{
+			Command:    command,
+			Args:       strings.Fields(os.Getenv("MCP_ARGS")),
+			ClientName: "registry-mcp-provider",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("start mcp server: %w", err)
+		}
+		return caller, nil
+	}
+	endpoint := os.Getenv("MCP_URL")
+	if endpoint == "" {
+		return nil, errors.New("MCP_COMMAND or MCP_URL is required")
+	}
+	opts := mcp.HTTPOptions{Endpoint: endpoint, ClientName: "registry-mcp-provider"}
+	switch transport := cmdenv.Or("MCP_TRANSPORT", "http"); transport {
+	case "http":
+		caller, err := mcp.NewHTTPCaller(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("connect mcp server: %w", err)
+		}
+		return caller, nil
+	case "sse":
+		caller, err := mcp.NewSSECaller(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("connect mcp server: %w", err)
+		}
+		return caller, nil
+	default:
+		return nil, fmt.Errorf("MCP_TRANSPORT must be http or sse, got %q", transport)
+	}
+}
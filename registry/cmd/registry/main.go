@@ -1,4 +1,5 @@
-// Command registry runs the internal tool registry gRPC server.
+// Command registry runs the internal tool registry gRPC server and, when
+// REGISTRY_HTTP_ADDR is set, its HTTP/JSON transport.
 //
 // The registry acts as both a catalog and gateway — agents discover toolsets
 // through the registry and invoke tools through it.
@@ -14,6 +15,7 @@
 // Environment variables:
 //
 //	REGISTRY_ADDR          - gRPC listen address (default: ":9090")
+//	REGISTRY_HTTP_ADDR     - HTTP/JSON listen address (optional)
 //	REGISTRY_NAME          - Registry cluster name (default: "registry")
 //	REDIS_URL              - Redis connection URL (default: "localhost:6379")
 //	REDIS_PASSWORD         - Redis password (optional)
@@ -22,6 +24,28 @@
 //	TOOL_EXECUTION_TIMEOUT - Maximum tool execution duration (default: registry default)
 //	RESULT_STREAM_TTL      - Tool result retention duration (default: registry default)
 //	PROVIDER_LEASE_DURATION - Provider lease duration (default: registry default)
+//	METRICS_ADDR           - Prometheus /metrics listen address (optional)
+//	QUOTA_FILE             - YAML/JSON per-caller call quotas (optional)
+//	AUDIT_LOG_FILE         - Append audit events as JSON lines to this file (optional)
+//	AUDIT_STREAM           - Publish audit events on this Pulse stream (optional)
+//	AUDIT_MONGO_URI        - Store audit events in this MongoDB deployment (optional)
+//	AUDIT_MONGO_DATABASE   - MongoDB database for audit events (default: "registry")
+//	AUDIT_MONGO_COLLECTION - MongoDB collection for audit events (default: "registry_audit_events")
+//	AUDIT_MONGO_RETENTION  - Expire stored audit events after this duration (default: keep forever)
+//	AUDIT_ASYNC            - Record audit events in the background and drop them on failure (default: false)
+//	AUDIT_BUFFER           - Audit events queued while sinks catch up when AUDIT_ASYNC is set (default: 1024)
+//
+// Security (all optional; without AUTH_POLICY_FILE any peer may call any method):
+//
+//	TLS_CERT_FILE          - Server certificate PEM; enables TLS with TLS_KEY_FILE
+//	TLS_KEY_FILE           - Server private key PEM
+//	TLS_CLIENT_CA_FILE     - CA bundle verifying client certificates (mTLS callers)
+//	JWT_KEY_FILE           - JWT verification key: PEM public key or HMAC secret
+//	JWT_ISSUER             - Required JWT "iss" claim (optional)
+//	JWT_AUDIENCE           - Required JWT "aud" entry (optional)
+//	AUTH_POLICY_FILE       - YAML/JSON caller policy; enables authentication
+//	                         and authorization and requires TLS plus
+//	                         TLS_CLIENT_CA_FILE and/or JWT_KEY_FILE
 //
 // # Example
 //
@@ -37,16 +61,33 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
 	"os"
 	"strconv"
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	mongodriver "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	pulsec "goa.design/goa-ai/features/stream/pulse/clients/pulse"
 	"goa.design/goa-ai/registry"
+	"goa.design/goa-ai/registry/audit"
+	auditmongo "goa.design/goa-ai/registry/audit/mongo"
+	"goa.design/goa-ai/registry/cmd/internal/cmdenv"
+	"goa.design/goa-ai/runtime/agent/telemetry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
+// httpShutdownTimeout bounds how long the HTTP transport waits for in-flight
+// requests when the registry stops.
+const httpShutdownTimeout = 30 * time.Second
+
 func main() {
 	if err := run(); err != nil {
 		log.Fatal(err)
@@ -54,21 +95,39 @@ func main() {
 }
 
 func run() error {
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	// Load configuration from environment.
-	addr := envOr("REGISTRY_ADDR", ":9090")
-	name := envOr("REGISTRY_NAME", "registry")
-	redisURL := envOr("REDIS_URL", "localhost:6379")
+	addr := cmdenv.Or("REGISTRY_ADDR", ":9090")
+	name := cmdenv.Or("REGISTRY_NAME", "registry")
+	redisURL := cmdenv.Or("REDIS_URL", "localhost:6379")
 	redisPassword := os.Getenv("REDIS_PASSWORD")
 	pingInterval := envDurationOr("PING_INTERVAL", 10*time.Second)
 	missedPingThreshold := envIntOr("MISSED_PING_THRESHOLD", 3)
-	executionTimeout, err := envDurationOrError("TOOL_EXECUTION_TIMEOUT", 0)
+	executionTimeout, err := cmdenv.Duration("TOOL_EXECUTION_TIMEOUT", 0)
 	if err != nil {
 		return err
 	}
 	resultStreamTTL := envDurationOr("RESULT_STREAM_TTL", 0)
 	providerLeaseDuration := envDurationOr("PROVIDER_LEASE_DURATION", 0)
+	httpAddr := os.Getenv("REGISTRY_HTTP_ADDR")
+	metricsAddr := os.Getenv("METRICS_ADDR")
+	auth, tlsCfg, err := loadSecurity()
+	if err != nil {
+		return err
+	}
+	var serverOpts []grpc.ServerOption
+	if tlsCfg != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
+	var quotas *registry.Quotas
+	if quotaFile := os.Getenv("QUOTA_FILE"); quotaFile != "" {
+		quotas, err = registry.LoadQuotas(quotaFile)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Connect to Redis.
 	rdb := redis.NewClient(&redis.Options{
@@ -86,6 +145,36 @@ func run() error {
 		return fmt.Errorf("connect to redis: %w", err)
 	}
 
+	// Record audit events when requested.
+	sink, closeAudit, err := loadAudit(ctx, rdb)
+	if err != nil {
+		return err
+	}
+	defer closeAudit()
+
+	// Serve Prometheus metrics when requested. The scrape listener is bound
+	// up front so a bind failure stops the process like the gRPC listener
+	// does, and the metrics server shuts down with the registry.
+	var metrics telemetry.Metrics
+	metricsErr := make(chan error, 1)
+	if metricsAddr != "" {
+		ln, err := net.Listen("tcp", metricsAddr)
+		if err != nil {
+			return fmt.Errorf("listen metrics: %w", err)
+		}
+		prom := telemetry.NewPrometheusMetrics()
+		metrics = prom
+		go func() {
+			err := serveMetrics(ctx, ln, prom)
+			if err != nil {
+				cancel()
+			}
+			metricsErr <- err
+		}()
+	} else {
+		metricsErr <- nil
+	}
+
 	// Create the registry.
 	reg, err := registry.New(ctx, registry.Config{
 		Redis:                 rdb,
@@ -95,26 +184,264 @@ func run() error {
 		ExecutionTimeout:      executionTimeout,
 		ResultStreamTTL:       resultStreamTTL,
 		ProviderLeaseDuration: providerLeaseDuration,
+		Metrics:               metrics,
+		Auth:                  auth,
+		Quotas:                quotas,
+		Audit:                 sink,
 	})
 	if err != nil {
 		return fmt.Errorf("create registry: %w", err)
 	}
 
+	// Serve the HTTP/JSON transport when requested. A failing HTTP listener
+	// stops the gRPC server, and the HTTP server shuts down with it.
+	httpErr := make(chan error, 1)
+	if httpAddr != "" {
+		go func() {
+			err := serveHTTP(ctx, httpAddr, reg.HTTPHandler(), tlsCfg)
+			if err != nil {
+				cancel()
+			}
+			httpErr <- err
+		}()
+	} else {
+		httpErr <- nil
+	}
+
 	// Run the registry server.
 	log.Printf("starting registry on %s (name=%s)", addr, name)
-	if err := reg.Run(ctx, addr); err != nil {
-		return fmt.Errorf("run registry: %w", err)
+	var runErr error
+	if err := reg.Run(ctx, addr, serverOpts...); err != nil {
+		runErr = fmt.Errorf("run registry: %w", err)
 	}
+	cancel()
+	return errors.Join(<-httpErr, <-metricsErr, runErr)
+}
 
-	return nil
+// loadAudit builds the audit sink selected by AUDIT_LOG_FILE, AUDIT_STREAM,
+// and AUDIT_MONGO_URI. Events are recorded on the request, which fails when
+// they cannot be stored. Setting AUDIT_ASYNC opts into recording through a
+// bounded buffer of AUDIT_BUFFER events instead, trading dropped events for
+// latency. It returns a nil sink when no backend is set, and a function
+// draining the buffer and closing the backends.
+func loadAudit(ctx context.Context, rdb *redis.Client) (audit.Sink, func(), error) {
+	var (
+		sinks   []audit.Sink
+		closers []func()
+	)
+	closeAll := func() {
+		for i := len(closers) - 1; i >= 0; i-- {
+			closers[i]()
+		}
+	}
+	if path := os.Getenv("AUDIT_LOG_FILE"); path != "" {
+		file, err := audit.OpenJSONLFile(path)
+		if err != nil {
+			return nil, closeAll, err
+		}
+		sinks = append(sinks, file)
+		closers = append(closers, func() {
+			if err := file.Close(); err != nil {
+				log.Printf("close audit log: %v", err)
+			}
+		})
+	}
+	if stream := os.Getenv("AUDIT_STREAM"); stream != "" {
+		pulse, err := pulsec.New(pulsec.Options{Redis: rdb})
+		if err != nil {
+			return nil, closeAll, fmt.Errorf("create audit pulse client: %w", err)
+		}
+		pulseSink, err := audit.NewPulseSink(pulse, stream)
+		if err != nil {
+			return nil, closeAll, err
+		}
+		sinks = append(sinks, pulseSink)
+	}
+	if uri := os.Getenv("AUDIT_MONGO_URI"); uri != "" {
+		mongoSink, disconnect, err := loadMongoAudit(ctx, uri)
+		if err != nil {
+			return nil, closeAll, err
+		}
+		sinks = append(sinks, mongoSink)
+		closers = append(closers, disconnect)
+	}
+	async, err := cmdenv.Bool("AUDIT_ASYNC", false)
+	if err != nil {
+		return nil, closeAll, err
+	}
+	var sink audit.Sink
+	switch len(sinks) {
+	case 0:
+		return nil, closeAll, nil
+	case 1:
+		sink = sinks[0]
+	default:
+		sink = audit.Multi(sinks...)
+	}
+	if !async {
+		return sink, closeAll, nil
+	}
+	buffered := audit.NewAsyncSink(sink, envIntOr("AUDIT_BUFFER", audit.DefaultAsyncBuffer), func(e audit.Event, err error) {
+		log.Printf("drop audit event %s for toolset %q: %v", e.Type, e.Toolset, err)
+	})
+	// Drain queued events before the backends close.
+	closers = append(closers, func() {
+		if err := buffered.Close(); err != nil {
+			log.Printf("drain audit events: %v", err)
+		}
+	})
+	return buffered, closeAll, nil
 }
 
-// envOr returns the environment variable value or a default.
-func envOr(key, defaultVal string) string {
-	if v := os.Getenv(key); v != "" {
-		return v
+// loadMongoAudit connects to the MongoDB deployment at uri and returns the
+// audit sink configured by AUDIT_MONGO_DATABASE, AUDIT_MONGO_COLLECTION, and
+// AUDIT_MONGO_RETENTION with a function disconnecting the client.
+func loadMongoAudit(ctx context.Context, uri string) (audit.Sink, func(), error) {
+	retention, err := cmdenv.Duration("AUDIT_MONGO_RETENTION", 0)
+	if err != nil {
+		return nil, nil, err
 	}
-	return defaultVal
+	mc, err := mongodriver.Connect(options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to audit mongo: %w", err)
+	}
+	disconnect := func() {
+		if err := mc.Disconnect(context.Background()); err != nil {
+			log.Printf("disconnect audit mongo: %v", err)
+		}
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := mc.Ping(pingCtx, nil); err != nil {
+		disconnect()
+		return nil, nil, fmt.Errorf("ping audit mongo: %w", err)
+	}
+	sink, err := auditmongo.New(auditmongo.Options{
+		Client:     mc,
+		Database:   cmdenv.Or("AUDIT_MONGO_DATABASE", "registry"),
+		Collection: os.Getenv("AUDIT_MONGO_COLLECTION"),
+		Retention:  retention,
+	})
+	if err != nil {
+		disconnect()
+		return nil, nil, fmt.Errorf("create audit mongo sink: %w", err)
+	}
+	return sink, disconnect, nil
+}
+
+// loadSecurity builds the registry auth configuration and the server TLS
+// configuration shared by the gRPC and HTTP transports from the environment.
+func loadSecurity() (*registry.AuthConfig, *tls.Config, error) {
+	certFile := os.Getenv("TLS_CERT_FILE")
+	keyFile := os.Getenv("TLS_KEY_FILE")
+	clientCAFile := os.Getenv("TLS_CLIENT_CA_FILE")
+	jwtKeyFile := os.Getenv("JWT_KEY_FILE")
+	policyFile := os.Getenv("AUTH_POLICY_FILE")
+
+	var tlsCfg *tls.Config
+	if certFile != "" || keyFile != "" {
+		var err error
+		tlsCfg, err = registry.ServerTLSConfig(certFile, keyFile, clientCAFile)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else if clientCAFile != "" {
+		return nil, nil, fmt.Errorf("TLS_CLIENT_CA_FILE requires TLS_CERT_FILE and TLS_KEY_FILE")
+	}
+	if policyFile == "" {
+		if jwtKeyFile != "" {
+			return nil, nil, fmt.Errorf("JWT_KEY_FILE requires AUTH_POLICY_FILE")
+		}
+		return nil, tlsCfg, nil
+	}
+	if tlsCfg == nil {
+		return nil, nil, fmt.Errorf("AUTH_POLICY_FILE requires TLS_CERT_FILE and TLS_KEY_FILE")
+	}
+
+	var authenticators []registry.Authenticator
+	if clientCAFile != "" {
+		authenticators = append(authenticators, registry.MTLSAuthenticator{})
+	}
+	if jwtKeyFile != "" {
+		key, err := registry.LoadJWTKey(jwtKeyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		authenticators = append(authenticators, &registry.JWTAuthenticator{
+			Keys:     map[string]any{"": key},
+			Issuer:   os.Getenv("JWT_ISSUER"),
+			Audience: os.Getenv("JWT_AUDIENCE"),
+			Leeway:   30 * time.Second,
+		})
+	}
+	if len(authenticators) == 0 {
+		return nil, nil, fmt.Errorf("AUTH_POLICY_FILE requires TLS_CLIENT_CA_FILE and/or JWT_KEY_FILE")
+	}
+	policy, err := registry.LoadPolicy(policyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &registry.AuthConfig{
+		Authenticator: registry.AnyAuthenticator(authenticators...),
+		Policy:        policy,
+	}, tlsCfg, nil
+}
+
+// serveMetrics serves the Prometheus scrape endpoint at /metrics on ln until
+// ctx is canceled, then shuts the server down gracefully.
+func serveMetrics(ctx context.Context, ln net.Listener, handler http.Handler) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", handler)
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	errCh := make(chan error, 1)
+	go func() {
+		log.Printf("serving metrics on %s/metrics", ln.Addr())
+		errCh <- srv.Serve(ln)
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("serve metrics: %w", err)
+	case <-ctx.Done():
+	}
+	return shutdownHTTP(ctx, srv, "metrics")
+}
+
+// serveHTTP serves the registry HTTP/JSON transport, over TLS when tlsCfg is
+// set, until ctx is canceled. It then shuts the server down gracefully,
+// closing connections still open after httpShutdownTimeout.
+func serveHTTP(ctx context.Context, addr string, handler http.Handler, tlsCfg *tls.Config) error {
+	srv := &http.Server{Addr: addr, Handler: handler, TLSConfig: tlsCfg, ReadHeaderTimeout: 5 * time.Second}
+	errCh := make(chan error, 1)
+	go func() {
+		log.Printf("serving registry HTTP on %s", addr)
+		if tlsCfg != nil {
+			errCh <- srv.ListenAndServeTLS("", "")
+			return
+		}
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("serve registry HTTP: %w", err)
+	case <-ctx.Done():
+	}
+	return shutdownHTTP(ctx, srv, "registry HTTP")
+}
+
+// shutdownHTTP shuts srv down gracefully, closing connections still open
+// after httpShutdownTimeout. name identifies the server in errors.
+func shutdownHTTP(ctx context.Context, srv *http.Server, name string) error {
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), httpShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		// Long-lived event streams may outlive the grace period.
+		if cerr := srv.Close(); cerr != nil {
+			return fmt.Errorf("close %s: %w", name, cerr)
+		}
+	}
+	return nil
 }
 
 // envIntOr returns the environment variable as int or a default.
@@ -136,17 +463,3 @@ func envDurationOr(key string, defaultVal time.Duration) time.Duration {
 	}
 	return defaultVal
 }
-
-// envDurationOrError returns the configured duration, the default when unset,
-// or an error when the process environment contains an invalid duration.
-func envDurationOrError(key string, defaultVal time.Duration) (time.Duration, error) {
-	value := os.Getenv(key)
-	if value == "" {
-		return defaultVal, nil
-	}
-	duration, err := time.ParseDuration(value)
-	if err != nil {
-		return 0, fmt.Errorf("parse %s: %w", key, err)
-	}
-	return duration, nil
-}
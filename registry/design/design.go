@@ -16,10 +16,18 @@ var _ = API("registry", func() {
 	Server("registry", func() {
 		Host("dev", func() {
 			URI("grpc://localhost:9090")
+			URI("http://localhost:8080")
 		})
 		Services("registry")
 	})
 
+	// Credentials travel on the transport (mTLS client certificates or an
+	// "authorization: Bearer <JWT>" gRPC metadata entry or HTTP header) rather
+	// than in payload attributes, so no Security scheme is declared here. The
+	// registry server authenticates each request from its transport
+	// credentials and authorizes each method against its policy; see
+	// registry.Config.Auth.
+
 	// Error definitions
 	Error("not_found", ErrorResult, "Toolset or tool not found")
 	Error("validation_error", ErrorResult, "Payload validation failed")
@@ -28,6 +36,9 @@ var _ = API("registry", func() {
 	Error("admission_blocked", ErrorResult, "Another admission still has active provider leases")
 	Error("admission_retired", ErrorResult, "The requested admission was intentionally retired")
 	Error("admission_conflict", ErrorResult, "The expected admission token does not match the catalog record")
+	Error("unauthenticated", ErrorResult, "The request carries no valid mTLS client certificate or bearer token")
+	Error("permission_denied", ErrorResult, "The authenticated caller is not authorized for the requested toolset or tool")
+	Error("quota_exceeded", ErrorResult, "The caller exhausted its call quota for the toolset; retry after the quota refills")
 
 	// gRPC transport configuration
 	GRPC(func() {
@@ -38,6 +49,23 @@ var _ = API("registry", func() {
 		Response("admission_blocked", CodeUnavailable)
 		Response("admission_retired", CodeFailedPrecondition)
 		Response("admission_conflict", CodeFailedPrecondition)
+		Response("unauthenticated", CodeUnauthenticated)
+		Response("permission_denied", CodePermissionDenied)
+		Response("quota_exceeded", CodeResourceExhausted)
+	})
+
+	// HTTP transport configuration
+	HTTP(func() {
+		Response("not_found", StatusNotFound)
+		Response("validation_error", StatusBadRequest)
+		Response("service_unavailable", StatusServiceUnavailable)
+		Response("call_not_admitted", StatusConflict)
+		Response("admission_blocked", StatusServiceUnavailable)
+		Response("admission_retired", StatusGone)
+		Response("admission_conflict", StatusConflict)
+		Response("unauthenticated", StatusUnauthorized)
+		Response("permission_denied", StatusForbidden)
+		Response("quota_exceeded", StatusTooManyRequests)
 	})
 })
 
@@ -50,6 +78,13 @@ var _ = Service("registry", func() {
 		Package("goa_ai_registry")
 	})
 
+	// The HTTP/JSON transport exposes discovery and invocation to browsers,
+	// scripts, and non-Go runtimes. Provider and admin operations remain
+	// gRPC-only.
+	HTTP(func() {
+		Path("/v1")
+	})
+
 	// ---- Provider Operations ----
 
 	Method("Register", func() {
@@ -60,6 +95,8 @@ var _ = Service("registry", func() {
 		Error("admission_retired")
 		Error("validation_error")
 		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
 	})
 
@@ -67,6 +104,8 @@ var _ = Service("registry", func() {
 		Description("Release one exact provider-incarnation lease from the admission token after that Serve lifecycle has stopped claiming work and settled in-flight calls. Missing incarnations and stale tokens succeed without mutation; infrastructure failures are retryable.")
 		Payload(ReleaseProviderPayload)
 		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
 	})
 
@@ -74,6 +113,8 @@ var _ = Service("registry", func() {
 		Description("Atomically mark one exact provider-incarnation lease non-routable before its Serve lifecycle closes the shared request sink. The provider supplies its configured settlement duration; Redis time extends the draining lease through that full lifecycle so already-claimed work can publish terminal results, while new calls route only when another non-draining provider remains.")
 		Payload(DrainProviderPayload)
 		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
 	})
 
@@ -82,12 +123,16 @@ var _ = Service("registry", func() {
 		Payload(UnregisterPayload)
 		Error("admission_conflict")
 		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
 	})
 
 	Method("Pong", func() {
 		Description("Atomically record shared consumer-group liveness for a token-and-membership-epoch health ping. The responding provider incarnation must hold an unexpired lease in that same catalog record.")
 		Payload(PongPayload)
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
 	})
 
@@ -97,7 +142,14 @@ var _ = Service("registry", func() {
 		Description("List all registered toolsets with optional tag filtering")
 		Payload(ListToolsetsPayload)
 		Result(ListToolsetsResult)
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
+		HTTP(func() {
+			GET("/toolsets")
+			Param("tags")
+			Response(StatusOK)
+		})
 	})
 
 	Method("GetToolset", func() {
@@ -105,14 +157,27 @@ var _ = Service("registry", func() {
 		Payload(GetToolsetPayload)
 		Result(Toolset)
 		Error("not_found")
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
+		HTTP(func() {
+			GET("/toolsets/{name}")
+			Response(StatusOK)
+		})
 	})
 
 	Method("Search", func() {
 		Description("Search toolsets by keyword matching name, description, or tags")
 		Payload(SearchPayload)
 		Result(SearchResult)
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
+		HTTP(func() {
+			GET("/search")
+			Param("query")
+			Response(StatusOK)
+		})
 	})
 
 	// ---- Invocation Operations ----
@@ -125,7 +190,14 @@ var _ = Service("registry", func() {
 		Error("validation_error")
 		Error("service_unavailable")
 		Error("call_not_admitted")
+		Error("unauthenticated")
+		Error("permission_denied")
+		Error("quota_exceeded")
 		GRPC(func() {})
+		HTTP(func() {
+			POST("/toolsets/{toolset}/tools/{tool}/calls")
+			Response(StatusOK)
+		})
 	})
 
 	Method("RetryTool", func() {
@@ -136,7 +208,33 @@ var _ = Service("registry", func() {
 		Error("validation_error")
 		Error("service_unavailable")
 		Error("admission_conflict")
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
+		HTTP(func() {
+			POST("/toolsets/{toolset}/tools/{tool}/retries")
+			Response(StatusOK)
+		})
+	})
+
+	Method("StreamToolCall", func() {
+		Description("Stream the result stream of one routed call as server-sent events: output deltas as the provider publishes them, retry control after provider overload, and finally the canonical terminal result, after which the stream ends. Events are read from the oldest retained entry, or after Last-Event-ID when a client reconnects. Only events stamped with the given registration token are forwarded. HTTP clients use it in place of reading the Pulse result stream directly.")
+		Payload(StreamToolCallPayload)
+		StreamingResult(ToolCallEvent)
+		Error("not_found")
+		Error("validation_error")
+		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
+		HTTP(func() {
+			GET("/toolsets/{toolset}/tools/{tool}/calls/{tool_use_id}/events")
+			Param("registration_token")
+			ServerSentEvents(func() {
+				SSEEventID("id")
+				SSEEventType("type")
+				SSERequestID("last_event_id")
+			})
+		})
 	})
 
 	Method("CompleteToolCall", func() {
@@ -144,6 +242,8 @@ var _ = Service("registry", func() {
 		Payload(CompleteToolCallPayload)
 		Error("validation_error")
 		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
 	})
 
@@ -152,6 +252,8 @@ var _ = Service("registry", func() {
 		Payload(PublishToolOutputDeltaPayload)
 		Error("validation_error")
 		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
 	})
 
@@ -160,6 +262,8 @@ var _ = Service("registry", func() {
 		Payload(ProviderToolCallClaimPayload)
 		Error("validation_error")
 		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
 	})
 
@@ -169,6 +273,92 @@ var _ = Service("registry", func() {
 		Result(ClaimToolCallResult)
 		Error("validation_error")
 		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
+		GRPC(func() {})
+	})
+
+	// ---- Admin Operations ----
+
+	Method("ListProviders", func() {
+		Description("List the catalog admission record of one toolset, active or retired, with every unexpired provider-incarnation lease, its Redis-time expiry, draining state, and the number of claimed calls it still owns.")
+		Payload(ListProvidersPayload)
+		Result(ListProvidersResult)
+		Error("not_found")
+		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
+		GRPC(func() {})
+	})
+
+	Method("ForceDrainProvider", func() {
+		Description("Mark one exact provider-incarnation lease draining on behalf of an operator. New calls stop routing to the incarnation while calls it already claimed keep settlement authority until its lease is released or expires; lease renewals by that incarnation keep it draining. Missing incarnations and stale tokens succeed without mutation.")
+		Payload(ForceDrainProviderPayload)
+		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
+		GRPC(func() {})
+	})
+
+	Method("RetireRegistration", func() {
+		Description("Retire the exact admission on behalf of an operator with the same semantics as Unregister: the toolset leaves discovery and routing, provider leases are preserved until release or expiry, and the token can never register again. A stale token returns admission_conflict.")
+		Payload(RetireRegistrationPayload)
+		Error("admission_conflict")
+		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
+		GRPC(func() {})
+	})
+
+	Method("ListToolCalls", func() {
+		Description("List the in-flight calls of one toolset: calls a provider incarnation has claimed and not yet settled, ordered by execution deadline. Queued calls that no provider has claimed yet are not indexed; inspect them individually with InspectToolCall.")
+		Payload(ListToolCallsPayload)
+		Result(ListToolCallsResult)
+		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
+		GRPC(func() {})
+	})
+
+	Method("InspectToolCall", func() {
+		Description("Inspect the authoritative record of one admitted call of a toolset: its publication, claim, and terminal state, the provider incarnation that owns it, and the length and last event of its result stream.")
+		Payload(InspectToolCallPayload)
+		Result(ToolCallInfo)
+		Error("not_found")
+		Error("validation_error")
+		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
+		GRPC(func() {})
+	})
+
+	Method("ListHealthTransitions", func() {
+		Description("List the recorded health transitions of one toolset, newest first. The health scheduler records a transition whenever the observed health, admission token, or membership epoch changes; the registry retains a bounded history per toolset.")
+		Payload(ListHealthTransitionsPayload)
+		Result(ListHealthTransitionsResult)
+		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
+		GRPC(func() {})
+	})
+
+	Method("GetToolsetRoutes", func() {
+		Description("Return the canary route table that splits unpinned calls between the side-by-side name@version registrations of one toolset. An empty table routes unpinned calls to the highest active version.")
+		Payload(GetToolsetRoutesPayload)
+		Result(ToolsetRoutesResult)
+		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
+		GRPC(func() {})
+	})
+
+	Method("SetToolsetRoutes", func() {
+		Description("Replace the canary route table of one toolset on behalf of an operator. Weights are relative; a zero weight drains a version of unpinned traffic while calls pinned to that exact version keep routing to it. An empty table routes unpinned calls to the highest active version.")
+		Payload(SetToolsetRoutesPayload)
+		Error("validation_error")
+		Error("service_unavailable")
+		Error("unauthenticated")
+		Error("permission_denied")
 		GRPC(func() {})
 	})
 })
@@ -436,6 +626,52 @@ var RetryToolPayload = Type("RetryToolPayload", func() {
 	Required("expected_registration_token")
 })
 
+var StreamToolCallPayload = Type("StreamToolCallPayload", func() {
+	Description("Identity of one routed call whose result stream is read.")
+	Field(1, "toolset", String, "Toolset reference the call was issued to.", func() {
+		MinLength(1)
+		MaxLength(256)
+		Example("atlas_data.atlas.read")
+	})
+	Field(2, "tool", String, "Tool the call invoked.", func() {
+		MinLength(1)
+		MaxLength(256)
+		Example("atlas.read.get_time_series")
+	})
+	Field(3, "tool_use_id", String, "Global transport identity returned by CallTool.", func() {
+		Pattern(toolregistry.ToolUseIDPattern)
+		Example("5c1d91e7ea6a1aa1bb3c395e0a7e09901a85df66fb064a679d6f0ff0d12a516e")
+	})
+	Field(4, "registration_token", String, "Admission-generation token returned by CallTool or RetryTool.", func() {
+		Pattern(toolregistry.RegistrationTokenPattern)
+		Example("270a659d38ff331401280ad7b0c8fdba673fd02e7114b856a2f12e1c49eec34c")
+	})
+	Field(5, "last_event_id", String, "Result stream event after which to resume.", func() {
+		Pattern(`^\d+-\d+$`)
+		Example("1721736123456-0")
+	})
+	Required("toolset", "tool", "tool_use_id", "registration_token")
+})
+
+var ToolCallEvent = Type("ToolCallEvent", func() {
+	Description("One event of a routed call result stream.")
+	Field(1, "id", String, "Result stream event ID; clients resume after it.", func() {
+		Example("1721736123456-0")
+	})
+	Field(2, "type", String, "output_delta carries a provider output fragment, retry reports provider overload and asks the caller to invoke RetryTool, and result carries the canonical terminal ToolResultMessage.", func() {
+		Enum("output_delta", "retry", "result")
+		Example("output_delta")
+	})
+	Field(3, "stream", String, "Logical output stream of an output_delta event.", func() {
+		Example("stdout")
+	})
+	Field(4, "delta", String, "Output fragment of an output_delta event.", func() {
+		Example("processed 10 rows\n")
+	})
+	Field(5, "message", Any, "ToolResultMessage carried by retry and result events.")
+	Required("id", "type")
+})
+
 var CompleteToolCallPayload = Type("CompleteToolCallPayload", func() {
 	Description("Exact provider lease and canonical terminal result for one admitted tool call.")
 	Field(1, "toolset", String, "Toolset whose provider completed the call.", func() {
@@ -546,6 +782,276 @@ var ClaimToolCallResult = Type("ClaimToolCallResult", func() {
 	Required("disposition")
 })
 
+var ListProvidersPayload = Type("ListProvidersPayload", func() {
+	Description("Toolset whose provider leases to list")
+	Field(1, "name", String, "Name of the toolset", func() {
+		MinLength(1)
+		MaxLength(256)
+		Example("data-tools")
+	})
+	Required("name")
+})
+
+var ListProvidersResult = Type("ListProvidersResult", func() {
+	Description("Catalog admission record and provider leases of one toolset")
+	Field(1, "name", String, "Name of the toolset", func() {
+		Example("data-tools")
+	})
+	Field(2, "state", String, "Admission state of the catalog record", func() {
+		Enum("active", "retired")
+		Example("active")
+	})
+	Field(3, "registration_token", String, "Admission-generation token of the catalog record", func() {
+		Example("270a659d38ff331401280ad7b0c8fdba673fd02e7114b856a2f12e1c49eec34c")
+	})
+	Field(4, "health_epoch", UInt64, "Provider membership epoch; it advances each time the toolset regains a routable provider after having none", func() {
+		Example(3)
+	})
+	Field(5, "last_pong_unix_milli", Int64, "Redis time of the last pong recorded in the current health epoch, in Unix milliseconds", func() {
+		Example(1721721600000)
+	})
+	Field(6, "healthy", Boolean, "Whether routed calls currently find a healthy provider", func() {
+		Example(true)
+	})
+	Field(7, "providers", ArrayOf(ProviderLeaseInfo), "Unexpired provider-incarnation leases")
+	Required("name", "state", "registration_token", "health_epoch", "healthy", "providers")
+})
+
+var ProviderLeaseInfo = Type("ProviderLeaseInfo", func() {
+	Description("One provider-incarnation lease of an admission")
+	Field(1, "provider_id", String, "Stable identity of the provider process", func() {
+		Example("atlas-data-7cd8949c8f-k2nrp/atlas_data.atlas.discover")
+	})
+	Field(2, "provider_incarnation_id", String, "Runtime-generated UUID of the Serve lifecycle holding the lease", func() {
+		Format(FormatUUID)
+		Example("8af45fe9-5c32-4b46-8da5-d350e98b68f3")
+	})
+	Field(3, "lease_expires_at_unix_milli", Int64, "Redis time at which the lease expires, in Unix milliseconds", func() {
+		Example(1721721720000)
+	})
+	Field(4, "draining", Boolean, "Whether the lease is draining and no longer receives new calls", func() {
+		Example(false)
+	})
+	Field(5, "claimed_calls", Int64, "Number of claimed calls the incarnation has not settled yet", func() {
+		Minimum(0)
+		Example(2)
+	})
+	Required("provider_id", "provider_incarnation_id", "lease_expires_at_unix_milli", "draining", "claimed_calls")
+})
+
+var ForceDrainProviderPayload = Type("ForceDrainProviderPayload", func() {
+	Description("Exact provider lease an operator drains")
+	Field(1, "name", String, "Name of the toolset", func() {
+		MinLength(1)
+		MaxLength(256)
+		Example("data-tools")
+	})
+	Field(2, "provider_id", String, "Stable identity of the provider process to drain", func() {
+		MinLength(1)
+		MaxLength(512)
+		Pattern(`^[^\x00]+$`)
+		Example("atlas-data-7cd8949c8f-k2nrp/atlas_data.atlas.discover")
+	})
+	Field(3, "provider_incarnation_id", String, "Runtime-generated UUID of the Serve lifecycle to drain", func() {
+		Format(FormatUUID)
+		Example("8af45fe9-5c32-4b46-8da5-d350e98b68f3")
+	})
+	Field(4, "registration_token", String, "Exact admission-generation token holding the lease, as reported by ListProviders", func() {
+		Pattern(toolregistry.RegistrationTokenPattern)
+		Example("270a659d38ff331401280ad7b0c8fdba673fd02e7114b856a2f12e1c49eec34c")
+	})
+	Required("name", "provider_id", "provider_incarnation_id", "registration_token")
+})
+
+var RetireRegistrationPayload = Type("RetireRegistrationPayload", func() {
+	Description("Exact admission an operator retires")
+	Field(1, "name", String, "Name of the toolset", func() {
+		MinLength(1)
+		MaxLength(256)
+		Example("data-tools")
+	})
+	Field(2, "registration_token", String, "Exact admission-generation token to retire, as reported by ListProviders", func() {
+		Pattern(toolregistry.RegistrationTokenPattern)
+		Example("270a659d38ff331401280ad7b0c8fdba673fd02e7114b856a2f12e1c49eec34c")
+	})
+	Required("name", "registration_token")
+})
+
+var ListToolCallsPayload = Type("ListToolCallsPayload", func() {
+	Description("Toolset whose in-flight calls to list")
+	Field(1, "toolset", String, "Name of the toolset", func() {
+		MinLength(1)
+		MaxLength(256)
+		Example("data-tools")
+	})
+	Field(2, "limit", Int, "Maximum number of calls to return", func() {
+		Minimum(1)
+		Maximum(1000)
+		Default(100)
+		Example(100)
+	})
+	Required("toolset")
+})
+
+var ListToolCallsResult = Type("ListToolCallsResult", func() {
+	Description("In-flight calls of one toolset")
+	Field(1, "calls", ArrayOf(ToolCallInfo), "Claimed calls ordered by execution deadline")
+	Required("calls")
+})
+
+var InspectToolCallPayload = Type("InspectToolCallPayload", func() {
+	Description("Admitted call to inspect")
+	Field(1, "toolset", String, "Name of the toolset that admitted the call", func() {
+		MinLength(1)
+		MaxLength(256)
+		Example("data-tools")
+	})
+	Field(2, "tool_use_id", String, "Global transport identity of the call", func() {
+		Pattern(toolregistry.ToolUseIDPattern)
+		Example("5c1d91e7ea6a1aa1bb3c395e0a7e09901a85df66fb064a679d6f0ff0d12a516e")
+	})
+	Required("toolset", "tool_use_id")
+})
+
+var ToolCallInfo = Type("ToolCallInfo", func() {
+	Description("Authoritative state of one admitted call and its result stream")
+	Field(1, "tool_use_id", String, "Global transport identity of the call", func() {
+		Example("5c1d91e7ea6a1aa1bb3c395e0a7e09901a85df66fb064a679d6f0ff0d12a516e")
+	})
+	Field(2, "state", String, "Call state. admitted calls are not published yet; queued calls await a provider claim; claimed calls are executing; terminal calls have a canonical result.", func() {
+		Enum("admitted", "queued", "claimed", "terminal")
+		Example("claimed")
+	})
+	Field(3, "registration_token", String, "Admission-generation token stamped on the call", func() {
+		Example("270a659d38ff331401280ad7b0c8fdba673fd02e7114b856a2f12e1c49eec34c")
+	})
+	Field(4, "execution_deadline_unix_milli", Int64, "Redis time by which the call must settle, in Unix milliseconds", func() {
+		Example(1721721900000)
+	})
+	Field(5, "expires_at_unix_milli", Int64, "Redis time at which the call record and its result history expire, in Unix milliseconds", func() {
+		Example(1721725200000)
+	})
+	Field(6, "provider_id", String, "Stable identity of the provider process that claimed the call", func() {
+		Example("atlas-data-7cd8949c8f-k2nrp/atlas_data.atlas.discover")
+	})
+	Field(7, "provider_incarnation_id", String, "Serve lifecycle that claimed the call", func() {
+		Example("8af45fe9-5c32-4b46-8da5-d350e98b68f3")
+	})
+	Field(8, "request_event_id", String, "Pulse request-stream event the provider claimed", func() {
+		Example("1721721600000-0")
+	})
+	Field(9, "terminal_cause", String, "What settled a terminal call", func() {
+		Enum("provider", "execution_deadline", "stale_admission", "provider_lease_lost", "provider_lease_released")
+		Example("provider")
+	})
+	Field(10, "output_delta_count", Int64, "Number of output deltas the provider published", func() {
+		Minimum(0)
+		Example(12)
+	})
+	Field(11, "result_stream_id", String, "Pulse stream carrying the call's output deltas and terminal result", func() {
+		Example("result:5c1d91e7ea6a1aa1bb3c395e0a7e09901a85df66fb064a679d6f0ff0d12a516e")
+	})
+	Field(12, "result_stream_length", Int64, "Number of events retained in the result stream", func() {
+		Minimum(0)
+		Example(13)
+	})
+	Field(13, "last_event_id", String, "ID of the newest retained result-stream event", func() {
+		Example("1721721642000-0")
+	})
+	Required("tool_use_id", "state", "registration_token", "execution_deadline_unix_milli", "expires_at_unix_milli", "output_delta_count", "result_stream_id", "result_stream_length")
+})
+
+var ListHealthTransitionsPayload = Type("ListHealthTransitionsPayload", func() {
+	Description("Toolset whose health history to list")
+	Field(1, "name", String, "Name of the toolset", func() {
+		MinLength(1)
+		MaxLength(256)
+		Example("data-tools")
+	})
+	Field(2, "limit", Int, "Maximum number of transitions to return", func() {
+		Minimum(1)
+		Maximum(256)
+		Default(50)
+		Example(50)
+	})
+	Required("name")
+})
+
+var ListHealthTransitionsResult = Type("ListHealthTransitionsResult", func() {
+	Description("Recorded health transitions of one toolset")
+	Field(1, "transitions", ArrayOf(HealthTransition), "Transitions, newest first")
+	Required("transitions")
+})
+
+var HealthTransition = Type("HealthTransition", func() {
+	Description("One observed change of toolset health, admission, or membership epoch")
+	Field(1, "at_unix_milli", Int64, "Redis time at which the scheduler observed the transition, in Unix milliseconds", func() {
+		Example(1721721600000)
+	})
+	Field(2, "healthy", Boolean, "Observed health after the transition", func() {
+		Example(false)
+	})
+	Field(3, "registration_token", String, "Admission-generation token observed", func() {
+		Example("270a659d38ff331401280ad7b0c8fdba673fd02e7114b856a2f12e1c49eec34c")
+	})
+	Field(4, "health_epoch", UInt64, "Provider membership epoch observed", func() {
+		Example(3)
+	})
+	Field(5, "provider_count", Int, "Number of routable provider leases observed", func() {
+		Minimum(0)
+		Example(2)
+	})
+	Field(6, "last_pong_unix_milli", Int64, "Redis time of the last recorded pong, in Unix milliseconds", func() {
+		Example(1721721570000)
+	})
+	Required("at_unix_milli", "healthy", "registration_token", "health_epoch", "provider_count")
+})
+
+var GetToolsetRoutesPayload = Type("GetToolsetRoutesPayload", func() {
+	Description("Toolset whose canary route table to return")
+	Field(1, "name", String, "Unversioned name of the toolset", func() {
+		MinLength(1)
+		MaxLength(256)
+		Example("data-tools")
+	})
+	Required("name")
+})
+
+var SetToolsetRoutesPayload = Type("SetToolsetRoutesPayload", func() {
+	Description("Canary route table an operator assigns to a toolset")
+	Field(1, "name", String, "Unversioned name of the toolset", func() {
+		MinLength(1)
+		MaxLength(256)
+		Example("data-tools")
+	})
+	Field(2, "routes", ArrayOf(ToolsetRoute), "Version weights; an empty list restores routing to the highest active version", func() {
+		MaxLength(64)
+	})
+	Required("name")
+})
+
+var ToolsetRoutesResult = Type("ToolsetRoutesResult", func() {
+	Description("Canary route table of one toolset")
+	Field(1, "name", String, "Unversioned name of the toolset", func() {
+		Example("data-tools")
+	})
+	Field(2, "routes", ArrayOf(ToolsetRoute), "Version weights ordered by version, highest first")
+	Required("name", "routes")
+})
+
+var ToolsetRoute = Type("ToolsetRoute", func() {
+	Description("Relative share of unpinned calls routed to one toolset version")
+	Field(1, "version", SemVer, "Canonical version of a name@version registration", func() {
+		Example("1.3.0")
+	})
+	Field(2, "weight", Int, "Relative traffic weight; zero drains the version of unpinned calls", func() {
+		Minimum(0)
+		Maximum(toolregistry.MaxToolsetRouteWeight)
+		Example(5)
+	})
+	Required("version", "weight")
+})
+
 // ---- Shared Types ----
 
 var Toolset = Type("Toolset", func() {
@@ -567,9 +1073,50 @@ var Toolset = Type("Toolset", func() {
 		Format(FormatDateTime)
 		Example("2024-01-15T10:30:00Z")
 	})
+	Field(7, "compatibility", SchemaCompatibility, "Schema changes relative to the registration this admission replaced or extends. Absent for the first registration of a toolset.")
 	Required("name", "tools", "registered_at")
 })
 
+var SchemaCompatibility = Type("SchemaCompatibility", func() {
+	Description("Classified tool schema changes between an admission and its baseline registration")
+	Field(1, "base_name", String, "Catalog name of the baseline registration: the replaced admission, or the highest lower version of a versioned toolset", func() {
+		Example("data-tools@1.2.0")
+	})
+	Field(2, "base_version", SemVer, "Semantic version of the baseline registration")
+	Field(3, "breaking", Boolean, "Whether any change can break agents built against the baseline")
+	Field(4, "changes", ArrayOf(SchemaChange), "Classified changes, ordered by tool and path")
+	Required("base_name", "breaking", "changes")
+})
+
+var SchemaChange = Type("SchemaChange", func() {
+	Description("One classified tool schema change")
+	Field(1, "tool", String, "Tool whose schema changed", func() {
+		Example("atlas.read.get_time_series")
+	})
+	Field(2, "kind", String, "Change classification", func() {
+		Enum(
+			"tool_added",
+			"tool_removed",
+			"property_added",
+			"property_removed",
+			"required_added",
+			"required_removed",
+			"type_narrowed",
+			"type_widened",
+			"enum_narrowed",
+			"enum_widened",
+		)
+	})
+	Field(3, "path", String, "Affected field: payload or result followed by the property path, with [] for array items", func() {
+		Example("payload.window.from")
+	})
+	Field(4, "breaking", Boolean, "Whether the change can break agents built against the baseline")
+	Field(5, "detail", String, "Human-readable description of the change", func() {
+		Example("required property \"window\" added")
+	})
+	Required("tool", "kind", "path", "breaking", "detail")
+})
+
 var ToolsetInfo = Type("ToolsetInfo", func() {
 	Description("Toolset metadata for listing and search results")
 	Field(1, "name", String, "Unique name for the toolset", func() {
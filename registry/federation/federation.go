@@ -0,0 +1,722 @@
+// Package federation mirrors toolsets of upstream registries into a local
+// registry.
+//
+// A Worker lists the toolsets of every upstream registry each SyncInterval,
+// keeps those allowed by the upstream Include/Exclude globs, and registers
+// them with the local registry under the upstream namespace: toolset
+// "data.tools" of upstream "east" becomes "east.data.tools" and its tool
+// "data.series" becomes "east.data.series". Imported toolsets carry an
+// OriginTag naming the upstream so agents and operators can tell where they
+// come from, and toolsets that already carry one are never re-exported, so
+// registries federating each other do not loop.
+//
+// Each imported toolset is served by a regular registry provider whose
+// handler proxies claimed calls to the origin registry and forwards the
+// origin's output deltas. Local agents discover and call federated tools like
+// any other toolset, under local authorization and quotas.
+//
+// When an upstream catalog cannot be listed, imported toolsets keep serving
+// until CacheTTL elapses since the last successful listing; they are then
+// withdrawn and the upstream is reported stale. A toolset whose schema cannot
+// be read keeps its current provider and is reported in
+// FederationStatus.ToolsetErrors; it never withdraws the other toolsets. Sync
+// events, status, and staleness are recorded through
+// runtime/registry.Observability.
+package federation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	pulsec "goa.design/goa-ai/features/stream/pulse/clients/pulse"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/registry/mcpprovider"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/runtime"
+	aistream "goa.design/goa-ai/runtime/agent/stream"
+	"goa.design/goa-ai/runtime/agent/telemetry"
+	"goa.design/goa-ai/runtime/agent/tools"
+	runtimeregistry "goa.design/goa-ai/runtime/registry"
+	"goa.design/goa-ai/runtime/toolregistry"
+	"goa.design/goa-ai/runtime/toolregistry/executor"
+	"goa.design/goa-ai/runtime/toolregistry/provider"
+)
+
+type (
+	// Catalog is the subset of the generated registry client used to read an
+	// upstream catalog. *genregistry.Client implements it.
+	Catalog interface {
+		ListToolsets(ctx context.Context, p *genregistry.ListToolsetsPayload) (*genregistry.ListToolsetsResult, error)
+		GetToolset(ctx context.Context, p *genregistry.GetToolsetPayload) (*genregistry.Toolset, error)
+	}
+
+	// Upstream describes one registry whose toolsets are mirrored.
+	Upstream struct {
+		// Name identifies the upstream registry in origin tags, logs, and
+		// metrics. Required.
+		Name string
+		// Namespace prefixes the names of imported toolsets and tools.
+		// Defaults to Name.
+		Namespace string
+		// Catalog reads the upstream toolsets. Required.
+		Catalog Catalog
+		// Client routes proxied calls through the upstream gateway. Required.
+		Client executor.Client
+		// Pulse reads proxied call results from the upstream result streams.
+		// Required.
+		Pulse pulsec.Client
+		// Config holds the registry settings declared in the design.
+		// SyncInterval defaults to one minute and CacheTTL to one hour;
+		// Federation filters the imported toolsets by name.
+		Config runtimeregistry.RegistryConfig
+		// ExecutorOptions are applied to the executors calling the upstream,
+		// for example executor.WithTokenSource.
+		ExecutorOptions []executor.Option
+	}
+
+	// Options configures a Worker.
+	Options struct {
+		// Registry is the local registry imported toolsets are registered
+		// with. Required.
+		Registry mcpprovider.Registry
+		// Provider configures the provider loop serving each imported
+		// toolset. Provider.ProviderID is required: each toolset is served as
+		// "<ProviderID>/<toolset>". The worker sets the Pong callback.
+		Provider provider.Options
+		// Observability records sync events and upstream status. Defaults to
+		// one built from Logger.
+		Observability *runtimeregistry.Observability
+		// Logger receives provider failures. Defaults to a noop logger.
+		Logger telemetry.Logger
+	}
+
+	// Worker mirrors upstream registries into a local registry.
+	Worker struct {
+		reg       mcpprovider.Registry
+		pulse     pulsec.Client
+		provider  provider.Options
+		obs       *runtimeregistry.Observability
+		logger    telemetry.Logger
+		upstreams []*upstream
+		now       func() time.Time
+		// serve runs the provider of one imported toolset until ctx is
+		// canceled. Tests replace it to observe imports.
+		serve func(ctx context.Context, imp *importedToolset) error
+	}
+
+	// upstream holds the sync state of one upstream registry.
+	upstream struct {
+		Upstream
+		syncInterval time.Duration
+		cacheTTL     time.Duration
+
+		mu      sync.Mutex
+		since   time.Time
+		imports map[string]*importedToolset
+		status  runtimeregistry.FederationStatus
+	}
+
+	// importedToolset is one upstream toolset served locally.
+	importedToolset struct {
+		register *genregistry.RegisterPayload
+		revision string
+		handler  *Handler
+		cancel   context.CancelFunc
+		done     chan struct{}
+		// after is closed once the provider this import replaces has
+		// drained, so two providers never serve the same toolset. Nil when
+		// the import replaces none.
+		after <-chan struct{}
+	}
+
+	// Handler proxies registry tool calls of one imported toolset to its
+	// origin registry.
+	Handler struct {
+		namespace string
+		exec      runtime.ToolCallExecutor
+		specs     map[tools.Ident]*tools.ToolSpec
+
+		mu         sync.Mutex
+		publishers map[string]toolregistry.OutputDeltaPublisher
+	}
+)
+
+const (
+	// OriginTagPrefix prefixes the tag naming the upstream registry of an
+	// imported toolset.
+	OriginTagPrefix = "origin:"
+
+	defaultSyncInterval = time.Minute
+	defaultCacheTTL     = time.Hour
+)
+
+// rawResultCodec keeps origin results as the JSON documents produced by the
+// origin providers; the handler forwards them verbatim.
+var rawResultCodec = tools.JSONCodec[any]{
+	ToJSON: func(v any) ([]byte, error) {
+		return json.Marshal(v)
+	},
+	FromJSON: func(data []byte) (any, error) {
+		return json.RawMessage(append([]byte(nil), data...)), nil
+	},
+}
+
+// New returns a Worker that mirrors upstreams into the local registry
+// described by opts. pulse reads the local registry streams served by the
+// imported toolset providers.
+func New(pulse pulsec.Client, opts Options, upstreams ...Upstream) (*Worker, error) {
+	if opts.Registry == nil {
+		return nil, errors.New("registry client is required")
+	}
+	if opts.Provider.ProviderID == "" {
+		return nil, errors.New("provider id is required")
+	}
+	if len(upstreams) == 0 {
+		return nil, errors.New("at least one upstream is required")
+	}
+	w := &Worker{
+		reg:      opts.Registry,
+		pulse:    pulse,
+		provider: opts.Provider,
+		obs:      opts.Observability,
+		logger:   opts.Logger,
+		now:      time.Now,
+	}
+	if w.logger == nil {
+		w.logger = telemetry.NewNoopLogger()
+	}
+	if w.obs == nil {
+		w.obs = runtimeregistry.NewObservability(w.logger, nil, nil)
+	}
+	w.serve = w.serveImport
+	namespaces := make(map[string]string, len(upstreams))
+	for _, up := range upstreams {
+		if up.Name == "" {
+			return nil, errors.New("upstream name is required")
+		}
+		if up.Catalog == nil || up.Client == nil || up.Pulse == nil {
+			return nil, fmt.Errorf("upstream %q requires a catalog, a client, and a pulse client", up.Name)
+		}
+		if up.Namespace == "" {
+			up.Namespace = up.Name
+		}
+		if other, ok := namespaces[up.Namespace]; ok {
+			return nil, fmt.Errorf("upstreams %q and %q share namespace %q", other, up.Name, up.Namespace)
+		}
+		namespaces[up.Namespace] = up.Name
+		u := &upstream{
+			Upstream:     up,
+			syncInterval: up.Config.SyncInterval,
+			cacheTTL:     up.Config.CacheTTL,
+			imports:      make(map[string]*importedToolset),
+			status:       runtimeregistry.FederationStatus{Registry: up.Name},
+		}
+		if u.syncInterval <= 0 {
+			u.syncInterval = defaultSyncInterval
+		}
+		if u.cacheTTL <= 0 {
+			u.cacheTTL = defaultCacheTTL
+		}
+		w.upstreams = append(w.upstreams, u)
+	}
+	return w, nil
+}
+
+// Run syncs every upstream on its SyncInterval until ctx is canceled, then
+// stops serving the imported toolsets.
+func (w *Worker) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for _, u := range w.upstreams {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.syncLoop(ctx, u)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// Status returns the sync status of every upstream in configuration order.
+func (w *Worker) Status() []runtimeregistry.FederationStatus {
+	out := make([]runtimeregistry.FederationStatus, len(w.upstreams))
+	for i, u := range w.upstreams {
+		u.mu.Lock()
+		out[i] = u.status
+		u.mu.Unlock()
+	}
+	return out
+}
+
+// OriginTag returns the tag recording that a toolset was imported from the
+// named upstream registry.
+func OriginTag(registry string) string {
+	return OriginTagPrefix + registry
+}
+
+// ImportToolset returns the local registration of an upstream toolset:
+// toolset and tool names are prefixed with namespace and the origin tag is
+// appended to the upstream tags.
+func ImportToolset(namespace, origin string, toolset *genregistry.Toolset) (*genregistry.RegisterPayload, error) {
+	if len(toolset.Tools) == 0 {
+		return nil, fmt.Errorf("upstream toolset %q has no tools", toolset.Name)
+	}
+	register := &genregistry.RegisterPayload{
+		Name:                namespace + "." + toolset.Name,
+		Description:         toolset.Description,
+		Version:             toolset.Version,
+		Tags:                append(slices.Clone(toolset.Tags), OriginTag(origin)),
+		Tools:               make([]*genregistry.ToolSchema, 0, len(toolset.Tools)),
+		WireProtocolVersion: toolregistry.WireProtocolVersion,
+	}
+	for _, tool := range toolset.Tools {
+		if tool.Name == "" {
+			return nil, fmt.Errorf("upstream toolset %q lists a tool without name", toolset.Name)
+		}
+		imported := *tool
+		imported.Name = namespace + "." + tool.Name
+		register.Tools = append(register.Tools, &imported)
+	}
+	return register, nil
+}
+
+// SchemaRevision returns an admission revision derived from an imported
+// registration, so a changed upstream contract creates a new admission.
+func SchemaRevision(register *genregistry.RegisterPayload) (string, error) {
+	data, err := json.Marshal(struct {
+		Version *genregistry.SemVer
+		Tools   []*genregistry.ToolSchema
+	}{register.Version, register.Tools})
+	if err != nil {
+		return "", fmt.Errorf("encode tool schemas: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return "fed-" + hex.EncodeToString(sum[:8]), nil
+}
+
+// NewHandler returns a Handler serving the namespaced tools of toolset by
+// calling them on the origin registry through client, awaiting results on
+// the origin result streams through pulse.
+func NewHandler(namespace string, toolset *genregistry.Toolset, client executor.Client, pulse pulsec.Client, opts ...executor.Option) *Handler {
+	h := newHandler(namespace, toolset)
+	execOpts := append([]executor.Option{executor.WithStreamSink(h)}, opts...)
+	h.exec = executor.New(client, pulse, h, execOpts...)
+	return h
+}
+
+// newHandler returns a Handler for toolset without executor.
+func newHandler(namespace string, toolset *genregistry.Toolset) *Handler {
+	h := &Handler{
+		namespace:  namespace,
+		specs:      make(map[tools.Ident]*tools.ToolSpec, len(toolset.Tools)),
+		publishers: make(map[string]toolregistry.OutputDeltaPublisher),
+	}
+	for _, schema := range toolset.Tools {
+		var description string
+		if schema.Description != nil {
+			description = *schema.Description
+		}
+		h.specs[tools.Ident(schema.Name)] = &tools.ToolSpec{
+			Name:        tools.Ident(schema.Name),
+			Toolset:     toolset.Name,
+			Description: description,
+			Tags:        schema.Tags,
+			Payload:     tools.TypeSpec{Schema: schema.PayloadSchema},
+			Result:      tools.TypeSpec{Schema: schema.ResultSchema, Codec: rawResultCodec},
+		}
+	}
+	return h
+}
+
+// HandleToolCall implements provider.Handler. The origin call reuses the
+// local call metadata and is identified by the local ToolUseID, so
+// redeliveries of one local call resolve to one origin call. Origin failures
+// keep their classification and recovery directive.
+func (h *Handler) HandleToolCall(ctx context.Context, msg toolregistry.ToolCallMessage) (toolregistry.ToolResultMessage, error) {
+	name, ok := strings.CutPrefix(msg.Tool.String(), h.namespace+".")
+	if _, known := h.Spec(tools.Ident(name)); !ok || !known {
+		return toolregistry.NewToolResultErrorMessage(msg.RegistrationToken, msg.ToolUseID, "unknown_tool", fmt.Sprintf("unknown tool %q", msg.Tool)), nil
+	}
+	meta := &runtime.ToolCallMeta{
+		RunID:      msg.ToolUseID,
+		SessionID:  msg.ToolUseID,
+		ToolCallID: msg.ToolUseID,
+	}
+	if msg.Meta != nil {
+		meta.RunID = msg.Meta.RunID
+		meta.SessionID = msg.Meta.SessionID
+		meta.TurnID = msg.Meta.TurnID
+		meta.ParentToolCallID = msg.Meta.ParentToolCallID
+	}
+	if pub, ok := toolregistry.OutputDeltaPublisherFromContext(ctx); ok {
+		h.mu.Lock()
+		h.publishers[meta.ToolCallID] = pub
+		h.mu.Unlock()
+		defer func() {
+			h.mu.Lock()
+			delete(h.publishers, meta.ToolCallID)
+			h.mu.Unlock()
+		}()
+	}
+	payload := msg.Payload
+	if len(payload) == 0 {
+		payload = json.RawMessage(`{}`)
+	}
+	res, err := h.exec.Execute(ctx, meta, &planner.ToolRequest{
+		Name:             tools.Ident(name),
+		Payload:          rawjson.Message(payload),
+		RunID:            meta.RunID,
+		SessionID:        meta.SessionID,
+		TurnID:           meta.TurnID,
+		ToolCallID:       meta.ToolCallID,
+		ParentToolCallID: meta.ParentToolCallID,
+	})
+	if err != nil {
+		return toolregistry.NewToolResultErrorMessage(msg.RegistrationToken, msg.ToolUseID, "service_unavailable", err.Error()), nil
+	}
+	if res == nil || res.ToolResult == nil {
+		return toolregistry.NewToolResultErrorMessage(msg.RegistrationToken, msg.ToolUseID, "execution_failed", "origin registry returned no result"), nil
+	}
+	if res.ToolResult.Failure != nil {
+		return failureResult(msg.RegistrationToken, msg.ToolUseID, res.ToolResult.Failure), nil
+	}
+	result, ok := res.ToolResult.Result.(json.RawMessage)
+	if !ok {
+		result, err = json.Marshal(res.ToolResult.Result)
+		if err != nil {
+			return toolregistry.NewToolResultErrorMessage(msg.RegistrationToken, msg.ToolUseID, "execution_failed", fmt.Sprintf("encode origin result: %v", err)), nil
+		}
+	}
+	return toolregistry.NewToolResultMessage(msg.RegistrationToken, msg.ToolUseID, result), nil
+}
+
+// Spec implements executor.SpecLookup with the upstream tool names.
+func (h *Handler) Spec(name tools.Ident) (*tools.ToolSpec, bool) {
+	spec, ok := h.specs[name]
+	return spec, ok
+}
+
+// Send implements aistream.Sink. It republishes origin output deltas on the
+// local call that proxied them and drops every other event.
+func (h *Handler) Send(ctx context.Context, event aistream.Event) error {
+	delta, ok := event.(aistream.ToolOutputDelta)
+	if !ok {
+		return nil
+	}
+	h.mu.Lock()
+	pub, ok := h.publishers[delta.Data.ToolCallID]
+	h.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return pub.PublishToolOutputDelta(ctx, delta.Data.Stream, delta.Data.Delta)
+}
+
+// Close implements aistream.Sink.
+func (h *Handler) Close(context.Context) error {
+	return nil
+}
+
+// syncLoop syncs u immediately and then on every tick until ctx is canceled.
+func (w *Worker) syncLoop(ctx context.Context, u *upstream) {
+	ticker := time.NewTicker(u.syncInterval)
+	defer ticker.Stop()
+	defer w.withdraw(u)
+
+	w.syncUpstream(ctx, u)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.syncUpstream(ctx, u)
+		}
+	}
+}
+
+// syncUpstream reads the upstream catalog once and reconciles the imported
+// toolsets with it. Providers run under ctx.
+func (w *Worker) syncUpstream(ctx context.Context, u *upstream) {
+	start := w.now()
+	spanCtx, span := w.obs.StartSpan(ctx, runtimeregistry.OpFederationSync,
+		attribute.String("registry", u.Name),
+	)
+	listed, fetched, failed, listErr := w.fetch(spanCtx, u)
+	err := listErr
+	if err == nil && len(failed) > 0 {
+		err = fmt.Errorf("%d upstream toolset(s) could not be read", len(failed))
+	}
+
+	u.mu.Lock()
+	now := w.now()
+	if u.since.IsZero() {
+		u.since = start
+	}
+	status := &u.status
+	status.LastSync = now
+	status.Error = ""
+	status.ToolsetErrors = nil
+	if listErr == nil {
+		status.LastSuccess = now
+	}
+	if err != nil {
+		status.Error = err.Error()
+	}
+	if len(failed) > 0 {
+		status.ToolsetErrors = make(map[string]string, len(failed))
+		for name, ferr := range failed {
+			status.ToolsetErrors[name] = ferr.Error()
+		}
+	}
+	status.Staleness = now.Sub(u.since)
+	if !status.LastSuccess.IsZero() {
+		status.Staleness = now.Sub(status.LastSuccess)
+	}
+	status.Stale = listErr != nil && (status.LastSuccess.IsZero() || status.Staleness > u.cacheTTL)
+	var stopped []*importedToolset
+	switch {
+	case status.Stale:
+		stopped = w.stopAll(u)
+	case listed != nil:
+		stopped = w.reconcile(ctx, u, listed, fetched)
+	}
+	status.Imported = len(u.imports)
+	snapshot := *status
+	u.mu.Unlock()
+	drain(stopped)
+
+	outcome := runtimeregistry.OutcomeSuccess
+	if err != nil {
+		outcome = runtimeregistry.OutcomeError
+	}
+	event := runtimeregistry.OperationEvent{
+		Operation:   runtimeregistry.OpFederationSync,
+		Registry:    u.Name,
+		Duration:    w.now().Sub(start),
+		Outcome:     outcome,
+		Error:       snapshot.Error,
+		ResultCount: snapshot.Imported,
+	}
+	w.obs.LogOperation(spanCtx, event)
+	w.obs.RecordOperationMetrics(event)
+	w.obs.RecordFederationStatus(spanCtx, snapshot)
+	w.obs.EndSpan(span, outcome, err)
+}
+
+// fetch lists the upstream toolsets allowed by the federation filters and
+// reads their schemas. listed is nil and err is set when the catalog could
+// not be listed; toolsets that could not be read are listed but not fetched,
+// and failed maps them to their error.
+func (w *Worker) fetch(ctx context.Context, u *upstream) (listed []string, fetched map[string]*genregistry.Toolset, failed map[string]error, err error) {
+	res, err := u.Catalog.ListToolsets(ctx, &genregistry.ListToolsetsPayload{})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list toolsets: %w", err)
+	}
+	listed = make([]string, 0, len(res.Toolsets))
+	fetched = make(map[string]*genregistry.Toolset, len(res.Toolsets))
+	for _, info := range res.Toolsets {
+		name, _ := toolregistry.SplitToolsetRef(info.Name)
+		if slices.ContainsFunc(info.Tags, isOriginTag) || !u.Config.Federation.Allows(name) {
+			continue
+		}
+		listed = append(listed, info.Name)
+		toolset, err := u.Catalog.GetToolset(ctx, &genregistry.GetToolsetPayload{Name: info.Name})
+		if err != nil {
+			if failed == nil {
+				failed = make(map[string]error)
+			}
+			failed[info.Name] = fmt.Errorf("get toolset %q: %w", info.Name, err)
+			continue
+		}
+		fetched[info.Name] = toolset
+	}
+	return listed, fetched, failed, nil
+}
+
+// reconcile starts providers for new or changed upstream toolsets, restarts
+// providers that exited, and stops providers of toolsets no longer listed.
+// Listed toolsets that could not be fetched keep their current provider.
+// It returns the stopped providers, which the caller drains once u.mu is
+// released. u.mu must be held.
+func (w *Worker) reconcile(ctx context.Context, u *upstream, listed []string, fetched map[string]*genregistry.Toolset) []*importedToolset {
+	var stopped []*importedToolset
+	keep := make(map[string]struct{}, len(listed))
+	for _, name := range listed {
+		local := u.Namespace + "." + name
+		keep[local] = struct{}{}
+		toolset, ok := fetched[name]
+		if !ok {
+			continue
+		}
+		register, err := ImportToolset(u.Namespace, u.Name, toolset)
+		if err != nil {
+			w.logger.Warn(ctx, "skipping federated toolset", "registry", u.Name, "toolset", name, "error", err)
+			continue
+		}
+		revision, err := SchemaRevision(register)
+		if err != nil {
+			w.logger.Warn(ctx, "skipping federated toolset", "registry", u.Name, "toolset", name, "error", err)
+			continue
+		}
+		var after <-chan struct{}
+		if current := u.imports[local]; current != nil {
+			if current.revision == revision && !current.exited() {
+				continue
+			}
+			current.cancel()
+			stopped = append(stopped, current)
+			after = current.done
+		}
+		execOpts := append([]executor.Option{executor.WithLogger(w.logger)}, u.ExecutorOptions...)
+		handler := NewHandler(u.Namespace, toolset, u.Client, u.Pulse, execOpts...)
+		u.imports[local] = w.start(ctx, register, revision, handler, after)
+	}
+	for local, imp := range u.imports {
+		if _, ok := keep[local]; !ok {
+			imp.cancel()
+			stopped = append(stopped, imp)
+			delete(u.imports, local)
+		}
+	}
+	return stopped
+}
+
+// start serves one imported toolset in the background once after, if any, is
+// closed.
+func (w *Worker) start(ctx context.Context, register *genregistry.RegisterPayload, revision string, handler *Handler, after <-chan struct{}) *importedToolset {
+	ctx, cancel := context.WithCancel(ctx)
+	imp := &importedToolset{
+		register: register,
+		revision: revision,
+		handler:  handler,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+		after:    after,
+	}
+	go func() {
+		defer close(imp.done)
+		if imp.after != nil {
+			select {
+			case <-imp.after:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := w.serve(ctx, imp); err != nil && ctx.Err() == nil {
+			w.logger.Error(ctx, "federated toolset provider stopped", "toolset", register.Name, "error", err)
+		}
+	}()
+	return imp
+}
+
+// serveImport registers imp with the local registry and serves its calls
+// until ctx is canceled.
+func (w *Worker) serveImport(ctx context.Context, imp *importedToolset) error {
+	toolset := imp.register.Name
+	opts := w.provider
+	opts.ProviderID = w.provider.ProviderID + "/" + toolset
+	opts.Pong = func(ctx context.Context, providerID, incarnationID, pingID string) error {
+		return w.reg.Pong(ctx, &genregistry.PongPayload{
+			PingID:                pingID,
+			Toolset:               toolset,
+			ProviderID:            providerID,
+			ProviderIncarnationID: incarnationID,
+		})
+	}
+	return provider.Serve(
+		ctx,
+		w.pulse,
+		toolset,
+		imp.handler,
+		mcpprovider.Registration(w.reg, imp.register, imp.revision),
+		opts,
+	)
+}
+
+// withdraw stops every provider of u and waits for them to drain.
+func (w *Worker) withdraw(u *upstream) {
+	u.mu.Lock()
+	stopped := w.stopAll(u)
+	u.status.Imported = 0
+	u.mu.Unlock()
+	drain(stopped)
+}
+
+// stopAll cancels every provider of u and returns them so the caller can
+// drain them once u.mu is released. u.mu must be held.
+func (w *Worker) stopAll(u *upstream) []*importedToolset {
+	stopped := make([]*importedToolset, 0, len(u.imports))
+	for local, imp := range u.imports {
+		imp.cancel()
+		stopped = append(stopped, imp)
+		delete(u.imports, local)
+	}
+	return stopped
+}
+
+// drain waits for the canceled providers to return.
+func drain(stopped []*importedToolset) {
+	for _, imp := range stopped {
+		<-imp.done
+	}
+}
+
+// exited reports whether the provider returned on its own.
+func (imp *importedToolset) exited() bool {
+	select {
+	case <-imp.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// failureResult returns the local result reporting an origin failure. The
+// correction context is stripped: the local executor attaches its own from
+// the rejected call.
+func failureResult(registrationToken, toolUseID string, failure *planner.ToolFailure) toolregistry.ToolResultMessage {
+	failure = planner.CloneToolFailure(failure)
+	failure.Recovery.PriorInput = nil
+	failure.Recovery.ExampleJSON = nil
+	return toolregistry.ToolResultMessage{
+		RegistrationToken: registrationToken,
+		ToolUseID:         toolUseID,
+		Error: &toolregistry.ToolError{
+			Code:    errorCode(failure.Kind),
+			Failure: failure,
+		},
+	}
+}
+
+// errorCode maps a failure classification to a registry tool error code.
+func errorCode(kind planner.FailureKind) string {
+	switch kind {
+	case planner.FailureInvalidCall:
+		return "invalid_arguments"
+	case planner.FailureDomainRejection:
+		return "invalid_input"
+	case planner.FailureUnavailable:
+		return "service_unavailable"
+	case planner.FailureRateLimited:
+		return "rate_limited"
+	case planner.FailureTimeout:
+		return "timeout"
+	default:
+		return "execution_failed"
+	}
+}
+
+// isOriginTag reports whether tag records a federation origin.
+func isOriginTag(tag string) bool {
+	return strings.HasPrefix(tag, OriginTagPrefix)
+}
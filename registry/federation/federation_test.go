@@ -0,0 +1,487 @@
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pulsec "goa.design/goa-ai/features/stream/pulse/clients/pulse"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/registry/mcpprovider"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/runtime"
+	aistream "goa.design/goa-ai/runtime/agent/stream"
+	"goa.design/goa-ai/runtime/agent/telemetry"
+	"goa.design/goa-ai/runtime/agent/tools"
+	runtimeregistry "goa.design/goa-ai/runtime/registry"
+	"goa.design/goa-ai/runtime/toolregistry"
+	"goa.design/goa-ai/runtime/toolregistry/executor"
+	"goa.design/goa-ai/runtime/toolregistry/provider"
+)
+
+const testRegistrationToken = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+type (
+	// fakeCatalog serves a mutable upstream catalog.
+	fakeCatalog struct {
+		mu       sync.Mutex
+		toolsets []*genregistry.Toolset
+		err      error
+		getErrs  map[string]error
+	}
+
+	fakeClient struct{ executor.Client }
+
+	fakePulse struct{ pulsec.Client }
+
+	fakeRegistry struct{ mcpprovider.Registry }
+
+	executorFunc func(ctx context.Context, meta *runtime.ToolCallMeta, call *planner.ToolRequest) (*runtime.ToolExecutionResult, error)
+
+	recordingPublisher struct {
+		deltas []string
+	}
+
+	recordingMetrics struct {
+		telemetry.Metrics
+		mu     sync.Mutex
+		gauges map[string]float64
+	}
+)
+
+func TestImportToolset(t *testing.T) {
+	t.Parallel()
+
+	description := "Data tools."
+	version := genregistry.SemVer("1.2.0")
+	toolset := &genregistry.Toolset{
+		Name:        "data.tools@1.2.0",
+		Description: &description,
+		Version:     &version,
+		Tags:        []string{"data"},
+		Tools: []*genregistry.ToolSchema{
+			{Name: "data.series", PayloadSchema: []byte(`{"type":"object"}`), ResultSchema: []byte(`{}`)},
+		},
+	}
+
+	register, err := ImportToolset("east", "east-registry", toolset)
+	require.NoError(t, err)
+	assert.Equal(t, "east.data.tools@1.2.0", register.Name)
+	assert.Equal(t, &version, register.Version)
+	assert.Equal(t, &description, register.Description)
+	assert.Equal(t, []string{"data", "origin:east-registry"}, register.Tags)
+	assert.Equal(t, []string{"data"}, toolset.Tags, "upstream tags are not modified")
+	require.Len(t, register.Tools, 1)
+	assert.Equal(t, "east.data.series", register.Tools[0].Name)
+	assert.Equal(t, "data.series", toolset.Tools[0].Name, "upstream schemas are not modified")
+	assert.Equal(t, toolregistry.WireProtocolVersion, register.WireProtocolVersion)
+
+	first, err := SchemaRevision(register)
+	require.NoError(t, err)
+	assert.Regexp(t, `^fed-[0-9a-f]{16}$`, first)
+	register.Tools[0].ResultSchema = []byte(`{"type":"object"}`)
+	changed, err := SchemaRevision(register)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, changed)
+
+	_, err = ImportToolset("east", "east-registry", &genregistry.Toolset{Name: "empty"})
+	assert.Error(t, err)
+}
+
+func TestWorkerMirrorsFilteredToolsetsUntilStale(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	catalog := &fakeCatalog{toolsets: []*genregistry.Toolset{
+		testToolset("data.tools", `{}`),
+		testToolset("data.tools@2.0.0", `{}`),
+		testToolset("admin.tools", `{}`),
+		testToolset("peer.data.tools", `{}`, OriginTag("peer")),
+	}}
+	metrics := &recordingMetrics{gauges: make(map[string]float64)}
+	w, err := New(fakePulse{}, Options{
+		Registry:      fakeRegistry{},
+		Provider:      provider.Options{ProviderID: "federation"},
+		Observability: runtimeregistry.NewObservability(nil, metrics, nil),
+	}, Upstream{
+		Name:    "east",
+		Catalog: catalog,
+		Client:  fakeClient{},
+		Pulse:   fakePulse{},
+		Config: runtimeregistry.RegistryConfig{
+			Federation: &runtimeregistry.FederationConfig{Exclude: []string{"admin.*"}},
+		},
+	})
+	require.NoError(t, err)
+	now := time.Unix(1_700_000_000, 0)
+	w.now = func() time.Time { return now }
+	w.serve = func(ctx context.Context, _ *importedToolset) error {
+		<-ctx.Done()
+		return nil
+	}
+	u := w.upstreams[0]
+	defer w.withdraw(u)
+
+	w.syncUpstream(ctx, u)
+	require.ElementsMatch(t, []string{"east.data.tools", "east.data.tools@2.0.0"}, importedNames(u))
+	status := w.Status()[0]
+	assert.Equal(t, 2, status.Imported)
+	assert.Equal(t, now, status.LastSuccess)
+	assert.False(t, status.Stale)
+	assert.Empty(t, status.Error)
+	unchanged := u.imports["east.data.tools@2.0.0"]
+	changed := u.imports["east.data.tools"]
+
+	catalog.set(testToolset("data.tools", `{"type":"object"}`), testToolset("data.tools@2.0.0", `{}`))
+	w.syncUpstream(ctx, u)
+	assert.Same(t, unchanged, u.imports["east.data.tools@2.0.0"], "unchanged toolsets keep their provider")
+	assert.NotSame(t, changed, u.imports["east.data.tools"], "changed schemas restart the provider")
+	assert.True(t, changed.exited(), "the replaced provider is stopped")
+
+	catalog.set(testToolset("data.tools", `{"type":"object"}`))
+	w.syncUpstream(ctx, u)
+	assert.Equal(t, []string{"east.data.tools"}, importedNames(u))
+	assert.True(t, unchanged.exited(), "delisted toolsets are withdrawn")
+
+	catalog.fail(errors.New("upstream unreachable"))
+	now = now.Add(30 * time.Minute)
+	w.syncUpstream(ctx, u)
+	status = w.Status()[0]
+	assert.Equal(t, 1, status.Imported, "imports keep serving within the cache TTL")
+	assert.False(t, status.Stale)
+	assert.Equal(t, 30*time.Minute, status.Staleness)
+	assert.Contains(t, status.Error, "upstream unreachable")
+
+	now = now.Add(time.Hour)
+	w.syncUpstream(ctx, u)
+	status = w.Status()[0]
+	assert.Equal(t, 0, status.Imported)
+	assert.True(t, status.Stale)
+	assert.Equal(t, 90*time.Minute, status.Staleness)
+	assert.Empty(t, importedNames(u))
+	assert.Equal(t, 1.0, metrics.gauge(telemetry.MetricRegistryFederationStale))
+	assert.Equal(t, (90 * time.Minute).Seconds(), metrics.gauge(telemetry.MetricRegistryFederationStaleness))
+
+	catalog.fail(nil)
+	w.syncUpstream(ctx, u)
+	assert.Equal(t, []string{"east.data.tools"}, importedNames(u), "recovered upstreams are imported again")
+	assert.False(t, w.Status()[0].Stale)
+	assert.Equal(t, 0.0, metrics.gauge(telemetry.MetricRegistryFederationStale))
+}
+
+func TestWorkerKeepsImportsWhenOneToolsetFails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	catalog := &fakeCatalog{toolsets: []*genregistry.Toolset{
+		testToolset("data.tools", `{}`),
+		testToolset("ops.tools", `{}`),
+	}}
+	catalog.failGet("ops.tools", errors.New("schema unavailable"))
+	w := newTestWorker(t, catalog)
+	now := time.Unix(1_700_000_000, 0)
+	w.now = func() time.Time { return now }
+	u := w.upstreams[0]
+	defer w.withdraw(u)
+
+	w.syncUpstream(ctx, u)
+	assert.Equal(t, []string{"east.data.tools"}, importedNames(u), "readable toolsets are imported on the first sync")
+	status := w.Status()[0]
+	assert.False(t, status.Stale)
+	assert.Equal(t, now, status.LastSuccess)
+	assert.Contains(t, status.ToolsetErrors["ops.tools"], "schema unavailable")
+	assert.NotEmpty(t, status.Error)
+
+	catalog.failGet("ops.tools", nil)
+	w.syncUpstream(ctx, u)
+	require.ElementsMatch(t, []string{"east.data.tools", "east.ops.tools"}, importedNames(u))
+	assert.Empty(t, w.Status()[0].ToolsetErrors)
+	imported := u.imports["east.ops.tools"]
+
+	catalog.set(testToolset("data.tools", `{}`), testToolset("ops.tools", `{"type":"object"}`))
+	catalog.failGet("ops.tools", errors.New("schema unavailable"))
+	now = now.Add(2 * time.Hour)
+	w.syncUpstream(ctx, u)
+	status = w.Status()[0]
+	require.ElementsMatch(t, []string{"east.data.tools", "east.ops.tools"}, importedNames(u), "failing toolsets never withdraw the others")
+	assert.Same(t, imported, u.imports["east.ops.tools"], "unreadable toolsets keep their current provider")
+	assert.False(t, status.Stale, "per-toolset failures do not make the upstream stale")
+	assert.Equal(t, now, status.LastSuccess)
+	assert.Equal(t, 2, status.Imported)
+	assert.Len(t, status.ToolsetErrors, 1)
+}
+
+func TestWorkerStatusDoesNotWaitForProviderDrain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	catalog := &fakeCatalog{toolsets: []*genregistry.Toolset{testToolset("data.tools", `{}`)}}
+	w := newTestWorker(t, catalog)
+	release := make(chan struct{})
+	draining := make(chan struct{})
+	w.serve = func(ctx context.Context, _ *importedToolset) error {
+		<-ctx.Done()
+		close(draining)
+		<-release
+		return nil
+	}
+	u := w.upstreams[0]
+
+	w.syncUpstream(ctx, u)
+	catalog.set()
+	synced := make(chan struct{})
+	go func() {
+		defer close(synced)
+		w.syncUpstream(ctx, u)
+	}()
+	<-draining
+
+	status := make(chan runtimeregistry.FederationStatus)
+	go func() { status <- w.Status()[0] }()
+	select {
+	case st := <-status:
+		assert.Equal(t, 0, st.Imported)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Status blocked while a provider drained")
+	}
+	close(release)
+	<-synced
+}
+
+func TestNewRejectsInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	opts := Options{Registry: fakeRegistry{}, Provider: provider.Options{ProviderID: "federation"}}
+	up := Upstream{Name: "east", Catalog: &fakeCatalog{}, Client: fakeClient{}, Pulse: fakePulse{}}
+
+	_, err := New(fakePulse{}, opts, up)
+	require.NoError(t, err)
+	_, err = New(fakePulse{}, Options{Provider: opts.Provider}, up)
+	assert.Error(t, err, "missing registry")
+	_, err = New(fakePulse{}, Options{Registry: opts.Registry}, up)
+	assert.Error(t, err, "missing provider id")
+	_, err = New(fakePulse{}, opts)
+	assert.Error(t, err, "missing upstream")
+	_, err = New(fakePulse{}, opts, Upstream{Name: "east"})
+	assert.Error(t, err, "incomplete upstream")
+	other := up
+	other.Name = "west"
+	other.Namespace = "east"
+	_, err = New(fakePulse{}, opts, up, other)
+	assert.Error(t, err, "shared namespace")
+}
+
+func TestHandlerProxiesCallsToOrigin(t *testing.T) {
+	t.Parallel()
+
+	h := newHandler("east", testToolset("data.tools@1.2.0", `{}`))
+	var got struct {
+		meta *runtime.ToolCallMeta
+		call *planner.ToolRequest
+	}
+	h.exec = executorFunc(func(ctx context.Context, meta *runtime.ToolCallMeta, call *planner.ToolRequest) (*runtime.ToolExecutionResult, error) {
+		got.meta, got.call = meta, call
+		spec, ok := h.Spec(call.Name)
+		require.True(t, ok)
+		assert.Equal(t, "data.tools@1.2.0", spec.Toolset, "calls route to the upstream registration")
+		require.NoError(t, h.Send(ctx, aistream.ToolOutputDelta{Data: aistream.ToolOutputDeltaPayload{
+			ToolCallID: meta.ToolCallID,
+			Stream:     "progress",
+			Delta:      "halfway",
+		}}))
+		require.NoError(t, h.Send(ctx, aistream.ToolOutputDelta{Data: aistream.ToolOutputDeltaPayload{
+			ToolCallID: "other-call",
+			Delta:      "dropped",
+		}}))
+		return runtime.Executed(&planner.ToolResult{Name: call.Name, Result: json.RawMessage(`{"points":[1,2]}`)}), nil
+	})
+	pub := &recordingPublisher{}
+	ctx := toolregistry.WithOutputDeltaPublisher(context.Background(), pub)
+
+	res, err := h.HandleToolCall(ctx, toolregistry.ToolCallMessage{
+		RegistrationToken: testRegistrationToken,
+		ToolUseID:         "use-1",
+		Tool:              "east.data.series",
+		Payload:           json.RawMessage(`{"metric":"cpu"}`),
+		Meta:              &toolregistry.ToolCallMeta{RunID: "run", SessionID: "session", TurnID: "turn", ToolCallID: "call"},
+	})
+	require.NoError(t, err)
+	require.Nil(t, res.Error)
+	assert.JSONEq(t, `{"points":[1,2]}`, string(res.Result))
+	assert.Equal(t, testRegistrationToken, res.RegistrationToken)
+	assert.Equal(t, "data.series", string(got.call.Name))
+	assert.JSONEq(t, `{"metric":"cpu"}`, string(got.call.Payload))
+	assert.Equal(t, &runtime.ToolCallMeta{RunID: "run", SessionID: "session", TurnID: "turn", ToolCallID: "use-1"}, got.meta)
+	assert.Equal(t, []string{"progress:halfway"}, pub.deltas)
+
+	h.exec = executorFunc(func(_ context.Context, _ *runtime.ToolCallMeta, call *planner.ToolRequest) (*runtime.ToolExecutionResult, error) {
+		return runtime.Executed(&planner.ToolResult{Name: call.Name, Failure: &planner.ToolFailure{
+			Kind:  planner.FailureInvalidCall,
+			Error: planner.NewToolError("metric is required"),
+			Recovery: planner.RecoveryDirective{
+				Action:     planner.RecoveryCorrectCall,
+				PriorInput: []byte(`{}`),
+			},
+		}}), nil
+	})
+	res, err = h.HandleToolCall(ctx, toolregistry.ToolCallMessage{
+		RegistrationToken: testRegistrationToken,
+		ToolUseID:         "use-2",
+		Tool:              "east.data.series",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res.Error)
+	assert.Equal(t, "invalid_arguments", res.Error.Code)
+	assert.Equal(t, planner.FailureInvalidCall, res.Error.Failure.Kind)
+	assert.Equal(t, planner.RecoveryCorrectCall, res.Error.Failure.Recovery.Action)
+	assert.Nil(t, res.Error.Failure.Recovery.PriorInput, "the local executor owns the correction context")
+
+	for _, tool := range []string{"data.series", "east.data.missing", "west.data.series"} {
+		res, err = h.HandleToolCall(ctx, toolregistry.ToolCallMessage{
+			RegistrationToken: testRegistrationToken,
+			ToolUseID:         "use-3",
+			Tool:              tools.Ident(tool),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res.Error, tool)
+		assert.Equal(t, "unknown_tool", res.Error.Code, tool)
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	t.Parallel()
+
+	for kind, code := range map[planner.FailureKind]string{
+		planner.FailureInvalidCall:     "invalid_arguments",
+		planner.FailureDomainRejection: "invalid_input",
+		planner.FailureUnavailable:     "service_unavailable",
+		planner.FailureRateLimited:     "rate_limited",
+		planner.FailureTimeout:         "timeout",
+		planner.FailureMalformedResult: "execution_failed",
+		planner.FailureInternal:        "execution_failed",
+	} {
+		assert.Equal(t, code, errorCode(kind), kind)
+	}
+}
+
+// testToolset returns an upstream toolset with one data tool whose result
+// schema is resultSchema.
+func testToolset(name, resultSchema string, tags ...string) *genregistry.Toolset {
+	return &genregistry.Toolset{
+		Name: name,
+		Tags: tags,
+		Tools: []*genregistry.ToolSchema{
+			{Name: "data.series", PayloadSchema: []byte(`{"type":"object"}`), ResultSchema: []byte(resultSchema)},
+		},
+	}
+}
+
+// newTestWorker returns a worker mirroring catalog as upstream "east" whose
+// providers serve until canceled.
+func newTestWorker(t *testing.T, catalog *fakeCatalog) *Worker {
+	t.Helper()
+	w, err := New(fakePulse{}, Options{
+		Registry: fakeRegistry{},
+		Provider: provider.Options{ProviderID: "federation"},
+	}, Upstream{
+		Name:    "east",
+		Catalog: catalog,
+		Client:  fakeClient{},
+		Pulse:   fakePulse{},
+	})
+	require.NoError(t, err)
+	w.serve = func(ctx context.Context, _ *importedToolset) error {
+		<-ctx.Done()
+		return nil
+	}
+	return w
+}
+
+// importedNames returns the local names of the toolsets imported from u.
+func importedNames(u *upstream) []string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	names := make([]string, 0, len(u.imports))
+	for name := range u.imports {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (c *fakeCatalog) ListToolsets(context.Context, *genregistry.ListToolsetsPayload) (*genregistry.ListToolsetsResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	res := &genregistry.ListToolsetsResult{}
+	for _, ts := range c.toolsets {
+		res.Toolsets = append(res.Toolsets, &genregistry.ToolsetInfo{Name: ts.Name, Tags: ts.Tags, ToolCount: len(ts.Tools)})
+	}
+	return res, nil
+}
+
+func (c *fakeCatalog) GetToolset(_ context.Context, p *genregistry.GetToolsetPayload) (*genregistry.Toolset, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.getErrs[p.Name]; err != nil {
+		return nil, err
+	}
+	for _, ts := range c.toolsets {
+		if ts.Name == p.Name {
+			return ts, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (c *fakeCatalog) set(toolsets ...*genregistry.Toolset) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.toolsets = toolsets
+}
+
+func (c *fakeCatalog) failGet(name string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.getErrs == nil {
+		c.getErrs = make(map[string]error)
+	}
+	c.getErrs[name] = err
+}
+
+func (c *fakeCatalog) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+}
+
+func (f executorFunc) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call *planner.ToolRequest) (*runtime.ToolExecutionResult, error) {
+	return f(ctx, meta, call)
+}
+
+func (p *recordingPublisher) PublishToolOutputDelta(_ context.Context, stream, delta string) error {
+	p.deltas = append(p.deltas, stream+":"+delta)
+	return nil
+}
+
+func (m *recordingMetrics) IncCounter(string, float64, ...string) {}
+
+func (m *recordingMetrics) RecordTimer(string, time.Duration, ...string) {}
+
+func (m *recordingMetrics) RecordGauge(name string, value float64, _ ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.gauges[name] = value
+}
+
+func (m *recordingMetrics) gauge(name string) float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.gauges[name]
+}
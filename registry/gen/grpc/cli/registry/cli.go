@@ -22,7 +22,7 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"registry (register|release-provider|drain-provider|unregister|pong|list-toolsets|get-toolset|search|call-tool|retry-tool|complete-tool-call|publish-tool-output-delta|report-tool-call-overload|claim-tool-call)",
+		"registry (register|release-provider|drain-provider|unregister|pong|list-toolsets|get-toolset|search|call-tool|retry-tool|complete-tool-call|publish-tool-output-delta|report-tool-call-overload|claim-tool-call|list-providers|force-drain-provider|retire-registration|list-tool-calls|inspect-tool-call|list-health-transitions|get-toolset-routes|set-toolset-routes)",
 	}
 }
 
@@ -82,6 +82,30 @@ func ParseEndpoint(
 
 		registryClaimToolCallFlags       = flag.NewFlagSet("claim-tool-call", flag.ExitOnError)
 		registryClaimToolCallMessageFlag = registryClaimToolCallFlags.String("message", "", "")
+
+		registryListProvidersFlags       = flag.NewFlagSet("list-providers", flag.ExitOnError)
+		registryListProvidersMessageFlag = registryListProvidersFlags.String("message", "", "")
+
+		registryForceDrainProviderFlags       = flag.NewFlagSet("force-drain-provider", flag.ExitOnError)
+		registryForceDrainProviderMessageFlag = registryForceDrainProviderFlags.String("message", "", "")
+
+		registryRetireRegistrationFlags       = flag.NewFlagSet("retire-registration", flag.ExitOnError)
+		registryRetireRegistrationMessageFlag = registryRetireRegistrationFlags.String("message", "", "")
+
+		registryListToolCallsFlags       = flag.NewFlagSet("list-tool-calls", flag.ExitOnError)
+		registryListToolCallsMessageFlag = registryListToolCallsFlags.String("message", "", "")
+
+		registryInspectToolCallFlags       = flag.NewFlagSet("inspect-tool-call", flag.ExitOnError)
+		registryInspectToolCallMessageFlag = registryInspectToolCallFlags.String("message", "", "")
+
+		registryListHealthTransitionsFlags       = flag.NewFlagSet("list-health-transitions", flag.ExitOnError)
+		registryListHealthTransitionsMessageFlag = registryListHealthTransitionsFlags.String("message", "", "")
+
+		registryGetToolsetRoutesFlags       = flag.NewFlagSet("get-toolset-routes", flag.ExitOnError)
+		registryGetToolsetRoutesMessageFlag = registryGetToolsetRoutesFlags.String("message", "", "")
+
+		registrySetToolsetRoutesFlags       = flag.NewFlagSet("set-toolset-routes", flag.ExitOnError)
+		registrySetToolsetRoutesMessageFlag = registrySetToolsetRoutesFlags.String("message", "", "")
 	)
 	registryFlags.Usage = registryUsage
 	registryRegisterFlags.Usage = registryRegisterUsage
@@ -98,6 +122,14 @@ func ParseEndpoint(
 	registryPublishToolOutputDeltaFlags.Usage = registryPublishToolOutputDeltaUsage
 	registryReportToolCallOverloadFlags.Usage = registryReportToolCallOverloadUsage
 	registryClaimToolCallFlags.Usage = registryClaimToolCallUsage
+	registryListProvidersFlags.Usage = registryListProvidersUsage
+	registryForceDrainProviderFlags.Usage = registryForceDrainProviderUsage
+	registryRetireRegistrationFlags.Usage = registryRetireRegistrationUsage
+	registryListToolCallsFlags.Usage = registryListToolCallsUsage
+	registryInspectToolCallFlags.Usage = registryInspectToolCallUsage
+	registryListHealthTransitionsFlags.Usage = registryListHealthTransitionsUsage
+	registryGetToolsetRoutesFlags.Usage = registryGetToolsetRoutesUsage
+	registrySetToolsetRoutesFlags.Usage = registrySetToolsetRoutesUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -175,6 +207,30 @@ func ParseEndpoint(
 			case "claim-tool-call":
 				epf = registryClaimToolCallFlags
 
+			case "list-providers":
+				epf = registryListProvidersFlags
+
+			case "force-drain-provider":
+				epf = registryForceDrainProviderFlags
+
+			case "retire-registration":
+				epf = registryRetireRegistrationFlags
+
+			case "list-tool-calls":
+				epf = registryListToolCallsFlags
+
+			case "inspect-tool-call":
+				epf = registryInspectToolCallFlags
+
+			case "list-health-transitions":
+				epf = registryListHealthTransitionsFlags
+
+			case "get-toolset-routes":
+				epf = registryGetToolsetRoutesFlags
+
+			case "set-toolset-routes":
+				epf = registrySetToolsetRoutesFlags
+
 			}
 
 		}
@@ -242,6 +298,30 @@ func ParseEndpoint(
 			case "claim-tool-call":
 				endpoint = c.ClaimToolCall()
 				data, err = registryc.BuildClaimToolCallPayload(*registryClaimToolCallMessageFlag)
+			case "list-providers":
+				endpoint = c.ListProviders()
+				data, err = registryc.BuildListProvidersPayload(*registryListProvidersMessageFlag)
+			case "force-drain-provider":
+				endpoint = c.ForceDrainProvider()
+				data, err = registryc.BuildForceDrainProviderPayload(*registryForceDrainProviderMessageFlag)
+			case "retire-registration":
+				endpoint = c.RetireRegistration()
+				data, err = registryc.BuildRetireRegistrationPayload(*registryRetireRegistrationMessageFlag)
+			case "list-tool-calls":
+				endpoint = c.ListToolCalls()
+				data, err = registryc.BuildListToolCallsPayload(*registryListToolCallsMessageFlag)
+			case "inspect-tool-call":
+				endpoint = c.InspectToolCall()
+				data, err = registryc.BuildInspectToolCallPayload(*registryInspectToolCallMessageFlag)
+			case "list-health-transitions":
+				endpoint = c.ListHealthTransitions()
+				data, err = registryc.BuildListHealthTransitionsPayload(*registryListHealthTransitionsMessageFlag)
+			case "get-toolset-routes":
+				endpoint = c.GetToolsetRoutes()
+				data, err = registryc.BuildGetToolsetRoutesPayload(*registryGetToolsetRoutesMessageFlag)
+			case "set-toolset-routes":
+				endpoint = c.SetToolsetRoutes()
+				data, err = registryc.BuildSetToolsetRoutesPayload(*registrySetToolsetRoutesMessageFlag)
 			}
 		}
 	}
@@ -271,6 +351,14 @@ func registryUsage() {
 	fmt.Fprintln(os.Stderr, `    publish-tool-output-delta: Publish one best-effort output fragment for a claimed live call. The registry verifies the exact provider lease and request-event claim, then atomically appends the delta only while the authoritative call record remains nonterminal.`)
 	fmt.Fprintln(os.Stderr, `    report-tool-call-overload: Report that an exact provider claim could not enter its bounded worker queue. The registry verifies the provider lease and request-event claim, then atomically appends retry control only while the authoritative call record remains nonterminal.`)
 	fmt.Fprintln(os.Stderr, `    claim-tool-call: Atomically settle one queued request before handler dispatch. The registry authenticates the exact provider lease and request event; only an active non-draining lease may gain immutable execution ownership. Existing owners, retained terminal history, and Redis-owned expiration settle without execution, while stale, draining, or retired unclaimed work receives the canonical stale-generation terminal. Only the exact granted provider incarnation and request event may publish deltas or complete the call; ownership never transfers after a crash.`)
+	fmt.Fprintln(os.Stderr, `    list-providers: List the catalog admission record of one toolset, active or retired, with every unexpired provider-incarnation lease, its Redis-time expiry, draining state, and the number of claimed calls it still owns.`)
+	fmt.Fprintln(os.Stderr, `    force-drain-provider: Mark one exact provider-incarnation lease draining on behalf of an operator. New calls stop routing to the incarnation while calls it already claimed keep settlement authority until its lease is released or expires; lease renewals by that incarnation keep it draining. Missing incarnations and stale tokens succeed without mutation.`)
+	fmt.Fprintln(os.Stderr, `    retire-registration: Retire the exact admission on behalf of an operator with the same semantics as Unregister: the toolset leaves discovery and routing, provider leases are preserved until release or expiry, and the token can never register again. A stale token returns admission_conflict.`)
+	fmt.Fprintln(os.Stderr, `    list-tool-calls: List the in-flight calls of one toolset: calls a provider incarnation has claimed and not yet settled, ordered by execution deadline. Queued calls that no provider has claimed yet are not indexed; inspect them individually with InspectToolCall.`)
+	fmt.Fprintln(os.Stderr, `    inspect-tool-call: Inspect the authoritative record of one admitted call of a toolset: its publication, claim, and terminal state, the provider incarnation that owns it, and the length and last event of its result stream.`)
+	fmt.Fprintln(os.Stderr, `    list-health-transitions: List the recorded health transitions of one toolset, newest first. The health scheduler records a transition whenever the observed health, admission token, or membership epoch changes; the registry retains a bounded history per toolset.`)
+	fmt.Fprintln(os.Stderr, `    get-toolset-routes: Return the canary route table that splits unpinned calls between the side-by-side name@version registrations of one toolset. An empty table routes unpinned calls to the highest active version.`)
+	fmt.Fprintln(os.Stderr, `    set-toolset-routes: Replace the canary route table of one toolset on behalf of an operator. Weights are relative; a zero weight drains a version of unpinned traffic while calls pinned to that exact version keep routing to it. An empty table routes unpinned calls to the highest active version.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s registry COMMAND --help\n", os.Args[0])
@@ -526,3 +614,147 @@ func registryClaimToolCallUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "registry claim-tool-call --message '{\n      \"call_registration_token\": \"270a659d38ff331401280ad7b0c8fdba673fd02e7114b856a2f12e1c49eec34c\",\n      \"provider_id\": \"atlas-data-7cd8949c8f-k2nrp/atlas_data.atlas.read\",\n      \"provider_incarnation_id\": \"8af45fe9-5c32-4b46-8da5-d350e98b68f3\",\n      \"provider_registration_token\": \"7ddaeccbe5b9c901a2773fc77097f7970669988ea6dfca6cb3205ffcd552cc82\",\n      \"request_event_id\": \"1721736123456-0\",\n      \"tool_use_id\": \"5c1d91e7ea6a1aa1bb3c395e0a7e09901a85df66fb064a679d6f0ff0d12a516e\",\n      \"toolset\": \"atlas_data.atlas.read\"\n   }'")
 }
+
+func registryListProvidersUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] registry list-providers", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the catalog admission record of one toolset, active or retired, with every unexpired provider-incarnation lease, its Redis-time expiry, draining state, and the number of claimed calls it still owns.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "registry list-providers --message '{\n      \"name\": \"data-tools\"\n   }'")
+}
+
+func registryForceDrainProviderUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] registry force-drain-provider", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Mark one exact provider-incarnation lease draining on behalf of an operator. New calls stop routing to the incarnation while calls it already claimed keep settlement authority until its lease is released or expires; lease renewals by that incarnation keep it draining. Missing incarnations and stale tokens succeed without mutation.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "registry force-drain-provider --message '{\n      \"name\": \"data-tools\",\n      \"provider_id\": \"atlas-data-7cd8949c8f-k2nrp/atlas_data.atlas.discover\",\n      \"provider_incarnation_id\": \"8af45fe9-5c32-4b46-8da5-d350e98b68f3\",\n      \"registration_token\": \"270a659d38ff331401280ad7b0c8fdba673fd02e7114b856a2f12e1c49eec34c\"\n   }'")
+}
+
+func registryRetireRegistrationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] registry retire-registration", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Retire the exact admission on behalf of an operator with the same semantics as Unregister: the toolset leaves discovery and routing, provider leases are preserved until release or expiry, and the token can never register again. A stale token returns admission_conflict.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "registry retire-registration --message '{\n      \"name\": \"data-tools\",\n      \"registration_token\": \"270a659d38ff331401280ad7b0c8fdba673fd02e7114b856a2f12e1c49eec34c\"\n   }'")
+}
+
+func registryListToolCallsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] registry list-tool-calls", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the in-flight calls of one toolset: calls a provider incarnation has claimed and not yet settled, ordered by execution deadline. Queued calls that no provider has claimed yet are not indexed; inspect them individually with InspectToolCall.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "registry list-tool-calls --message '{\n      \"limit\": 100,\n      \"toolset\": \"data-tools\"\n   }'")
+}
+
+func registryInspectToolCallUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] registry inspect-tool-call", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Inspect the authoritative record of one admitted call of a toolset: its publication, claim, and terminal state, the provider incarnation that owns it, and the length and last event of its result stream.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "registry inspect-tool-call --message '{\n      \"tool_use_id\": \"5c1d91e7ea6a1aa1bb3c395e0a7e09901a85df66fb064a679d6f0ff0d12a516e\",\n      \"toolset\": \"data-tools\"\n   }'")
+}
+
+func registryListHealthTransitionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] registry list-health-transitions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the recorded health transitions of one toolset, newest first. The health scheduler records a transition whenever the observed health, admission token, or membership epoch changes; the registry retains a bounded history per toolset.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "registry list-health-transitions --message '{\n      \"limit\": 50,\n      \"name\": \"data-tools\"\n   }'")
+}
+
+func registryGetToolsetRoutesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] registry get-toolset-routes", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Return the canary route table that splits unpinned calls between the side-by-side name@version registrations of one toolset. An empty table routes unpinned calls to the highest active version.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "registry get-toolset-routes --message '{\n      \"name\": \"data-tools\"\n   }'")
+}
+
+func registrySetToolsetRoutesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] registry set-toolset-routes", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replace the canary route table of one toolset on behalf of an operator. Weights are relative; a zero weight drains a version of unpinned traffic while calls pinned to that exact version keep routing to it. An empty table routes unpinned calls to the highest active version.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "registry set-toolset-routes --message '{\n      \"name\": \"data-tools\",\n      \"routes\": [\n         {\n            \"version\": \"1.3.0\",\n            \"weight\": 5\n         },\n         {\n            \"version\": \"1.2.0\",\n            \"weight\": 95\n         }\n      ]\n   }'")
+}
@@ -382,3 +382,189 @@ func BuildClaimToolCallPayload(registryClaimToolCallMessage string) (*registry.P
 
 	return v, nil
 }
+
+// BuildListProvidersPayload builds the payload for the registry ListProviders
+// endpoint from CLI flags.
+func BuildListProvidersPayload(registryListProvidersMessage string) (*registry.ListProvidersPayload, error) {
+	var err error
+	var message registrypb.ListProvidersRequest
+	{
+		if registryListProvidersMessage != "" {
+			err = json.Unmarshal([]byte(registryListProvidersMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"data-tools\"\n   }'")
+			}
+		}
+	}
+	v := &registry.ListProvidersPayload{
+		Name: message.Name,
+	}
+
+	return v, nil
+}
+
+// BuildForceDrainProviderPayload builds the payload for the registry
+// ForceDrainProvider endpoint from CLI flags.
+func BuildForceDrainProviderPayload(registryForceDrainProviderMessage string) (*registry.ForceDrainProviderPayload, error) {
+	var err error
+	var message registrypb.ForceDrainProviderRequest
+	{
+		if registryForceDrainProviderMessage != "" {
+			err = json.Unmarshal([]byte(registryForceDrainProviderMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"data-tools\",\n      \"provider_id\": \"atlas-data-7cd8949c8f-k2nrp/atlas_data.atlas.discover\",\n      \"provider_incarnation_id\": \"8af45fe9-5c32-4b46-8da5-d350e98b68f3\",\n      \"registration_token\": \"270a659d38ff331401280ad7b0c8fdba673fd02e7114b856a2f12e1c49eec34c\"\n   }'")
+			}
+		}
+	}
+	v := &registry.ForceDrainProviderPayload{
+		Name:                  message.Name,
+		ProviderID:            message.ProviderId,
+		ProviderIncarnationID: message.ProviderIncarnationId,
+		RegistrationToken:     message.RegistrationToken,
+	}
+
+	return v, nil
+}
+
+// BuildRetireRegistrationPayload builds the payload for the registry
+// RetireRegistration endpoint from CLI flags.
+func BuildRetireRegistrationPayload(registryRetireRegistrationMessage string) (*registry.RetireRegistrationPayload, error) {
+	var err error
+	var message registrypb.RetireRegistrationRequest
+	{
+		if registryRetireRegistrationMessage != "" {
+			err = json.Unmarshal([]byte(registryRetireRegistrationMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"data-tools\",\n      \"registration_token\": \"270a659d38ff331401280ad7b0c8fdba673fd02e7114b856a2f12e1c49eec34c\"\n   }'")
+			}
+		}
+	}
+	v := &registry.RetireRegistrationPayload{
+		Name:              message.Name,
+		RegistrationToken: message.RegistrationToken,
+	}
+
+	return v, nil
+}
+
+// BuildListToolCallsPayload builds the payload for the registry ListToolCalls
+// endpoint from CLI flags.
+func BuildListToolCallsPayload(registryListToolCallsMessage string) (*registry.ListToolCallsPayload, error) {
+	var err error
+	var message registrypb.ListToolCallsRequest
+	{
+		if registryListToolCallsMessage != "" {
+			err = json.Unmarshal([]byte(registryListToolCallsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"limit\": 100,\n      \"toolset\": \"data-tools\"\n   }'")
+			}
+		}
+	}
+	v := &registry.ListToolCallsPayload{
+		Toolset: message.Toolset,
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Limit == nil {
+		v.Limit = 100
+	}
+
+	return v, nil
+}
+
+// BuildInspectToolCallPayload builds the payload for the registry
+// InspectToolCall endpoint from CLI flags.
+func BuildInspectToolCallPayload(registryInspectToolCallMessage string) (*registry.InspectToolCallPayload, error) {
+	var err error
+	var message registrypb.InspectToolCallRequest
+	{
+		if registryInspectToolCallMessage != "" {
+			err = json.Unmarshal([]byte(registryInspectToolCallMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"tool_use_id\": \"5c1d91e7ea6a1aa1bb3c395e0a7e09901a85df66fb064a679d6f0ff0d12a516e\",\n      \"toolset\": \"data-tools\"\n   }'")
+			}
+		}
+	}
+	v := &registry.InspectToolCallPayload{
+		Toolset:   message.Toolset,
+		ToolUseID: message.ToolUseId,
+	}
+
+	return v, nil
+}
+
+// BuildListHealthTransitionsPayload builds the payload for the registry
+// ListHealthTransitions endpoint from CLI flags.
+func BuildListHealthTransitionsPayload(registryListHealthTransitionsMessage string) (*registry.ListHealthTransitionsPayload, error) {
+	var err error
+	var message registrypb.ListHealthTransitionsRequest
+	{
+		if registryListHealthTransitionsMessage != "" {
+			err = json.Unmarshal([]byte(registryListHealthTransitionsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"limit\": 50,\n      \"name\": \"data-tools\"\n   }'")
+			}
+		}
+	}
+	v := &registry.ListHealthTransitionsPayload{
+		Name: message.Name,
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Limit == nil {
+		v.Limit = 50
+	}
+
+	return v, nil
+}
+
+// BuildGetToolsetRoutesPayload builds the payload for the registry
+// GetToolsetRoutes endpoint from CLI flags.
+func BuildGetToolsetRoutesPayload(registryGetToolsetRoutesMessage string) (*registry.GetToolsetRoutesPayload, error) {
+	var err error
+	var message registrypb.GetToolsetRoutesRequest
+	{
+		if registryGetToolsetRoutesMessage != "" {
+			err = json.Unmarshal([]byte(registryGetToolsetRoutesMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"data-tools\"\n   }'")
+			}
+		}
+	}
+	v := &registry.GetToolsetRoutesPayload{
+		Name: message.Name,
+	}
+
+	return v, nil
+}
+
+// BuildSetToolsetRoutesPayload builds the payload for the registry
+// SetToolsetRoutes endpoint from CLI flags.
+func BuildSetToolsetRoutesPayload(registrySetToolsetRoutesMessage string) (*registry.SetToolsetRoutesPayload, error) {
+	var err error
+	var message registrypb.SetToolsetRoutesRequest
+	{
+		if registrySetToolsetRoutesMessage != "" {
+			err = json.Unmarshal([]byte(registrySetToolsetRoutesMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"data-tools\",\n      \"routes\": [\n         {\n            \"version\": \"1.3.0\",\n            \"weight\": 5\n         },\n         {\n            \"version\": \"1.2.0\",\n            \"weight\": 95\n         }\n      ]\n   }'")
+			}
+		}
+	}
+	v := &registry.SetToolsetRoutesPayload{
+		Name: message.Name,
+	}
+	if message.Routes != nil {
+		v.Routes = make([]*registry.ToolsetRoute, len(message.Routes))
+		for i, val := range message.Routes {
+			v.Routes[i] = &registry.ToolsetRoute{
+				Version: registry.SemVer(val.Version),
+				Weight:  int(val.Weight),
+			}
+		}
+	}
+
+	return v, nil
+}
@@ -333,3 +333,179 @@ func (c *Client) ClaimToolCall() goa.Endpoint {
 		return res, nil
 	}
 }
+
+// ListProviders calls the "ListProviders" function in registrypb.RegistryClient
+// interface.
+func (c *Client) ListProviders() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListProvidersFunc(c.grpccli, c.opts...),
+			EncodeListProvidersRequest,
+			DecodeListProvidersResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ForceDrainProvider calls the "ForceDrainProvider" function in
+// registrypb.RegistryClient interface.
+func (c *Client) ForceDrainProvider() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildForceDrainProviderFunc(c.grpccli, c.opts...),
+			EncodeForceDrainProviderRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// RetireRegistration calls the "RetireRegistration" function in
+// registrypb.RegistryClient interface.
+func (c *Client) RetireRegistration() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildRetireRegistrationFunc(c.grpccli, c.opts...),
+			EncodeRetireRegistrationRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ListToolCalls calls the "ListToolCalls" function in registrypb.RegistryClient
+// interface.
+func (c *Client) ListToolCalls() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListToolCallsFunc(c.grpccli, c.opts...),
+			EncodeListToolCallsRequest,
+			DecodeListToolCallsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// InspectToolCall calls the "InspectToolCall" function in
+// registrypb.RegistryClient interface.
+func (c *Client) InspectToolCall() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildInspectToolCallFunc(c.grpccli, c.opts...),
+			EncodeInspectToolCallRequest,
+			DecodeInspectToolCallResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ListHealthTransitions calls the "ListHealthTransitions" function in
+// registrypb.RegistryClient interface.
+func (c *Client) ListHealthTransitions() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListHealthTransitionsFunc(c.grpccli, c.opts...),
+			EncodeListHealthTransitionsRequest,
+			DecodeListHealthTransitionsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// GetToolsetRoutes calls the "GetToolsetRoutes" function in
+// registrypb.RegistryClient interface.
+func (c *Client) GetToolsetRoutes() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetToolsetRoutesFunc(c.grpccli, c.opts...),
+			EncodeGetToolsetRoutesRequest,
+			DecodeGetToolsetRoutesResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// SetToolsetRoutes calls the "SetToolsetRoutes" function in
+// registrypb.RegistryClient interface.
+func (c *Client) SetToolsetRoutes() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildSetToolsetRoutesFunc(c.grpccli, c.opts...),
+			EncodeSetToolsetRoutesRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
@@ -442,3 +442,265 @@ func DecodeClaimToolCallResponse(ctx context.Context, v any, hdr, trlr metadata.
 	res := NewClaimToolCallResult(message)
 	return res, nil
 }
+
+// BuildListProvidersFunc builds the remote method to invoke for "registry"
+// service "ListProviders" endpoint.
+func BuildListProvidersFunc(grpccli registrypb.RegistryClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListProviders(ctx, reqpb.(*registrypb.ListProvidersRequest), opts...)
+		}
+		return grpccli.ListProviders(ctx, &registrypb.ListProvidersRequest{}, opts...)
+	}
+}
+
+// EncodeListProvidersRequest encodes requests sent to registry ListProviders
+// endpoint.
+func EncodeListProvidersRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*registry.ListProvidersPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "ListProviders", "*registry.ListProvidersPayload", v)
+	}
+	return NewProtoListProvidersRequest(payload), nil
+}
+
+// DecodeListProvidersResponse decodes responses from the registry ListProviders
+// endpoint.
+func DecodeListProvidersResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*registrypb.ListProvidersResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "ListProviders", "*registrypb.ListProvidersResponse", v)
+	}
+	if err := ValidateListProvidersResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListProvidersResult(message)
+	return res, nil
+}
+
+// BuildForceDrainProviderFunc builds the remote method to invoke for "registry"
+// service "ForceDrainProvider" endpoint.
+func BuildForceDrainProviderFunc(grpccli registrypb.RegistryClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ForceDrainProvider(ctx, reqpb.(*registrypb.ForceDrainProviderRequest), opts...)
+		}
+		return grpccli.ForceDrainProvider(ctx, &registrypb.ForceDrainProviderRequest{}, opts...)
+	}
+}
+
+// EncodeForceDrainProviderRequest encodes requests sent to registry
+// ForceDrainProvider endpoint.
+func EncodeForceDrainProviderRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*registry.ForceDrainProviderPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "ForceDrainProvider", "*registry.ForceDrainProviderPayload", v)
+	}
+	return NewProtoForceDrainProviderRequest(payload), nil
+}
+
+// BuildRetireRegistrationFunc builds the remote method to invoke for "registry"
+// service "RetireRegistration" endpoint.
+func BuildRetireRegistrationFunc(grpccli registrypb.RegistryClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.RetireRegistration(ctx, reqpb.(*registrypb.RetireRegistrationRequest), opts...)
+		}
+		return grpccli.RetireRegistration(ctx, &registrypb.RetireRegistrationRequest{}, opts...)
+	}
+}
+
+// EncodeRetireRegistrationRequest encodes requests sent to registry
+// RetireRegistration endpoint.
+func EncodeRetireRegistrationRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*registry.RetireRegistrationPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "RetireRegistration", "*registry.RetireRegistrationPayload", v)
+	}
+	return NewProtoRetireRegistrationRequest(payload), nil
+}
+
+// BuildListToolCallsFunc builds the remote method to invoke for "registry"
+// service "ListToolCalls" endpoint.
+func BuildListToolCallsFunc(grpccli registrypb.RegistryClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListToolCalls(ctx, reqpb.(*registrypb.ListToolCallsRequest), opts...)
+		}
+		return grpccli.ListToolCalls(ctx, &registrypb.ListToolCallsRequest{}, opts...)
+	}
+}
+
+// EncodeListToolCallsRequest encodes requests sent to registry ListToolCalls
+// endpoint.
+func EncodeListToolCallsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*registry.ListToolCallsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "ListToolCalls", "*registry.ListToolCallsPayload", v)
+	}
+	return NewProtoListToolCallsRequest(payload), nil
+}
+
+// DecodeListToolCallsResponse decodes responses from the registry ListToolCalls
+// endpoint.
+func DecodeListToolCallsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*registrypb.ListToolCallsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "ListToolCalls", "*registrypb.ListToolCallsResponse", v)
+	}
+	if err := ValidateListToolCallsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListToolCallsResult(message)
+	return res, nil
+}
+
+// BuildInspectToolCallFunc builds the remote method to invoke for "registry"
+// service "InspectToolCall" endpoint.
+func BuildInspectToolCallFunc(grpccli registrypb.RegistryClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.InspectToolCall(ctx, reqpb.(*registrypb.InspectToolCallRequest), opts...)
+		}
+		return grpccli.InspectToolCall(ctx, &registrypb.InspectToolCallRequest{}, opts...)
+	}
+}
+
+// EncodeInspectToolCallRequest encodes requests sent to registry
+// InspectToolCall endpoint.
+func EncodeInspectToolCallRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*registry.InspectToolCallPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "InspectToolCall", "*registry.InspectToolCallPayload", v)
+	}
+	return NewProtoInspectToolCallRequest(payload), nil
+}
+
+// DecodeInspectToolCallResponse decodes responses from the registry
+// InspectToolCall endpoint.
+func DecodeInspectToolCallResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*registrypb.InspectToolCallResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "InspectToolCall", "*registrypb.InspectToolCallResponse", v)
+	}
+	if err := ValidateInspectToolCallResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewInspectToolCallResult(message)
+	return res, nil
+}
+
+// BuildListHealthTransitionsFunc builds the remote method to invoke for
+// "registry" service "ListHealthTransitions" endpoint.
+func BuildListHealthTransitionsFunc(grpccli registrypb.RegistryClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListHealthTransitions(ctx, reqpb.(*registrypb.ListHealthTransitionsRequest), opts...)
+		}
+		return grpccli.ListHealthTransitions(ctx, &registrypb.ListHealthTransitionsRequest{}, opts...)
+	}
+}
+
+// EncodeListHealthTransitionsRequest encodes requests sent to registry
+// ListHealthTransitions endpoint.
+func EncodeListHealthTransitionsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*registry.ListHealthTransitionsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "ListHealthTransitions", "*registry.ListHealthTransitionsPayload", v)
+	}
+	return NewProtoListHealthTransitionsRequest(payload), nil
+}
+
+// DecodeListHealthTransitionsResponse decodes responses from the registry
+// ListHealthTransitions endpoint.
+func DecodeListHealthTransitionsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*registrypb.ListHealthTransitionsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "ListHealthTransitions", "*registrypb.ListHealthTransitionsResponse", v)
+	}
+	if err := ValidateListHealthTransitionsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListHealthTransitionsResult(message)
+	return res, nil
+}
+
+// BuildGetToolsetRoutesFunc builds the remote method to invoke for "registry"
+// service "GetToolsetRoutes" endpoint.
+func BuildGetToolsetRoutesFunc(grpccli registrypb.RegistryClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetToolsetRoutes(ctx, reqpb.(*registrypb.GetToolsetRoutesRequest), opts...)
+		}
+		return grpccli.GetToolsetRoutes(ctx, &registrypb.GetToolsetRoutesRequest{}, opts...)
+	}
+}
+
+// EncodeGetToolsetRoutesRequest encodes requests sent to registry
+// GetToolsetRoutes endpoint.
+func EncodeGetToolsetRoutesRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*registry.GetToolsetRoutesPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "GetToolsetRoutes", "*registry.GetToolsetRoutesPayload", v)
+	}
+	return NewProtoGetToolsetRoutesRequest(payload), nil
+}
+
+// DecodeGetToolsetRoutesResponse decodes responses from the registry
+// GetToolsetRoutes endpoint.
+func DecodeGetToolsetRoutesResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*registrypb.GetToolsetRoutesResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "GetToolsetRoutes", "*registrypb.GetToolsetRoutesResponse", v)
+	}
+	if err := ValidateGetToolsetRoutesResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetToolsetRoutesResult(message)
+	return res, nil
+}
+
+// BuildSetToolsetRoutesFunc builds the remote method to invoke for "registry"
+// service "SetToolsetRoutes" endpoint.
+func BuildSetToolsetRoutesFunc(grpccli registrypb.RegistryClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.SetToolsetRoutes(ctx, reqpb.(*registrypb.SetToolsetRoutesRequest), opts...)
+		}
+		return grpccli.SetToolsetRoutes(ctx, &registrypb.SetToolsetRoutesRequest{}, opts...)
+	}
+}
+
+// EncodeSetToolsetRoutesRequest encodes requests sent to registry
+// SetToolsetRoutes endpoint.
+func EncodeSetToolsetRoutesRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*registry.SetToolsetRoutesPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "SetToolsetRoutes", "*registry.SetToolsetRoutesPayload", v)
+	}
+	return NewProtoSetToolsetRoutesRequest(payload), nil
+}
@@ -201,6 +201,9 @@ func NewGetToolsetResult(message *registrypb.GetToolsetResponse) *registry.Tools
 			}
 		}
 	}
+	if message.Compatibility != nil {
+		result.Compatibility = protobufRegistrypbSchemaCompatibilityToRegistrySchemaCompatibility(message.Compatibility)
+	}
 	return result
 }
 
@@ -368,6 +371,209 @@ func NewClaimToolCallResult(message *registrypb.ClaimToolCallResponse) *registry
 	return result
 }
 
+// NewProtoListProvidersRequest builds the gRPC request type from the payload of
+// the "ListProviders" endpoint of the "registry" service.
+func NewProtoListProvidersRequest(payload *registry.ListProvidersPayload) *registrypb.ListProvidersRequest {
+	message := &registrypb.ListProvidersRequest{
+		Name: payload.Name,
+	}
+	return message
+}
+
+// NewListProvidersResult builds the result type of the "ListProviders" endpoint
+// of the "registry" service from the gRPC response type.
+func NewListProvidersResult(message *registrypb.ListProvidersResponse) *registry.ListProvidersResult {
+	result := &registry.ListProvidersResult{
+		Name:              message.Name,
+		State:             message.State,
+		RegistrationToken: message.RegistrationToken,
+		HealthEpoch:       message.HealthEpoch,
+		LastPongUnixMilli: message.LastPongUnixMilli,
+		Healthy:           message.Healthy,
+	}
+	if message.Providers != nil {
+		result.Providers = make([]*registry.ProviderLeaseInfo, len(message.Providers))
+		for i, val := range message.Providers {
+			result.Providers[i] = &registry.ProviderLeaseInfo{
+				ProviderID:              val.ProviderId,
+				ProviderIncarnationID:   val.ProviderIncarnationId,
+				LeaseExpiresAtUnixMilli: val.LeaseExpiresAtUnixMilli,
+				Draining:                val.Draining,
+				ClaimedCalls:            val.ClaimedCalls,
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoForceDrainProviderRequest builds the gRPC request type from the
+// payload of the "ForceDrainProvider" endpoint of the "registry" service.
+func NewProtoForceDrainProviderRequest(payload *registry.ForceDrainProviderPayload) *registrypb.ForceDrainProviderRequest {
+	message := &registrypb.ForceDrainProviderRequest{
+		Name:                  payload.Name,
+		ProviderId:            payload.ProviderID,
+		ProviderIncarnationId: payload.ProviderIncarnationID,
+		RegistrationToken:     payload.RegistrationToken,
+	}
+	return message
+}
+
+// NewProtoRetireRegistrationRequest builds the gRPC request type from the
+// payload of the "RetireRegistration" endpoint of the "registry" service.
+func NewProtoRetireRegistrationRequest(payload *registry.RetireRegistrationPayload) *registrypb.RetireRegistrationRequest {
+	message := &registrypb.RetireRegistrationRequest{
+		Name:              payload.Name,
+		RegistrationToken: payload.RegistrationToken,
+	}
+	return message
+}
+
+// NewProtoListToolCallsRequest builds the gRPC request type from the payload of
+// the "ListToolCalls" endpoint of the "registry" service.
+func NewProtoListToolCallsRequest(payload *registry.ListToolCallsPayload) *registrypb.ListToolCallsRequest {
+	message := &registrypb.ListToolCallsRequest{
+		Toolset: payload.Toolset,
+	}
+	limit := int32(payload.Limit)
+	message.Limit = &limit
+	return message
+}
+
+// NewListToolCallsResult builds the result type of the "ListToolCalls" endpoint
+// of the "registry" service from the gRPC response type.
+func NewListToolCallsResult(message *registrypb.ListToolCallsResponse) *registry.ListToolCallsResult {
+	result := &registry.ListToolCallsResult{}
+	if message.Calls != nil {
+		result.Calls = make([]*registry.ToolCallInfo, len(message.Calls))
+		for i, val := range message.Calls {
+			result.Calls[i] = &registry.ToolCallInfo{
+				ToolUseID:                  val.ToolUseId,
+				State:                      val.State,
+				RegistrationToken:          val.RegistrationToken,
+				ExecutionDeadlineUnixMilli: val.ExecutionDeadlineUnixMilli,
+				ExpiresAtUnixMilli:         val.ExpiresAtUnixMilli,
+				ProviderID:                 val.ProviderId,
+				ProviderIncarnationID:      val.ProviderIncarnationId,
+				RequestEventID:             val.RequestEventId,
+				TerminalCause:              val.TerminalCause,
+				OutputDeltaCount:           val.OutputDeltaCount,
+				ResultStreamID:             val.ResultStreamId,
+				ResultStreamLength:         val.ResultStreamLength,
+				LastEventID:                val.LastEventId,
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoInspectToolCallRequest builds the gRPC request type from the payload
+// of the "InspectToolCall" endpoint of the "registry" service.
+func NewProtoInspectToolCallRequest(payload *registry.InspectToolCallPayload) *registrypb.InspectToolCallRequest {
+	message := &registrypb.InspectToolCallRequest{
+		Toolset:   payload.Toolset,
+		ToolUseId: payload.ToolUseID,
+	}
+	return message
+}
+
+// NewInspectToolCallResult builds the result type of the "InspectToolCall"
+// endpoint of the "registry" service from the gRPC response type.
+func NewInspectToolCallResult(message *registrypb.InspectToolCallResponse) *registry.ToolCallInfo {
+	result := &registry.ToolCallInfo{
+		ToolUseID:                  message.ToolUseId,
+		State:                      message.State,
+		RegistrationToken:          message.RegistrationToken,
+		ExecutionDeadlineUnixMilli: message.ExecutionDeadlineUnixMilli,
+		ExpiresAtUnixMilli:         message.ExpiresAtUnixMilli,
+		ProviderID:                 message.ProviderId,
+		ProviderIncarnationID:      message.ProviderIncarnationId,
+		RequestEventID:             message.RequestEventId,
+		TerminalCause:              message.TerminalCause,
+		OutputDeltaCount:           message.OutputDeltaCount,
+		ResultStreamID:             message.ResultStreamId,
+		ResultStreamLength:         message.ResultStreamLength,
+		LastEventID:                message.LastEventId,
+	}
+	return result
+}
+
+// NewProtoListHealthTransitionsRequest builds the gRPC request type from the
+// payload of the "ListHealthTransitions" endpoint of the "registry" service.
+func NewProtoListHealthTransitionsRequest(payload *registry.ListHealthTransitionsPayload) *registrypb.ListHealthTransitionsRequest {
+	message := &registrypb.ListHealthTransitionsRequest{
+		Name: payload.Name,
+	}
+	limit := int32(payload.Limit)
+	message.Limit = &limit
+	return message
+}
+
+// NewListHealthTransitionsResult builds the result type of the
+// "ListHealthTransitions" endpoint of the "registry" service from the gRPC
+// response type.
+func NewListHealthTransitionsResult(message *registrypb.ListHealthTransitionsResponse) *registry.ListHealthTransitionsResult {
+	result := &registry.ListHealthTransitionsResult{}
+	if message.Transitions != nil {
+		result.Transitions = make([]*registry.HealthTransition, len(message.Transitions))
+		for i, val := range message.Transitions {
+			result.Transitions[i] = &registry.HealthTransition{
+				AtUnixMilli:       val.AtUnixMilli,
+				Healthy:           val.Healthy,
+				RegistrationToken: val.RegistrationToken,
+				HealthEpoch:       val.HealthEpoch,
+				ProviderCount:     int(val.ProviderCount),
+				LastPongUnixMilli: val.LastPongUnixMilli,
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoGetToolsetRoutesRequest builds the gRPC request type from the
+// payload of the "GetToolsetRoutes" endpoint of the "registry" service.
+func NewProtoGetToolsetRoutesRequest(payload *registry.GetToolsetRoutesPayload) *registrypb.GetToolsetRoutesRequest {
+	message := &registrypb.GetToolsetRoutesRequest{
+		Name: payload.Name,
+	}
+	return message
+}
+
+// NewGetToolsetRoutesResult builds the result type of the "GetToolsetRoutes"
+// endpoint of the "registry" service from the gRPC response type.
+func NewGetToolsetRoutesResult(message *registrypb.GetToolsetRoutesResponse) *registry.ToolsetRoutesResult {
+	result := &registry.ToolsetRoutesResult{
+		Name: message.Name,
+	}
+	if message.Routes != nil {
+		result.Routes = make([]*registry.ToolsetRoute, len(message.Routes))
+		for i, val := range message.Routes {
+			result.Routes[i] = &registry.ToolsetRoute{
+				Version: registry.SemVer(val.Version),
+				Weight:  int(val.Weight),
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoSetToolsetRoutesRequest builds the gRPC request type from the
+// payload of the "SetToolsetRoutes" endpoint of the "registry" service.
+func NewProtoSetToolsetRoutesRequest(payload *registry.SetToolsetRoutesPayload) *registrypb.SetToolsetRoutesRequest {
+	message := &registrypb.SetToolsetRoutesRequest{
+		Name: payload.Name,
+	}
+	if payload.Routes != nil {
+		message.Routes = make([]*registrypb.ToolsetRoute, len(payload.Routes))
+		for i, val := range payload.Routes {
+			message.Routes[i] = &registrypb.ToolsetRoute{
+				Version: string(val.Version),
+				Weight:  int32(val.Weight),
+			}
+		}
+	}
+	return message
+}
+
 // ValidateToolSchema runs the validations defined on ToolSchema.
 func ValidateToolSchema(elem *registrypb.ToolSchema) (err error) {
 	if utf8.RuneCountInString(elem.Name) < 1 {
@@ -452,6 +658,38 @@ func ValidateGetToolsetResponse(message *registrypb.GetToolsetResponse) (err err
 		}
 	}
 	err = goa.MergeErrors(err, goa.ValidateFormat("message.registered_at", message.RegisteredAt, goa.FormatDateTime))
+	if message.Compatibility != nil {
+		if err2 := ValidateSchemaCompatibility(message.Compatibility); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateSchemaCompatibility runs the validations defined on
+// SchemaCompatibility.
+func ValidateSchemaCompatibility(compatibility *registrypb.SchemaCompatibility) (err error) {
+	if compatibility.Changes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("changes", "compatibility"))
+	}
+	if compatibility.BaseVersion != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("compatibility.base_version", string(*compatibility.BaseVersion), "^v?\\d+\\.\\d+\\.\\d+(-[a-zA-Z0-9.]+)?$"))
+	}
+	for _, e := range compatibility.Changes {
+		if e != nil {
+			if err2 := ValidateSchemaChange(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSchemaChange runs the validations defined on SchemaChange.
+func ValidateSchemaChange(elem *registrypb.SchemaChange) (err error) {
+	if !(elem.Kind == "tool_added" || elem.Kind == "tool_removed" || elem.Kind == "property_added" || elem.Kind == "property_removed" || elem.Kind == "required_added" || elem.Kind == "required_removed" || elem.Kind == "type_narrowed" || elem.Kind == "type_widened" || elem.Kind == "enum_narrowed" || elem.Kind == "enum_widened") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.kind", elem.Kind, []any{"tool_added", "tool_removed", "property_added", "property_removed", "required_added", "required_removed", "type_narrowed", "type_widened", "enum_narrowed", "enum_widened"}))
+	}
 	return
 }
 
@@ -504,6 +742,141 @@ func ValidateClaimToolCallResponse(message *registrypb.ClaimToolCallResponse) (e
 	return
 }
 
+// ValidateListProvidersResponse runs the validations defined on
+// ListProvidersResponse.
+func ValidateListProvidersResponse(message *registrypb.ListProvidersResponse) (err error) {
+	if message.Providers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("providers", "message"))
+	}
+	if !(message.State == "active" || message.State == "retired") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.state", message.State, []any{"active", "retired"}))
+	}
+	for _, e := range message.Providers {
+		if e != nil {
+			if err2 := ValidateProviderLeaseInfo(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateProviderLeaseInfo runs the validations defined on ProviderLeaseInfo.
+func ValidateProviderLeaseInfo(elem *registrypb.ProviderLeaseInfo) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.provider_incarnation_id", elem.ProviderIncarnationId, goa.FormatUUID))
+	if elem.ClaimedCalls < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("elem.claimed_calls", elem.ClaimedCalls, 0, true))
+	}
+	return
+}
+
+// ValidateListToolCallsResponse runs the validations defined on
+// ListToolCallsResponse.
+func ValidateListToolCallsResponse(message *registrypb.ListToolCallsResponse) (err error) {
+	if message.Calls == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("calls", "message"))
+	}
+	for _, e := range message.Calls {
+		if e != nil {
+			if err2 := ValidateToolCallInfo(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateToolCallInfo runs the validations defined on ToolCallInfo.
+func ValidateToolCallInfo(elem *registrypb.ToolCallInfo) (err error) {
+	if !(elem.State == "admitted" || elem.State == "queued" || elem.State == "claimed" || elem.State == "terminal") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.state", elem.State, []any{"admitted", "queued", "claimed", "terminal"}))
+	}
+	if elem.TerminalCause != nil {
+		if !(*elem.TerminalCause == "provider" || *elem.TerminalCause == "execution_deadline" || *elem.TerminalCause == "stale_admission" || *elem.TerminalCause == "provider_lease_lost" || *elem.TerminalCause == "provider_lease_released") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.terminal_cause", *elem.TerminalCause, []any{"provider", "execution_deadline", "stale_admission", "provider_lease_lost", "provider_lease_released"}))
+		}
+	}
+	if elem.OutputDeltaCount < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("elem.output_delta_count", elem.OutputDeltaCount, 0, true))
+	}
+	if elem.ResultStreamLength < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("elem.result_stream_length", elem.ResultStreamLength, 0, true))
+	}
+	return
+}
+
+// ValidateInspectToolCallResponse runs the validations defined on
+// InspectToolCallResponse.
+func ValidateInspectToolCallResponse(message *registrypb.InspectToolCallResponse) (err error) {
+	if !(message.State == "admitted" || message.State == "queued" || message.State == "claimed" || message.State == "terminal") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.state", message.State, []any{"admitted", "queued", "claimed", "terminal"}))
+	}
+	if message.TerminalCause != nil {
+		if !(*message.TerminalCause == "provider" || *message.TerminalCause == "execution_deadline" || *message.TerminalCause == "stale_admission" || *message.TerminalCause == "provider_lease_lost" || *message.TerminalCause == "provider_lease_released") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.terminal_cause", *message.TerminalCause, []any{"provider", "execution_deadline", "stale_admission", "provider_lease_lost", "provider_lease_released"}))
+		}
+	}
+	if message.OutputDeltaCount < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.output_delta_count", message.OutputDeltaCount, 0, true))
+	}
+	if message.ResultStreamLength < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("message.result_stream_length", message.ResultStreamLength, 0, true))
+	}
+	return
+}
+
+// ValidateListHealthTransitionsResponse runs the validations defined on
+// ListHealthTransitionsResponse.
+func ValidateListHealthTransitionsResponse(message *registrypb.ListHealthTransitionsResponse) (err error) {
+	if message.Transitions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transitions", "message"))
+	}
+	for _, e := range message.Transitions {
+		if e != nil {
+			if err2 := ValidateHealthTransition(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateHealthTransition runs the validations defined on HealthTransition.
+func ValidateHealthTransition(elem *registrypb.HealthTransition) (err error) {
+	if elem.ProviderCount < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("elem.provider_count", elem.ProviderCount, 0, true))
+	}
+	return
+}
+
+// ValidateGetToolsetRoutesResponse runs the validations defined on
+// GetToolsetRoutesResponse.
+func ValidateGetToolsetRoutesResponse(message *registrypb.GetToolsetRoutesResponse) (err error) {
+	if message.Routes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("routes", "message"))
+	}
+	for _, e := range message.Routes {
+		if e != nil {
+			if err2 := ValidateToolsetRoute(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateToolsetRoute runs the validations defined on ToolsetRoute.
+func ValidateToolsetRoute(elem *registrypb.ToolsetRoute) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("elem.version", string(elem.Version), "^v?\\d+\\.\\d+\\.\\d+(-[a-zA-Z0-9.]+)?$"))
+	if elem.Weight < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("elem.weight", elem.Weight, 0, true))
+	}
+	if elem.Weight > 10000 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("elem.weight", elem.Weight, 10000, false))
+	}
+	return
+}
+
 // protobufRegistrypbToolCallMetaToRegistryToolCallMeta builds a value of type
 // *registry.ToolCallMeta from a value of type *registrypb.ToolCallMeta.
 func protobufRegistrypbToolCallMetaToRegistryToolCallMeta(v *registrypb.ToolCallMeta) *registry.ToolCallMeta {
@@ -531,3 +904,49 @@ func svcRegistryToolCallMetaToRegistrypbToolCallMeta(v *registry.ToolCallMeta) *
 
 	return res
 }
+
+// protobufRegistrypbSchemaCompatibilityToRegistrySchemaCompatibility builds a
+// value of type *registry.SchemaCompatibility from a value of type
+// *registrypb.SchemaCompatibility.
+func protobufRegistrypbSchemaCompatibilityToRegistrySchemaCompatibility(v *registrypb.SchemaCompatibility) *registry.SchemaCompatibility {
+	if v == nil {
+		return nil
+	}
+	res := &registry.SchemaCompatibility{
+		BaseName: v.BaseName,
+		Breaking: v.Breaking,
+	}
+	if v.BaseVersion != nil {
+		baseVersion := registry.SemVer(*v.BaseVersion)
+		res.BaseVersion = &baseVersion
+	}
+	if v.Changes != nil {
+		res.Changes = make([]*registry.SchemaChange, len(v.Changes))
+		for i, val := range v.Changes {
+			if val == nil {
+				res.Changes[i] = nil
+				continue
+			}
+			res.Changes[i] = protobufRegistrypbSchemaChangeToRegistrySchemaChange(val)
+		}
+	}
+
+	return res
+}
+
+// protobufRegistrypbSchemaChangeToRegistrySchemaChange builds a value of type
+// *registry.SchemaChange from a value of type *registrypb.SchemaChange.
+func protobufRegistrypbSchemaChangeToRegistrySchemaChange(v *registrypb.SchemaChange) *registry.SchemaChange {
+	if v == nil {
+		return nil
+	}
+	res := &registry.SchemaChange{
+		Tool:     v.Tool,
+		Kind:     v.Kind,
+		Path:     v.Path,
+		Breaking: v.Breaking,
+		Detail:   v.Detail,
+	}
+
+	return res
+}
@@ -969,7 +969,10 @@ type GetToolsetResponse struct {
 	// Tool schemas included in the toolset.
 	Tools []*ToolSchema `protobuf:"bytes,5,rep,name=tools,proto3" json:"tools,omitempty"`
 	// ISO 8601 registration timestamp
-	RegisteredAt  string `protobuf:"bytes,6,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	RegisteredAt string `protobuf:"bytes,6,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	// Schema changes relative to the registration this admission replaced or
+	// extends. Absent for the first registration of a toolset.
+	Compatibility *SchemaCompatibility `protobuf:"bytes,7,opt,name=compatibility,proto3,oneof" json:"compatibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1046,6 +1049,171 @@ func (x *GetToolsetResponse) GetRegisteredAt() string {
 	return ""
 }
 
+func (x *GetToolsetResponse) GetCompatibility() *SchemaCompatibility {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+// Classified tool schema changes between an admission and its baseline
+// registration
+type SchemaCompatibility struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Catalog name of the baseline registration: the replaced admission, or the
+	// highest lower version of a versioned toolset
+	BaseName string `protobuf:"bytes,1,opt,name=base_name,json=baseName,proto3" json:"base_name,omitempty"`
+	// Semantic version of the baseline registration
+	BaseVersion *string `protobuf:"bytes,2,opt,name=base_version,json=baseVersion,proto3,oneof" json:"base_version,omitempty"`
+	// Whether any change can break agents built against the baseline
+	Breaking bool `protobuf:"varint,3,opt,name=breaking,proto3" json:"breaking,omitempty"`
+	// Classified changes, ordered by tool and path
+	Changes       []*SchemaChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaCompatibility) Reset() {
+	*x = SchemaCompatibility{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaCompatibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaCompatibility) ProtoMessage() {}
+
+func (x *SchemaCompatibility) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaCompatibility.ProtoReflect.Descriptor instead.
+func (*SchemaCompatibility) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SchemaCompatibility) GetBaseName() string {
+	if x != nil {
+		return x.BaseName
+	}
+	return ""
+}
+
+func (x *SchemaCompatibility) GetBaseVersion() string {
+	if x != nil && x.BaseVersion != nil {
+		return *x.BaseVersion
+	}
+	return ""
+}
+
+func (x *SchemaCompatibility) GetBreaking() bool {
+	if x != nil {
+		return x.Breaking
+	}
+	return false
+}
+
+func (x *SchemaCompatibility) GetChanges() []*SchemaChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// One classified tool schema change
+type SchemaChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tool whose schema changed
+	Tool string `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	// Change classification
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Affected field: payload or result followed by the property path, with []
+	// for array items
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Whether the change can break agents built against the baseline
+	Breaking bool `protobuf:"varint,4,opt,name=breaking,proto3" json:"breaking,omitempty"`
+	// Human-readable description of the change
+	Detail        string `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaChange) Reset() {
+	*x = SchemaChange{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaChange) ProtoMessage() {}
+
+func (x *SchemaChange) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaChange.ProtoReflect.Descriptor instead.
+func (*SchemaChange) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SchemaChange) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *SchemaChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SchemaChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SchemaChange) GetBreaking() bool {
+	if x != nil {
+		return x.Breaking
+	}
+	return false
+}
+
+func (x *SchemaChange) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
 type SearchRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Search query string
@@ -1056,7 +1224,7 @@ type SearchRequest struct {
 
 func (x *SearchRequest) Reset() {
 	*x = SearchRequest{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[16]
+	mi := &file_goagen_registry_registry_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1068,7 +1236,7 @@ func (x *SearchRequest) String() string {
 func (*SearchRequest) ProtoMessage() {}
 
 func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[16]
+	mi := &file_goagen_registry_registry_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1081,7 +1249,7 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{16}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SearchRequest) GetQuery() string {
@@ -1101,7 +1269,7 @@ type SearchResponse struct {
 
 func (x *SearchResponse) Reset() {
 	*x = SearchResponse{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[17]
+	mi := &file_goagen_registry_registry_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1113,7 +1281,7 @@ func (x *SearchResponse) String() string {
 func (*SearchResponse) ProtoMessage() {}
 
 func (x *SearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[17]
+	mi := &file_goagen_registry_registry_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1126,7 +1294,7 @@ func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
 func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{17}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SearchResponse) GetToolsets() []*ToolsetInfo {
@@ -1158,7 +1326,7 @@ type CallToolRequest struct {
 
 func (x *CallToolRequest) Reset() {
 	*x = CallToolRequest{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[18]
+	mi := &file_goagen_registry_registry_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1170,7 +1338,7 @@ func (x *CallToolRequest) String() string {
 func (*CallToolRequest) ProtoMessage() {}
 
 func (x *CallToolRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[18]
+	mi := &file_goagen_registry_registry_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,7 +1351,7 @@ func (x *CallToolRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallToolRequest.ProtoReflect.Descriptor instead.
 func (*CallToolRequest) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{18}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CallToolRequest) GetToolset() string {
@@ -1241,7 +1409,7 @@ type ToolCallMeta struct {
 
 func (x *ToolCallMeta) Reset() {
 	*x = ToolCallMeta{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[19]
+	mi := &file_goagen_registry_registry_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1253,7 +1421,7 @@ func (x *ToolCallMeta) String() string {
 func (*ToolCallMeta) ProtoMessage() {}
 
 func (x *ToolCallMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[19]
+	mi := &file_goagen_registry_registry_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1266,7 +1434,7 @@ func (x *ToolCallMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCallMeta.ProtoReflect.Descriptor instead.
 func (*ToolCallMeta) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{19}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ToolCallMeta) GetRunId() string {
@@ -1322,7 +1490,7 @@ type CallToolResponse struct {
 
 func (x *CallToolResponse) Reset() {
 	*x = CallToolResponse{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[20]
+	mi := &file_goagen_registry_registry_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1334,7 +1502,7 @@ func (x *CallToolResponse) String() string {
 func (*CallToolResponse) ProtoMessage() {}
 
 func (x *CallToolResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[20]
+	mi := &file_goagen_registry_registry_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1515,7 @@ func (x *CallToolResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallToolResponse.ProtoReflect.Descriptor instead.
 func (*CallToolResponse) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{20}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CallToolResponse) GetToolUseId() string {
@@ -1402,7 +1570,7 @@ type RetryToolRequest struct {
 
 func (x *RetryToolRequest) Reset() {
 	*x = RetryToolRequest{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[21]
+	mi := &file_goagen_registry_registry_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1414,7 +1582,7 @@ func (x *RetryToolRequest) String() string {
 func (*RetryToolRequest) ProtoMessage() {}
 
 func (x *RetryToolRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[21]
+	mi := &file_goagen_registry_registry_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1427,7 +1595,7 @@ func (x *RetryToolRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetryToolRequest.ProtoReflect.Descriptor instead.
 func (*RetryToolRequest) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{21}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RetryToolRequest) GetExpectedRegistrationToken() string {
@@ -1490,7 +1658,7 @@ type RetryToolResponse struct {
 
 func (x *RetryToolResponse) Reset() {
 	*x = RetryToolResponse{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[22]
+	mi := &file_goagen_registry_registry_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1502,7 +1670,7 @@ func (x *RetryToolResponse) String() string {
 func (*RetryToolResponse) ProtoMessage() {}
 
 func (x *RetryToolResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[22]
+	mi := &file_goagen_registry_registry_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1515,7 +1683,7 @@ func (x *RetryToolResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetryToolResponse.ProtoReflect.Descriptor instead.
 func (*RetryToolResponse) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{22}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RetryToolResponse) GetToolUseId() string {
@@ -1570,7 +1738,7 @@ type CompleteToolCallRequest struct {
 
 func (x *CompleteToolCallRequest) Reset() {
 	*x = CompleteToolCallRequest{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[23]
+	mi := &file_goagen_registry_registry_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1582,7 +1750,7 @@ func (x *CompleteToolCallRequest) String() string {
 func (*CompleteToolCallRequest) ProtoMessage() {}
 
 func (x *CompleteToolCallRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[23]
+	mi := &file_goagen_registry_registry_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1595,7 +1763,7 @@ func (x *CompleteToolCallRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompleteToolCallRequest.ProtoReflect.Descriptor instead.
 func (*CompleteToolCallRequest) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{23}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CompleteToolCallRequest) GetToolset() string {
@@ -1662,7 +1830,7 @@ type CompleteToolCallResponse struct {
 
 func (x *CompleteToolCallResponse) Reset() {
 	*x = CompleteToolCallResponse{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[24]
+	mi := &file_goagen_registry_registry_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1674,7 +1842,7 @@ func (x *CompleteToolCallResponse) String() string {
 func (*CompleteToolCallResponse) ProtoMessage() {}
 
 func (x *CompleteToolCallResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[24]
+	mi := &file_goagen_registry_registry_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1687,7 +1855,7 @@ func (x *CompleteToolCallResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompleteToolCallResponse.ProtoReflect.Descriptor instead.
 func (*CompleteToolCallResponse) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{24}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{26}
 }
 
 type PublishToolOutputDeltaRequest struct {
@@ -1716,7 +1884,7 @@ type PublishToolOutputDeltaRequest struct {
 
 func (x *PublishToolOutputDeltaRequest) Reset() {
 	*x = PublishToolOutputDeltaRequest{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[25]
+	mi := &file_goagen_registry_registry_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1728,7 +1896,7 @@ func (x *PublishToolOutputDeltaRequest) String() string {
 func (*PublishToolOutputDeltaRequest) ProtoMessage() {}
 
 func (x *PublishToolOutputDeltaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[25]
+	mi := &file_goagen_registry_registry_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1741,7 +1909,7 @@ func (x *PublishToolOutputDeltaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishToolOutputDeltaRequest.ProtoReflect.Descriptor instead.
 func (*PublishToolOutputDeltaRequest) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{25}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *PublishToolOutputDeltaRequest) GetStream() string {
@@ -1815,7 +1983,7 @@ type PublishToolOutputDeltaResponse struct {
 
 func (x *PublishToolOutputDeltaResponse) Reset() {
 	*x = PublishToolOutputDeltaResponse{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[26]
+	mi := &file_goagen_registry_registry_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1827,7 +1995,7 @@ func (x *PublishToolOutputDeltaResponse) String() string {
 func (*PublishToolOutputDeltaResponse) ProtoMessage() {}
 
 func (x *PublishToolOutputDeltaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[26]
+	mi := &file_goagen_registry_registry_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +2008,7 @@ func (x *PublishToolOutputDeltaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishToolOutputDeltaResponse.ProtoReflect.Descriptor instead.
 func (*PublishToolOutputDeltaResponse) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{26}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{28}
 }
 
 type ReportToolCallOverloadRequest struct {
@@ -1865,7 +2033,7 @@ type ReportToolCallOverloadRequest struct {
 
 func (x *ReportToolCallOverloadRequest) Reset() {
 	*x = ReportToolCallOverloadRequest{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[27]
+	mi := &file_goagen_registry_registry_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1877,7 +2045,7 @@ func (x *ReportToolCallOverloadRequest) String() string {
 func (*ReportToolCallOverloadRequest) ProtoMessage() {}
 
 func (x *ReportToolCallOverloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[27]
+	mi := &file_goagen_registry_registry_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,7 +2058,7 @@ func (x *ReportToolCallOverloadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportToolCallOverloadRequest.ProtoReflect.Descriptor instead.
 func (*ReportToolCallOverloadRequest) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{27}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ReportToolCallOverloadRequest) GetToolset() string {
@@ -1950,7 +2118,7 @@ type ReportToolCallOverloadResponse struct {
 
 func (x *ReportToolCallOverloadResponse) Reset() {
 	*x = ReportToolCallOverloadResponse{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[28]
+	mi := &file_goagen_registry_registry_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1962,7 +2130,7 @@ func (x *ReportToolCallOverloadResponse) String() string {
 func (*ReportToolCallOverloadResponse) ProtoMessage() {}
 
 func (x *ReportToolCallOverloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[28]
+	mi := &file_goagen_registry_registry_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1975,7 +2143,7 @@ func (x *ReportToolCallOverloadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportToolCallOverloadResponse.ProtoReflect.Descriptor instead.
 func (*ReportToolCallOverloadResponse) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{28}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{30}
 }
 
 type ClaimToolCallRequest struct {
@@ -2000,7 +2168,7 @@ type ClaimToolCallRequest struct {
 
 func (x *ClaimToolCallRequest) Reset() {
 	*x = ClaimToolCallRequest{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[29]
+	mi := &file_goagen_registry_registry_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2012,7 +2180,7 @@ func (x *ClaimToolCallRequest) String() string {
 func (*ClaimToolCallRequest) ProtoMessage() {}
 
 func (x *ClaimToolCallRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[29]
+	mi := &file_goagen_registry_registry_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2025,7 +2193,7 @@ func (x *ClaimToolCallRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimToolCallRequest.ProtoReflect.Descriptor instead.
 func (*ClaimToolCallRequest) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{29}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ClaimToolCallRequest) GetToolset() string {
@@ -2090,7 +2258,7 @@ type ClaimToolCallResponse struct {
 
 func (x *ClaimToolCallResponse) Reset() {
 	*x = ClaimToolCallResponse{}
-	mi := &file_goagen_registry_registry_proto_msgTypes[30]
+	mi := &file_goagen_registry_registry_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2102,7 +2270,7 @@ func (x *ClaimToolCallResponse) String() string {
 func (*ClaimToolCallResponse) ProtoMessage() {}
 
 func (x *ClaimToolCallResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_registry_registry_proto_msgTypes[30]
+	mi := &file_goagen_registry_registry_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2115,7 +2283,7 @@ func (x *ClaimToolCallResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimToolCallResponse.ProtoReflect.Descriptor instead.
 func (*ClaimToolCallResponse) Descriptor() ([]byte, []int) {
-	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{30}
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ClaimToolCallResponse) GetDisposition() string {
@@ -2125,69 +2293,1397 @@ func (x *ClaimToolCallResponse) GetDisposition() string {
 	return ""
 }
 
-var File_goagen_registry_registry_proto protoreflect.FileDescriptor
+type ListProvidersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the toolset
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_goagen_registry_registry_proto_rawDesc = "" +
-	"\n" +
-	"\x1egoagen_registry_registry.proto\x12\x0fgoa_ai_registry\"\x8a\x03\n" +
-	"\x0fRegisterRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
-	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
-	"\aversion\x18\x03 \x01(\tH\x01R\aversion\x88\x01\x01\x12\x12\n" +
-	"\x04tags\x18\x04 \x03(\tR\x04tags\x121\n" +
-	"\x05tools\x18\x05 \x03(\v2\x1b.goa_ai_registry.ToolSchemaR\x05tools\x12\x1f\n" +
-	"\vprovider_id\x18\x06 \x01(\tR\n" +
-	"providerId\x12-\n" +
-	"\x12admission_revision\x18\a \x01(\tR\x11admissionRevision\x126\n" +
-	"\x17provider_incarnation_id\x18\b \x01(\tR\x15providerIncarnationId\x122\n" +
-	"\x15wire_protocol_version\x18\t \x01(\x11R\x13wireProtocolVersionB\x0e\n" +
-	"\f_descriptionB\n" +
-	"\n" +
-	"\b_version\"\xf6\x01\n" +
-	"\n" +
-	"ToolSchema\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
-	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x12\n" +
-	"\x04tags\x18\x03 \x03(\tR\x04tags\x12%\n" +
-	"\x0epayload_schema\x18\x04 \x01(\fR\rpayloadSchema\x12#\n" +
-	"\rresult_schema\x18\x05 \x01(\fR\fresultSchema\x12*\n" +
-	"\x0esidecar_schema\x18\x06 \x01(\fH\x01R\rsidecarSchema\x88\x01\x01B\x0e\n" +
-	"\f_descriptionB\x11\n" +
-	"\x0f_sidecar_schema\"\x92\x01\n" +
-	"\x10RegisterResponse\x12#\n" +
-	"\rregistered_at\x18\x01 \x01(\tR\fregisteredAt\x12-\n" +
-	"\x12registration_token\x18\x02 \x01(\tR\x11registrationToken\x12*\n" +
-	"\x11lease_duration_ms\x18\x03 \x01(\x12R\x0fleaseDurationMs\"\xc5\x01\n" +
-	"\x16ReleaseProviderRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
-	"\vprovider_id\x18\x02 \x01(\tR\n" +
-	"providerId\x12>\n" +
-	"\x1bexpected_registration_token\x18\x03 \x01(\tR\x19expectedRegistrationToken\x126\n" +
-	"\x17provider_incarnation_id\x18\x04 \x01(\tR\x15providerIncarnationId\"\x19\n" +
-	"\x17ReleaseProviderResponse\"\xf9\x01\n" +
-	"\x14DrainProviderRequest\x124\n" +
-	"\x16settlement_duration_ms\x18d \x01(\x12R\x14settlementDurationMs\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
-	"\vprovider_id\x18\x02 \x01(\tR\n" +
-	"providerId\x12>\n" +
-	"\x1bexpected_registration_token\x18\x03 \x01(\tR\x19expectedRegistrationToken\x126\n" +
-	"\x17provider_incarnation_id\x18\x04 \x01(\tR\x15providerIncarnationId\"\x17\n" +
-	"\x15DrainProviderResponse\"g\n" +
-	"\x11UnregisterRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12>\n" +
-	"\x1bexpected_registration_token\x18\x02 \x01(\tR\x19expectedRegistrationToken\"\x14\n" +
-	"\x12UnregisterResponse\"\x99\x01\n" +
-	"\vPongRequest\x12\x17\n" +
-	"\aping_id\x18\x01 \x01(\tR\x06pingId\x12\x18\n" +
-	"\atoolset\x18\x02 \x01(\tR\atoolset\x12\x1f\n" +
-	"\vprovider_id\x18\x03 \x01(\tR\n" +
-	"providerId\x126\n" +
-	"\x17provider_incarnation_id\x18\x04 \x01(\tR\x15providerIncarnationId\"\x0e\n" +
-	"\fPongResponse\")\n" +
-	"\x13ListToolsetsRequest\x12\x12\n" +
-	"\x04tags\x18\x01 \x03(\tR\x04tags\"P\n" +
-	"\x14ListToolsetsResponse\x128\n" +
-	"\btoolsets\x18\x01 \x03(\v2\x1c.goa_ai_registry.ToolsetInfoR\btoolsets\"\xdb\x01\n" +
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersRequest) ProtoMessage() {}
+
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListProvidersRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListProvidersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the toolset
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Admission state of the catalog record
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Admission-generation token of the catalog record
+	RegistrationToken string `protobuf:"bytes,3,opt,name=registration_token,json=registrationToken,proto3" json:"registration_token,omitempty"`
+	// Provider membership epoch; it advances each time the toolset regains a
+	// routable provider after having none
+	HealthEpoch uint64 `protobuf:"varint,4,opt,name=health_epoch,json=healthEpoch,proto3" json:"health_epoch,omitempty"`
+	// Redis time of the last pong recorded in the current health epoch, in Unix
+	// milliseconds
+	LastPongUnixMilli *int64 `protobuf:"zigzag64,5,opt,name=last_pong_unix_milli,json=lastPongUnixMilli,proto3,oneof" json:"last_pong_unix_milli,omitempty"`
+	// Whether routed calls currently find a healthy provider
+	Healthy bool `protobuf:"varint,6,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Unexpired provider-incarnation leases
+	Providers     []*ProviderLeaseInfo `protobuf:"bytes,7,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProvidersResponse) Reset() {
+	*x = ListProvidersResponse{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersResponse) ProtoMessage() {}
+
+func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListProvidersResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListProvidersResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListProvidersResponse) GetRegistrationToken() string {
+	if x != nil {
+		return x.RegistrationToken
+	}
+	return ""
+}
+
+func (x *ListProvidersResponse) GetHealthEpoch() uint64 {
+	if x != nil {
+		return x.HealthEpoch
+	}
+	return 0
+}
+
+func (x *ListProvidersResponse) GetLastPongUnixMilli() int64 {
+	if x != nil && x.LastPongUnixMilli != nil {
+		return *x.LastPongUnixMilli
+	}
+	return 0
+}
+
+func (x *ListProvidersResponse) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *ListProvidersResponse) GetProviders() []*ProviderLeaseInfo {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// One provider-incarnation lease of an admission
+type ProviderLeaseInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stable identity of the provider process
+	ProviderId string `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// Runtime-generated UUID of the Serve lifecycle holding the lease
+	ProviderIncarnationId string `protobuf:"bytes,2,opt,name=provider_incarnation_id,json=providerIncarnationId,proto3" json:"provider_incarnation_id,omitempty"`
+	// Redis time at which the lease expires, in Unix milliseconds
+	LeaseExpiresAtUnixMilli int64 `protobuf:"zigzag64,3,opt,name=lease_expires_at_unix_milli,json=leaseExpiresAtUnixMilli,proto3" json:"lease_expires_at_unix_milli,omitempty"`
+	// Whether the lease is draining and no longer receives new calls
+	Draining bool `protobuf:"varint,4,opt,name=draining,proto3" json:"draining,omitempty"`
+	// Number of claimed calls the incarnation has not settled yet
+	ClaimedCalls  int64 `protobuf:"zigzag64,5,opt,name=claimed_calls,json=claimedCalls,proto3" json:"claimed_calls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderLeaseInfo) Reset() {
+	*x = ProviderLeaseInfo{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderLeaseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderLeaseInfo) ProtoMessage() {}
+
+func (x *ProviderLeaseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderLeaseInfo.ProtoReflect.Descriptor instead.
+func (*ProviderLeaseInfo) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ProviderLeaseInfo) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *ProviderLeaseInfo) GetProviderIncarnationId() string {
+	if x != nil {
+		return x.ProviderIncarnationId
+	}
+	return ""
+}
+
+func (x *ProviderLeaseInfo) GetLeaseExpiresAtUnixMilli() int64 {
+	if x != nil {
+		return x.LeaseExpiresAtUnixMilli
+	}
+	return 0
+}
+
+func (x *ProviderLeaseInfo) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *ProviderLeaseInfo) GetClaimedCalls() int64 {
+	if x != nil {
+		return x.ClaimedCalls
+	}
+	return 0
+}
+
+type ForceDrainProviderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the toolset
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Stable identity of the provider process to drain
+	ProviderId string `protobuf:"bytes,2,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// Runtime-generated UUID of the Serve lifecycle to drain
+	ProviderIncarnationId string `protobuf:"bytes,3,opt,name=provider_incarnation_id,json=providerIncarnationId,proto3" json:"provider_incarnation_id,omitempty"`
+	// Exact admission-generation token holding the lease, as reported by
+	// ListProviders
+	RegistrationToken string `protobuf:"bytes,4,opt,name=registration_token,json=registrationToken,proto3" json:"registration_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ForceDrainProviderRequest) Reset() {
+	*x = ForceDrainProviderRequest{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceDrainProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDrainProviderRequest) ProtoMessage() {}
+
+func (x *ForceDrainProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDrainProviderRequest.ProtoReflect.Descriptor instead.
+func (*ForceDrainProviderRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ForceDrainProviderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ForceDrainProviderRequest) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *ForceDrainProviderRequest) GetProviderIncarnationId() string {
+	if x != nil {
+		return x.ProviderIncarnationId
+	}
+	return ""
+}
+
+func (x *ForceDrainProviderRequest) GetRegistrationToken() string {
+	if x != nil {
+		return x.RegistrationToken
+	}
+	return ""
+}
+
+type ForceDrainProviderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceDrainProviderResponse) Reset() {
+	*x = ForceDrainProviderResponse{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceDrainProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDrainProviderResponse) ProtoMessage() {}
+
+func (x *ForceDrainProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDrainProviderResponse.ProtoReflect.Descriptor instead.
+func (*ForceDrainProviderResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{37}
+}
+
+type RetireRegistrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the toolset
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Exact admission-generation token to retire, as reported by ListProviders
+	RegistrationToken string `protobuf:"bytes,2,opt,name=registration_token,json=registrationToken,proto3" json:"registration_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RetireRegistrationRequest) Reset() {
+	*x = RetireRegistrationRequest{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetireRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetireRegistrationRequest) ProtoMessage() {}
+
+func (x *RetireRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetireRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*RetireRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RetireRegistrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RetireRegistrationRequest) GetRegistrationToken() string {
+	if x != nil {
+		return x.RegistrationToken
+	}
+	return ""
+}
+
+type RetireRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetireRegistrationResponse) Reset() {
+	*x = RetireRegistrationResponse{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetireRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetireRegistrationResponse) ProtoMessage() {}
+
+func (x *RetireRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetireRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*RetireRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{39}
+}
+
+type ListToolCallsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the toolset
+	Toolset string `protobuf:"bytes,1,opt,name=toolset,proto3" json:"toolset,omitempty"`
+	// Maximum number of calls to return
+	Limit         *int32 `protobuf:"zigzag32,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToolCallsRequest) Reset() {
+	*x = ListToolCallsRequest{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToolCallsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolCallsRequest) ProtoMessage() {}
+
+func (x *ListToolCallsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolCallsRequest.ProtoReflect.Descriptor instead.
+func (*ListToolCallsRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListToolCallsRequest) GetToolset() string {
+	if x != nil {
+		return x.Toolset
+	}
+	return ""
+}
+
+func (x *ListToolCallsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListToolCallsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Claimed calls ordered by execution deadline
+	Calls         []*ToolCallInfo `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToolCallsResponse) Reset() {
+	*x = ListToolCallsResponse{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToolCallsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolCallsResponse) ProtoMessage() {}
+
+func (x *ListToolCallsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolCallsResponse.ProtoReflect.Descriptor instead.
+func (*ListToolCallsResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListToolCallsResponse) GetCalls() []*ToolCallInfo {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+// Authoritative state of one admitted call and its result stream
+type ToolCallInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Global transport identity of the call
+	ToolUseId string `protobuf:"bytes,1,opt,name=tool_use_id,json=toolUseId,proto3" json:"tool_use_id,omitempty"`
+	// Call state. admitted calls are not published yet; queued calls await a
+	// provider claim; claimed calls are executing; terminal calls have a canonical
+	// result.
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Admission-generation token stamped on the call
+	RegistrationToken string `protobuf:"bytes,3,opt,name=registration_token,json=registrationToken,proto3" json:"registration_token,omitempty"`
+	// Redis time by which the call must settle, in Unix milliseconds
+	ExecutionDeadlineUnixMilli int64 `protobuf:"zigzag64,4,opt,name=execution_deadline_unix_milli,json=executionDeadlineUnixMilli,proto3" json:"execution_deadline_unix_milli,omitempty"`
+	// Redis time at which the call record and its result history expire, in Unix
+	// milliseconds
+	ExpiresAtUnixMilli int64 `protobuf:"zigzag64,5,opt,name=expires_at_unix_milli,json=expiresAtUnixMilli,proto3" json:"expires_at_unix_milli,omitempty"`
+	// Stable identity of the provider process that claimed the call
+	ProviderId *string `protobuf:"bytes,6,opt,name=provider_id,json=providerId,proto3,oneof" json:"provider_id,omitempty"`
+	// Serve lifecycle that claimed the call
+	ProviderIncarnationId *string `protobuf:"bytes,7,opt,name=provider_incarnation_id,json=providerIncarnationId,proto3,oneof" json:"provider_incarnation_id,omitempty"`
+	// Pulse request-stream event the provider claimed
+	RequestEventId *string `protobuf:"bytes,8,opt,name=request_event_id,json=requestEventId,proto3,oneof" json:"request_event_id,omitempty"`
+	// What settled a terminal call
+	TerminalCause *string `protobuf:"bytes,9,opt,name=terminal_cause,json=terminalCause,proto3,oneof" json:"terminal_cause,omitempty"`
+	// Number of output deltas the provider published
+	OutputDeltaCount int64 `protobuf:"zigzag64,10,opt,name=output_delta_count,json=outputDeltaCount,proto3" json:"output_delta_count,omitempty"`
+	// Pulse stream carrying the call's output deltas and terminal result
+	ResultStreamId string `protobuf:"bytes,11,opt,name=result_stream_id,json=resultStreamId,proto3" json:"result_stream_id,omitempty"`
+	// Number of events retained in the result stream
+	ResultStreamLength int64 `protobuf:"zigzag64,12,opt,name=result_stream_length,json=resultStreamLength,proto3" json:"result_stream_length,omitempty"`
+	// ID of the newest retained result-stream event
+	LastEventId   *string `protobuf:"bytes,13,opt,name=last_event_id,json=lastEventId,proto3,oneof" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCallInfo) Reset() {
+	*x = ToolCallInfo{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCallInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCallInfo) ProtoMessage() {}
+
+func (x *ToolCallInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCallInfo.ProtoReflect.Descriptor instead.
+func (*ToolCallInfo) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ToolCallInfo) GetToolUseId() string {
+	if x != nil {
+		return x.ToolUseId
+	}
+	return ""
+}
+
+func (x *ToolCallInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ToolCallInfo) GetRegistrationToken() string {
+	if x != nil {
+		return x.RegistrationToken
+	}
+	return ""
+}
+
+func (x *ToolCallInfo) GetExecutionDeadlineUnixMilli() int64 {
+	if x != nil {
+		return x.ExecutionDeadlineUnixMilli
+	}
+	return 0
+}
+
+func (x *ToolCallInfo) GetExpiresAtUnixMilli() int64 {
+	if x != nil {
+		return x.ExpiresAtUnixMilli
+	}
+	return 0
+}
+
+func (x *ToolCallInfo) GetProviderId() string {
+	if x != nil && x.ProviderId != nil {
+		return *x.ProviderId
+	}
+	return ""
+}
+
+func (x *ToolCallInfo) GetProviderIncarnationId() string {
+	if x != nil && x.ProviderIncarnationId != nil {
+		return *x.ProviderIncarnationId
+	}
+	return ""
+}
+
+func (x *ToolCallInfo) GetRequestEventId() string {
+	if x != nil && x.RequestEventId != nil {
+		return *x.RequestEventId
+	}
+	return ""
+}
+
+func (x *ToolCallInfo) GetTerminalCause() string {
+	if x != nil && x.TerminalCause != nil {
+		return *x.TerminalCause
+	}
+	return ""
+}
+
+func (x *ToolCallInfo) GetOutputDeltaCount() int64 {
+	if x != nil {
+		return x.OutputDeltaCount
+	}
+	return 0
+}
+
+func (x *ToolCallInfo) GetResultStreamId() string {
+	if x != nil {
+		return x.ResultStreamId
+	}
+	return ""
+}
+
+func (x *ToolCallInfo) GetResultStreamLength() int64 {
+	if x != nil {
+		return x.ResultStreamLength
+	}
+	return 0
+}
+
+func (x *ToolCallInfo) GetLastEventId() string {
+	if x != nil && x.LastEventId != nil {
+		return *x.LastEventId
+	}
+	return ""
+}
+
+type InspectToolCallRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the toolset that admitted the call
+	Toolset string `protobuf:"bytes,1,opt,name=toolset,proto3" json:"toolset,omitempty"`
+	// Global transport identity of the call
+	ToolUseId     string `protobuf:"bytes,2,opt,name=tool_use_id,json=toolUseId,proto3" json:"tool_use_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectToolCallRequest) Reset() {
+	*x = InspectToolCallRequest{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectToolCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectToolCallRequest) ProtoMessage() {}
+
+func (x *InspectToolCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectToolCallRequest.ProtoReflect.Descriptor instead.
+func (*InspectToolCallRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *InspectToolCallRequest) GetToolset() string {
+	if x != nil {
+		return x.Toolset
+	}
+	return ""
+}
+
+func (x *InspectToolCallRequest) GetToolUseId() string {
+	if x != nil {
+		return x.ToolUseId
+	}
+	return ""
+}
+
+type InspectToolCallResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Global transport identity of the call
+	ToolUseId string `protobuf:"bytes,1,opt,name=tool_use_id,json=toolUseId,proto3" json:"tool_use_id,omitempty"`
+	// Call state. admitted calls are not published yet; queued calls await a
+	// provider claim; claimed calls are executing; terminal calls have a canonical
+	// result.
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Admission-generation token stamped on the call
+	RegistrationToken string `protobuf:"bytes,3,opt,name=registration_token,json=registrationToken,proto3" json:"registration_token,omitempty"`
+	// Redis time by which the call must settle, in Unix milliseconds
+	ExecutionDeadlineUnixMilli int64 `protobuf:"zigzag64,4,opt,name=execution_deadline_unix_milli,json=executionDeadlineUnixMilli,proto3" json:"execution_deadline_unix_milli,omitempty"`
+	// Redis time at which the call record and its result history expire, in Unix
+	// milliseconds
+	ExpiresAtUnixMilli int64 `protobuf:"zigzag64,5,opt,name=expires_at_unix_milli,json=expiresAtUnixMilli,proto3" json:"expires_at_unix_milli,omitempty"`
+	// Stable identity of the provider process that claimed the call
+	ProviderId *string `protobuf:"bytes,6,opt,name=provider_id,json=providerId,proto3,oneof" json:"provider_id,omitempty"`
+	// Serve lifecycle that claimed the call
+	ProviderIncarnationId *string `protobuf:"bytes,7,opt,name=provider_incarnation_id,json=providerIncarnationId,proto3,oneof" json:"provider_incarnation_id,omitempty"`
+	// Pulse request-stream event the provider claimed
+	RequestEventId *string `protobuf:"bytes,8,opt,name=request_event_id,json=requestEventId,proto3,oneof" json:"request_event_id,omitempty"`
+	// What settled a terminal call
+	TerminalCause *string `protobuf:"bytes,9,opt,name=terminal_cause,json=terminalCause,proto3,oneof" json:"terminal_cause,omitempty"`
+	// Number of output deltas the provider published
+	OutputDeltaCount int64 `protobuf:"zigzag64,10,opt,name=output_delta_count,json=outputDeltaCount,proto3" json:"output_delta_count,omitempty"`
+	// Pulse stream carrying the call's output deltas and terminal result
+	ResultStreamId string `protobuf:"bytes,11,opt,name=result_stream_id,json=resultStreamId,proto3" json:"result_stream_id,omitempty"`
+	// Number of events retained in the result stream
+	ResultStreamLength int64 `protobuf:"zigzag64,12,opt,name=result_stream_length,json=resultStreamLength,proto3" json:"result_stream_length,omitempty"`
+	// ID of the newest retained result-stream event
+	LastEventId   *string `protobuf:"bytes,13,opt,name=last_event_id,json=lastEventId,proto3,oneof" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectToolCallResponse) Reset() {
+	*x = InspectToolCallResponse{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectToolCallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectToolCallResponse) ProtoMessage() {}
+
+func (x *InspectToolCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectToolCallResponse.ProtoReflect.Descriptor instead.
+func (*InspectToolCallResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *InspectToolCallResponse) GetToolUseId() string {
+	if x != nil {
+		return x.ToolUseId
+	}
+	return ""
+}
+
+func (x *InspectToolCallResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *InspectToolCallResponse) GetRegistrationToken() string {
+	if x != nil {
+		return x.RegistrationToken
+	}
+	return ""
+}
+
+func (x *InspectToolCallResponse) GetExecutionDeadlineUnixMilli() int64 {
+	if x != nil {
+		return x.ExecutionDeadlineUnixMilli
+	}
+	return 0
+}
+
+func (x *InspectToolCallResponse) GetExpiresAtUnixMilli() int64 {
+	if x != nil {
+		return x.ExpiresAtUnixMilli
+	}
+	return 0
+}
+
+func (x *InspectToolCallResponse) GetProviderId() string {
+	if x != nil && x.ProviderId != nil {
+		return *x.ProviderId
+	}
+	return ""
+}
+
+func (x *InspectToolCallResponse) GetProviderIncarnationId() string {
+	if x != nil && x.ProviderIncarnationId != nil {
+		return *x.ProviderIncarnationId
+	}
+	return ""
+}
+
+func (x *InspectToolCallResponse) GetRequestEventId() string {
+	if x != nil && x.RequestEventId != nil {
+		return *x.RequestEventId
+	}
+	return ""
+}
+
+func (x *InspectToolCallResponse) GetTerminalCause() string {
+	if x != nil && x.TerminalCause != nil {
+		return *x.TerminalCause
+	}
+	return ""
+}
+
+func (x *InspectToolCallResponse) GetOutputDeltaCount() int64 {
+	if x != nil {
+		return x.OutputDeltaCount
+	}
+	return 0
+}
+
+func (x *InspectToolCallResponse) GetResultStreamId() string {
+	if x != nil {
+		return x.ResultStreamId
+	}
+	return ""
+}
+
+func (x *InspectToolCallResponse) GetResultStreamLength() int64 {
+	if x != nil {
+		return x.ResultStreamLength
+	}
+	return 0
+}
+
+func (x *InspectToolCallResponse) GetLastEventId() string {
+	if x != nil && x.LastEventId != nil {
+		return *x.LastEventId
+	}
+	return ""
+}
+
+type ListHealthTransitionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the toolset
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Maximum number of transitions to return
+	Limit         *int32 `protobuf:"zigzag32,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHealthTransitionsRequest) Reset() {
+	*x = ListHealthTransitionsRequest{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHealthTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHealthTransitionsRequest) ProtoMessage() {}
+
+func (x *ListHealthTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHealthTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListHealthTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListHealthTransitionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListHealthTransitionsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListHealthTransitionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Transitions, newest first
+	Transitions   []*HealthTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHealthTransitionsResponse) Reset() {
+	*x = ListHealthTransitionsResponse{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHealthTransitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHealthTransitionsResponse) ProtoMessage() {}
+
+func (x *ListHealthTransitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHealthTransitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListHealthTransitionsResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListHealthTransitionsResponse) GetTransitions() []*HealthTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+// One observed change of toolset health, admission, or membership epoch
+type HealthTransition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Redis time at which the scheduler observed the transition, in Unix
+	// milliseconds
+	AtUnixMilli int64 `protobuf:"zigzag64,1,opt,name=at_unix_milli,json=atUnixMilli,proto3" json:"at_unix_milli,omitempty"`
+	// Observed health after the transition
+	Healthy bool `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Admission-generation token observed
+	RegistrationToken string `protobuf:"bytes,3,opt,name=registration_token,json=registrationToken,proto3" json:"registration_token,omitempty"`
+	// Provider membership epoch observed
+	HealthEpoch uint64 `protobuf:"varint,4,opt,name=health_epoch,json=healthEpoch,proto3" json:"health_epoch,omitempty"`
+	// Number of routable provider leases observed
+	ProviderCount int32 `protobuf:"zigzag32,5,opt,name=provider_count,json=providerCount,proto3" json:"provider_count,omitempty"`
+	// Redis time of the last recorded pong, in Unix milliseconds
+	LastPongUnixMilli *int64 `protobuf:"zigzag64,6,opt,name=last_pong_unix_milli,json=lastPongUnixMilli,proto3,oneof" json:"last_pong_unix_milli,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *HealthTransition) Reset() {
+	*x = HealthTransition{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthTransition) ProtoMessage() {}
+
+func (x *HealthTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthTransition.ProtoReflect.Descriptor instead.
+func (*HealthTransition) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *HealthTransition) GetAtUnixMilli() int64 {
+	if x != nil {
+		return x.AtUnixMilli
+	}
+	return 0
+}
+
+func (x *HealthTransition) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *HealthTransition) GetRegistrationToken() string {
+	if x != nil {
+		return x.RegistrationToken
+	}
+	return ""
+}
+
+func (x *HealthTransition) GetHealthEpoch() uint64 {
+	if x != nil {
+		return x.HealthEpoch
+	}
+	return 0
+}
+
+func (x *HealthTransition) GetProviderCount() int32 {
+	if x != nil {
+		return x.ProviderCount
+	}
+	return 0
+}
+
+func (x *HealthTransition) GetLastPongUnixMilli() int64 {
+	if x != nil && x.LastPongUnixMilli != nil {
+		return *x.LastPongUnixMilli
+	}
+	return 0
+}
+
+type GetToolsetRoutesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unversioned name of the toolset
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetToolsetRoutesRequest) Reset() {
+	*x = GetToolsetRoutesRequest{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToolsetRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolsetRoutesRequest) ProtoMessage() {}
+
+func (x *GetToolsetRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolsetRoutesRequest.ProtoReflect.Descriptor instead.
+func (*GetToolsetRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetToolsetRoutesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetToolsetRoutesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unversioned name of the toolset
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Version weights ordered by version, highest first
+	Routes        []*ToolsetRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetToolsetRoutesResponse) Reset() {
+	*x = GetToolsetRoutesResponse{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToolsetRoutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolsetRoutesResponse) ProtoMessage() {}
+
+func (x *GetToolsetRoutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolsetRoutesResponse.ProtoReflect.Descriptor instead.
+func (*GetToolsetRoutesResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetToolsetRoutesResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetToolsetRoutesResponse) GetRoutes() []*ToolsetRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+// Relative share of unpinned calls routed to one toolset version
+type ToolsetRoute struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Canonical version of a name@version registration
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Relative traffic weight; zero drains the version of unpinned calls
+	Weight        int32 `protobuf:"zigzag32,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolsetRoute) Reset() {
+	*x = ToolsetRoute{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolsetRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolsetRoute) ProtoMessage() {}
+
+func (x *ToolsetRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolsetRoute.ProtoReflect.Descriptor instead.
+func (*ToolsetRoute) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ToolsetRoute) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ToolsetRoute) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type SetToolsetRoutesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unversioned name of the toolset
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Version weights; an empty list restores routing to the highest active version
+	Routes        []*ToolsetRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetToolsetRoutesRequest) Reset() {
+	*x = SetToolsetRoutesRequest{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetToolsetRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetToolsetRoutesRequest) ProtoMessage() {}
+
+func (x *SetToolsetRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetToolsetRoutesRequest.ProtoReflect.Descriptor instead.
+func (*SetToolsetRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetToolsetRoutesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetToolsetRoutesRequest) GetRoutes() []*ToolsetRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type SetToolsetRoutesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetToolsetRoutesResponse) Reset() {
+	*x = SetToolsetRoutesResponse{}
+	mi := &file_goagen_registry_registry_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetToolsetRoutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetToolsetRoutesResponse) ProtoMessage() {}
+
+func (x *SetToolsetRoutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_registry_registry_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetToolsetRoutesResponse.ProtoReflect.Descriptor instead.
+func (*SetToolsetRoutesResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_registry_registry_proto_rawDescGZIP(), []int{52}
+}
+
+var File_goagen_registry_registry_proto protoreflect.FileDescriptor
+
+const file_goagen_registry_registry_proto_rawDesc = "" +
+	"\n" +
+	"\x1egoagen_registry_registry.proto\x12\x0fgoa_ai_registry\"\x8a\x03\n" +
+	"\x0fRegisterRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\tH\x01R\aversion\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x121\n" +
+	"\x05tools\x18\x05 \x03(\v2\x1b.goa_ai_registry.ToolSchemaR\x05tools\x12\x1f\n" +
+	"\vprovider_id\x18\x06 \x01(\tR\n" +
+	"providerId\x12-\n" +
+	"\x12admission_revision\x18\a \x01(\tR\x11admissionRevision\x126\n" +
+	"\x17provider_incarnation_id\x18\b \x01(\tR\x15providerIncarnationId\x122\n" +
+	"\x15wire_protocol_version\x18\t \x01(\x11R\x13wireProtocolVersionB\x0e\n" +
+	"\f_descriptionB\n" +
+	"\n" +
+	"\b_version\"\xf6\x01\n" +
+	"\n" +
+	"ToolSchema\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12%\n" +
+	"\x0epayload_schema\x18\x04 \x01(\fR\rpayloadSchema\x12#\n" +
+	"\rresult_schema\x18\x05 \x01(\fR\fresultSchema\x12*\n" +
+	"\x0esidecar_schema\x18\x06 \x01(\fH\x01R\rsidecarSchema\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\x11\n" +
+	"\x0f_sidecar_schema\"\x92\x01\n" +
+	"\x10RegisterResponse\x12#\n" +
+	"\rregistered_at\x18\x01 \x01(\tR\fregisteredAt\x12-\n" +
+	"\x12registration_token\x18\x02 \x01(\tR\x11registrationToken\x12*\n" +
+	"\x11lease_duration_ms\x18\x03 \x01(\x12R\x0fleaseDurationMs\"\xc5\x01\n" +
+	"\x16ReleaseProviderRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vprovider_id\x18\x02 \x01(\tR\n" +
+	"providerId\x12>\n" +
+	"\x1bexpected_registration_token\x18\x03 \x01(\tR\x19expectedRegistrationToken\x126\n" +
+	"\x17provider_incarnation_id\x18\x04 \x01(\tR\x15providerIncarnationId\"\x19\n" +
+	"\x17ReleaseProviderResponse\"\xf9\x01\n" +
+	"\x14DrainProviderRequest\x124\n" +
+	"\x16settlement_duration_ms\x18d \x01(\x12R\x14settlementDurationMs\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vprovider_id\x18\x02 \x01(\tR\n" +
+	"providerId\x12>\n" +
+	"\x1bexpected_registration_token\x18\x03 \x01(\tR\x19expectedRegistrationToken\x126\n" +
+	"\x17provider_incarnation_id\x18\x04 \x01(\tR\x15providerIncarnationId\"\x17\n" +
+	"\x15DrainProviderResponse\"g\n" +
+	"\x11UnregisterRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12>\n" +
+	"\x1bexpected_registration_token\x18\x02 \x01(\tR\x19expectedRegistrationToken\"\x14\n" +
+	"\x12UnregisterResponse\"\x99\x01\n" +
+	"\vPongRequest\x12\x17\n" +
+	"\aping_id\x18\x01 \x01(\tR\x06pingId\x12\x18\n" +
+	"\atoolset\x18\x02 \x01(\tR\atoolset\x12\x1f\n" +
+	"\vprovider_id\x18\x03 \x01(\tR\n" +
+	"providerId\x126\n" +
+	"\x17provider_incarnation_id\x18\x04 \x01(\tR\x15providerIncarnationId\"\x0e\n" +
+	"\fPongResponse\")\n" +
+	"\x13ListToolsetsRequest\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"P\n" +
+	"\x14ListToolsetsResponse\x128\n" +
+	"\btoolsets\x18\x01 \x03(\v2\x1c.goa_ai_registry.ToolsetInfoR\btoolsets\"\xdb\x01\n" +
 	"\vToolsetInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
@@ -2200,17 +3696,31 @@ const file_goagen_registry_registry_proto_rawDesc = "" +
 	"\n" +
 	"\b_version\"'\n" +
 	"\x11GetToolsetRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\xf6\x01\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xd9\x02\n" +
 	"\x12GetToolsetResponse\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
 	"\aversion\x18\x03 \x01(\tH\x01R\aversion\x88\x01\x01\x12\x12\n" +
 	"\x04tags\x18\x04 \x03(\tR\x04tags\x121\n" +
 	"\x05tools\x18\x05 \x03(\v2\x1b.goa_ai_registry.ToolSchemaR\x05tools\x12#\n" +
-	"\rregistered_at\x18\x06 \x01(\tR\fregisteredAtB\x0e\n" +
+	"\rregistered_at\x18\x06 \x01(\tR\fregisteredAt\x12O\n" +
+	"\rcompatibility\x18\a \x01(\v2$.goa_ai_registry.SchemaCompatibilityH\x02R\rcompatibility\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
-	"\b_version\"%\n" +
+	"\b_versionB\x10\n" +
+	"\x0e_compatibility\"\xc0\x01\n" +
+	"\x13SchemaCompatibility\x12\x1b\n" +
+	"\tbase_name\x18\x01 \x01(\tR\bbaseName\x12&\n" +
+	"\fbase_version\x18\x02 \x01(\tH\x00R\vbaseVersion\x88\x01\x01\x12\x1a\n" +
+	"\bbreaking\x18\x03 \x01(\bR\bbreaking\x127\n" +
+	"\achanges\x18\x04 \x03(\v2\x1d.goa_ai_registry.SchemaChangeR\achangesB\x0f\n" +
+	"\r_base_version\"~\n" +
+	"\fSchemaChange\x12\x12\n" +
+	"\x04tool\x18\x01 \x01(\tR\x04tool\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x1a\n" +
+	"\bbreaking\x18\x04 \x01(\bR\bbreaking\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\"%\n" +
 	"\rSearchRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\"J\n" +
 	"\x0eSearchResponse\x128\n" +
@@ -2293,8 +3803,113 @@ const file_goagen_registry_registry_proto_rawDesc = "" +
 	"\vtool_use_id\x18\x06 \x01(\tR\ttoolUseId\x12(\n" +
 	"\x10request_event_id\x18\a \x01(\tR\x0erequestEventId\"9\n" +
 	"\x15ClaimToolCallResponse\x12 \n" +
-	"\vdisposition\x18\x01 \x01(\tR\vdisposition2\xa0\n" +
-	"\n" +
+	"\vdisposition\x18\x01 \x01(\tR\vdisposition\"*\n" +
+	"\x14ListProvidersRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xbe\x02\n" +
+	"\x15ListProvidersResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12-\n" +
+	"\x12registration_token\x18\x03 \x01(\tR\x11registrationToken\x12!\n" +
+	"\fhealth_epoch\x18\x04 \x01(\x04R\vhealthEpoch\x124\n" +
+	"\x14last_pong_unix_milli\x18\x05 \x01(\x12H\x00R\x11lastPongUnixMilli\x88\x01\x01\x12\x18\n" +
+	"\ahealthy\x18\x06 \x01(\bR\ahealthy\x12@\n" +
+	"\tproviders\x18\a \x03(\v2\".goa_ai_registry.ProviderLeaseInfoR\tprovidersB\x17\n" +
+	"\x15_last_pong_unix_milli\"\xeb\x01\n" +
+	"\x11ProviderLeaseInfo\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\tR\n" +
+	"providerId\x126\n" +
+	"\x17provider_incarnation_id\x18\x02 \x01(\tR\x15providerIncarnationId\x12<\n" +
+	"\x1blease_expires_at_unix_milli\x18\x03 \x01(\x12R\x17leaseExpiresAtUnixMilli\x12\x1a\n" +
+	"\bdraining\x18\x04 \x01(\bR\bdraining\x12#\n" +
+	"\rclaimed_calls\x18\x05 \x01(\x12R\fclaimedCalls\"\xb7\x01\n" +
+	"\x19ForceDrainProviderRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vprovider_id\x18\x02 \x01(\tR\n" +
+	"providerId\x126\n" +
+	"\x17provider_incarnation_id\x18\x03 \x01(\tR\x15providerIncarnationId\x12-\n" +
+	"\x12registration_token\x18\x04 \x01(\tR\x11registrationToken\"\x1c\n" +
+	"\x1aForceDrainProviderResponse\"^\n" +
+	"\x19RetireRegistrationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12-\n" +
+	"\x12registration_token\x18\x02 \x01(\tR\x11registrationToken\"\x1c\n" +
+	"\x1aRetireRegistrationResponse\"U\n" +
+	"\x14ListToolCallsRequest\x12\x18\n" +
+	"\atoolset\x18\x01 \x01(\tR\atoolset\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x11H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"L\n" +
+	"\x15ListToolCallsResponse\x123\n" +
+	"\x05calls\x18\x01 \x03(\v2\x1d.goa_ai_registry.ToolCallInfoR\x05calls\"\xc0\x05\n" +
+	"\fToolCallInfo\x12\x1e\n" +
+	"\vtool_use_id\x18\x01 \x01(\tR\ttoolUseId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12-\n" +
+	"\x12registration_token\x18\x03 \x01(\tR\x11registrationToken\x12A\n" +
+	"\x1dexecution_deadline_unix_milli\x18\x04 \x01(\x12R\x1aexecutionDeadlineUnixMilli\x121\n" +
+	"\x15expires_at_unix_milli\x18\x05 \x01(\x12R\x12expiresAtUnixMilli\x12$\n" +
+	"\vprovider_id\x18\x06 \x01(\tH\x00R\n" +
+	"providerId\x88\x01\x01\x12;\n" +
+	"\x17provider_incarnation_id\x18\a \x01(\tH\x01R\x15providerIncarnationId\x88\x01\x01\x12-\n" +
+	"\x10request_event_id\x18\b \x01(\tH\x02R\x0erequestEventId\x88\x01\x01\x12*\n" +
+	"\x0eterminal_cause\x18\t \x01(\tH\x03R\rterminalCause\x88\x01\x01\x12,\n" +
+	"\x12output_delta_count\x18\n" +
+	" \x01(\x12R\x10outputDeltaCount\x12(\n" +
+	"\x10result_stream_id\x18\v \x01(\tR\x0eresultStreamId\x120\n" +
+	"\x14result_stream_length\x18\f \x01(\x12R\x12resultStreamLength\x12'\n" +
+	"\rlast_event_id\x18\r \x01(\tH\x04R\vlastEventId\x88\x01\x01B\x0e\n" +
+	"\f_provider_idB\x1a\n" +
+	"\x18_provider_incarnation_idB\x13\n" +
+	"\x11_request_event_idB\x11\n" +
+	"\x0f_terminal_causeB\x10\n" +
+	"\x0e_last_event_id\"R\n" +
+	"\x16InspectToolCallRequest\x12\x18\n" +
+	"\atoolset\x18\x01 \x01(\tR\atoolset\x12\x1e\n" +
+	"\vtool_use_id\x18\x02 \x01(\tR\ttoolUseId\"\xcb\x05\n" +
+	"\x17InspectToolCallResponse\x12\x1e\n" +
+	"\vtool_use_id\x18\x01 \x01(\tR\ttoolUseId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12-\n" +
+	"\x12registration_token\x18\x03 \x01(\tR\x11registrationToken\x12A\n" +
+	"\x1dexecution_deadline_unix_milli\x18\x04 \x01(\x12R\x1aexecutionDeadlineUnixMilli\x121\n" +
+	"\x15expires_at_unix_milli\x18\x05 \x01(\x12R\x12expiresAtUnixMilli\x12$\n" +
+	"\vprovider_id\x18\x06 \x01(\tH\x00R\n" +
+	"providerId\x88\x01\x01\x12;\n" +
+	"\x17provider_incarnation_id\x18\a \x01(\tH\x01R\x15providerIncarnationId\x88\x01\x01\x12-\n" +
+	"\x10request_event_id\x18\b \x01(\tH\x02R\x0erequestEventId\x88\x01\x01\x12*\n" +
+	"\x0eterminal_cause\x18\t \x01(\tH\x03R\rterminalCause\x88\x01\x01\x12,\n" +
+	"\x12output_delta_count\x18\n" +
+	" \x01(\x12R\x10outputDeltaCount\x12(\n" +
+	"\x10result_stream_id\x18\v \x01(\tR\x0eresultStreamId\x120\n" +
+	"\x14result_stream_length\x18\f \x01(\x12R\x12resultStreamLength\x12'\n" +
+	"\rlast_event_id\x18\r \x01(\tH\x04R\vlastEventId\x88\x01\x01B\x0e\n" +
+	"\f_provider_idB\x1a\n" +
+	"\x18_provider_incarnation_idB\x13\n" +
+	"\x11_request_event_idB\x11\n" +
+	"\x0f_terminal_causeB\x10\n" +
+	"\x0e_last_event_id\"W\n" +
+	"\x1cListHealthTransitionsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x11H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"d\n" +
+	"\x1dListHealthTransitionsResponse\x12C\n" +
+	"\vtransitions\x18\x01 \x03(\v2!.goa_ai_registry.HealthTransitionR\vtransitions\"\x98\x02\n" +
+	"\x10HealthTransition\x12\"\n" +
+	"\rat_unix_milli\x18\x01 \x01(\x12R\vatUnixMilli\x12\x18\n" +
+	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x12-\n" +
+	"\x12registration_token\x18\x03 \x01(\tR\x11registrationToken\x12!\n" +
+	"\fhealth_epoch\x18\x04 \x01(\x04R\vhealthEpoch\x12%\n" +
+	"\x0eprovider_count\x18\x05 \x01(\x11R\rproviderCount\x124\n" +
+	"\x14last_pong_unix_milli\x18\x06 \x01(\x12H\x00R\x11lastPongUnixMilli\x88\x01\x01B\x17\n" +
+	"\x15_last_pong_unix_milli\"-\n" +
+	"\x17GetToolsetRoutesRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"e\n" +
+	"\x18GetToolsetRoutesResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\x06routes\x18\x02 \x03(\v2\x1d.goa_ai_registry.ToolsetRouteR\x06routes\"@\n" +
+	"\fToolsetRoute\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x11R\x06weight\"d\n" +
+	"\x17SetToolsetRoutesRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\x06routes\x18\x02 \x03(\v2\x1d.goa_ai_registry.ToolsetRouteR\x06routes\"\x1a\n" +
+	"\x18SetToolsetRoutesResponse2\xee\x10\n" +
 	"\bRegistry\x12O\n" +
 	"\bRegister\x12 .goa_ai_registry.RegisterRequest\x1a!.goa_ai_registry.RegisterResponse\x12d\n" +
 	"\x0fReleaseProvider\x12'.goa_ai_registry.ReleaseProviderRequest\x1a(.goa_ai_registry.ReleaseProviderResponse\x12^\n" +
@@ -2311,7 +3926,15 @@ const file_goagen_registry_registry_proto_rawDesc = "" +
 	"\x10CompleteToolCall\x12(.goa_ai_registry.CompleteToolCallRequest\x1a).goa_ai_registry.CompleteToolCallResponse\x12y\n" +
 	"\x16PublishToolOutputDelta\x12..goa_ai_registry.PublishToolOutputDeltaRequest\x1a/.goa_ai_registry.PublishToolOutputDeltaResponse\x12y\n" +
 	"\x16ReportToolCallOverload\x12..goa_ai_registry.ReportToolCallOverloadRequest\x1a/.goa_ai_registry.ReportToolCallOverloadResponse\x12^\n" +
-	"\rClaimToolCall\x12%.goa_ai_registry.ClaimToolCallRequest\x1a&.goa_ai_registry.ClaimToolCallResponseB\x14Z\x12/goa_ai_registrypbb\x06proto3"
+	"\rClaimToolCall\x12%.goa_ai_registry.ClaimToolCallRequest\x1a&.goa_ai_registry.ClaimToolCallResponse\x12^\n" +
+	"\rListProviders\x12%.goa_ai_registry.ListProvidersRequest\x1a&.goa_ai_registry.ListProvidersResponse\x12m\n" +
+	"\x12ForceDrainProvider\x12*.goa_ai_registry.ForceDrainProviderRequest\x1a+.goa_ai_registry.ForceDrainProviderResponse\x12m\n" +
+	"\x12RetireRegistration\x12*.goa_ai_registry.RetireRegistrationRequest\x1a+.goa_ai_registry.RetireRegistrationResponse\x12^\n" +
+	"\rListToolCalls\x12%.goa_ai_registry.ListToolCallsRequest\x1a&.goa_ai_registry.ListToolCallsResponse\x12d\n" +
+	"\x0fInspectToolCall\x12'.goa_ai_registry.InspectToolCallRequest\x1a(.goa_ai_registry.InspectToolCallResponse\x12v\n" +
+	"\x15ListHealthTransitions\x12-.goa_ai_registry.ListHealthTransitionsRequest\x1a..goa_ai_registry.ListHealthTransitionsResponse\x12g\n" +
+	"\x10GetToolsetRoutes\x12(.goa_ai_registry.GetToolsetRoutesRequest\x1a).goa_ai_registry.GetToolsetRoutesResponse\x12g\n" +
+	"\x10SetToolsetRoutes\x12(.goa_ai_registry.SetToolsetRoutesRequest\x1a).goa_ai_registry.SetToolsetRoutesResponseB\x14Z\x12/goa_ai_registrypbb\x06proto3"
 
 var (
 	file_goagen_registry_registry_proto_rawDescOnce sync.Once
@@ -2325,7 +3948,7 @@ func file_goagen_registry_registry_proto_rawDescGZIP() []byte {
 	return file_goagen_registry_registry_proto_rawDescData
 }
 
-var file_goagen_registry_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_goagen_registry_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_goagen_registry_registry_proto_goTypes = []any{
 	(*RegisterRequest)(nil),                // 0: goa_ai_registry.RegisterRequest
 	(*ToolSchema)(nil),                     // 1: goa_ai_registry.ToolSchema
@@ -2343,62 +3966,107 @@ var file_goagen_registry_registry_proto_goTypes = []any{
 	(*ToolsetInfo)(nil),                    // 13: goa_ai_registry.ToolsetInfo
 	(*GetToolsetRequest)(nil),              // 14: goa_ai_registry.GetToolsetRequest
 	(*GetToolsetResponse)(nil),             // 15: goa_ai_registry.GetToolsetResponse
-	(*SearchRequest)(nil),                  // 16: goa_ai_registry.SearchRequest
-	(*SearchResponse)(nil),                 // 17: goa_ai_registry.SearchResponse
-	(*CallToolRequest)(nil),                // 18: goa_ai_registry.CallToolRequest
-	(*ToolCallMeta)(nil),                   // 19: goa_ai_registry.ToolCallMeta
-	(*CallToolResponse)(nil),               // 20: goa_ai_registry.CallToolResponse
-	(*RetryToolRequest)(nil),               // 21: goa_ai_registry.RetryToolRequest
-	(*RetryToolResponse)(nil),              // 22: goa_ai_registry.RetryToolResponse
-	(*CompleteToolCallRequest)(nil),        // 23: goa_ai_registry.CompleteToolCallRequest
-	(*CompleteToolCallResponse)(nil),       // 24: goa_ai_registry.CompleteToolCallResponse
-	(*PublishToolOutputDeltaRequest)(nil),  // 25: goa_ai_registry.PublishToolOutputDeltaRequest
-	(*PublishToolOutputDeltaResponse)(nil), // 26: goa_ai_registry.PublishToolOutputDeltaResponse
-	(*ReportToolCallOverloadRequest)(nil),  // 27: goa_ai_registry.ReportToolCallOverloadRequest
-	(*ReportToolCallOverloadResponse)(nil), // 28: goa_ai_registry.ReportToolCallOverloadResponse
-	(*ClaimToolCallRequest)(nil),           // 29: goa_ai_registry.ClaimToolCallRequest
-	(*ClaimToolCallResponse)(nil),          // 30: goa_ai_registry.ClaimToolCallResponse
+	(*SchemaCompatibility)(nil),            // 16: goa_ai_registry.SchemaCompatibility
+	(*SchemaChange)(nil),                   // 17: goa_ai_registry.SchemaChange
+	(*SearchRequest)(nil),                  // 18: goa_ai_registry.SearchRequest
+	(*SearchResponse)(nil),                 // 19: goa_ai_registry.SearchResponse
+	(*CallToolRequest)(nil),                // 20: goa_ai_registry.CallToolRequest
+	(*ToolCallMeta)(nil),                   // 21: goa_ai_registry.ToolCallMeta
+	(*CallToolResponse)(nil),               // 22: goa_ai_registry.CallToolResponse
+	(*RetryToolRequest)(nil),               // 23: goa_ai_registry.RetryToolRequest
+	(*RetryToolResponse)(nil),              // 24: goa_ai_registry.RetryToolResponse
+	(*CompleteToolCallRequest)(nil),        // 25: goa_ai_registry.CompleteToolCallRequest
+	(*CompleteToolCallResponse)(nil),       // 26: goa_ai_registry.CompleteToolCallResponse
+	(*PublishToolOutputDeltaRequest)(nil),  // 27: goa_ai_registry.PublishToolOutputDeltaRequest
+	(*PublishToolOutputDeltaResponse)(nil), // 28: goa_ai_registry.PublishToolOutputDeltaResponse
+	(*ReportToolCallOverloadRequest)(nil),  // 29: goa_ai_registry.ReportToolCallOverloadRequest
+	(*ReportToolCallOverloadResponse)(nil), // 30: goa_ai_registry.ReportToolCallOverloadResponse
+	(*ClaimToolCallRequest)(nil),           // 31: goa_ai_registry.ClaimToolCallRequest
+	(*ClaimToolCallResponse)(nil),          // 32: goa_ai_registry.ClaimToolCallResponse
+	(*ListProvidersRequest)(nil),           // 33: goa_ai_registry.ListProvidersRequest
+	(*ListProvidersResponse)(nil),          // 34: goa_ai_registry.ListProvidersResponse
+	(*ProviderLeaseInfo)(nil),              // 35: goa_ai_registry.ProviderLeaseInfo
+	(*ForceDrainProviderRequest)(nil),      // 36: goa_ai_registry.ForceDrainProviderRequest
+	(*ForceDrainProviderResponse)(nil),     // 37: goa_ai_registry.ForceDrainProviderResponse
+	(*RetireRegistrationRequest)(nil),      // 38: goa_ai_registry.RetireRegistrationRequest
+	(*RetireRegistrationResponse)(nil),     // 39: goa_ai_registry.RetireRegistrationResponse
+	(*ListToolCallsRequest)(nil),           // 40: goa_ai_registry.ListToolCallsRequest
+	(*ListToolCallsResponse)(nil),          // 41: goa_ai_registry.ListToolCallsResponse
+	(*ToolCallInfo)(nil),                   // 42: goa_ai_registry.ToolCallInfo
+	(*InspectToolCallRequest)(nil),         // 43: goa_ai_registry.InspectToolCallRequest
+	(*InspectToolCallResponse)(nil),        // 44: goa_ai_registry.InspectToolCallResponse
+	(*ListHealthTransitionsRequest)(nil),   // 45: goa_ai_registry.ListHealthTransitionsRequest
+	(*ListHealthTransitionsResponse)(nil),  // 46: goa_ai_registry.ListHealthTransitionsResponse
+	(*HealthTransition)(nil),               // 47: goa_ai_registry.HealthTransition
+	(*GetToolsetRoutesRequest)(nil),        // 48: goa_ai_registry.GetToolsetRoutesRequest
+	(*GetToolsetRoutesResponse)(nil),       // 49: goa_ai_registry.GetToolsetRoutesResponse
+	(*ToolsetRoute)(nil),                   // 50: goa_ai_registry.ToolsetRoute
+	(*SetToolsetRoutesRequest)(nil),        // 51: goa_ai_registry.SetToolsetRoutesRequest
+	(*SetToolsetRoutesResponse)(nil),       // 52: goa_ai_registry.SetToolsetRoutesResponse
 }
 var file_goagen_registry_registry_proto_depIdxs = []int32{
 	1,  // 0: goa_ai_registry.RegisterRequest.tools:type_name -> goa_ai_registry.ToolSchema
 	13, // 1: goa_ai_registry.ListToolsetsResponse.toolsets:type_name -> goa_ai_registry.ToolsetInfo
 	1,  // 2: goa_ai_registry.GetToolsetResponse.tools:type_name -> goa_ai_registry.ToolSchema
-	13, // 3: goa_ai_registry.SearchResponse.toolsets:type_name -> goa_ai_registry.ToolsetInfo
-	19, // 4: goa_ai_registry.CallToolRequest.meta:type_name -> goa_ai_registry.ToolCallMeta
-	19, // 5: goa_ai_registry.RetryToolRequest.meta:type_name -> goa_ai_registry.ToolCallMeta
-	0,  // 6: goa_ai_registry.Registry.Register:input_type -> goa_ai_registry.RegisterRequest
-	3,  // 7: goa_ai_registry.Registry.ReleaseProvider:input_type -> goa_ai_registry.ReleaseProviderRequest
-	5,  // 8: goa_ai_registry.Registry.DrainProvider:input_type -> goa_ai_registry.DrainProviderRequest
-	7,  // 9: goa_ai_registry.Registry.Unregister:input_type -> goa_ai_registry.UnregisterRequest
-	9,  // 10: goa_ai_registry.Registry.Pong:input_type -> goa_ai_registry.PongRequest
-	11, // 11: goa_ai_registry.Registry.ListToolsets:input_type -> goa_ai_registry.ListToolsetsRequest
-	14, // 12: goa_ai_registry.Registry.GetToolset:input_type -> goa_ai_registry.GetToolsetRequest
-	16, // 13: goa_ai_registry.Registry.Search:input_type -> goa_ai_registry.SearchRequest
-	18, // 14: goa_ai_registry.Registry.CallTool:input_type -> goa_ai_registry.CallToolRequest
-	21, // 15: goa_ai_registry.Registry.RetryTool:input_type -> goa_ai_registry.RetryToolRequest
-	23, // 16: goa_ai_registry.Registry.CompleteToolCall:input_type -> goa_ai_registry.CompleteToolCallRequest
-	25, // 17: goa_ai_registry.Registry.PublishToolOutputDelta:input_type -> goa_ai_registry.PublishToolOutputDeltaRequest
-	27, // 18: goa_ai_registry.Registry.ReportToolCallOverload:input_type -> goa_ai_registry.ReportToolCallOverloadRequest
-	29, // 19: goa_ai_registry.Registry.ClaimToolCall:input_type -> goa_ai_registry.ClaimToolCallRequest
-	2,  // 20: goa_ai_registry.Registry.Register:output_type -> goa_ai_registry.RegisterResponse
-	4,  // 21: goa_ai_registry.Registry.ReleaseProvider:output_type -> goa_ai_registry.ReleaseProviderResponse
-	6,  // 22: goa_ai_registry.Registry.DrainProvider:output_type -> goa_ai_registry.DrainProviderResponse
-	8,  // 23: goa_ai_registry.Registry.Unregister:output_type -> goa_ai_registry.UnregisterResponse
-	10, // 24: goa_ai_registry.Registry.Pong:output_type -> goa_ai_registry.PongResponse
-	12, // 25: goa_ai_registry.Registry.ListToolsets:output_type -> goa_ai_registry.ListToolsetsResponse
-	15, // 26: goa_ai_registry.Registry.GetToolset:output_type -> goa_ai_registry.GetToolsetResponse
-	17, // 27: goa_ai_registry.Registry.Search:output_type -> goa_ai_registry.SearchResponse
-	20, // 28: goa_ai_registry.Registry.CallTool:output_type -> goa_ai_registry.CallToolResponse
-	22, // 29: goa_ai_registry.Registry.RetryTool:output_type -> goa_ai_registry.RetryToolResponse
-	24, // 30: goa_ai_registry.Registry.CompleteToolCall:output_type -> goa_ai_registry.CompleteToolCallResponse
-	26, // 31: goa_ai_registry.Registry.PublishToolOutputDelta:output_type -> goa_ai_registry.PublishToolOutputDeltaResponse
-	28, // 32: goa_ai_registry.Registry.ReportToolCallOverload:output_type -> goa_ai_registry.ReportToolCallOverloadResponse
-	30, // 33: goa_ai_registry.Registry.ClaimToolCall:output_type -> goa_ai_registry.ClaimToolCallResponse
-	20, // [20:34] is the sub-list for method output_type
-	6,  // [6:20] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	16, // 3: goa_ai_registry.GetToolsetResponse.compatibility:type_name -> goa_ai_registry.SchemaCompatibility
+	17, // 4: goa_ai_registry.SchemaCompatibility.changes:type_name -> goa_ai_registry.SchemaChange
+	13, // 5: goa_ai_registry.SearchResponse.toolsets:type_name -> goa_ai_registry.ToolsetInfo
+	21, // 6: goa_ai_registry.CallToolRequest.meta:type_name -> goa_ai_registry.ToolCallMeta
+	21, // 7: goa_ai_registry.RetryToolRequest.meta:type_name -> goa_ai_registry.ToolCallMeta
+	35, // 8: goa_ai_registry.ListProvidersResponse.providers:type_name -> goa_ai_registry.ProviderLeaseInfo
+	42, // 9: goa_ai_registry.ListToolCallsResponse.calls:type_name -> goa_ai_registry.ToolCallInfo
+	47, // 10: goa_ai_registry.ListHealthTransitionsResponse.transitions:type_name -> goa_ai_registry.HealthTransition
+	50, // 11: goa_ai_registry.GetToolsetRoutesResponse.routes:type_name -> goa_ai_registry.ToolsetRoute
+	50, // 12: goa_ai_registry.SetToolsetRoutesRequest.routes:type_name -> goa_ai_registry.ToolsetRoute
+	0,  // 13: goa_ai_registry.Registry.Register:input_type -> goa_ai_registry.RegisterRequest
+	3,  // 14: goa_ai_registry.Registry.ReleaseProvider:input_type -> goa_ai_registry.ReleaseProviderRequest
+	5,  // 15: goa_ai_registry.Registry.DrainProvider:input_type -> goa_ai_registry.DrainProviderRequest
+	7,  // 16: goa_ai_registry.Registry.Unregister:input_type -> goa_ai_registry.UnregisterRequest
+	9,  // 17: goa_ai_registry.Registry.Pong:input_type -> goa_ai_registry.PongRequest
+	11, // 18: goa_ai_registry.Registry.ListToolsets:input_type -> goa_ai_registry.ListToolsetsRequest
+	14, // 19: goa_ai_registry.Registry.GetToolset:input_type -> goa_ai_registry.GetToolsetRequest
+	18, // 20: goa_ai_registry.Registry.Search:input_type -> goa_ai_registry.SearchRequest
+	20, // 21: goa_ai_registry.Registry.CallTool:input_type -> goa_ai_registry.CallToolRequest
+	23, // 22: goa_ai_registry.Registry.RetryTool:input_type -> goa_ai_registry.RetryToolRequest
+	25, // 23: goa_ai_registry.Registry.CompleteToolCall:input_type -> goa_ai_registry.CompleteToolCallRequest
+	27, // 24: goa_ai_registry.Registry.PublishToolOutputDelta:input_type -> goa_ai_registry.PublishToolOutputDeltaRequest
+	29, // 25: goa_ai_registry.Registry.ReportToolCallOverload:input_type -> goa_ai_registry.ReportToolCallOverloadRequest
+	31, // 26: goa_ai_registry.Registry.ClaimToolCall:input_type -> goa_ai_registry.ClaimToolCallRequest
+	33, // 27: goa_ai_registry.Registry.ListProviders:input_type -> goa_ai_registry.ListProvidersRequest
+	36, // 28: goa_ai_registry.Registry.ForceDrainProvider:input_type -> goa_ai_registry.ForceDrainProviderRequest
+	38, // 29: goa_ai_registry.Registry.RetireRegistration:input_type -> goa_ai_registry.RetireRegistrationRequest
+	40, // 30: goa_ai_registry.Registry.ListToolCalls:input_type -> goa_ai_registry.ListToolCallsRequest
+	43, // 31: goa_ai_registry.Registry.InspectToolCall:input_type -> goa_ai_registry.InspectToolCallRequest
+	45, // 32: goa_ai_registry.Registry.ListHealthTransitions:input_type -> goa_ai_registry.ListHealthTransitionsRequest
+	48, // 33: goa_ai_registry.Registry.GetToolsetRoutes:input_type -> goa_ai_registry.GetToolsetRoutesRequest
+	51, // 34: goa_ai_registry.Registry.SetToolsetRoutes:input_type -> goa_ai_registry.SetToolsetRoutesRequest
+	2,  // 35: goa_ai_registry.Registry.Register:output_type -> goa_ai_registry.RegisterResponse
+	4,  // 36: goa_ai_registry.Registry.ReleaseProvider:output_type -> goa_ai_registry.ReleaseProviderResponse
+	6,  // 37: goa_ai_registry.Registry.DrainProvider:output_type -> goa_ai_registry.DrainProviderResponse
+	8,  // 38: goa_ai_registry.Registry.Unregister:output_type -> goa_ai_registry.UnregisterResponse
+	10, // 39: goa_ai_registry.Registry.Pong:output_type -> goa_ai_registry.PongResponse
+	12, // 40: goa_ai_registry.Registry.ListToolsets:output_type -> goa_ai_registry.ListToolsetsResponse
+	15, // 41: goa_ai_registry.Registry.GetToolset:output_type -> goa_ai_registry.GetToolsetResponse
+	19, // 42: goa_ai_registry.Registry.Search:output_type -> goa_ai_registry.SearchResponse
+	22, // 43: goa_ai_registry.Registry.CallTool:output_type -> goa_ai_registry.CallToolResponse
+	24, // 44: goa_ai_registry.Registry.RetryTool:output_type -> goa_ai_registry.RetryToolResponse
+	26, // 45: goa_ai_registry.Registry.CompleteToolCall:output_type -> goa_ai_registry.CompleteToolCallResponse
+	28, // 46: goa_ai_registry.Registry.PublishToolOutputDelta:output_type -> goa_ai_registry.PublishToolOutputDeltaResponse
+	30, // 47: goa_ai_registry.Registry.ReportToolCallOverload:output_type -> goa_ai_registry.ReportToolCallOverloadResponse
+	32, // 48: goa_ai_registry.Registry.ClaimToolCall:output_type -> goa_ai_registry.ClaimToolCallResponse
+	34, // 49: goa_ai_registry.Registry.ListProviders:output_type -> goa_ai_registry.ListProvidersResponse
+	37, // 50: goa_ai_registry.Registry.ForceDrainProvider:output_type -> goa_ai_registry.ForceDrainProviderResponse
+	39, // 51: goa_ai_registry.Registry.RetireRegistration:output_type -> goa_ai_registry.RetireRegistrationResponse
+	41, // 52: goa_ai_registry.Registry.ListToolCalls:output_type -> goa_ai_registry.ListToolCallsResponse
+	44, // 53: goa_ai_registry.Registry.InspectToolCall:output_type -> goa_ai_registry.InspectToolCallResponse
+	46, // 54: goa_ai_registry.Registry.ListHealthTransitions:output_type -> goa_ai_registry.ListHealthTransitionsResponse
+	49, // 55: goa_ai_registry.Registry.GetToolsetRoutes:output_type -> goa_ai_registry.GetToolsetRoutesResponse
+	52, // 56: goa_ai_registry.Registry.SetToolsetRoutes:output_type -> goa_ai_registry.SetToolsetRoutesResponse
+	35, // [35:57] is the sub-list for method output_type
+	13, // [13:35] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_goagen_registry_registry_proto_init() }
@@ -2410,14 +4078,21 @@ func file_goagen_registry_registry_proto_init() {
 	file_goagen_registry_registry_proto_msgTypes[1].OneofWrappers = []any{}
 	file_goagen_registry_registry_proto_msgTypes[13].OneofWrappers = []any{}
 	file_goagen_registry_registry_proto_msgTypes[15].OneofWrappers = []any{}
-	file_goagen_registry_registry_proto_msgTypes[19].OneofWrappers = []any{}
+	file_goagen_registry_registry_proto_msgTypes[16].OneofWrappers = []any{}
+	file_goagen_registry_registry_proto_msgTypes[21].OneofWrappers = []any{}
+	file_goagen_registry_registry_proto_msgTypes[34].OneofWrappers = []any{}
+	file_goagen_registry_registry_proto_msgTypes[40].OneofWrappers = []any{}
+	file_goagen_registry_registry_proto_msgTypes[42].OneofWrappers = []any{}
+	file_goagen_registry_registry_proto_msgTypes[44].OneofWrappers = []any{}
+	file_goagen_registry_registry_proto_msgTypes[45].OneofWrappers = []any{}
+	file_goagen_registry_registry_proto_msgTypes[47].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goagen_registry_registry_proto_rawDesc), len(file_goagen_registry_registry_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
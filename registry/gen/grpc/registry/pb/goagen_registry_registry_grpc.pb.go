@@ -40,6 +40,14 @@ const (
 	Registry_PublishToolOutputDelta_FullMethodName = "/goa_ai_registry.Registry/PublishToolOutputDelta"
 	Registry_ReportToolCallOverload_FullMethodName = "/goa_ai_registry.Registry/ReportToolCallOverload"
 	Registry_ClaimToolCall_FullMethodName          = "/goa_ai_registry.Registry/ClaimToolCall"
+	Registry_ListProviders_FullMethodName          = "/goa_ai_registry.Registry/ListProviders"
+	Registry_ForceDrainProvider_FullMethodName     = "/goa_ai_registry.Registry/ForceDrainProvider"
+	Registry_RetireRegistration_FullMethodName     = "/goa_ai_registry.Registry/RetireRegistration"
+	Registry_ListToolCalls_FullMethodName          = "/goa_ai_registry.Registry/ListToolCalls"
+	Registry_InspectToolCall_FullMethodName        = "/goa_ai_registry.Registry/InspectToolCall"
+	Registry_ListHealthTransitions_FullMethodName  = "/goa_ai_registry.Registry/ListHealthTransitions"
+	Registry_GetToolsetRoutes_FullMethodName       = "/goa_ai_registry.Registry/GetToolsetRoutes"
+	Registry_SetToolsetRoutes_FullMethodName       = "/goa_ai_registry.Registry/SetToolsetRoutes"
 )
 
 // RegistryClient is the client API for Registry service.
@@ -138,6 +146,43 @@ type RegistryClient interface {
 	// incarnation and request event may publish deltas or complete the call;
 	// ownership never transfers after a crash.
 	ClaimToolCall(ctx context.Context, in *ClaimToolCallRequest, opts ...grpc.CallOption) (*ClaimToolCallResponse, error)
+	// List the catalog admission record of one toolset, active or retired, with every
+	// unexpired provider-incarnation lease, its Redis-time expiry, draining state, and
+	// the number of claimed calls it still owns.
+	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
+	// Mark one exact provider-incarnation lease draining on behalf of an operator. New
+	// calls stop routing to the incarnation while calls it already claimed keep
+	// settlement authority until its lease is released or expires; lease renewals by
+	// that incarnation keep it draining. Missing incarnations and stale tokens succeed
+	// without mutation.
+	ForceDrainProvider(ctx context.Context, in *ForceDrainProviderRequest, opts ...grpc.CallOption) (*ForceDrainProviderResponse, error)
+	// Retire the exact admission on behalf of an operator with the same semantics as
+	// Unregister: the toolset leaves discovery and routing, provider leases are
+	// preserved until release or expiry, and the token can never register again. A
+	// stale token returns admission_conflict.
+	RetireRegistration(ctx context.Context, in *RetireRegistrationRequest, opts ...grpc.CallOption) (*RetireRegistrationResponse, error)
+	// List the in-flight calls of one toolset: calls a provider incarnation has
+	// claimed and not yet settled, ordered by execution deadline. Queued calls that
+	// no provider has claimed yet are not indexed; inspect them individually with
+	// InspectToolCall.
+	ListToolCalls(ctx context.Context, in *ListToolCallsRequest, opts ...grpc.CallOption) (*ListToolCallsResponse, error)
+	// Inspect the authoritative record of one admitted call of a toolset: its
+	// publication, claim, and terminal state, the provider incarnation that owns it,
+	// and the length and last event of its result stream.
+	InspectToolCall(ctx context.Context, in *InspectToolCallRequest, opts ...grpc.CallOption) (*InspectToolCallResponse, error)
+	// List the recorded health transitions of one toolset, newest first. The health
+	// scheduler records a transition whenever the observed health, admission token, or
+	// membership epoch changes; the registry retains a bounded history per toolset.
+	ListHealthTransitions(ctx context.Context, in *ListHealthTransitionsRequest, opts ...grpc.CallOption) (*ListHealthTransitionsResponse, error)
+	// Return the canary route table that splits unpinned calls between the
+	// side-by-side name@version registrations of one toolset. An empty table routes
+	// unpinned calls to the highest active version.
+	GetToolsetRoutes(ctx context.Context, in *GetToolsetRoutesRequest, opts ...grpc.CallOption) (*GetToolsetRoutesResponse, error)
+	// Replace the canary route table of one toolset on behalf of an operator.
+	// Weights are relative; a zero weight drains a version of unpinned traffic while
+	// calls pinned to that exact version keep routing to it. An empty table routes
+	// unpinned calls to the highest active version.
+	SetToolsetRoutes(ctx context.Context, in *SetToolsetRoutesRequest, opts ...grpc.CallOption) (*SetToolsetRoutesResponse, error)
 }
 
 type registryClient struct {
@@ -288,6 +333,86 @@ func (c *registryClient) ClaimToolCall(ctx context.Context, in *ClaimToolCallReq
 	return out, nil
 }
 
+func (c *registryClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProvidersResponse)
+	err := c.cc.Invoke(ctx, Registry_ListProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) ForceDrainProvider(ctx context.Context, in *ForceDrainProviderRequest, opts ...grpc.CallOption) (*ForceDrainProviderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceDrainProviderResponse)
+	err := c.cc.Invoke(ctx, Registry_ForceDrainProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) RetireRegistration(ctx context.Context, in *RetireRegistrationRequest, opts ...grpc.CallOption) (*RetireRegistrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetireRegistrationResponse)
+	err := c.cc.Invoke(ctx, Registry_RetireRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) ListToolCalls(ctx context.Context, in *ListToolCallsRequest, opts ...grpc.CallOption) (*ListToolCallsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListToolCallsResponse)
+	err := c.cc.Invoke(ctx, Registry_ListToolCalls_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) InspectToolCall(ctx context.Context, in *InspectToolCallRequest, opts ...grpc.CallOption) (*InspectToolCallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InspectToolCallResponse)
+	err := c.cc.Invoke(ctx, Registry_InspectToolCall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) ListHealthTransitions(ctx context.Context, in *ListHealthTransitionsRequest, opts ...grpc.CallOption) (*ListHealthTransitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHealthTransitionsResponse)
+	err := c.cc.Invoke(ctx, Registry_ListHealthTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) GetToolsetRoutes(ctx context.Context, in *GetToolsetRoutesRequest, opts ...grpc.CallOption) (*GetToolsetRoutesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetToolsetRoutesResponse)
+	err := c.cc.Invoke(ctx, Registry_GetToolsetRoutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) SetToolsetRoutes(ctx context.Context, in *SetToolsetRoutesRequest, opts ...grpc.CallOption) (*SetToolsetRoutesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetToolsetRoutesResponse)
+	err := c.cc.Invoke(ctx, Registry_SetToolsetRoutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RegistryServer is the server API for Registry service.
 // All implementations must embed UnimplementedRegistryServer
 // for forward compatibility.
@@ -384,6 +509,43 @@ type RegistryServer interface {
 	// incarnation and request event may publish deltas or complete the call;
 	// ownership never transfers after a crash.
 	ClaimToolCall(context.Context, *ClaimToolCallRequest) (*ClaimToolCallResponse, error)
+	// List the catalog admission record of one toolset, active or retired, with every
+	// unexpired provider-incarnation lease, its Redis-time expiry, draining state, and
+	// the number of claimed calls it still owns.
+	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
+	// Mark one exact provider-incarnation lease draining on behalf of an operator. New
+	// calls stop routing to the incarnation while calls it already claimed keep
+	// settlement authority until its lease is released or expires; lease renewals by
+	// that incarnation keep it draining. Missing incarnations and stale tokens succeed
+	// without mutation.
+	ForceDrainProvider(context.Context, *ForceDrainProviderRequest) (*ForceDrainProviderResponse, error)
+	// Retire the exact admission on behalf of an operator with the same semantics as
+	// Unregister: the toolset leaves discovery and routing, provider leases are
+	// preserved until release or expiry, and the token can never register again. A
+	// stale token returns admission_conflict.
+	RetireRegistration(context.Context, *RetireRegistrationRequest) (*RetireRegistrationResponse, error)
+	// List the in-flight calls of one toolset: calls a provider incarnation has
+	// claimed and not yet settled, ordered by execution deadline. Queued calls that
+	// no provider has claimed yet are not indexed; inspect them individually with
+	// InspectToolCall.
+	ListToolCalls(context.Context, *ListToolCallsRequest) (*ListToolCallsResponse, error)
+	// Inspect the authoritative record of one admitted call of a toolset: its
+	// publication, claim, and terminal state, the provider incarnation that owns it,
+	// and the length and last event of its result stream.
+	InspectToolCall(context.Context, *InspectToolCallRequest) (*InspectToolCallResponse, error)
+	// List the recorded health transitions of one toolset, newest first. The health
+	// scheduler records a transition whenever the observed health, admission token, or
+	// membership epoch changes; the registry retains a bounded history per toolset.
+	ListHealthTransitions(context.Context, *ListHealthTransitionsRequest) (*ListHealthTransitionsResponse, error)
+	// Return the canary route table that splits unpinned calls between the
+	// side-by-side name@version registrations of one toolset. An empty table routes
+	// unpinned calls to the highest active version.
+	GetToolsetRoutes(context.Context, *GetToolsetRoutesRequest) (*GetToolsetRoutesResponse, error)
+	// Replace the canary route table of one toolset on behalf of an operator.
+	// Weights are relative; a zero weight drains a version of unpinned traffic while
+	// calls pinned to that exact version keep routing to it. An empty table routes
+	// unpinned calls to the highest active version.
+	SetToolsetRoutes(context.Context, *SetToolsetRoutesRequest) (*SetToolsetRoutesResponse, error)
 	mustEmbedUnimplementedRegistryServer()
 }
 
@@ -436,6 +598,30 @@ func (UnimplementedRegistryServer) ReportToolCallOverload(context.Context, *Repo
 func (UnimplementedRegistryServer) ClaimToolCall(context.Context, *ClaimToolCallRequest) (*ClaimToolCallResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ClaimToolCall not implemented")
 }
+func (UnimplementedRegistryServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProviders not implemented")
+}
+func (UnimplementedRegistryServer) ForceDrainProvider(context.Context, *ForceDrainProviderRequest) (*ForceDrainProviderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForceDrainProvider not implemented")
+}
+func (UnimplementedRegistryServer) RetireRegistration(context.Context, *RetireRegistrationRequest) (*RetireRegistrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetireRegistration not implemented")
+}
+func (UnimplementedRegistryServer) ListToolCalls(context.Context, *ListToolCallsRequest) (*ListToolCallsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListToolCalls not implemented")
+}
+func (UnimplementedRegistryServer) InspectToolCall(context.Context, *InspectToolCallRequest) (*InspectToolCallResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InspectToolCall not implemented")
+}
+func (UnimplementedRegistryServer) ListHealthTransitions(context.Context, *ListHealthTransitionsRequest) (*ListHealthTransitionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListHealthTransitions not implemented")
+}
+func (UnimplementedRegistryServer) GetToolsetRoutes(context.Context, *GetToolsetRoutesRequest) (*GetToolsetRoutesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetToolsetRoutes not implemented")
+}
+func (UnimplementedRegistryServer) SetToolsetRoutes(context.Context, *SetToolsetRoutesRequest) (*SetToolsetRoutesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetToolsetRoutes not implemented")
+}
 func (UnimplementedRegistryServer) mustEmbedUnimplementedRegistryServer() {}
 func (UnimplementedRegistryServer) testEmbeddedByValue()                  {}
 
@@ -709,6 +895,150 @@ func _Registry_ClaimToolCall_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registry_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).ListProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_ListProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).ListProviders(ctx, req.(*ListProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_ForceDrainProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceDrainProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).ForceDrainProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_ForceDrainProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).ForceDrainProvider(ctx, req.(*ForceDrainProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_RetireRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetireRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).RetireRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_RetireRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).RetireRegistration(ctx, req.(*RetireRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_ListToolCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListToolCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).ListToolCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_ListToolCalls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).ListToolCalls(ctx, req.(*ListToolCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_InspectToolCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectToolCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).InspectToolCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_InspectToolCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).InspectToolCall(ctx, req.(*InspectToolCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_ListHealthTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHealthTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).ListHealthTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_ListHealthTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).ListHealthTransitions(ctx, req.(*ListHealthTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_GetToolsetRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetToolsetRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).GetToolsetRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_GetToolsetRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).GetToolsetRoutes(ctx, req.(*GetToolsetRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_SetToolsetRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetToolsetRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).SetToolsetRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_SetToolsetRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).SetToolsetRoutes(ctx, req.(*SetToolsetRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Registry_ServiceDesc is the grpc.ServiceDesc for Registry service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -772,6 +1102,38 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimToolCall",
 			Handler:    _Registry_ClaimToolCall_Handler,
 		},
+		{
+			MethodName: "ListProviders",
+			Handler:    _Registry_ListProviders_Handler,
+		},
+		{
+			MethodName: "ForceDrainProvider",
+			Handler:    _Registry_ForceDrainProvider_Handler,
+		},
+		{
+			MethodName: "RetireRegistration",
+			Handler:    _Registry_RetireRegistration_Handler,
+		},
+		{
+			MethodName: "ListToolCalls",
+			Handler:    _Registry_ListToolCalls_Handler,
+		},
+		{
+			MethodName: "InspectToolCall",
+			Handler:    _Registry_InspectToolCall_Handler,
+		},
+		{
+			MethodName: "ListHealthTransitions",
+			Handler:    _Registry_ListHealthTransitions_Handler,
+		},
+		{
+			MethodName: "GetToolsetRoutes",
+			Handler:    _Registry_GetToolsetRoutes_Handler,
+		},
+		{
+			MethodName: "SetToolsetRoutes",
+			Handler:    _Registry_SetToolsetRoutes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "goagen_registry_registry.proto",
@@ -446,3 +446,255 @@ func DecodeClaimToolCallRequest(ctx context.Context, v any, md metadata.MD) (any
 	}
 	return payload, nil
 }
+
+// EncodeListProvidersResponse encodes responses from the "registry" service
+// "ListProviders" endpoint.
+func EncodeListProvidersResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*registry.ListProvidersResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "ListProviders", "*registry.ListProvidersResult", v)
+	}
+	resp := NewProtoListProvidersResponse(result)
+	return resp, nil
+}
+
+// DecodeListProvidersRequest decodes requests sent to "registry" service
+// "ListProviders" endpoint.
+func DecodeListProvidersRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *registrypb.ListProvidersRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*registrypb.ListProvidersRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("registry", "ListProviders", "*registrypb.ListProvidersRequest", v)
+		}
+		if err := ValidateListProvidersRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *registry.ListProvidersPayload
+	{
+		payload = NewListProvidersPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeForceDrainProviderResponse encodes responses from the "registry"
+// service "ForceDrainProvider" endpoint.
+func EncodeForceDrainProviderResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoForceDrainProviderResponse()
+	return resp, nil
+}
+
+// DecodeForceDrainProviderRequest decodes requests sent to "registry" service
+// "ForceDrainProvider" endpoint.
+func DecodeForceDrainProviderRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *registrypb.ForceDrainProviderRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*registrypb.ForceDrainProviderRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("registry", "ForceDrainProvider", "*registrypb.ForceDrainProviderRequest", v)
+		}
+		if err := ValidateForceDrainProviderRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *registry.ForceDrainProviderPayload
+	{
+		payload = NewForceDrainProviderPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeRetireRegistrationResponse encodes responses from the "registry"
+// service "RetireRegistration" endpoint.
+func EncodeRetireRegistrationResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoRetireRegistrationResponse()
+	return resp, nil
+}
+
+// DecodeRetireRegistrationRequest decodes requests sent to "registry" service
+// "RetireRegistration" endpoint.
+func DecodeRetireRegistrationRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *registrypb.RetireRegistrationRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*registrypb.RetireRegistrationRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("registry", "RetireRegistration", "*registrypb.RetireRegistrationRequest", v)
+		}
+		if err := ValidateRetireRegistrationRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *registry.RetireRegistrationPayload
+	{
+		payload = NewRetireRegistrationPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeListToolCallsResponse encodes responses from the "registry" service
+// "ListToolCalls" endpoint.
+func EncodeListToolCallsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*registry.ListToolCallsResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "ListToolCalls", "*registry.ListToolCallsResult", v)
+	}
+	resp := NewProtoListToolCallsResponse(result)
+	return resp, nil
+}
+
+// DecodeListToolCallsRequest decodes requests sent to "registry" service
+// "ListToolCalls" endpoint.
+func DecodeListToolCallsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *registrypb.ListToolCallsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*registrypb.ListToolCallsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("registry", "ListToolCalls", "*registrypb.ListToolCallsRequest", v)
+		}
+		if err := ValidateListToolCallsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *registry.ListToolCallsPayload
+	{
+		payload = NewListToolCallsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeInspectToolCallResponse encodes responses from the "registry" service
+// "InspectToolCall" endpoint.
+func EncodeInspectToolCallResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*registry.ToolCallInfo)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "InspectToolCall", "*registry.ToolCallInfo", v)
+	}
+	resp := NewProtoInspectToolCallResponse(result)
+	return resp, nil
+}
+
+// DecodeInspectToolCallRequest decodes requests sent to "registry" service
+// "InspectToolCall" endpoint.
+func DecodeInspectToolCallRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *registrypb.InspectToolCallRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*registrypb.InspectToolCallRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("registry", "InspectToolCall", "*registrypb.InspectToolCallRequest", v)
+		}
+		if err := ValidateInspectToolCallRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *registry.InspectToolCallPayload
+	{
+		payload = NewInspectToolCallPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeListHealthTransitionsResponse encodes responses from the "registry"
+// service "ListHealthTransitions" endpoint.
+func EncodeListHealthTransitionsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*registry.ListHealthTransitionsResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "ListHealthTransitions", "*registry.ListHealthTransitionsResult", v)
+	}
+	resp := NewProtoListHealthTransitionsResponse(result)
+	return resp, nil
+}
+
+// DecodeListHealthTransitionsRequest decodes requests sent to "registry"
+// service "ListHealthTransitions" endpoint.
+func DecodeListHealthTransitionsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *registrypb.ListHealthTransitionsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*registrypb.ListHealthTransitionsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("registry", "ListHealthTransitions", "*registrypb.ListHealthTransitionsRequest", v)
+		}
+		if err := ValidateListHealthTransitionsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *registry.ListHealthTransitionsPayload
+	{
+		payload = NewListHealthTransitionsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeGetToolsetRoutesResponse encodes responses from the "registry" service
+// "GetToolsetRoutes" endpoint.
+func EncodeGetToolsetRoutesResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*registry.ToolsetRoutesResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("registry", "GetToolsetRoutes", "*registry.ToolsetRoutesResult", v)
+	}
+	resp := NewProtoGetToolsetRoutesResponse(result)
+	return resp, nil
+}
+
+// DecodeGetToolsetRoutesRequest decodes requests sent to "registry" service
+// "GetToolsetRoutes" endpoint.
+func DecodeGetToolsetRoutesRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *registrypb.GetToolsetRoutesRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*registrypb.GetToolsetRoutesRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("registry", "GetToolsetRoutes", "*registrypb.GetToolsetRoutesRequest", v)
+		}
+		if err := ValidateGetToolsetRoutesRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *registry.GetToolsetRoutesPayload
+	{
+		payload = NewGetToolsetRoutesPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeSetToolsetRoutesResponse encodes responses from the "registry" service
+// "SetToolsetRoutes" endpoint.
+func EncodeSetToolsetRoutesResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoSetToolsetRoutesResponse()
+	return resp, nil
+}
+
+// DecodeSetToolsetRoutesRequest decodes requests sent to "registry" service
+// "SetToolsetRoutes" endpoint.
+func DecodeSetToolsetRoutesRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *registrypb.SetToolsetRoutesRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*registrypb.SetToolsetRoutesRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("registry", "SetToolsetRoutes", "*registrypb.SetToolsetRoutesRequest", v)
+		}
+		if err := ValidateSetToolsetRoutesRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *registry.SetToolsetRoutesPayload
+	{
+		payload = NewSetToolsetRoutesPayload(message)
+	}
+	return payload, nil
+}
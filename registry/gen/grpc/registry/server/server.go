@@ -34,6 +34,14 @@ type Server struct {
 	PublishToolOutputDeltaH goagrpc.UnaryHandler
 	ReportToolCallOverloadH goagrpc.UnaryHandler
 	ClaimToolCallH          goagrpc.UnaryHandler
+	ListProvidersH          goagrpc.UnaryHandler
+	ForceDrainProviderH     goagrpc.UnaryHandler
+	RetireRegistrationH     goagrpc.UnaryHandler
+	ListToolCallsH          goagrpc.UnaryHandler
+	InspectToolCallH        goagrpc.UnaryHandler
+	ListHealthTransitionsH  goagrpc.UnaryHandler
+	GetToolsetRoutesH       goagrpc.UnaryHandler
+	SetToolsetRoutesH       goagrpc.UnaryHandler
 	registrypb.UnimplementedRegistryServer
 }
 
@@ -54,6 +62,14 @@ func New(e *registry.Endpoints, uh goagrpc.UnaryHandler) *Server {
 		PublishToolOutputDeltaH: NewPublishToolOutputDeltaHandler(e.PublishToolOutputDelta, uh),
 		ReportToolCallOverloadH: NewReportToolCallOverloadHandler(e.ReportToolCallOverload, uh),
 		ClaimToolCallH:          NewClaimToolCallHandler(e.ClaimToolCall, uh),
+		ListProvidersH:          NewListProvidersHandler(e.ListProviders, uh),
+		ForceDrainProviderH:     NewForceDrainProviderHandler(e.ForceDrainProvider, uh),
+		RetireRegistrationH:     NewRetireRegistrationHandler(e.RetireRegistration, uh),
+		ListToolCallsH:          NewListToolCallsHandler(e.ListToolCalls, uh),
+		InspectToolCallH:        NewInspectToolCallHandler(e.InspectToolCall, uh),
+		ListHealthTransitionsH:  NewListHealthTransitionsHandler(e.ListHealthTransitions, uh),
+		GetToolsetRoutesH:       NewGetToolsetRoutesHandler(e.GetToolsetRoutes, uh),
+		SetToolsetRoutesH:       NewSetToolsetRoutesHandler(e.SetToolsetRoutes, uh),
 	}
 }
 
@@ -84,6 +100,10 @@ func (s *Server) Register(ctx context.Context, message *registrypb.RegisterReque
 				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
 			case "service_unavailable":
 				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
@@ -112,6 +132,10 @@ func (s *Server) ReleaseProvider(ctx context.Context, message *registrypb.Releas
 			switch en.GoaErrorName() {
 			case "service_unavailable":
 				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
@@ -140,6 +164,10 @@ func (s *Server) DrainProvider(ctx context.Context, message *registrypb.DrainPro
 			switch en.GoaErrorName() {
 			case "service_unavailable":
 				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
@@ -170,6 +198,10 @@ func (s *Server) Unregister(ctx context.Context, message *registrypb.UnregisterR
 				return nil, goagrpc.NewStatusError(codes.FailedPrecondition, err, goagrpc.NewErrorResponse(err))
 			case "service_unavailable":
 				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
@@ -192,6 +224,15 @@ func (s *Server) Pong(ctx context.Context, message *registrypb.PongRequest) (*re
 	ctx = context.WithValue(ctx, goa.ServiceKey, "registry")
 	resp, err := s.PongH.Handle(ctx, message)
 	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			}
+		}
 		return nil, goagrpc.EncodeError(err)
 	}
 	return resp.(*registrypb.PongResponse), nil
@@ -213,6 +254,15 @@ func (s *Server) ListToolsets(ctx context.Context, message *registrypb.ListTools
 	ctx = context.WithValue(ctx, goa.ServiceKey, "registry")
 	resp, err := s.ListToolsetsH.Handle(ctx, message)
 	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			}
+		}
 		return nil, goagrpc.EncodeError(err)
 	}
 	return resp.(*registrypb.ListToolsetsResponse), nil
@@ -239,6 +289,10 @@ func (s *Server) GetToolset(ctx context.Context, message *registrypb.GetToolsetR
 			switch en.GoaErrorName() {
 			case "not_found":
 				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
@@ -261,6 +315,15 @@ func (s *Server) Search(ctx context.Context, message *registrypb.SearchRequest)
 	ctx = context.WithValue(ctx, goa.ServiceKey, "registry")
 	resp, err := s.SearchH.Handle(ctx, message)
 	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			}
+		}
 		return nil, goagrpc.EncodeError(err)
 	}
 	return resp.(*registrypb.SearchResponse), nil
@@ -293,6 +356,12 @@ func (s *Server) CallTool(ctx context.Context, message *registrypb.CallToolReque
 				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
 			case "call_not_admitted":
 				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "quota_exceeded":
+				return nil, goagrpc.NewStatusError(codes.ResourceExhausted, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
@@ -327,6 +396,10 @@ func (s *Server) RetryTool(ctx context.Context, message *registrypb.RetryToolReq
 				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
 			case "admission_conflict":
 				return nil, goagrpc.NewStatusError(codes.FailedPrecondition, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
@@ -357,6 +430,10 @@ func (s *Server) CompleteToolCall(ctx context.Context, message *registrypb.Compl
 				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
 			case "service_unavailable":
 				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
@@ -387,6 +464,10 @@ func (s *Server) PublishToolOutputDelta(ctx context.Context, message *registrypb
 				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
 			case "service_unavailable":
 				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
@@ -417,6 +498,10 @@ func (s *Server) ReportToolCallOverload(ctx context.Context, message *registrypb
 				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
 			case "service_unavailable":
 				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
@@ -447,9 +532,279 @@ func (s *Server) ClaimToolCall(ctx context.Context, message *registrypb.ClaimToo
 				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
 			case "service_unavailable":
 				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
 	}
 	return resp.(*registrypb.ClaimToolCallResponse), nil
 }
+
+// NewListProvidersHandler creates a gRPC handler which serves the "registry"
+// service "ListProviders" endpoint.
+func NewListProvidersHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListProvidersRequest, EncodeListProvidersResponse)
+	}
+	return h
+}
+
+// ListProviders implements the "ListProviders" method in
+// registrypb.RegistryServer interface.
+func (s *Server) ListProviders(ctx context.Context, message *registrypb.ListProvidersRequest) (*registrypb.ListProvidersResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "ListProviders")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "registry")
+	resp, err := s.ListProvidersH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			case "service_unavailable":
+				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*registrypb.ListProvidersResponse), nil
+}
+
+// NewForceDrainProviderHandler creates a gRPC handler which serves the
+// "registry" service "ForceDrainProvider" endpoint.
+func NewForceDrainProviderHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeForceDrainProviderRequest, EncodeForceDrainProviderResponse)
+	}
+	return h
+}
+
+// ForceDrainProvider implements the "ForceDrainProvider" method in
+// registrypb.RegistryServer interface.
+func (s *Server) ForceDrainProvider(ctx context.Context, message *registrypb.ForceDrainProviderRequest) (*registrypb.ForceDrainProviderResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "ForceDrainProvider")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "registry")
+	resp, err := s.ForceDrainProviderH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "service_unavailable":
+				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*registrypb.ForceDrainProviderResponse), nil
+}
+
+// NewRetireRegistrationHandler creates a gRPC handler which serves the
+// "registry" service "RetireRegistration" endpoint.
+func NewRetireRegistrationHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeRetireRegistrationRequest, EncodeRetireRegistrationResponse)
+	}
+	return h
+}
+
+// RetireRegistration implements the "RetireRegistration" method in
+// registrypb.RegistryServer interface.
+func (s *Server) RetireRegistration(ctx context.Context, message *registrypb.RetireRegistrationRequest) (*registrypb.RetireRegistrationResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "RetireRegistration")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "registry")
+	resp, err := s.RetireRegistrationH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "admission_conflict":
+				return nil, goagrpc.NewStatusError(codes.FailedPrecondition, err, goagrpc.NewErrorResponse(err))
+			case "service_unavailable":
+				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*registrypb.RetireRegistrationResponse), nil
+}
+
+// NewListToolCallsHandler creates a gRPC handler which serves the "registry"
+// service "ListToolCalls" endpoint.
+func NewListToolCallsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListToolCallsRequest, EncodeListToolCallsResponse)
+	}
+	return h
+}
+
+// ListToolCalls implements the "ListToolCalls" method in
+// registrypb.RegistryServer interface.
+func (s *Server) ListToolCalls(ctx context.Context, message *registrypb.ListToolCallsRequest) (*registrypb.ListToolCallsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "ListToolCalls")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "registry")
+	resp, err := s.ListToolCallsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "service_unavailable":
+				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*registrypb.ListToolCallsResponse), nil
+}
+
+// NewInspectToolCallHandler creates a gRPC handler which serves the "registry"
+// service "InspectToolCall" endpoint.
+func NewInspectToolCallHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeInspectToolCallRequest, EncodeInspectToolCallResponse)
+	}
+	return h
+}
+
+// InspectToolCall implements the "InspectToolCall" method in
+// registrypb.RegistryServer interface.
+func (s *Server) InspectToolCall(ctx context.Context, message *registrypb.InspectToolCallRequest) (*registrypb.InspectToolCallResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "InspectToolCall")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "registry")
+	resp, err := s.InspectToolCallH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			case "validation_error":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "service_unavailable":
+				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*registrypb.InspectToolCallResponse), nil
+}
+
+// NewListHealthTransitionsHandler creates a gRPC handler which serves the
+// "registry" service "ListHealthTransitions" endpoint.
+func NewListHealthTransitionsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListHealthTransitionsRequest, EncodeListHealthTransitionsResponse)
+	}
+	return h
+}
+
+// ListHealthTransitions implements the "ListHealthTransitions" method in
+// registrypb.RegistryServer interface.
+func (s *Server) ListHealthTransitions(ctx context.Context, message *registrypb.ListHealthTransitionsRequest) (*registrypb.ListHealthTransitionsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "ListHealthTransitions")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "registry")
+	resp, err := s.ListHealthTransitionsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "service_unavailable":
+				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*registrypb.ListHealthTransitionsResponse), nil
+}
+
+// NewGetToolsetRoutesHandler creates a gRPC handler which serves the
+// "registry" service "GetToolsetRoutes" endpoint.
+func NewGetToolsetRoutesHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetToolsetRoutesRequest, EncodeGetToolsetRoutesResponse)
+	}
+	return h
+}
+
+// GetToolsetRoutes implements the "GetToolsetRoutes" method in
+// registrypb.RegistryServer interface.
+func (s *Server) GetToolsetRoutes(ctx context.Context, message *registrypb.GetToolsetRoutesRequest) (*registrypb.GetToolsetRoutesResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetToolsetRoutes")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "registry")
+	resp, err := s.GetToolsetRoutesH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "service_unavailable":
+				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*registrypb.GetToolsetRoutesResponse), nil
+}
+
+// NewSetToolsetRoutesHandler creates a gRPC handler which serves the
+// "registry" service "SetToolsetRoutes" endpoint.
+func NewSetToolsetRoutesHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeSetToolsetRoutesRequest, EncodeSetToolsetRoutesResponse)
+	}
+	return h
+}
+
+// SetToolsetRoutes implements the "SetToolsetRoutes" method in
+// registrypb.RegistryServer interface.
+func (s *Server) SetToolsetRoutes(ctx context.Context, message *registrypb.SetToolsetRoutesRequest) (*registrypb.SetToolsetRoutesResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "SetToolsetRoutes")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "registry")
+	resp, err := s.SetToolsetRoutesH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "validation_error":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "service_unavailable":
+				return nil, goagrpc.NewStatusError(codes.Unavailable, err, goagrpc.NewErrorResponse(err))
+			case "unauthenticated":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "permission_denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*registrypb.SetToolsetRoutesResponse), nil
+}
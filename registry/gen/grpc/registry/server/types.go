@@ -229,6 +229,9 @@ func NewProtoGetToolsetResponse(result *registry.Toolset) *registrypb.GetToolset
 			}
 		}
 	}
+	if result.Compatibility != nil {
+		message.Compatibility = svcRegistrySchemaCompatibilityToRegistrypbSchemaCompatibility(result.Compatibility)
+	}
 	return message
 }
 
@@ -421,6 +424,238 @@ func NewProtoClaimToolCallResponse(result *registry.ClaimToolCallResult) *regist
 	return message
 }
 
+// NewListProvidersPayload builds the payload of the "ListProviders" endpoint of
+// the "registry" service from the gRPC request type.
+func NewListProvidersPayload(message *registrypb.ListProvidersRequest) *registry.ListProvidersPayload {
+	v := &registry.ListProvidersPayload{
+		Name: message.Name,
+	}
+	return v
+}
+
+// NewProtoListProvidersResponse builds the gRPC response type from the result
+// of the "ListProviders" endpoint of the "registry" service.
+func NewProtoListProvidersResponse(result *registry.ListProvidersResult) *registrypb.ListProvidersResponse {
+	message := &registrypb.ListProvidersResponse{
+		Name:              result.Name,
+		State:             result.State,
+		RegistrationToken: result.RegistrationToken,
+		HealthEpoch:       result.HealthEpoch,
+		LastPongUnixMilli: result.LastPongUnixMilli,
+		Healthy:           result.Healthy,
+	}
+	if result.Providers != nil {
+		message.Providers = make([]*registrypb.ProviderLeaseInfo, len(result.Providers))
+		for i, val := range result.Providers {
+			message.Providers[i] = &registrypb.ProviderLeaseInfo{
+				ProviderId:              val.ProviderID,
+				ProviderIncarnationId:   val.ProviderIncarnationID,
+				LeaseExpiresAtUnixMilli: val.LeaseExpiresAtUnixMilli,
+				Draining:                val.Draining,
+				ClaimedCalls:            val.ClaimedCalls,
+			}
+		}
+	}
+	return message
+}
+
+// NewForceDrainProviderPayload builds the payload of the "ForceDrainProvider"
+// endpoint of the "registry" service from the gRPC request type.
+func NewForceDrainProviderPayload(message *registrypb.ForceDrainProviderRequest) *registry.ForceDrainProviderPayload {
+	v := &registry.ForceDrainProviderPayload{
+		Name:                  message.Name,
+		ProviderID:            message.ProviderId,
+		ProviderIncarnationID: message.ProviderIncarnationId,
+		RegistrationToken:     message.RegistrationToken,
+	}
+	return v
+}
+
+// NewProtoForceDrainProviderResponse builds the gRPC response type from the
+// result of the "ForceDrainProvider" endpoint of the "registry" service.
+func NewProtoForceDrainProviderResponse() *registrypb.ForceDrainProviderResponse {
+	message := &registrypb.ForceDrainProviderResponse{}
+	return message
+}
+
+// NewRetireRegistrationPayload builds the payload of the "RetireRegistration"
+// endpoint of the "registry" service from the gRPC request type.
+func NewRetireRegistrationPayload(message *registrypb.RetireRegistrationRequest) *registry.RetireRegistrationPayload {
+	v := &registry.RetireRegistrationPayload{
+		Name:              message.Name,
+		RegistrationToken: message.RegistrationToken,
+	}
+	return v
+}
+
+// NewProtoRetireRegistrationResponse builds the gRPC response type from the
+// result of the "RetireRegistration" endpoint of the "registry" service.
+func NewProtoRetireRegistrationResponse() *registrypb.RetireRegistrationResponse {
+	message := &registrypb.RetireRegistrationResponse{}
+	return message
+}
+
+// NewListToolCallsPayload builds the payload of the "ListToolCalls" endpoint of
+// the "registry" service from the gRPC request type.
+func NewListToolCallsPayload(message *registrypb.ListToolCallsRequest) *registry.ListToolCallsPayload {
+	v := &registry.ListToolCallsPayload{
+		Toolset: message.Toolset,
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Limit == nil {
+		v.Limit = 100
+	}
+	return v
+}
+
+// NewProtoListToolCallsResponse builds the gRPC response type from the result
+// of the "ListToolCalls" endpoint of the "registry" service.
+func NewProtoListToolCallsResponse(result *registry.ListToolCallsResult) *registrypb.ListToolCallsResponse {
+	message := &registrypb.ListToolCallsResponse{}
+	if result.Calls != nil {
+		message.Calls = make([]*registrypb.ToolCallInfo, len(result.Calls))
+		for i, val := range result.Calls {
+			message.Calls[i] = &registrypb.ToolCallInfo{
+				ToolUseId:                  val.ToolUseID,
+				State:                      val.State,
+				RegistrationToken:          val.RegistrationToken,
+				ExecutionDeadlineUnixMilli: val.ExecutionDeadlineUnixMilli,
+				ExpiresAtUnixMilli:         val.ExpiresAtUnixMilli,
+				ProviderId:                 val.ProviderID,
+				ProviderIncarnationId:      val.ProviderIncarnationID,
+				RequestEventId:             val.RequestEventID,
+				TerminalCause:              val.TerminalCause,
+				OutputDeltaCount:           val.OutputDeltaCount,
+				ResultStreamId:             val.ResultStreamID,
+				ResultStreamLength:         val.ResultStreamLength,
+				LastEventId:                val.LastEventID,
+			}
+		}
+	}
+	return message
+}
+
+// NewInspectToolCallPayload builds the payload of the "InspectToolCall"
+// endpoint of the "registry" service from the gRPC request type.
+func NewInspectToolCallPayload(message *registrypb.InspectToolCallRequest) *registry.InspectToolCallPayload {
+	v := &registry.InspectToolCallPayload{
+		Toolset:   message.Toolset,
+		ToolUseID: message.ToolUseId,
+	}
+	return v
+}
+
+// NewProtoInspectToolCallResponse builds the gRPC response type from the result
+// of the "InspectToolCall" endpoint of the "registry" service.
+func NewProtoInspectToolCallResponse(result *registry.ToolCallInfo) *registrypb.InspectToolCallResponse {
+	message := &registrypb.InspectToolCallResponse{
+		ToolUseId:                  result.ToolUseID,
+		State:                      result.State,
+		RegistrationToken:          result.RegistrationToken,
+		ExecutionDeadlineUnixMilli: result.ExecutionDeadlineUnixMilli,
+		ExpiresAtUnixMilli:         result.ExpiresAtUnixMilli,
+		ProviderId:                 result.ProviderID,
+		ProviderIncarnationId:      result.ProviderIncarnationID,
+		RequestEventId:             result.RequestEventID,
+		TerminalCause:              result.TerminalCause,
+		OutputDeltaCount:           result.OutputDeltaCount,
+		ResultStreamId:             result.ResultStreamID,
+		ResultStreamLength:         result.ResultStreamLength,
+		LastEventId:                result.LastEventID,
+	}
+	return message
+}
+
+// NewListHealthTransitionsPayload builds the payload of the
+// "ListHealthTransitions" endpoint of the "registry" service from the gRPC
+// request type.
+func NewListHealthTransitionsPayload(message *registrypb.ListHealthTransitionsRequest) *registry.ListHealthTransitionsPayload {
+	v := &registry.ListHealthTransitionsPayload{
+		Name: message.Name,
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Limit == nil {
+		v.Limit = 50
+	}
+	return v
+}
+
+// NewProtoListHealthTransitionsResponse builds the gRPC response type from the
+// result of the "ListHealthTransitions" endpoint of the "registry" service.
+func NewProtoListHealthTransitionsResponse(result *registry.ListHealthTransitionsResult) *registrypb.ListHealthTransitionsResponse {
+	message := &registrypb.ListHealthTransitionsResponse{}
+	if result.Transitions != nil {
+		message.Transitions = make([]*registrypb.HealthTransition, len(result.Transitions))
+		for i, val := range result.Transitions {
+			message.Transitions[i] = &registrypb.HealthTransition{
+				AtUnixMilli:       val.AtUnixMilli,
+				Healthy:           val.Healthy,
+				RegistrationToken: val.RegistrationToken,
+				HealthEpoch:       val.HealthEpoch,
+				ProviderCount:     int32(val.ProviderCount),
+				LastPongUnixMilli: val.LastPongUnixMilli,
+			}
+		}
+	}
+	return message
+}
+
+// NewGetToolsetRoutesPayload builds the payload of the "GetToolsetRoutes"
+// endpoint of the "registry" service from the gRPC request type.
+func NewGetToolsetRoutesPayload(message *registrypb.GetToolsetRoutesRequest) *registry.GetToolsetRoutesPayload {
+	v := &registry.GetToolsetRoutesPayload{
+		Name: message.Name,
+	}
+	return v
+}
+
+// NewProtoGetToolsetRoutesResponse builds the gRPC response type from the
+// result of the "GetToolsetRoutes" endpoint of the "registry" service.
+func NewProtoGetToolsetRoutesResponse(result *registry.ToolsetRoutesResult) *registrypb.GetToolsetRoutesResponse {
+	message := &registrypb.GetToolsetRoutesResponse{
+		Name: result.Name,
+	}
+	if result.Routes != nil {
+		message.Routes = make([]*registrypb.ToolsetRoute, len(result.Routes))
+		for i, val := range result.Routes {
+			message.Routes[i] = &registrypb.ToolsetRoute{
+				Version: string(val.Version),
+				Weight:  int32(val.Weight),
+			}
+		}
+	}
+	return message
+}
+
+// NewSetToolsetRoutesPayload builds the payload of the "SetToolsetRoutes"
+// endpoint of the "registry" service from the gRPC request type.
+func NewSetToolsetRoutesPayload(message *registrypb.SetToolsetRoutesRequest) *registry.SetToolsetRoutesPayload {
+	v := &registry.SetToolsetRoutesPayload{
+		Name: message.Name,
+	}
+	if message.Routes != nil {
+		v.Routes = make([]*registry.ToolsetRoute, len(message.Routes))
+		for i, val := range message.Routes {
+			v.Routes[i] = &registry.ToolsetRoute{
+				Version: registry.SemVer(val.Version),
+				Weight:  int(val.Weight),
+			}
+		}
+	}
+	return v
+}
+
+// NewProtoSetToolsetRoutesResponse builds the gRPC response type from the
+// result of the "SetToolsetRoutes" endpoint of the "registry" service.
+func NewProtoSetToolsetRoutesResponse() *registrypb.SetToolsetRoutesResponse {
+	message := &registrypb.SetToolsetRoutesResponse{}
+	return message
+}
+
 // ValidateRegisterRequest runs the validations defined on RegisterRequest.
 func ValidateRegisterRequest(message *registrypb.RegisterRequest) (err error) {
 	if message.Tools == nil {
@@ -807,6 +1042,155 @@ func ValidateClaimToolCallRequest(message *registrypb.ClaimToolCallRequest) (err
 	return
 }
 
+// ValidateListProvidersRequest runs the validations defined on
+// ListProvidersRequest.
+func ValidateListProvidersRequest(message *registrypb.ListProvidersRequest) (err error) {
+	if utf8.RuneCountInString(message.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 1, true))
+	}
+	if utf8.RuneCountInString(message.Name) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 256, false))
+	}
+	return
+}
+
+// ValidateForceDrainProviderRequest runs the validations defined on
+// ForceDrainProviderRequest.
+func ValidateForceDrainProviderRequest(message *registrypb.ForceDrainProviderRequest) (err error) {
+	if utf8.RuneCountInString(message.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 1, true))
+	}
+	if utf8.RuneCountInString(message.Name) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 256, false))
+	}
+	err = goa.MergeErrors(err, goa.ValidatePattern("message.provider_id", message.ProviderId, "^[^\\x00]+$"))
+	if utf8.RuneCountInString(message.ProviderId) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.provider_id", message.ProviderId, utf8.RuneCountInString(message.ProviderId), 1, true))
+	}
+	if utf8.RuneCountInString(message.ProviderId) > 512 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.provider_id", message.ProviderId, utf8.RuneCountInString(message.ProviderId), 512, false))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.provider_incarnation_id", message.ProviderIncarnationId, goa.FormatUUID))
+	err = goa.MergeErrors(err, goa.ValidatePattern("message.registration_token", message.RegistrationToken, "^[0-9a-f]{64}$"))
+	return
+}
+
+// ValidateRetireRegistrationRequest runs the validations defined on
+// RetireRegistrationRequest.
+func ValidateRetireRegistrationRequest(message *registrypb.RetireRegistrationRequest) (err error) {
+	if utf8.RuneCountInString(message.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 1, true))
+	}
+	if utf8.RuneCountInString(message.Name) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 256, false))
+	}
+	err = goa.MergeErrors(err, goa.ValidatePattern("message.registration_token", message.RegistrationToken, "^[0-9a-f]{64}$"))
+	return
+}
+
+// ValidateListToolCallsRequest runs the validations defined on
+// ListToolCallsRequest.
+func ValidateListToolCallsRequest(message *registrypb.ListToolCallsRequest) (err error) {
+	if utf8.RuneCountInString(message.Toolset) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.toolset", message.Toolset, utf8.RuneCountInString(message.Toolset), 1, true))
+	}
+	if utf8.RuneCountInString(message.Toolset) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.toolset", message.Toolset, utf8.RuneCountInString(message.Toolset), 256, false))
+	}
+	if message.Limit != nil {
+		if *message.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1, true))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1000, false))
+		}
+	}
+	return
+}
+
+// ValidateInspectToolCallRequest runs the validations defined on
+// InspectToolCallRequest.
+func ValidateInspectToolCallRequest(message *registrypb.InspectToolCallRequest) (err error) {
+	if utf8.RuneCountInString(message.Toolset) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.toolset", message.Toolset, utf8.RuneCountInString(message.Toolset), 1, true))
+	}
+	if utf8.RuneCountInString(message.Toolset) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.toolset", message.Toolset, utf8.RuneCountInString(message.Toolset), 256, false))
+	}
+	err = goa.MergeErrors(err, goa.ValidatePattern("message.tool_use_id", message.ToolUseId, "^[^\\x00]{1,256}$"))
+	return
+}
+
+// ValidateListHealthTransitionsRequest runs the validations defined on
+// ListHealthTransitionsRequest.
+func ValidateListHealthTransitionsRequest(message *registrypb.ListHealthTransitionsRequest) (err error) {
+	if utf8.RuneCountInString(message.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 1, true))
+	}
+	if utf8.RuneCountInString(message.Name) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 256, false))
+	}
+	if message.Limit != nil {
+		if *message.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1, true))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit > 256 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 256, false))
+		}
+	}
+	return
+}
+
+// ValidateGetToolsetRoutesRequest runs the validations defined on
+// GetToolsetRoutesRequest.
+func ValidateGetToolsetRoutesRequest(message *registrypb.GetToolsetRoutesRequest) (err error) {
+	if utf8.RuneCountInString(message.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 1, true))
+	}
+	if utf8.RuneCountInString(message.Name) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 256, false))
+	}
+	return
+}
+
+// ValidateSetToolsetRoutesRequest runs the validations defined on
+// SetToolsetRoutesRequest.
+func ValidateSetToolsetRoutesRequest(message *registrypb.SetToolsetRoutesRequest) (err error) {
+	if utf8.RuneCountInString(message.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 1, true))
+	}
+	if utf8.RuneCountInString(message.Name) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", message.Name, utf8.RuneCountInString(message.Name), 256, false))
+	}
+	if len(message.Routes) > 64 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.routes", message.Routes, len(message.Routes), 64, false))
+	}
+	for _, e := range message.Routes {
+		if e != nil {
+			if err2 := ValidateToolsetRoute(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateToolsetRoute runs the validations defined on ToolsetRoute.
+func ValidateToolsetRoute(elem *registrypb.ToolsetRoute) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("elem.version", string(elem.Version), "^v?\\d+\\.\\d+\\.\\d+(-[a-zA-Z0-9.]+)?$"))
+	if elem.Weight < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("elem.weight", elem.Weight, 0, true))
+	}
+	if elem.Weight > 10000 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("elem.weight", elem.Weight, 10000, false))
+	}
+	return
+}
+
 // protobufRegistrypbToolCallMetaToRegistryToolCallMeta builds a value of type
 // *registry.ToolCallMeta from a value of type *registrypb.ToolCallMeta.
 func protobufRegistrypbToolCallMetaToRegistryToolCallMeta(v *registrypb.ToolCallMeta) *registry.ToolCallMeta {
@@ -834,3 +1218,51 @@ func svcRegistryToolCallMetaToRegistrypbToolCallMeta(v *registry.ToolCallMeta) *
 
 	return res
 }
+
+// svcRegistrySchemaCompatibilityToRegistrypbSchemaCompatibility builds a value
+// of type *registrypb.SchemaCompatibility from a value of type
+// *registry.SchemaCompatibility.
+func svcRegistrySchemaCompatibilityToRegistrypbSchemaCompatibility(v *registry.SchemaCompatibility) *registrypb.SchemaCompatibility {
+	if v == nil {
+		return nil
+	}
+	res := &registrypb.SchemaCompatibility{
+		BaseName: v.BaseName,
+		Breaking: v.Breaking,
+	}
+	if v.BaseVersion != nil {
+		baseVersion := string(*v.BaseVersion)
+		res.BaseVersion = &baseVersion
+	}
+	if v.Changes != nil {
+		res.Changes = make([]*registrypb.SchemaChange, len(v.Changes))
+		for i, val := range v.Changes {
+			if val == nil {
+				res.Changes[i] = nil
+				continue
+			}
+			res.Changes[i] = svcRegistrySchemaChangeToRegistrypbSchemaChange(val)
+		}
+	} else {
+		res.Changes = []*registrypb.SchemaChange{}
+	}
+
+	return res
+}
+
+// svcRegistrySchemaChangeToRegistrypbSchemaChange builds a value of type
+// *registrypb.SchemaChange from a value of type *registry.SchemaChange.
+func svcRegistrySchemaChangeToRegistrypbSchemaChange(v *registry.SchemaChange) *registrypb.SchemaChange {
+	if v == nil {
+		return nil
+	}
+	res := &registrypb.SchemaChange{
+		Tool:     v.Tool,
+		Kind:     v.Kind,
+		Path:     v.Path,
+		Breaking: v.Breaking,
+		Detail:   v.Detail,
+	}
+
+	return res
+}
@@ -25,14 +25,23 @@ type Client struct {
 	SearchEndpoint                 goa.Endpoint
 	CallToolEndpoint               goa.Endpoint
 	RetryToolEndpoint              goa.Endpoint
+	StreamToolCallEndpoint         goa.Endpoint
 	CompleteToolCallEndpoint       goa.Endpoint
 	PublishToolOutputDeltaEndpoint goa.Endpoint
 	ReportToolCallOverloadEndpoint goa.Endpoint
 	ClaimToolCallEndpoint          goa.Endpoint
+	ListProvidersEndpoint          goa.Endpoint
+	ForceDrainProviderEndpoint     goa.Endpoint
+	RetireRegistrationEndpoint     goa.Endpoint
+	ListToolCallsEndpoint          goa.Endpoint
+	InspectToolCallEndpoint        goa.Endpoint
+	ListHealthTransitionsEndpoint  goa.Endpoint
+	GetToolsetRoutesEndpoint       goa.Endpoint
+	SetToolsetRoutesEndpoint       goa.Endpoint
 }
 
 // NewClient initializes a "registry" service client given the endpoints.
-func NewClient(register, releaseProvider, drainProvider, unregister, pong, listToolsets, getToolset, search, callTool, retryTool, completeToolCall, publishToolOutputDelta, reportToolCallOverload, claimToolCall goa.Endpoint) *Client {
+func NewClient(register, releaseProvider, drainProvider, unregister, pong, listToolsets, getToolset, search, callTool, retryTool, streamToolCall, completeToolCall, publishToolOutputDelta, reportToolCallOverload, claimToolCall, listProviders, forceDrainProvider, retireRegistration, listToolCalls, inspectToolCall, listHealthTransitions, getToolsetRoutes, setToolsetRoutes goa.Endpoint) *Client {
 	return &Client{
 		RegisterEndpoint:               register,
 		ReleaseProviderEndpoint:        releaseProvider,
@@ -44,10 +53,19 @@ func NewClient(register, releaseProvider, drainProvider, unregister, pong, listT
 		SearchEndpoint:                 search,
 		CallToolEndpoint:               callTool,
 		RetryToolEndpoint:              retryTool,
+		StreamToolCallEndpoint:         streamToolCall,
 		CompleteToolCallEndpoint:       completeToolCall,
 		PublishToolOutputDeltaEndpoint: publishToolOutputDelta,
 		ReportToolCallOverloadEndpoint: reportToolCallOverload,
 		ClaimToolCallEndpoint:          claimToolCall,
+		ListProvidersEndpoint:          listProviders,
+		ForceDrainProviderEndpoint:     forceDrainProvider,
+		RetireRegistrationEndpoint:     retireRegistration,
+		ListToolCallsEndpoint:          listToolCalls,
+		InspectToolCallEndpoint:        inspectToolCall,
+		ListHealthTransitionsEndpoint:  listHealthTransitions,
+		GetToolsetRoutesEndpoint:       getToolsetRoutes,
+		SetToolsetRoutesEndpoint:       setToolsetRoutes,
 	}
 }
 
@@ -57,6 +75,8 @@ func NewClient(register, releaseProvider, drainProvider, unregister, pong, listT
 //   - "admission_retired" (type *goa.ServiceError)
 //   - "validation_error" (type *goa.ServiceError)
 //   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) Register(ctx context.Context, p *RegisterPayload) (res *RegisterResult, err error) {
 	var ires any
@@ -71,6 +91,8 @@ func (c *Client) Register(ctx context.Context, p *RegisterPayload) (res *Registe
 // service.
 // ReleaseProvider may return the following errors:
 //   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) ReleaseProvider(ctx context.Context, p *ReleaseProviderPayload) (err error) {
 	_, err = c.ReleaseProviderEndpoint(ctx, p)
@@ -80,6 +102,8 @@ func (c *Client) ReleaseProvider(ctx context.Context, p *ReleaseProviderPayload)
 // DrainProvider calls the "DrainProvider" endpoint of the "registry" service.
 // DrainProvider may return the following errors:
 //   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) DrainProvider(ctx context.Context, p *DrainProviderPayload) (err error) {
 	_, err = c.DrainProviderEndpoint(ctx, p)
@@ -90,6 +114,8 @@ func (c *Client) DrainProvider(ctx context.Context, p *DrainProviderPayload) (er
 // Unregister may return the following errors:
 //   - "admission_conflict" (type *goa.ServiceError)
 //   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) Unregister(ctx context.Context, p *UnregisterPayload) (err error) {
 	_, err = c.UnregisterEndpoint(ctx, p)
@@ -97,12 +123,20 @@ func (c *Client) Unregister(ctx context.Context, p *UnregisterPayload) (err erro
 }
 
 // Pong calls the "Pong" endpoint of the "registry" service.
+// Pong may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
 func (c *Client) Pong(ctx context.Context, p *PongPayload) (err error) {
 	_, err = c.PongEndpoint(ctx, p)
 	return
 }
 
 // ListToolsets calls the "ListToolsets" endpoint of the "registry" service.
+// ListToolsets may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
 func (c *Client) ListToolsets(ctx context.Context, p *ListToolsetsPayload) (res *ListToolsetsResult, err error) {
 	var ires any
 	ires, err = c.ListToolsetsEndpoint(ctx, p)
@@ -115,6 +149,8 @@ func (c *Client) ListToolsets(ctx context.Context, p *ListToolsetsPayload) (res
 // GetToolset calls the "GetToolset" endpoint of the "registry" service.
 // GetToolset may return the following errors:
 //   - "not_found" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) GetToolset(ctx context.Context, p *GetToolsetPayload) (res *Toolset, err error) {
 	var ires any
@@ -126,6 +162,10 @@ func (c *Client) GetToolset(ctx context.Context, p *GetToolsetPayload) (res *Too
 }
 
 // Search calls the "Search" endpoint of the "registry" service.
+// Search may return the following errors:
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
 func (c *Client) Search(ctx context.Context, p *SearchPayload) (res *SearchResult, err error) {
 	var ires any
 	ires, err = c.SearchEndpoint(ctx, p)
@@ -141,6 +181,9 @@ func (c *Client) Search(ctx context.Context, p *SearchPayload) (res *SearchResul
 //   - "validation_error" (type *goa.ServiceError)
 //   - "service_unavailable" (type *goa.ServiceError)
 //   - "call_not_admitted" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - "quota_exceeded" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) CallTool(ctx context.Context, p *CallToolPayload) (res *CallToolResult, err error) {
 	var ires any
@@ -157,6 +200,8 @@ func (c *Client) CallTool(ctx context.Context, p *CallToolPayload) (res *CallToo
 //   - "validation_error" (type *goa.ServiceError)
 //   - "service_unavailable" (type *goa.ServiceError)
 //   - "admission_conflict" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) RetryTool(ctx context.Context, p *RetryToolPayload) (res *CallToolResult, err error) {
 	var ires any
@@ -167,11 +212,31 @@ func (c *Client) RetryTool(ctx context.Context, p *RetryToolPayload) (res *CallT
 	return ires.(*CallToolResult), nil
 }
 
+// StreamToolCall calls the "StreamToolCall" endpoint of the "registry"
+// service.
+// StreamToolCall may return the following errors:
+//   - "not_found" (type *goa.ServiceError)
+//   - "validation_error" (type *goa.ServiceError)
+//   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) StreamToolCall(ctx context.Context, p *StreamToolCallPayload) (res StreamToolCallClientStream, err error) {
+	var ires any
+	ires, err = c.StreamToolCallEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(StreamToolCallClientStream), nil
+}
+
 // CompleteToolCall calls the "CompleteToolCall" endpoint of the "registry"
 // service.
 // CompleteToolCall may return the following errors:
 //   - "validation_error" (type *goa.ServiceError)
 //   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) CompleteToolCall(ctx context.Context, p *CompleteToolCallPayload) (err error) {
 	_, err = c.CompleteToolCallEndpoint(ctx, p)
@@ -183,6 +248,8 @@ func (c *Client) CompleteToolCall(ctx context.Context, p *CompleteToolCallPayloa
 // PublishToolOutputDelta may return the following errors:
 //   - "validation_error" (type *goa.ServiceError)
 //   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) PublishToolOutputDelta(ctx context.Context, p *PublishToolOutputDeltaPayload) (err error) {
 	_, err = c.PublishToolOutputDeltaEndpoint(ctx, p)
@@ -194,6 +261,8 @@ func (c *Client) PublishToolOutputDelta(ctx context.Context, p *PublishToolOutpu
 // ReportToolCallOverload may return the following errors:
 //   - "validation_error" (type *goa.ServiceError)
 //   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) ReportToolCallOverload(ctx context.Context, p *ProviderToolCallClaimPayload) (err error) {
 	_, err = c.ReportToolCallOverloadEndpoint(ctx, p)
@@ -204,6 +273,8 @@ func (c *Client) ReportToolCallOverload(ctx context.Context, p *ProviderToolCall
 // ClaimToolCall may return the following errors:
 //   - "validation_error" (type *goa.ServiceError)
 //   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
 //   - error: internal error
 func (c *Client) ClaimToolCall(ctx context.Context, p *ProviderToolCallClaimPayload) (res *ClaimToolCallResult, err error) {
 	var ires any
@@ -213,3 +284,122 @@ func (c *Client) ClaimToolCall(ctx context.Context, p *ProviderToolCallClaimPayl
 	}
 	return ires.(*ClaimToolCallResult), nil
 }
+
+// ListProviders calls the "ListProviders" endpoint of the "registry" service.
+// ListProviders may return the following errors:
+//   - "not_found" (type *goa.ServiceError)
+//   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ListProviders(ctx context.Context, p *ListProvidersPayload) (res *ListProvidersResult, err error) {
+	var ires any
+	ires, err = c.ListProvidersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListProvidersResult), nil
+}
+
+// ForceDrainProvider calls the "ForceDrainProvider" endpoint of the "registry"
+// service.
+// ForceDrainProvider may return the following errors:
+//   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ForceDrainProvider(ctx context.Context, p *ForceDrainProviderPayload) (err error) {
+	_, err = c.ForceDrainProviderEndpoint(ctx, p)
+	return
+}
+
+// RetireRegistration calls the "RetireRegistration" endpoint of the "registry"
+// service.
+// RetireRegistration may return the following errors:
+//   - "admission_conflict" (type *goa.ServiceError)
+//   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) RetireRegistration(ctx context.Context, p *RetireRegistrationPayload) (err error) {
+	_, err = c.RetireRegistrationEndpoint(ctx, p)
+	return
+}
+
+// ListToolCalls calls the "ListToolCalls" endpoint of the "registry" service.
+// ListToolCalls may return the following errors:
+//   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ListToolCalls(ctx context.Context, p *ListToolCallsPayload) (res *ListToolCallsResult, err error) {
+	var ires any
+	ires, err = c.ListToolCallsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListToolCallsResult), nil
+}
+
+// InspectToolCall calls the "InspectToolCall" endpoint of the "registry"
+// service.
+// InspectToolCall may return the following errors:
+//   - "not_found" (type *goa.ServiceError)
+//   - "validation_error" (type *goa.ServiceError)
+//   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) InspectToolCall(ctx context.Context, p *InspectToolCallPayload) (res *ToolCallInfo, err error) {
+	var ires any
+	ires, err = c.InspectToolCallEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ToolCallInfo), nil
+}
+
+// ListHealthTransitions calls the "ListHealthTransitions" endpoint of the
+// "registry" service.
+// ListHealthTransitions may return the following errors:
+//   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) ListHealthTransitions(ctx context.Context, p *ListHealthTransitionsPayload) (res *ListHealthTransitionsResult, err error) {
+	var ires any
+	ires, err = c.ListHealthTransitionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListHealthTransitionsResult), nil
+}
+
+// GetToolsetRoutes calls the "GetToolsetRoutes" endpoint of the "registry"
+// service.
+// GetToolsetRoutes may return the following errors:
+//   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) GetToolsetRoutes(ctx context.Context, p *GetToolsetRoutesPayload) (res *ToolsetRoutesResult, err error) {
+	var ires any
+	ires, err = c.GetToolsetRoutesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ToolsetRoutesResult), nil
+}
+
+// SetToolsetRoutes calls the "SetToolsetRoutes" endpoint of the "registry"
+// service.
+// SetToolsetRoutes may return the following errors:
+//   - "validation_error" (type *goa.ServiceError)
+//   - "service_unavailable" (type *goa.ServiceError)
+//   - "unauthenticated" (type *goa.ServiceError)
+//   - "permission_denied" (type *goa.ServiceError)
+//   - error: internal error
+func (c *Client) SetToolsetRoutes(ctx context.Context, p *SetToolsetRoutesPayload) (err error) {
+	_, err = c.SetToolsetRoutesEndpoint(ctx, p)
+	return
+}
@@ -25,10 +25,29 @@ type Endpoints struct {
 	Search                 goa.Endpoint
 	CallTool               goa.Endpoint
 	RetryTool              goa.Endpoint
+	StreamToolCall         goa.Endpoint
 	CompleteToolCall       goa.Endpoint
 	PublishToolOutputDelta goa.Endpoint
 	ReportToolCallOverload goa.Endpoint
 	ClaimToolCall          goa.Endpoint
+	ListProviders          goa.Endpoint
+	ForceDrainProvider     goa.Endpoint
+	RetireRegistration     goa.Endpoint
+	ListToolCalls          goa.Endpoint
+	InspectToolCall        goa.Endpoint
+	ListHealthTransitions  goa.Endpoint
+	GetToolsetRoutes       goa.Endpoint
+	SetToolsetRoutes       goa.Endpoint
+}
+
+// StreamToolCallEndpointInput holds both the payload and the server stream of
+// the "StreamToolCall" method.
+type StreamToolCallEndpointInput struct {
+	// Payload is the method payload.
+	Payload *StreamToolCallPayload
+	// Stream is the server stream used by the "StreamToolCall" method to send
+	// data.
+	Stream StreamToolCallServerStream
 }
 
 // NewEndpoints wraps the methods of the "registry" service with endpoints.
@@ -44,10 +63,19 @@ func NewEndpoints(s Service) *Endpoints {
 		Search:                 NewSearchEndpoint(s),
 		CallTool:               NewCallToolEndpoint(s),
 		RetryTool:              NewRetryToolEndpoint(s),
+		StreamToolCall:         NewStreamToolCallEndpoint(s),
 		CompleteToolCall:       NewCompleteToolCallEndpoint(s),
 		PublishToolOutputDelta: NewPublishToolOutputDeltaEndpoint(s),
 		ReportToolCallOverload: NewReportToolCallOverloadEndpoint(s),
 		ClaimToolCall:          NewClaimToolCallEndpoint(s),
+		ListProviders:          NewListProvidersEndpoint(s),
+		ForceDrainProvider:     NewForceDrainProviderEndpoint(s),
+		RetireRegistration:     NewRetireRegistrationEndpoint(s),
+		ListToolCalls:          NewListToolCallsEndpoint(s),
+		InspectToolCall:        NewInspectToolCallEndpoint(s),
+		ListHealthTransitions:  NewListHealthTransitionsEndpoint(s),
+		GetToolsetRoutes:       NewGetToolsetRoutesEndpoint(s),
+		SetToolsetRoutes:       NewSetToolsetRoutesEndpoint(s),
 	}
 }
 
@@ -63,10 +91,19 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Search = m(e.Search)
 	e.CallTool = m(e.CallTool)
 	e.RetryTool = m(e.RetryTool)
+	e.StreamToolCall = m(e.StreamToolCall)
 	e.CompleteToolCall = m(e.CompleteToolCall)
 	e.PublishToolOutputDelta = m(e.PublishToolOutputDelta)
 	e.ReportToolCallOverload = m(e.ReportToolCallOverload)
 	e.ClaimToolCall = m(e.ClaimToolCall)
+	e.ListProviders = m(e.ListProviders)
+	e.ForceDrainProvider = m(e.ForceDrainProvider)
+	e.RetireRegistration = m(e.RetireRegistration)
+	e.ListToolCalls = m(e.ListToolCalls)
+	e.InspectToolCall = m(e.InspectToolCall)
+	e.ListHealthTransitions = m(e.ListHealthTransitions)
+	e.GetToolsetRoutes = m(e.GetToolsetRoutes)
+	e.SetToolsetRoutes = m(e.SetToolsetRoutes)
 }
 
 // NewRegisterEndpoint returns an endpoint function that calls the method
@@ -159,6 +196,15 @@ func NewRetryToolEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewStreamToolCallEndpoint returns an endpoint function that calls the method
+// "StreamToolCall" of service "registry".
+func NewStreamToolCallEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*StreamToolCallEndpointInput)
+		return nil, s.StreamToolCall(ctx, ep.Payload, ep.Stream)
+	}
+}
+
 // NewCompleteToolCallEndpoint returns an endpoint function that calls the
 // method "CompleteToolCall" of service "registry".
 func NewCompleteToolCallEndpoint(s Service) goa.Endpoint {
@@ -194,3 +240,75 @@ func NewClaimToolCallEndpoint(s Service) goa.Endpoint {
 		return s.ClaimToolCall(ctx, p)
 	}
 }
+
+// NewListProvidersEndpoint returns an endpoint function that calls the method
+// "ListProviders" of service "registry".
+func NewListProvidersEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListProvidersPayload)
+		return s.ListProviders(ctx, p)
+	}
+}
+
+// NewForceDrainProviderEndpoint returns an endpoint function that calls the
+// method "ForceDrainProvider" of service "registry".
+func NewForceDrainProviderEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ForceDrainProviderPayload)
+		return nil, s.ForceDrainProvider(ctx, p)
+	}
+}
+
+// NewRetireRegistrationEndpoint returns an endpoint function that calls the
+// method "RetireRegistration" of service "registry".
+func NewRetireRegistrationEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RetireRegistrationPayload)
+		return nil, s.RetireRegistration(ctx, p)
+	}
+}
+
+// NewListToolCallsEndpoint returns an endpoint function that calls the method
+// "ListToolCalls" of service "registry".
+func NewListToolCallsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListToolCallsPayload)
+		return s.ListToolCalls(ctx, p)
+	}
+}
+
+// NewInspectToolCallEndpoint returns an endpoint function that calls the method
+// "InspectToolCall" of service "registry".
+func NewInspectToolCallEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*InspectToolCallPayload)
+		return s.InspectToolCall(ctx, p)
+	}
+}
+
+// NewListHealthTransitionsEndpoint returns an endpoint function that calls the
+// method "ListHealthTransitions" of service "registry".
+func NewListHealthTransitionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListHealthTransitionsPayload)
+		return s.ListHealthTransitions(ctx, p)
+	}
+}
+
+// NewGetToolsetRoutesEndpoint returns an endpoint function that calls the
+// method "GetToolsetRoutes" of service "registry".
+func NewGetToolsetRoutesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetToolsetRoutesPayload)
+		return s.GetToolsetRoutes(ctx, p)
+	}
+}
+
+// NewSetToolsetRoutesEndpoint returns an endpoint function that calls the
+// method "SetToolsetRoutes" of service "registry".
+func NewSetToolsetRoutesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetToolsetRoutesPayload)
+		return nil, s.SetToolsetRoutes(ctx, p)
+	}
+}
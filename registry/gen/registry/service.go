@@ -78,6 +78,14 @@ type Service interface {
 	// Before returning either a republished or terminal call, the registry
 	// establishes the result stream so the caller can create a reader immediately.
 	RetryTool(context.Context, *RetryToolPayload) (res *CallToolResult, err error)
+	// Stream the result stream of one routed call as server-sent events: output
+	// deltas as the provider publishes them, retry control after provider
+	// overload, and finally the canonical terminal result, after which the stream
+	// ends. Events are read from the oldest retained entry, or after
+	// Last-Event-ID when a client reconnects. Only events stamped with the given
+	// registration token are forwarded. HTTP clients use it in place of reading
+	// the Pulse result stream directly.
+	StreamToolCall(context.Context, *StreamToolCallPayload, StreamToolCallServerStream) (err error)
 	// Publish one canonical terminal result for an admitted call. The registry
 	// verifies the exact provider incarnation still owns an unexpired lease and
 	// claimed request event, then atomically stores the full terminal in the
@@ -105,6 +113,44 @@ type Service interface {
 	// incarnation and request event may publish deltas or complete the call;
 	// ownership never transfers after a crash.
 	ClaimToolCall(context.Context, *ProviderToolCallClaimPayload) (res *ClaimToolCallResult, err error)
+	// List the catalog admission record of one toolset, active or retired, with
+	// every unexpired provider-incarnation lease, its Redis-time expiry, draining
+	// state, and the number of claimed calls it still owns.
+	ListProviders(context.Context, *ListProvidersPayload) (res *ListProvidersResult, err error)
+	// Mark one exact provider-incarnation lease draining on behalf of an operator.
+	// New calls stop routing to the incarnation while calls it already claimed
+	// keep settlement authority until its lease is released or expires; lease
+	// renewals by that incarnation keep it draining. Missing incarnations and
+	// stale tokens succeed without mutation.
+	ForceDrainProvider(context.Context, *ForceDrainProviderPayload) (err error)
+	// Retire the exact admission on behalf of an operator with the same semantics
+	// as Unregister: the toolset leaves discovery and routing, provider leases are
+	// preserved until release or expiry, and the token can never register again. A
+	// stale token returns admission_conflict.
+	RetireRegistration(context.Context, *RetireRegistrationPayload) (err error)
+	// List the in-flight calls of one toolset: calls a provider incarnation has
+	// claimed and not yet settled, ordered by execution deadline. Queued calls
+	// that no provider has claimed yet are not indexed; inspect them individually
+	// with InspectToolCall.
+	ListToolCalls(context.Context, *ListToolCallsPayload) (res *ListToolCallsResult, err error)
+	// Inspect the authoritative record of one admitted call of a toolset: its
+	// publication, claim, and terminal state, the provider incarnation that owns
+	// it, and the length and last event of its result stream.
+	InspectToolCall(context.Context, *InspectToolCallPayload) (res *ToolCallInfo, err error)
+	// List the recorded health transitions of one toolset, newest first. The
+	// health scheduler records a transition whenever the observed health,
+	// admission token, or membership epoch changes; the registry retains a bounded
+	// history per toolset.
+	ListHealthTransitions(context.Context, *ListHealthTransitionsPayload) (res *ListHealthTransitionsResult, err error)
+	// Return the canary route table that splits unpinned calls between the
+	// side-by-side name@version registrations of one toolset. An empty table
+	// routes unpinned calls to the highest active version.
+	GetToolsetRoutes(context.Context, *GetToolsetRoutesPayload) (res *ToolsetRoutesResult, err error)
+	// Replace the canary route table of one toolset on behalf of an operator.
+	// Weights are relative; a zero weight drains a version of unpinned traffic
+	// while calls pinned to that exact version keep routing to it. An empty table
+	// routes unpinned calls to the highest active version.
+	SetToolsetRoutes(context.Context, *SetToolsetRoutesPayload) (err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -121,7 +167,28 @@ const ServiceName = "registry"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [14]string{"Register", "ReleaseProvider", "DrainProvider", "Unregister", "Pong", "ListToolsets", "GetToolset", "Search", "CallTool", "RetryTool", "CompleteToolCall", "PublishToolOutputDelta", "ReportToolCallOverload", "ClaimToolCall"}
+var MethodNames = [23]string{"Register", "ReleaseProvider", "DrainProvider", "Unregister", "Pong", "ListToolsets", "GetToolset", "Search", "CallTool", "RetryTool", "StreamToolCall", "CompleteToolCall", "PublishToolOutputDelta", "ReportToolCallOverload", "ClaimToolCall", "ListProviders", "ForceDrainProvider", "RetireRegistration", "ListToolCalls", "InspectToolCall", "ListHealthTransitions", "GetToolsetRoutes", "SetToolsetRoutes"}
+
+// StreamToolCallServerStream is the interface a "StreamToolCall" endpoint
+// server stream must satisfy.
+type StreamToolCallServerStream interface {
+	// Send streams instances of "ToolCallEvent".
+	Send(*ToolCallEvent) error
+	// SendWithContext streams instances of "ToolCallEvent" with context.
+	SendWithContext(context.Context, *ToolCallEvent) error
+	// Close closes the stream.
+	Close() error
+}
+
+// StreamToolCallClientStream is the interface a "StreamToolCall" endpoint
+// client stream must satisfy.
+type StreamToolCallClientStream interface {
+	// Recv reads instances of "ToolCallEvent" from the stream.
+	Recv() (*ToolCallEvent, error)
+	// RecvWithContext reads instances of "ToolCallEvent" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*ToolCallEvent, error)
+}
 
 // CallToolPayload is the payload type of the registry service CallTool method.
 type CallToolPayload struct {
@@ -202,6 +269,20 @@ type DrainProviderPayload struct {
 	ProviderIncarnationID string
 }
 
+// ForceDrainProviderPayload is the payload type of the registry service
+// ForceDrainProvider method.
+type ForceDrainProviderPayload struct {
+	// Name of the toolset
+	Name string
+	// Stable identity of the provider process to drain
+	ProviderID string
+	// Runtime-generated UUID of the Serve lifecycle to drain
+	ProviderIncarnationID string
+	// Exact admission-generation token holding the lease, as reported by
+	// ListProviders
+	RegistrationToken string
+}
+
 // GetToolsetPayload is the payload type of the registry service GetToolset
 // method.
 type GetToolsetPayload struct {
@@ -209,6 +290,99 @@ type GetToolsetPayload struct {
 	Name string
 }
 
+// GetToolsetRoutesPayload is the payload type of the registry service
+// GetToolsetRoutes method.
+type GetToolsetRoutesPayload struct {
+	// Unversioned name of the toolset
+	Name string
+}
+
+// One observed change of toolset health, admission, or membership epoch
+type HealthTransition struct {
+	// Redis time at which the scheduler observed the transition, in Unix
+	// milliseconds
+	AtUnixMilli int64
+	// Observed health after the transition
+	Healthy bool
+	// Admission-generation token observed
+	RegistrationToken string
+	// Provider membership epoch observed
+	HealthEpoch uint64
+	// Number of routable provider leases observed
+	ProviderCount int
+	// Redis time of the last recorded pong, in Unix milliseconds
+	LastPongUnixMilli *int64
+}
+
+// InspectToolCallPayload is the payload type of the registry service
+// InspectToolCall method.
+type InspectToolCallPayload struct {
+	// Name of the toolset that admitted the call
+	Toolset string
+	// Global transport identity of the call
+	ToolUseID string
+}
+
+// ListHealthTransitionsPayload is the payload type of the registry service
+// ListHealthTransitions method.
+type ListHealthTransitionsPayload struct {
+	// Name of the toolset
+	Name string
+	// Maximum number of transitions to return
+	Limit int
+}
+
+// ListHealthTransitionsResult is the result type of the registry service
+// ListHealthTransitions method.
+type ListHealthTransitionsResult struct {
+	// Transitions, newest first
+	Transitions []*HealthTransition
+}
+
+// ListProvidersPayload is the payload type of the registry service
+// ListProviders method.
+type ListProvidersPayload struct {
+	// Name of the toolset
+	Name string
+}
+
+// ListProvidersResult is the result type of the registry service ListProviders
+// method.
+type ListProvidersResult struct {
+	// Name of the toolset
+	Name string
+	// Admission state of the catalog record
+	State string
+	// Admission-generation token of the catalog record
+	RegistrationToken string
+	// Provider membership epoch; it advances each time the toolset regains a
+	// routable provider after having none
+	HealthEpoch uint64
+	// Redis time of the last pong recorded in the current health epoch, in Unix
+	// milliseconds
+	LastPongUnixMilli *int64
+	// Whether routed calls currently find a healthy provider
+	Healthy bool
+	// Unexpired provider-incarnation leases
+	Providers []*ProviderLeaseInfo
+}
+
+// ListToolCallsPayload is the payload type of the registry service
+// ListToolCalls method.
+type ListToolCallsPayload struct {
+	// Name of the toolset
+	Toolset string
+	// Maximum number of calls to return
+	Limit int
+}
+
+// ListToolCallsResult is the result type of the registry service ListToolCalls
+// method.
+type ListToolCallsResult struct {
+	// Claimed calls ordered by execution deadline
+	Calls []*ToolCallInfo
+}
+
 // ListToolsetsPayload is the payload type of the registry service ListToolsets
 // method.
 type ListToolsetsPayload struct {
@@ -235,6 +409,20 @@ type PongPayload struct {
 	ProviderIncarnationID string
 }
 
+// One provider-incarnation lease of an admission
+type ProviderLeaseInfo struct {
+	// Stable identity of the provider process
+	ProviderID string
+	// Runtime-generated UUID of the Serve lifecycle holding the lease
+	ProviderIncarnationID string
+	// Redis time at which the lease expires, in Unix milliseconds
+	LeaseExpiresAtUnixMilli int64
+	// Whether the lease is draining and no longer receives new calls
+	Draining bool
+	// Number of claimed calls the incarnation has not settled yet
+	ClaimedCalls int64
+}
+
 // ProviderToolCallClaimPayload is the payload type of the registry service
 // ReportToolCallOverload method.
 type ProviderToolCallClaimPayload struct {
@@ -328,6 +516,15 @@ type ReleaseProviderPayload struct {
 	ProviderIncarnationID string
 }
 
+// RetireRegistrationPayload is the payload type of the registry service
+// RetireRegistration method.
+type RetireRegistrationPayload struct {
+	// Name of the toolset
+	Name string
+	// Exact admission-generation token to retire, as reported by ListProviders
+	RegistrationToken string
+}
+
 // RetryToolPayload is the payload type of the registry service RetryTool
 // method.
 type RetryToolPayload struct {
@@ -349,6 +546,35 @@ type RetryToolPayload struct {
 	WireProtocolVersion int
 }
 
+// One classified tool schema change
+type SchemaChange struct {
+	// Tool whose schema changed
+	Tool string
+	// Change classification
+	Kind string
+	// Affected field: payload or result followed by the property path, with []
+	// for array items
+	Path string
+	// Whether the change can break agents built against the baseline
+	Breaking bool
+	// Human-readable description of the change
+	Detail string
+}
+
+// Classified tool schema changes between an admission and its baseline
+// registration
+type SchemaCompatibility struct {
+	// Catalog name of the baseline registration: the replaced admission, or the
+	// highest lower version of a versioned toolset
+	BaseName string
+	// Semantic version of the baseline registration
+	BaseVersion *SemVer
+	// Whether any change can break agents built against the baseline
+	Breaking bool
+	// Classified changes, ordered by tool and path
+	Changes []*SchemaChange
+}
+
 // SearchPayload is the payload type of the registry service Search method.
 type SearchPayload struct {
 	// Search query string
@@ -364,6 +590,82 @@ type SearchResult struct {
 // Semantic version string (for example, "1.0.0" or "v1.0.0").
 type SemVer string
 
+// SetToolsetRoutesPayload is the payload type of the registry service
+// SetToolsetRoutes method.
+type SetToolsetRoutesPayload struct {
+	// Unversioned name of the toolset
+	Name string
+	// Version weights; an empty list restores routing to the highest active
+	// version
+	Routes []*ToolsetRoute
+}
+
+// StreamToolCallPayload is the payload type of the registry service
+// StreamToolCall method.
+type StreamToolCallPayload struct {
+	// Toolset reference the call was issued to.
+	Toolset string
+	// Tool the call invoked.
+	Tool string
+	// Global transport identity returned by CallTool.
+	ToolUseID string
+	// Admission-generation token returned by CallTool or RetryTool.
+	RegistrationToken string
+	// Result stream event after which to resume.
+	LastEventID *string
+}
+
+// ToolCallEvent is the result type of the registry service StreamToolCall
+// method.
+type ToolCallEvent struct {
+	// Result stream event ID; clients resume after it.
+	ID string
+	// output_delta carries a provider output fragment, retry reports provider
+	// overload and asks the caller to invoke RetryTool, and result carries the
+	// canonical terminal ToolResultMessage.
+	Type string
+	// Logical output stream of an output_delta event.
+	Stream *string
+	// Output fragment of an output_delta event.
+	Delta *string
+	// ToolResultMessage carried by retry and result events.
+	Message any
+}
+
+// ToolCallInfo is the result type of the registry service InspectToolCall
+// method.
+type ToolCallInfo struct {
+	// Global transport identity of the call
+	ToolUseID string
+	// Call state. admitted calls are not published yet; queued calls await a
+	// provider claim; claimed calls are executing; terminal calls have a canonical
+	// result.
+	State string
+	// Admission-generation token stamped on the call
+	RegistrationToken string
+	// Redis time by which the call must settle, in Unix milliseconds
+	ExecutionDeadlineUnixMilli int64
+	// Redis time at which the call record and its result history expire, in Unix
+	// milliseconds
+	ExpiresAtUnixMilli int64
+	// Stable identity of the provider process that claimed the call
+	ProviderID *string
+	// Serve lifecycle that claimed the call
+	ProviderIncarnationID *string
+	// Pulse request-stream event the provider claimed
+	RequestEventID *string
+	// What settled a terminal call
+	TerminalCause *string
+	// Number of output deltas the provider published
+	OutputDeltaCount int64
+	// Pulse stream carrying the call's output deltas and terminal result
+	ResultStreamID string
+	// Number of events retained in the result stream
+	ResultStreamLength int64
+	// ID of the newest retained result-stream event
+	LastEventID *string
+}
+
 // Context metadata propagated alongside tool calls for routing, correlation,
 // and domain injection (for example, session-scoped data access).
 type ToolCallMeta struct {
@@ -409,6 +711,9 @@ type Toolset struct {
 	Tools []*ToolSchema
 	// ISO 8601 registration timestamp
 	RegisteredAt string
+	// Schema changes relative to the registration this admission replaced or
+	// extends. Absent for the first registration of a toolset.
+	Compatibility *SchemaCompatibility
 }
 
 // Toolset metadata for listing and search results
@@ -427,6 +732,23 @@ type ToolsetInfo struct {
 	RegisteredAt string
 }
 
+// Relative share of unpinned calls routed to one toolset version
+type ToolsetRoute struct {
+	// Canonical version of a name@version registration
+	Version SemVer
+	// Relative traffic weight; zero drains the version of unpinned calls
+	Weight int
+}
+
+// ToolsetRoutesResult is the result type of the registry service
+// GetToolsetRoutes method.
+type ToolsetRoutesResult struct {
+	// Unversioned name of the toolset
+	Name string
+	// Version weights ordered by version, highest first
+	Routes []*ToolsetRoute
+}
+
 // UnregisterPayload is the payload type of the registry service Unregister
 // method.
 type UnregisterPayload struct {
@@ -471,3 +793,18 @@ func MakeNotFound(err error) *goa.ServiceError {
 func MakeCallNotAdmitted(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "call_not_admitted", false, false, false)
 }
+
+// MakeUnauthenticated builds a goa.ServiceError from an error.
+func MakeUnauthenticated(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthenticated", false, false, false)
+}
+
+// MakePermissionDenied builds a goa.ServiceError from an error.
+func MakePermissionDenied(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "permission_denied", false, false, false)
+}
+
+// MakeQuotaExceeded builds a goa.ServiceError from an error.
+func MakeQuotaExceeded(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "quota_exceeded", false, false, false)
+}
@@ -0,0 +1,137 @@
+// Package registry keeps a bounded history of toolset health transitions.
+//
+// The health scheduler node that wins a toolset's ping lease samples health
+// once per interval; the history records a sample only when the observed
+// health, admission token, or membership epoch differs from the newest
+// recorded transition. Redis owns both the deduplication and the timestamp, so
+// registry nodes observing the same state never record it twice.
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+)
+
+type (
+	// healthHistory records and lists toolset health transitions. Production
+	// uses Redis; service tests may substitute fixed transitions.
+	healthHistory interface {
+		// Record appends transition unless it matches the newest recorded
+		// transition of toolset.
+		Record(ctx context.Context, toolset string, transition healthTransition) error
+		// Transitions returns at most limit transitions of toolset, newest
+		// first.
+		Transitions(ctx context.Context, toolset string, limit int) ([]*genregistry.HealthTransition, error)
+	}
+
+	// healthTransition is one observed health state of an active admission.
+	healthTransition struct {
+		AtUnixMilli       int64  `json:"at_unix_milli,omitempty"`
+		Healthy           bool   `json:"healthy"`
+		RegistrationToken string `json:"registration_token"`
+		HealthEpoch       uint64 `json:"health_epoch"`
+		ProviderCount     int    `json:"provider_count"`
+		LastPongUnixMilli int64  `json:"last_pong_unix_milli,omitempty"`
+	}
+
+	// healthHistoryStore keeps one capped Redis list per toolset.
+	healthHistoryStore struct {
+		redis  *redis.Client
+		prefix string
+	}
+)
+
+// healthHistoryLimit bounds the transitions retained per toolset.
+const healthHistoryLimit = 256
+
+// recordHealthTransitionScript stamps a transition with Redis time and pushes
+// it unless the newest transition has the same health, token, and epoch. The
+// identity of the newest transition lives beside the list so the comparison
+// never round-trips epochs through Lua numbers.
+var recordHealthTransitionScript = redis.NewScript(`
+if redis.call("GET", KEYS[2]) == ARGV[1] then
+  return 0
+end
+local now = redis.call("TIME")
+local now_millis = (tonumber(now[1]) * 1000) + math.floor(tonumber(now[2]) / 1000)
+redis.call("LPUSH", KEYS[1], '{"at_unix_milli":' .. string.format("%d", now_millis) .. ',' .. string.sub(ARGV[2], 2))
+redis.call("LTRIM", KEYS[1], 0, tonumber(ARGV[3]) - 1)
+redis.call("SET", KEYS[2], ARGV[1])
+return 1
+`)
+
+// newHealthHistoryStore returns the Redis-backed history of the registry
+// named registryName.
+func newHealthHistoryStore(redisClient *redis.Client, registryName string) *healthHistoryStore {
+	return &healthHistoryStore{
+		redis:  redisClient,
+		prefix: "registry:" + registryName + ":health-history:",
+	}
+}
+
+// Record implements healthHistory. Redis time replaces AtUnixMilli.
+func (s *healthHistoryStore) Record(ctx context.Context, toolset string, transition healthTransition) error {
+	transition.AtUnixMilli = 0
+	body, err := json.Marshal(transition)
+	if err != nil {
+		return fmt.Errorf("encode health transition: %w", err)
+	}
+	identity := strconv.FormatBool(transition.Healthy) + ":" +
+		strconv.FormatUint(transition.HealthEpoch, 10) + ":" +
+		transition.RegistrationToken
+	key := s.prefix + toolset
+	if err := recordHealthTransitionScript.Run(
+		ctx,
+		s.redis,
+		[]string{key, key + ":newest"},
+		identity,
+		string(body),
+		healthHistoryLimit,
+	).Err(); err != nil {
+		return fmt.Errorf("record health transition: %w", err)
+	}
+	return nil
+}
+
+// Transitions implements healthHistory.
+func (s *healthHistoryStore) Transitions(
+	ctx context.Context,
+	toolset string,
+	limit int,
+) ([]*genregistry.HealthTransition, error) {
+	raw, err := s.redis.LRange(ctx, s.prefix+toolset, 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("read health transitions: %w", err)
+	}
+	transitions := make([]*genregistry.HealthTransition, 0, len(raw))
+	for _, body := range raw {
+		var transition healthTransition
+		if err := json.Unmarshal([]byte(body), &transition); err != nil {
+			return nil, fmt.Errorf("decode health transition: %w", err)
+		}
+		transitions = append(transitions, transition.info())
+	}
+	return transitions, nil
+}
+
+// info projects the transition onto the admin API type.
+func (t healthTransition) info() *genregistry.HealthTransition {
+	info := &genregistry.HealthTransition{
+		AtUnixMilli:       t.AtUnixMilli,
+		Healthy:           t.Healthy,
+		RegistrationToken: t.RegistrationToken,
+		HealthEpoch:       t.HealthEpoch,
+		ProviderCount:     t.ProviderCount,
+	}
+	if t.LastPongUnixMilli != 0 {
+		lastPong := t.LastPongUnixMilli
+		info.LastPongUnixMilli = &lastPong
+	}
+	return info
+}
@@ -0,0 +1,50 @@
+//go:build integration
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthHistoryStoreDeduplicatesAcrossNodes(t *testing.T) {
+	ctx := context.Background()
+	rdb := getRedis(t)
+	nodeA := newHealthHistoryStore(rdb, "health-history-test")
+	nodeB := newHealthHistoryStore(rdb, "health-history-test")
+	healthy := healthTransition{
+		Healthy:           true,
+		RegistrationToken: "token",
+		HealthEpoch:       1,
+		ProviderCount:     2,
+		LastPongUnixMilli: 1_700_000_000_000,
+	}
+
+	require.NoError(t, nodeA.Record(ctx, "data.tools", healthy))
+	require.NoError(t, nodeB.Record(ctx, "data.tools", healthy))
+	require.NoError(t, nodeB.Record(ctx, "data.tools", healthTransition{RegistrationToken: "token", HealthEpoch: 2}))
+	require.NoError(t, nodeA.Record(ctx, "data.tools", healthTransition{RegistrationToken: "token", HealthEpoch: 2}))
+
+	transitions, err := nodeA.Transitions(ctx, "data.tools", 10)
+	require.NoError(t, err)
+	require.Len(t, transitions, 2, "identical observations are recorded once")
+	assert.False(t, transitions[0].Healthy)
+	assert.Equal(t, uint64(2), transitions[0].HealthEpoch)
+	assert.Nil(t, transitions[0].LastPongUnixMilli)
+	assert.True(t, transitions[1].Healthy)
+	assert.Equal(t, 2, transitions[1].ProviderCount)
+	require.NotNil(t, transitions[1].LastPongUnixMilli)
+	assert.Equal(t, healthy.LastPongUnixMilli, *transitions[1].LastPongUnixMilli)
+	assert.GreaterOrEqual(t, transitions[0].AtUnixMilli, transitions[1].AtUnixMilli)
+	assert.Positive(t, transitions[1].AtUnixMilli, "Redis stamps the transition time")
+
+	for epoch := uint64(3); epoch < healthHistoryLimit+10; epoch++ {
+		require.NoError(t, nodeA.Record(ctx, "data.tools", healthTransition{RegistrationToken: "token", HealthEpoch: epoch}))
+	}
+	transitions, err = nodeB.Transitions(ctx, "data.tools", healthHistoryLimit+10)
+	require.NoError(t, err)
+	assert.Len(t, transitions, healthHistoryLimit)
+}
@@ -60,6 +60,7 @@ type (
 		pingInterval        time.Duration
 		missedPingThreshold int
 		logger              telemetry.Logger
+		history             healthHistory
 	}
 
 	healthTracker struct {
@@ -74,6 +75,7 @@ type (
 		missedPingThreshold int
 		stalenessThreshold  time.Duration
 		logger              telemetry.Logger
+		history             healthHistory
 
 		// revFloors remembers the highest revision this node pinned or
 		// observed per replicated-map hash key; a Redis counter below the
@@ -83,6 +85,9 @@ type (
 		stateMu             sync.Mutex
 		lastObservedHealthy map[string]bool
 		lastObservedPong    map[string]int64
+		// lastObservedRoutable remembers whether this node last saw a toolset
+		// with routable providers so losing the last provider is recorded once.
+		lastObservedRoutable map[string]bool
 
 		closeCh   chan struct{}
 		doneCh    chan struct{}
@@ -137,6 +142,13 @@ func WithHealthLogger(logger telemetry.Logger) HealthTrackerOption {
 	}
 }
 
+// withHealthHistory records observed health transitions in history.
+func withHealthHistory(history healthHistory) HealthTrackerOption {
+	return func(options *healthTrackerOptions) {
+		options.history = history
+	}
+}
+
 // newHealthTracker creates lease-scheduled ping coordination over the
 // canonical catalog. registryMapName scopes the ping leases and identifies
 // the replicated map whose revision counter the scheduler keeps repaired.
@@ -168,22 +180,24 @@ func newHealthTracker(
 		option(&options)
 	}
 	tracker := &healthTracker{
-		streamManager:       streamManager,
-		catalog:             catalog,
-		catalogMap:          catalog.m,
-		redis:               rdb,
-		nodeID:              uuid.NewString(),
-		leaseScope:          registryMapName,
-		revisionHashKey:     "map:" + registryMapName + ":content",
-		pingInterval:        options.pingInterval,
-		missedPingThreshold: options.missedPingThreshold,
-		stalenessThreshold:  deriveStalenessThreshold(options.pingInterval, options.missedPingThreshold),
-		logger:              options.logger,
-		revFloors:           make(map[string]int64),
-		lastObservedHealthy: make(map[string]bool),
-		lastObservedPong:    make(map[string]int64),
-		closeCh:             make(chan struct{}),
-		doneCh:              make(chan struct{}),
+		streamManager:        streamManager,
+		catalog:              catalog,
+		catalogMap:           catalog.m,
+		redis:                rdb,
+		nodeID:               uuid.NewString(),
+		leaseScope:           registryMapName,
+		revisionHashKey:      "map:" + registryMapName + ":content",
+		pingInterval:         options.pingInterval,
+		missedPingThreshold:  options.missedPingThreshold,
+		stalenessThreshold:   deriveStalenessThreshold(options.pingInterval, options.missedPingThreshold),
+		logger:               options.logger,
+		history:              options.history,
+		revFloors:            make(map[string]int64),
+		lastObservedHealthy:  make(map[string]bool),
+		lastObservedPong:     make(map[string]int64),
+		lastObservedRoutable: make(map[string]bool),
+		closeCh:              make(chan struct{}),
+		doneCh:               make(chan struct{}),
 	}
 	go tracker.run()
 	return tracker, nil
@@ -376,11 +390,14 @@ func (h *healthTracker) pingRegisteredToolsets() {
 			continue
 		}
 		if _, _, err := h.catalog.HealthIdentity(ctx, toolset); err != nil {
-			if !errors.Is(err, errToolsetNotFound) {
+			if errors.Is(err, errToolsetNotFound) {
+				h.observeUnroutable(ctx, toolset)
+			} else {
 				h.logger.Error(ctx, "resolve ping identity failed", "toolset", toolset, "err", err)
 			}
 			continue
 		}
+		h.noteRoutable(toolset, true)
 		acquired, err := h.acquirePingLease(ctx, toolset)
 		if err != nil {
 			h.logger.Error(
@@ -441,9 +458,9 @@ func (h *healthTracker) sendPing(ctx context.Context, toolset string) {
 	}
 }
 
-// observeHealth samples and logs meaningful health transitions.
+// observeHealth samples, logs, and records meaningful health transitions.
 func (h *healthTracker) observeHealth(ctx context.Context, toolset string) {
-	token, _, err := h.catalog.HealthIdentity(ctx, toolset)
+	token, epoch, err := h.catalog.HealthIdentity(ctx, toolset)
 	if err != nil {
 		return
 	}
@@ -452,6 +469,68 @@ func (h *healthTracker) observeHealth(ctx context.Context, toolset string) {
 		return
 	}
 	h.noteHealth(ctx, toolset, health)
+	h.recordTransition(ctx, toolset, token, epoch, health)
+}
+
+// observeUnroutable records that an active admission lost its last routable
+// provider. Every node records the first unroutable observation after a
+// routable one; the history drops the duplicates.
+func (h *healthTracker) observeUnroutable(ctx context.Context, toolset string) {
+	if h.history == nil || !h.noteRoutable(toolset, false) {
+		return
+	}
+	entry, _, err := h.catalog.healthEntry(ctx, toolset)
+	if err != nil {
+		return
+	}
+	var health ToolsetHealth
+	if entry.LastPongUnixNano != 0 {
+		health.LastPong = time.Unix(0, entry.LastPongUnixNano)
+	}
+	h.recordTransition(ctx, toolset, entry.RegistrationToken, entry.HealthEpoch, health)
+}
+
+// noteRoutable stores whether toolset has routable providers and reports
+// whether this is the node's first observation or a change, so a node
+// restarted after the last provider left still records the loss.
+func (h *healthTracker) noteRoutable(toolset string, routable bool) bool {
+	h.stateMu.Lock()
+	defer h.stateMu.Unlock()
+	previous, observed := h.lastObservedRoutable[toolset]
+	h.lastObservedRoutable[toolset] = routable
+	return !observed || previous != routable
+}
+
+// recordTransition appends one health observation to the history, which
+// keeps it only when health, token, or epoch changed.
+func (h *healthTracker) recordTransition(
+	ctx context.Context,
+	toolset, token string,
+	epoch uint64,
+	health ToolsetHealth,
+) {
+	if h.history == nil {
+		return
+	}
+	transition := healthTransition{
+		Healthy:           health.Healthy,
+		RegistrationToken: token,
+		HealthEpoch:       epoch,
+		ProviderCount:     health.ProviderCount,
+	}
+	if !health.LastPong.IsZero() {
+		transition.LastPongUnixMilli = health.LastPong.UnixMilli()
+	}
+	if err := h.history.Record(ctx, toolset, transition); err != nil {
+		h.logger.Error(
+			ctx,
+			"record health transition failed",
+			"event", "record_health_transition_failed",
+			"component", "tool-registry-health",
+			"toolset", toolset,
+			"err", err,
+		)
+	}
 }
 
 // noteHealth logs healthy-to-unhealthy transitions without tick-level spam.
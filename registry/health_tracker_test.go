@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
 	"goa.design/goa-ai/runtime/agent/telemetry"
 )
 
@@ -115,20 +116,87 @@ func TestHealthTrackerZeroLeaseReregistrationRejectsOldPong(t *testing.T) {
 	assert.True(t, health.Healthy)
 }
 
+func TestHealthTrackerRecordsObservedTransitions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Unix(1_700_000_000, 0)
+	catalog := newToolsetCatalog(newTestCatalogMap(), newTestTimeSource(now))
+	admission, err := catalog.Register(
+		ctx,
+		testCatalogToolset("test.toolset", "test", nil),
+		testAdmissionRevisionA,
+		"provider",
+		testIncarnationA,
+		time.Minute,
+	)
+	require.NoError(t, err)
+	history := &recordingHealthHistory{}
+	tracker := newDirectHealthTracker(ctx, catalog)
+	tracker.history = history
+
+	tracker.observeHealth(ctx, "test.toolset")
+	require.NoError(t, tracker.RecordPong(
+		ctx,
+		"test.toolset",
+		"provider",
+		testIncarnationA,
+		newPingID(admission.RegistrationToken, admission.HealthEpoch),
+	))
+	tracker.noteRoutable("test.toolset", true)
+	tracker.observeHealth(ctx, "test.toolset")
+	require.NoError(t, catalog.ReleaseProvider(
+		ctx,
+		"test.toolset",
+		"provider",
+		testIncarnationA,
+		admission.RegistrationToken,
+	))
+	tracker.observeUnroutable(ctx, "test.toolset")
+	tracker.observeUnroutable(ctx, "test.toolset")
+
+	assert.Equal(t, []healthTransition{
+		{RegistrationToken: admission.RegistrationToken, HealthEpoch: admission.HealthEpoch, ProviderCount: 1},
+		{
+			Healthy:           true,
+			RegistrationToken: admission.RegistrationToken,
+			HealthEpoch:       admission.HealthEpoch,
+			ProviderCount:     1,
+			LastPongUnixMilli: now.UnixMilli(),
+		},
+		{RegistrationToken: admission.RegistrationToken, HealthEpoch: admission.HealthEpoch + 1},
+	}, history.transitions, "losing the last provider is recorded once per node")
+}
+
 func newDirectHealthTracker(_ context.Context, catalog *toolsetCatalog) *healthTracker {
 	closed := make(chan struct{})
 	close(closed)
 	return &healthTracker{
-		catalog:             catalog,
-		catalogMap:          catalog.m,
-		pingInterval:        time.Second,
-		missedPingThreshold: 1,
-		stalenessThreshold:  2 * time.Second,
-		logger:              telemetry.NewNoopLogger(),
-		revFloors:           make(map[string]int64),
-		lastObservedHealthy: make(map[string]bool),
-		lastObservedPong:    make(map[string]int64),
-		closeCh:             make(chan struct{}),
-		doneCh:              closed,
+		catalog:              catalog,
+		catalogMap:           catalog.m,
+		pingInterval:         time.Second,
+		missedPingThreshold:  1,
+		stalenessThreshold:   2 * time.Second,
+		logger:               telemetry.NewNoopLogger(),
+		revFloors:            make(map[string]int64),
+		lastObservedHealthy:  make(map[string]bool),
+		lastObservedPong:     make(map[string]int64),
+		lastObservedRoutable: make(map[string]bool),
+		closeCh:              make(chan struct{}),
+		doneCh:               closed,
 	}
 }
+
+// recordingHealthHistory captures recorded transitions in order.
+type recordingHealthHistory struct {
+	transitions []healthTransition
+}
+
+func (r *recordingHealthHistory) Record(_ context.Context, _ string, transition healthTransition) error {
+	r.transitions = append(r.transitions, transition)
+	return nil
+}
+
+func (r *recordingHealthHistory) Transitions(context.Context, string, int) ([]*genregistry.HealthTransition, error) {
+	return nil, nil
+}
@@ -0,0 +1,49 @@
+package registry
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	httpserver "goa.design/goa-ai/registry/gen/http/registry/server"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/toolregistry"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// HTTPHandler returns the registry HTTP/JSON transport: toolset discovery,
+// tool invocation, and server-sent result streams under /v1. Provider
+// operations remain gRPC-only. Callers authenticate with an
+// "Authorization: Bearer <JWT>" header or a verified TLS client certificate,
+// and the same policy applies as over gRPC. Serve it from an http.Server
+// configured with the TLS settings used for gRPC when Config.Auth is set.
+func (r *Registry) HTTPHandler() http.Handler {
+	return newHTTPHandler(r.service)
+}
+
+// newHTTPHandler mounts the generated HTTP server for svc.
+func newHTTPHandler(svc *Service) http.Handler {
+	mux := goahttp.NewMuxer()
+	endpoints := genregistry.NewEndpoints(svc)
+	server := httpserver.New(endpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil)
+	httpserver.Mount(mux, server)
+	return withTransportCredentials(mux)
+}
+
+// withTransportCredentials exposes HTTP credentials to the authenticators in
+// the shape the gRPC transport provides them: the Authorization header as
+// incoming metadata and the TLS connection state as the request peer.
+func withTransportCredentials(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		if values := req.Header.Values("Authorization"); len(values) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.MD{toolregistry.AuthorizationMetadataKey: values})
+		}
+		if req.TLS != nil {
+			ctx = peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{State: *req.TLS}})
+		}
+		h.ServeHTTP(w, req.WithContext(ctx))
+	})
+}
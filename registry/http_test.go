@@ -0,0 +1,188 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientspulse "goa.design/goa-ai/features/stream/pulse/clients/pulse"
+	mockpulse "goa.design/goa-ai/features/stream/pulse/clients/pulse/mocks"
+	httpclient "goa.design/goa-ai/registry/gen/http/registry/client"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/toolregistry"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/pulse/streaming"
+	streamopts "goa.design/pulse/streaming/options"
+)
+
+// bearerDoer sets the Authorization header on every request.
+type bearerDoer struct {
+	token string
+}
+
+func (d bearerDoer) Do(req *http.Request) (*http.Response, error) {
+	if d.token != "" {
+		req.Header.Set("Authorization", "Bearer "+d.token)
+	}
+	return http.DefaultClient.Do(req)
+}
+
+func TestHTTPTransportAuthorizesBearerHeader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	catalog := newToolsetCatalog(
+		newTestCatalogMap(),
+		newTestTimeSource(time.Unix(1_700_000_000, 0)),
+	)
+	for _, name := range []string{"data.tools", "billing.invoices"} {
+		_, err := catalog.Register(
+			ctx,
+			testCatalogToolset(name, "http", nil),
+			testAdmissionRevisionA,
+			"provider-"+name,
+			testIncarnationA,
+			time.Hour,
+		)
+		require.NoError(t, err)
+	}
+	secret := []byte("registry-test-secret")
+	svc := &Service{
+		catalog:       catalog,
+		authenticator: &JWTAuthenticator{Keys: map[string]any{"": secret}},
+		policy: &Policy{Rules: []PolicyRule{
+			{Callers: []string{"agent"}, Call: []string{"data.*"}},
+		}},
+	}
+	srv := httptest.NewServer(newHTTPHandler(svc))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	client := func(token string) *httpclient.Client {
+		return httpclient.NewClient(u.Scheme, u.Host, bearerDoer{token: token}, goahttp.RequestEncoder, goahttp.ResponseDecoder, false)
+	}
+	token := signHS256(t, secret, map[string]any{
+		"sub": "agent",
+		"exp": time.Now().Add(time.Minute).Unix(),
+	})
+	requireServiceError := func(t *testing.T, err error, name string) {
+		t.Helper()
+		var serviceErr *goa.ServiceError
+		require.ErrorAs(t, err, &serviceErr)
+		assert.Equal(t, name, serviceErr.Name)
+	}
+
+	_, err = client("").ListToolsets()(ctx, &genregistry.ListToolsetsPayload{})
+	requireServiceError(t, err, "unauthenticated")
+	_, err = client(token).GetToolset()(ctx, &genregistry.GetToolsetPayload{Name: "billing.invoices"})
+	requireServiceError(t, err, "permission_denied")
+	_, err = client(token).GetToolset()(ctx, &genregistry.GetToolsetPayload{Name: "data.missing"})
+	requireServiceError(t, err, "not_found")
+
+	listed, err := client(token).ListToolsets()(ctx, &genregistry.ListToolsetsPayload{})
+	require.NoError(t, err)
+	toolsets := listed.(*genregistry.ListToolsetsResult).Toolsets
+	require.Len(t, toolsets, 1)
+	assert.Equal(t, "data.tools", toolsets[0].Name)
+
+	toolset, err := client(token).GetToolset()(ctx, &genregistry.GetToolsetPayload{Name: "data.tools"})
+	require.NoError(t, err)
+	assert.Equal(t, "data.tools", toolset.(*genregistry.Toolset).Name)
+}
+
+func TestHTTPStreamToolCallForwardsEventsUntilResult(t *testing.T) {
+	t.Parallel()
+
+	const toolUseID = "call-1"
+	token := strings.Repeat("a", 64)
+	staleToken := strings.Repeat("b", 64)
+	event := func(id, name string, payload any) *streaming.Event {
+		data, err := json.Marshal(payload)
+		require.NoError(t, err)
+		return &streaming.Event{ID: id, EventName: name, Payload: data}
+	}
+	events := make(chan *streaming.Event, 4)
+	events <- event("1-0", toolregistry.OutputDeltaEventKey, toolregistry.ToolOutputDeltaMessage{
+		RegistrationToken: staleToken, ToolUseID: toolUseID, Stream: "stdout", Delta: "stale",
+	})
+	events <- event("2-0", toolregistry.OutputDeltaEventKey, toolregistry.ToolOutputDeltaMessage{
+		RegistrationToken: token, ToolUseID: toolUseID, Stream: "stdout", Delta: "processed 10 rows\n",
+	})
+	events <- event("3-0", toolregistry.ResultEventKey, toolregistry.ToolResultMessage{
+		RegistrationToken: token, ToolUseID: toolUseID, Result: json.RawMessage(`{"ok":true}`),
+	})
+	reader := mockpulse.NewReader(t)
+	reader.SetSubscribe(func() <-chan *streaming.Event { return events })
+	reader.SetClose(func() {})
+	resultStream := mockpulse.NewStream(t)
+	resultStream.SetNewReader(func(context.Context, ...streamopts.Reader) (clientspulse.Reader, error) {
+		return reader, nil
+	})
+	pulseClient := mockpulse.NewClient(t)
+	pulseClient.SetStream(func(name string, _ ...streamopts.Stream) (clientspulse.Stream, error) {
+		assert.Equal(t, toolregistry.ResultStreamID(toolUseID), name)
+		return resultStream, nil
+	})
+	svc := &Service{
+		catalog:          newToolsetCatalog(newTestCatalogMap(), newTestTimeSource(time.Unix(1_700_000_000, 0))),
+		pulseClient:      pulseClient,
+		executionTimeout: time.Minute,
+	}
+	srv := httptest.NewServer(newHTTPHandler(svc))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	client := httpclient.NewClient(u.Scheme, u.Host, http.DefaultClient, goahttp.RequestEncoder, goahttp.ResponseDecoder, false)
+
+	res, err := client.StreamToolCall()(context.Background(), &genregistry.StreamToolCallPayload{
+		Toolset:           "data.tools",
+		Tool:              "lookup",
+		ToolUseID:         toolUseID,
+		RegistrationToken: token,
+	})
+	require.NoError(t, err)
+	stream := res.(*httpclient.StreamToolCallClientStream)
+	t.Cleanup(func() { _ = stream.Close() })
+
+	delta, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "2-0", delta.ID)
+	assert.Equal(t, "output_delta", delta.Type)
+	require.NotNil(t, delta.Delta)
+	assert.Equal(t, "processed 10 rows\n", *delta.Delta)
+
+	result, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "3-0", result.ID)
+	assert.Equal(t, "result", result.Type)
+	message, err := json.Marshal(result.Message)
+	require.NoError(t, err)
+	var terminal toolregistry.ToolResultMessage
+	require.NoError(t, json.Unmarshal(message, &terminal))
+	assert.JSONEq(t, `{"ok":true}`, string(terminal.Result))
+
+	_, err = stream.Recv()
+	assert.True(t, errors.Is(err, io.EOF), "stream ends after the terminal result: %v", err)
+}
+
+func TestHTTPStreamToolCallRejectsMissingRegistrationToken(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(newHTTPHandler(&Service{}))
+	t.Cleanup(srv.Close)
+
+	resp, err := http.Get(srv.URL + "/v1/toolsets/data.tools/tools/lookup/calls/call-1/events")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,375 @@
+// Package mcpbridge serves registry toolsets as an MCP server.
+//
+// A Bridge lists the tools of selected registry toolsets with tools/list and
+// routes tools/call through the registry gateway, so any MCP client can use
+// registry-backed tools without linking the goa-ai runtime. Tool output deltas
+// published by providers while a call runs are forwarded as MCP progress
+// notifications when the client supplied a progress token.
+package mcpbridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	pulsec "goa.design/goa-ai/features/stream/pulse/clients/pulse"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/runtime"
+	aistream "goa.design/goa-ai/runtime/agent/stream"
+	"goa.design/goa-ai/runtime/agent/telemetry"
+	"goa.design/goa-ai/runtime/agent/tools"
+	"goa.design/goa-ai/runtime/mcp"
+	"goa.design/goa-ai/runtime/toolregistry/executor"
+)
+
+type (
+	// Catalog is the subset of the generated registry client used to discover
+	// tools. *genregistry.Client implements it.
+	Catalog interface {
+		ListToolsets(ctx context.Context, p *genregistry.ListToolsetsPayload) (*genregistry.ListToolsetsResult, error)
+		GetToolset(ctx context.Context, p *genregistry.GetToolsetPayload) (*genregistry.Toolset, error)
+	}
+
+	// Options configures a Bridge.
+	Options struct {
+		// Name is the server name reported to MCP clients. Defaults to
+		// "registry-mcp-bridge".
+		Name string
+		// Version is the server version reported to MCP clients.
+		Version string
+		// Toolsets lists the registry toolsets to serve. Entries may be
+		// toolset references such as "data-tools@^1.2"; calls are then routed
+		// to the same versions. When empty, the bridge serves every toolset
+		// listed by the registry that carries all of Tags. Tool names must be
+		// unique across the served toolsets.
+		Toolsets []string
+		// Tags filters the toolsets served when Toolsets is empty.
+		Tags []string
+		// Logger receives transport errors. Defaults to a noop logger.
+		Logger telemetry.Logger
+		// ExecutorOptions are applied to the registry executor that runs
+		// tool calls, for example executor.WithTokenSource.
+		ExecutorOptions []executor.Option
+	}
+
+	// Bridge is an MCP server backed by the registry. It is safe for
+	// concurrent use; ServeStdio and ServeHTTP may share one Bridge.
+	Bridge struct {
+		catalog  Catalog
+		exec     runtime.ToolCallExecutor
+		info     mcp.Implementation
+		toolsets []string
+		tags     []string
+		logger   telemetry.Logger
+		// runID groups every call made through this bridge in the registry
+		// call metadata and on the result streams.
+		runID string
+
+		mu    sync.RWMutex
+		specs map[tools.Ident]*tools.ToolSpec
+
+		progressMu sync.Mutex
+		progress   map[string]*progressReporter
+	}
+
+	// progressReporter forwards output deltas of one call to the MCP client
+	// that asked for progress.
+	progressReporter struct {
+		token  json.RawMessage
+		notify func(mcp.RPCNotification) error
+		count  float64
+	}
+)
+
+// rawResultCodec keeps tool results as the JSON documents produced by
+// providers; the bridge forwards them to MCP clients verbatim.
+var rawResultCodec = tools.JSONCodec[any]{
+	ToJSON: func(v any) ([]byte, error) {
+		return json.Marshal(v)
+	},
+	FromJSON: func(data []byte) (any, error) {
+		return json.RawMessage(append([]byte(nil), data...)), nil
+	},
+}
+
+// New returns a Bridge that discovers tools with catalog and calls them with
+// client, awaiting results on the registry result streams through pulse.
+func New(catalog Catalog, client executor.Client, pulse pulsec.Client, opts Options) *Bridge {
+	b := &Bridge{
+		catalog: catalog,
+		info: mcp.Implementation{
+			Name:    opts.Name,
+			Version: opts.Version,
+		},
+		toolsets: opts.Toolsets,
+		tags:     opts.Tags,
+		logger:   opts.Logger,
+		runID:    "mcp-bridge-" + uuid.NewString(),
+		specs:    make(map[tools.Ident]*tools.ToolSpec),
+		progress: make(map[string]*progressReporter),
+	}
+	if b.info.Name == "" {
+		b.info.Name = "registry-mcp-bridge"
+	}
+	if b.logger == nil {
+		b.logger = telemetry.NewNoopLogger()
+	}
+	execOpts := append([]executor.Option{
+		executor.WithStreamSink(b),
+		executor.WithLogger(b.logger),
+	}, opts.ExecutorOptions...)
+	b.exec = executor.New(client, pulse, b, execOpts...)
+	return b
+}
+
+// Handle processes one JSON-RPC message and returns the response to send, or
+// nil when req is a notification. notify sends server notifications related
+// to req, such as progress, before the response; it may be nil.
+func (b *Bridge) Handle(ctx context.Context, req *mcp.RPCRequest, notify func(mcp.RPCNotification) error) *mcp.RPCResponse {
+	if len(req.ID) == 0 {
+		return nil
+	}
+	var (
+		result any
+		err    error
+	)
+	switch req.Method {
+	case mcp.MethodInitialize:
+		result, err = b.initialize(req.Params)
+	case mcp.MethodPing:
+		result = struct{}{}
+	case mcp.MethodToolsList:
+		result, err = b.listTools(ctx)
+	case mcp.MethodToolsCall:
+		result, err = b.callTool(ctx, req.Params, notify)
+	default:
+		err = &mcp.Error{Code: mcp.JSONRPCMethodNotFound, Message: fmt.Sprintf("method %q not found", req.Method)}
+	}
+	if err != nil {
+		return errorResponse(req.ID, err)
+	}
+	return &mcp.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: result}
+}
+
+// Spec implements executor.SpecLookup for the tools listed so far.
+func (b *Bridge) Spec(name tools.Ident) (*tools.ToolSpec, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	spec, ok := b.specs[name]
+	return spec, ok
+}
+
+// Send implements aistream.Sink. It turns tool output deltas into progress
+// notifications for calls that carry a progress token and drops every other
+// event.
+func (b *Bridge) Send(_ context.Context, event aistream.Event) error {
+	delta, ok := event.(aistream.ToolOutputDelta)
+	if !ok {
+		return nil
+	}
+	b.progressMu.Lock()
+	reporter, ok := b.progress[delta.Data.ToolCallID]
+	if !ok {
+		b.progressMu.Unlock()
+		return nil
+	}
+	reporter.count++
+	params := mcp.ProgressParams{
+		ProgressToken: reporter.token,
+		Progress:      reporter.count,
+		Message:       delta.Data.Delta,
+	}
+	b.progressMu.Unlock()
+	return reporter.notify(mcp.RPCNotification{
+		JSONRPC: "2.0",
+		Method:  mcp.MethodProgress,
+		Params:  params,
+	})
+}
+
+// Close implements aistream.Sink.
+func (b *Bridge) Close(context.Context) error {
+	return nil
+}
+
+// initialize answers the MCP handshake, echoing the client protocol version.
+func (b *Bridge) initialize(params json.RawMessage) (*mcp.InitializeResult, error) {
+	var p struct {
+		ProtocolVersion string `json:"protocolVersion"` //nolint:tagliatelle // MCP protocol field.
+	}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, invalidParams(err)
+		}
+	}
+	if p.ProtocolVersion == "" {
+		p.ProtocolVersion = mcp.DefaultProtocolVersion
+	}
+	return &mcp.InitializeResult{
+		ProtocolVersion: p.ProtocolVersion,
+		Capabilities: map[string]any{
+			"tools": map[string]any{"listChanged": false},
+		},
+		ServerInfo: b.info,
+	}, nil
+}
+
+// listTools reads the served toolsets from the registry and refreshes the
+// specs used to route calls. MCP clients call tools by name alone, so it fails
+// when two served toolsets expose a tool with the same name rather than route
+// the calls of one to the other.
+func (b *Bridge) listTools(ctx context.Context) (*mcp.ListToolsResult, error) {
+	refs := b.toolsets
+	if len(refs) == 0 {
+		res, err := b.catalog.ListToolsets(ctx, &genregistry.ListToolsetsPayload{Tags: b.tags})
+		if err != nil {
+			return nil, fmt.Errorf("list toolsets: %w", err)
+		}
+		for _, info := range res.Toolsets {
+			refs = append(refs, info.Name)
+		}
+	}
+	out := &mcp.ListToolsResult{Tools: []mcp.Tool{}}
+	specs := make(map[tools.Ident]*tools.ToolSpec)
+	for _, ref := range refs {
+		toolset, err := b.catalog.GetToolset(ctx, &genregistry.GetToolsetPayload{Name: ref})
+		if err != nil {
+			return nil, fmt.Errorf("get toolset %q: %w", ref, err)
+		}
+		for _, schema := range toolset.Tools {
+			if prev, ok := specs[tools.Ident(schema.Name)]; ok {
+				return nil, fmt.Errorf("tool %q is served by both toolsets %q and %q", schema.Name, prev.Toolset, ref)
+			}
+			var description string
+			if schema.Description != nil {
+				description = *schema.Description
+			}
+			inputSchema := json.RawMessage(schema.PayloadSchema)
+			if len(inputSchema) == 0 {
+				inputSchema = json.RawMessage(`{"type":"object"}`)
+			}
+			out.Tools = append(out.Tools, mcp.Tool{
+				Name:        schema.Name,
+				Description: description,
+				InputSchema: inputSchema,
+			})
+			specs[tools.Ident(schema.Name)] = &tools.ToolSpec{
+				Name:        tools.Ident(schema.Name),
+				Toolset:     ref,
+				Description: description,
+				Tags:        schema.Tags,
+				Payload:     tools.TypeSpec{Schema: schema.PayloadSchema},
+				Result:      tools.TypeSpec{Schema: schema.ResultSchema, Codec: rawResultCodec},
+			}
+		}
+	}
+	b.mu.Lock()
+	b.specs = specs
+	b.mu.Unlock()
+	return out, nil
+}
+
+// callTool routes one tools/call request through the registry. Tool failures
+// are reported in the result with isError so the model can see them; only
+// malformed requests and unknown tools are JSON-RPC errors.
+func (b *Bridge) callTool(ctx context.Context, params json.RawMessage, notify func(mcp.RPCNotification) error) (*mcp.CallToolResult, error) {
+	var p mcp.CallToolParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, invalidParams(err)
+	}
+	name := tools.Ident(p.Name)
+	if _, ok := b.Spec(name); !ok {
+		// Clients may call tools without listing them first.
+		if _, err := b.listTools(ctx); err != nil {
+			return nil, err
+		}
+		if _, ok := b.Spec(name); !ok {
+			return nil, &mcp.Error{Code: mcp.JSONRPCInvalidParams, Message: fmt.Sprintf("unknown tool %q", p.Name)}
+		}
+	}
+	payload := p.Arguments
+	if len(payload) == 0 {
+		payload = json.RawMessage(`{}`)
+	}
+	meta := &runtime.ToolCallMeta{
+		RunID:      b.runID,
+		SessionID:  b.runID,
+		ToolCallID: uuid.NewString(),
+	}
+	if p.Meta != nil && len(p.Meta.ProgressToken) > 0 && notify != nil {
+		b.progressMu.Lock()
+		b.progress[meta.ToolCallID] = &progressReporter{token: p.Meta.ProgressToken, notify: notify}
+		b.progressMu.Unlock()
+		defer func() {
+			b.progressMu.Lock()
+			delete(b.progress, meta.ToolCallID)
+			b.progressMu.Unlock()
+		}()
+	}
+	res, err := b.exec.Execute(ctx, meta, &planner.ToolRequest{
+		Name:      name,
+		Payload:   rawjson.Message(payload),
+		RunID:     meta.RunID,
+		SessionID: meta.SessionID,
+	})
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	if res == nil || res.ToolResult == nil {
+		return errorResult("tool call returned no result"), nil
+	}
+	return toolResult(res.ToolResult)
+}
+
+// toolResult converts an executor result to an MCP tool result.
+func toolResult(res *planner.ToolResult) (*mcp.CallToolResult, error) {
+	if res.Failure != nil {
+		msg := string(res.Failure.Kind)
+		if res.Failure.Error != nil {
+			msg = res.Failure.Error.Error()
+		}
+		return errorResult(msg), nil
+	}
+	var text string
+	switch v := res.Result.(type) {
+	case nil:
+		text = "null"
+	case json.RawMessage:
+		text = string(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("encode tool result: %w", err)
+		}
+		text = string(data)
+	}
+	return &mcp.CallToolResult{Content: []mcp.ContentItem{mcp.NewTextContent(text)}}, nil
+}
+
+// errorResult returns a tool result reporting a failed call.
+func errorResult(msg string) *mcp.CallToolResult {
+	return &mcp.CallToolResult{
+		Content: []mcp.ContentItem{mcp.NewTextContent(msg)},
+		IsError: true,
+	}
+}
+
+// invalidParams returns the JSON-RPC error for undecodable parameters.
+func invalidParams(err error) *mcp.Error {
+	return &mcp.Error{Code: mcp.JSONRPCInvalidParams, Message: fmt.Sprintf("invalid params: %v", err)}
+}
+
+// errorResponse returns the JSON-RPC error response for err. Errors that are
+// not already JSON-RPC errors are reported as internal errors.
+func errorResponse(id json.RawMessage, err error) *mcp.RPCResponse {
+	var rpcErr *mcp.Error
+	if !errors.As(err, &rpcErr) {
+		rpcErr = &mcp.Error{Code: mcp.JSONRPCInternalError, Message: err.Error()}
+	}
+	return &mcp.RPCResponse{JSONRPC: "2.0", ID: id, Error: rpcErr}
+}
@@ -0,0 +1,306 @@
+package mcpbridge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/runtime"
+	aistream "goa.design/goa-ai/runtime/agent/stream"
+	"goa.design/goa-ai/runtime/mcp"
+)
+
+type (
+	fakeCatalog struct {
+		toolsets map[string]*genregistry.Toolset
+		listTags []string
+	}
+
+	// fakeExecutor streams deltas through the bridge sink before returning
+	// its canned result.
+	fakeExecutor struct {
+		bridge *Bridge
+		deltas []string
+		result *planner.ToolResult
+		calls  []*planner.ToolRequest
+		specs  []string
+	}
+)
+
+func (c *fakeCatalog) ListToolsets(_ context.Context, p *genregistry.ListToolsetsPayload) (*genregistry.ListToolsetsResult, error) {
+	c.listTags = p.Tags
+	res := &genregistry.ListToolsetsResult{}
+	for name := range c.toolsets {
+		res.Toolsets = append(res.Toolsets, &genregistry.ToolsetInfo{Name: name})
+	}
+	return res, nil
+}
+
+func (c *fakeCatalog) GetToolset(_ context.Context, p *genregistry.GetToolsetPayload) (*genregistry.Toolset, error) {
+	ts, ok := c.toolsets[p.Name]
+	if !ok {
+		return nil, genregistry.MakeNotFound(errors.New("toolset not found"))
+	}
+	return ts, nil
+}
+
+func (e *fakeExecutor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call *planner.ToolRequest) (*runtime.ToolExecutionResult, error) {
+	e.calls = append(e.calls, call)
+	spec, _ := e.bridge.Spec(call.Name)
+	e.specs = append(e.specs, spec.Toolset)
+	for _, delta := range e.deltas {
+		p := aistream.ToolOutputDeltaPayload{ToolCallID: meta.ToolCallID, ToolName: call.Name.String(), Delta: delta}
+		if err := e.bridge.Send(ctx, aistream.ToolOutputDelta{
+			Base: aistream.NewBase(aistream.EventToolOutputDelta, meta.RunID, meta.SessionID, p),
+			Data: p,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return runtime.Executed(e.result), nil
+}
+
+func newTestBridge(t *testing.T, opts Options, exec *fakeExecutor) *Bridge {
+	t.Helper()
+	description := "Searches documents."
+	catalog := &fakeCatalog{toolsets: map[string]*genregistry.Toolset{
+		"docs": {
+			Name: "docs",
+			Tools: []*genregistry.ToolSchema{{
+				Name:          "docs.search",
+				Description:   &description,
+				PayloadSchema: []byte(`{"type":"object","properties":{"q":{"type":"string"}}}`),
+			}},
+		},
+		"docs@^1.2": {
+			Name:  "docs",
+			Tools: []*genregistry.ToolSchema{{Name: "docs.search"}},
+		},
+	}}
+	b := New(catalog, nil, nil, opts)
+	exec.bridge = b
+	b.exec = exec
+	return b
+}
+
+func request(t *testing.T, id int, method string, params any) *mcp.RPCRequest {
+	t.Helper()
+	req := &mcp.RPCRequest{JSONRPC: "2.0", Method: method}
+	if id != 0 {
+		req.ID = mustJSON(t, id)
+	}
+	if params != nil {
+		req.Params = mustJSON(t, params)
+	}
+	return req
+}
+
+func mustJSON(t *testing.T, v any) json.RawMessage {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return data
+}
+
+func TestBridgeListTools(t *testing.T) {
+	t.Parallel()
+
+	b := newTestBridge(t, Options{Toolsets: []string{"docs"}}, &fakeExecutor{})
+
+	resp := b.Handle(context.Background(), request(t, 1, mcp.MethodToolsList, nil), nil)
+	require.Nil(t, resp.Error)
+	res, ok := resp.Result.(*mcp.ListToolsResult)
+	require.True(t, ok)
+	require.Len(t, res.Tools, 1)
+	assert.Equal(t, "docs.search", res.Tools[0].Name)
+	assert.Equal(t, "Searches documents.", res.Tools[0].Description)
+	assert.JSONEq(t, `{"type":"object","properties":{"q":{"type":"string"}}}`, string(res.Tools[0].InputSchema))
+}
+
+func TestBridgeListToolsByTags(t *testing.T) {
+	t.Parallel()
+
+	b := newTestBridge(t, Options{Tags: []string{"search"}}, &fakeExecutor{})
+	catalog := b.catalog.(*fakeCatalog)
+	delete(catalog.toolsets, "docs@^1.2")
+
+	resp := b.Handle(context.Background(), request(t, 1, mcp.MethodToolsList, nil), nil)
+	require.Nil(t, resp.Error)
+	assert.Equal(t, []string{"search"}, catalog.listTags)
+	assert.Len(t, resp.Result.(*mcp.ListToolsResult).Tools, 1)
+}
+
+func TestBridgeListToolsRejectsDuplicateToolNames(t *testing.T) {
+	t.Parallel()
+
+	b := newTestBridge(t, Options{Toolsets: []string{"docs", "docs@^1.2"}}, &fakeExecutor{})
+
+	resp := b.Handle(context.Background(), request(t, 1, mcp.MethodToolsList, nil), nil)
+	require.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Message, `tool "docs.search" is served by both toolsets "docs" and "docs@^1.2"`)
+	_, ok := b.Spec("docs.search")
+	assert.False(t, ok, "specs are not refreshed from a conflicting listing")
+}
+
+func TestBridgeCallToolReportsProgress(t *testing.T) {
+	t.Parallel()
+
+	exec := &fakeExecutor{
+		deltas: []string{"scanning", "ranking"},
+		result: &planner.ToolResult{Name: "docs.search", Result: json.RawMessage(`{"hits":2}`)},
+	}
+	b := newTestBridge(t, Options{Toolsets: []string{"docs@^1.2"}}, exec)
+
+	var notes []mcp.RPCNotification
+	notify := func(n mcp.RPCNotification) error {
+		notes = append(notes, n)
+		return nil
+	}
+	resp := b.Handle(context.Background(), request(t, 7, mcp.MethodToolsCall, map[string]any{
+		"name":      "docs.search",
+		"arguments": map[string]any{"q": "mcp"},
+		"_meta":     map[string]any{"progressToken": "tok"},
+	}), notify)
+
+	require.Nil(t, resp.Error)
+	assert.JSONEq(t, `7`, string(resp.ID))
+	res := resp.Result.(*mcp.CallToolResult)
+	assert.False(t, res.IsError)
+	require.Len(t, res.Content, 1)
+	assert.JSONEq(t, `{"hits":2}`, *res.Content[0].Text)
+
+	require.Len(t, exec.calls, 1)
+	assert.JSONEq(t, `{"q":"mcp"}`, string(exec.calls[0].Payload))
+	assert.Equal(t, []string{"docs@^1.2"}, exec.specs, "calls route to the selected toolset reference")
+
+	require.Len(t, notes, 2)
+	for i, msg := range []string{"scanning", "ranking"} {
+		assert.Equal(t, mcp.MethodProgress, notes[i].Method)
+		p := notes[i].Params.(mcp.ProgressParams)
+		assert.JSONEq(t, `"tok"`, string(p.ProgressToken))
+		assert.InDelta(t, float64(i+1), p.Progress, 0)
+		assert.Equal(t, msg, p.Message)
+	}
+	assert.Empty(t, b.progress, "progress reporters are released after the call")
+}
+
+func TestBridgeCallToolFailure(t *testing.T) {
+	t.Parallel()
+
+	exec := &fakeExecutor{
+		deltas: []string{"ignored without a progress token"},
+		result: &planner.ToolResult{
+			Name: "docs.search",
+			Failure: &planner.ToolFailure{
+				Kind:  planner.FailureUnavailable,
+				Error: planner.NewToolError("provider unavailable"),
+			},
+		},
+	}
+	b := newTestBridge(t, Options{Toolsets: []string{"docs"}}, exec)
+
+	resp := b.Handle(context.Background(), request(t, 1, mcp.MethodToolsCall, map[string]any{"name": "docs.search"}), nil)
+	require.Nil(t, resp.Error)
+	res := resp.Result.(*mcp.CallToolResult)
+	assert.True(t, res.IsError)
+	assert.Equal(t, "provider unavailable", *res.Content[0].Text)
+	assert.JSONEq(t, `{}`, string(exec.calls[0].Payload))
+}
+
+func TestBridgeProtocolErrors(t *testing.T) {
+	t.Parallel()
+
+	b := newTestBridge(t, Options{Toolsets: []string{"docs"}}, &fakeExecutor{})
+	ctx := context.Background()
+
+	resp := b.Handle(ctx, request(t, 1, mcp.MethodToolsCall, map[string]any{"name": "docs.missing"}), nil)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, mcp.JSONRPCInvalidParams, resp.Error.Code)
+
+	resp = b.Handle(ctx, request(t, 2, "resources/list", nil), nil)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, mcp.JSONRPCMethodNotFound, resp.Error.Code)
+
+	assert.Nil(t, b.Handle(ctx, request(t, 0, mcp.MethodInitialized, nil), nil), "notifications get no response")
+
+	resp = b.Handle(ctx, request(t, 3, mcp.MethodInitialize, map[string]any{"protocolVersion": "2025-03-26"}), nil)
+	require.Nil(t, resp.Error)
+	init := resp.Result.(*mcp.InitializeResult)
+	assert.Equal(t, "2025-03-26", init.ProtocolVersion)
+	assert.Equal(t, "registry-mcp-bridge", init.ServerInfo.Name)
+}
+
+func TestServeStdio(t *testing.T) {
+	t.Parallel()
+
+	exec := &fakeExecutor{
+		deltas: []string{"working"},
+		result: &planner.ToolResult{Name: "docs.search", Result: json.RawMessage(`"done"`)},
+	}
+	b := newTestBridge(t, Options{Toolsets: []string{"docs"}}, exec)
+	in := strings.Join([]string{
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`not json`,
+		`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"docs.search","_meta":{"progressToken":5}}}`,
+	}, "\n")
+	var out bytes.Buffer
+
+	require.NoError(t, b.ServeStdio(context.Background(), strings.NewReader(in), &out))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"parse error: invalid character 'o' in literal null (expecting 'u')"}}`, lines[0])
+	assert.JSONEq(t, `{"jsonrpc":"2.0","method":"notifications/progress","params":{"progressToken":5,"progress":1,"message":"working"}}`, lines[1])
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":{"content":[{"type":"text","text":"\"done\""}],"isError":false}}`, lines[2])
+}
+
+func TestServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	exec := &fakeExecutor{
+		deltas: []string{"working"},
+		result: &planner.ToolResult{Name: "docs.search", Result: json.RawMessage(`"done"`)},
+	}
+	b := newTestBridge(t, Options{Toolsets: []string{"docs"}}, exec)
+	body := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"docs.search","_meta":{"progressToken":"p"}}}`
+
+	t.Run("sse", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Accept", "application/json, text/event-stream")
+		rec := httptest.NewRecorder()
+		b.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		events := strings.Split(strings.TrimSpace(rec.Body.String()), "\n\n")
+		require.Len(t, events, 2)
+		assert.Contains(t, events[0], `"method":"notifications/progress"`)
+		assert.Contains(t, events[1], `"id":1`)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		b.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":{"content":[{"type":"text","text":"\"done\""}],"isError":false}}`, rec.Body.String())
+	})
+
+	t.Run("notification", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","method":"notifications/initialized"}`))
+		rec := httptest.NewRecorder()
+		b.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	})
+}
@@ -0,0 +1,144 @@
+package mcpbridge
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"goa.design/goa-ai/runtime/mcp"
+)
+
+// maxMessageSize bounds one JSON-RPC message read from stdio or HTTP.
+const maxMessageSize = 16 << 20
+
+// ServeStdio serves newline-delimited JSON-RPC messages read from r and writes
+// responses and notifications to w. Requests are handled concurrently so a
+// long-running tool call does not block pings or other calls. ServeStdio
+// returns once r is exhausted and in-flight requests complete, or when ctx is
+// canceled between messages.
+func (b *Bridge) ServeStdio(ctx context.Context, r io.Reader, w io.Writer) error {
+	var (
+		writeMu sync.Mutex
+		wg      sync.WaitGroup
+	)
+	enc := json.NewEncoder(w)
+	write := func(msg any) error {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		return enc.Encode(msg)
+	}
+	defer wg.Wait()
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var req mcp.RPCRequest
+		if err := json.Unmarshal(line, &req); err != nil {
+			if err := write(parseErrorResponse(err)); err != nil {
+				return fmt.Errorf("write response: %w", err)
+			}
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			notify := func(n mcp.RPCNotification) error { return write(n) }
+			resp := b.Handle(ctx, &req, notify)
+			if resp == nil {
+				return
+			}
+			if err := write(resp); err != nil {
+				b.logger.Error(ctx, "write mcp response failed", "method", req.Method, "err", err)
+			}
+		}()
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read requests: %w", err)
+	}
+	return nil
+}
+
+// ServeHTTP implements http.Handler for the MCP streamable HTTP transport.
+// Each POST carries one JSON-RPC message. When the client accepts
+// text/event-stream, progress notifications and the response are streamed as
+// server-sent events; otherwise the response is returned as JSON and progress
+// is not reported. Notifications are acknowledged with 202 Accepted.
+func (b *Bridge) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var req mcp.RPCRequest
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxMessageSize)).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, parseErrorResponse(err))
+		return
+	}
+	if len(req.ID) == 0 {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok || !strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		writeJSON(w, http.StatusOK, b.Handle(r.Context(), &req, nil))
+		return
+	}
+
+	var (
+		mu      sync.Mutex
+		started bool
+	)
+	send := func(msg any) error {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if !started {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		if _, err := fmt.Fprintf(w, "event: message\ndata: %s\n\n", data); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+	notify := func(n mcp.RPCNotification) error { return send(n) }
+	if err := send(b.Handle(r.Context(), &req, notify)); err != nil && !errors.Is(err, context.Canceled) {
+		b.logger.Error(r.Context(), "write mcp response failed", "method", req.Method, "err", err)
+	}
+}
+
+// parseErrorResponse returns the JSON-RPC response for an undecodable message.
+func parseErrorResponse(err error) *mcp.RPCResponse {
+	return &mcp.RPCResponse{
+		JSONRPC: "2.0",
+		ID:      json.RawMessage("null"),
+		Error:   &mcp.Error{Code: mcp.JSONRPCParseError, Message: fmt.Sprintf("parse error: %v", err)},
+	}
+}
+
+// writeJSON writes v as a JSON response body.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
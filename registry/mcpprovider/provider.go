@@ -0,0 +1,322 @@
+// Package mcpprovider registers an external MCP server as a registry provider.
+//
+// Serve imports the server's tools/list schemas into a registry Register call,
+// answers registry health pings, and forwards each claimed tool call to the
+// server with tools/call. The MCP server becomes a regular registry toolset:
+// agents discover it through the catalog and calls are governed by registry
+// authorization and quotas like any generated provider.
+package mcpprovider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pulseclients "goa.design/goa-ai/features/stream/pulse/clients/pulse"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/mcp"
+	"goa.design/goa-ai/runtime/toolregistry"
+	"goa.design/goa-ai/runtime/toolregistry/provider"
+)
+
+type (
+	// Registry is the subset of the generated registry client used by a
+	// provider. *genregistry.Client implements it.
+	Registry interface {
+		Register(ctx context.Context, p *genregistry.RegisterPayload) (*genregistry.RegisterResult, error)
+		DrainProvider(ctx context.Context, p *genregistry.DrainProviderPayload) error
+		ReleaseProvider(ctx context.Context, p *genregistry.ReleaseProviderPayload) error
+		Pong(ctx context.Context, p *genregistry.PongPayload) error
+		CompleteToolCall(ctx context.Context, p *genregistry.CompleteToolCallPayload) error
+		PublishToolOutputDelta(ctx context.Context, p *genregistry.PublishToolOutputDeltaPayload) error
+		ReportToolCallOverload(ctx context.Context, p *genregistry.ProviderToolCallClaimPayload) error
+		ClaimToolCall(ctx context.Context, p *genregistry.ProviderToolCallClaimPayload) (*genregistry.ClaimToolCallResult, error)
+	}
+
+	// Server is a connected MCP server. The runtime/mcp stdio, HTTP, and SSE
+	// callers implement it.
+	Server interface {
+		mcp.Caller
+		mcp.ToolLister
+	}
+
+	// Options configures Serve.
+	Options struct {
+		// Toolset is the registry toolset name the MCP server is registered
+		// under. Its tools are named "<Toolset>.<MCP tool name>". Required.
+		Toolset string
+		// Description is the toolset description shown in the catalog.
+		Description string
+		// Tags categorize the toolset in the catalog.
+		Tags []string
+		// AdmissionRevision fences the registry admission. When empty, Serve
+		// derives it from the imported schemas so replicas serving the same
+		// tools share one admission and a changed tool list creates a new one.
+		AdmissionRevision string
+		// Provider configures the provider loop. Serve sets its Pong callback.
+		// Provider.ProviderID is required.
+		Provider provider.Options
+	}
+
+	// Handler forwards registry tool calls to an MCP server.
+	Handler struct {
+		toolset string
+		server  mcp.Caller
+	}
+)
+
+// emptyResultSchema accepts any result for MCP tools that declare no output
+// schema.
+var emptyResultSchema = []byte(`{}`)
+
+// Serve registers the tools of server as toolset opts.Toolset and serves
+// claimed calls until ctx is canceled. The tool list is read once: restart the
+// provider to publish tools added to the MCP server later.
+func Serve(ctx context.Context, pulse pulseclients.Client, reg Registry, server Server, opts Options) error {
+	if opts.Toolset == "" {
+		return errors.New("toolset is required")
+	}
+	if reg == nil {
+		return errors.New("registry client is required")
+	}
+	if server == nil {
+		return errors.New("mcp server is required")
+	}
+	tools, err := server.ListTools(ctx)
+	if err != nil {
+		return fmt.Errorf("list mcp tools: %w", err)
+	}
+	schemas, err := ImportTools(opts.Toolset, tools)
+	if err != nil {
+		return err
+	}
+	revision := opts.AdmissionRevision
+	if revision == "" {
+		revision, err = SchemaRevision(schemas)
+		if err != nil {
+			return err
+		}
+	}
+	if err := toolregistry.ValidateAdmissionRevision(revision); err != nil {
+		return err
+	}
+	register := &genregistry.RegisterPayload{
+		Name:                opts.Toolset,
+		Tags:                opts.Tags,
+		Tools:               schemas,
+		WireProtocolVersion: toolregistry.WireProtocolVersion,
+	}
+	if opts.Description != "" {
+		register.Description = &opts.Description
+	}
+	providerOpts := opts.Provider
+	providerOpts.Pong = func(ctx context.Context, providerID, incarnationID, pingID string) error {
+		return reg.Pong(ctx, &genregistry.PongPayload{
+			PingID:                pingID,
+			Toolset:               opts.Toolset,
+			ProviderID:            providerID,
+			ProviderIncarnationID: incarnationID,
+		})
+	}
+	return provider.Serve(
+		ctx,
+		pulse,
+		opts.Toolset,
+		NewHandler(opts.Toolset, server),
+		Registration(reg, register, revision),
+		providerOpts,
+	)
+}
+
+// ImportTools converts MCP tool definitions to registry tool schemas. Tools
+// without an output schema accept any result.
+func ImportTools(toolset string, tools []mcp.Tool) ([]*genregistry.ToolSchema, error) {
+	if len(tools) == 0 {
+		return nil, errors.New("mcp server lists no tools")
+	}
+	schemas := make([]*genregistry.ToolSchema, 0, len(tools))
+	seen := make(map[string]struct{}, len(tools))
+	for _, tool := range tools {
+		if tool.Name == "" {
+			return nil, errors.New("mcp server lists a tool without name")
+		}
+		if _, ok := seen[tool.Name]; ok {
+			return nil, fmt.Errorf("mcp server lists tool %q twice", tool.Name)
+		}
+		seen[tool.Name] = struct{}{}
+		if len(tool.InputSchema) == 0 {
+			return nil, fmt.Errorf("mcp tool %q has no input schema", tool.Name)
+		}
+		schema := &genregistry.ToolSchema{
+			Name:          toolset + "." + tool.Name,
+			PayloadSchema: append([]byte(nil), tool.InputSchema...),
+			ResultSchema:  emptyResultSchema,
+		}
+		if len(tool.OutputSchema) > 0 {
+			schema.ResultSchema = append([]byte(nil), tool.OutputSchema...)
+		}
+		if tool.Description != "" {
+			description := tool.Description
+			schema.Description = &description
+		}
+		schemas = append(schemas, schema)
+	}
+	return schemas, nil
+}
+
+// SchemaRevision returns an admission revision derived from the imported
+// schemas.
+func SchemaRevision(schemas []*genregistry.ToolSchema) (string, error) {
+	data, err := json.Marshal(schemas)
+	if err != nil {
+		return "", fmt.Errorf("encode tool schemas: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return "mcp-" + hex.EncodeToString(sum[:8]), nil
+}
+
+// NewHandler returns a Handler forwarding calls for toolset to server.
+func NewHandler(toolset string, server mcp.Caller) *Handler {
+	return &Handler{toolset: toolset, server: server}
+}
+
+// HandleToolCall implements provider.Handler. MCP tool errors become domain
+// rejections the planner can replan around; rejected arguments become
+// correctable calls and unreachable servers become unavailable tools.
+func (h *Handler) HandleToolCall(ctx context.Context, msg toolregistry.ToolCallMessage) (toolregistry.ToolResultMessage, error) {
+	name, ok := strings.CutPrefix(msg.Tool.String(), h.toolset+".")
+	if !ok || name == "" {
+		return toolregistry.NewToolResultErrorMessage(msg.RegistrationToken, msg.ToolUseID, "unknown_tool", fmt.Sprintf("unknown tool %q", msg.Tool)), nil
+	}
+	payload := msg.Payload
+	if len(payload) == 0 {
+		payload = json.RawMessage(`{}`)
+	}
+	resp, err := h.server.CallTool(ctx, mcp.CallRequest{
+		Suite:   h.toolset,
+		Tool:    name,
+		Payload: payload,
+	})
+	if err != nil {
+		return toolregistry.NewToolResultErrorMessage(msg.RegistrationToken, msg.ToolUseID, errorCode(err), err.Error()), nil
+	}
+	return toolregistry.NewToolResultMessage(msg.RegistrationToken, msg.ToolUseID, resp.Result), nil
+}
+
+// errorCode maps an MCP call error to a registry tool error code.
+func errorCode(err error) string {
+	var (
+		toolErr      *mcp.ToolExecutionError
+		rpcErr       *mcp.Error
+		malformedErr *mcp.MalformedResponseError
+	)
+	switch {
+	case errors.As(err, &toolErr):
+		return "invalid_input"
+	case errors.As(err, &rpcErr) && rpcErr.Code == mcp.JSONRPCInvalidParams:
+		return "invalid_arguments"
+	case errors.As(err, &rpcErr), errors.As(err, &malformedErr):
+		return "execution_failed"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	default:
+		return "service_unavailable"
+	}
+}
+
+// Registration returns the provider registration that admits payload through
+// reg. Serve supplies the provider identity fields on every call.
+func Registration(reg Registry, payload *genregistry.RegisterPayload, admissionRevision string) provider.Registration {
+	return provider.Registration{
+		AdmissionRevision: admissionRevision,
+		Register: func(ctx context.Context, toolset, providerID, incarnationID, admissionRevision string) (provider.RegistrationLease, error) {
+			p := *payload
+			p.Name = toolset
+			p.ProviderID = providerID
+			p.ProviderIncarnationID = incarnationID
+			p.AdmissionRevision = admissionRevision
+			res, err := reg.Register(ctx, &p)
+			if err != nil {
+				return provider.RegistrationLease{}, err
+			}
+			return provider.RegistrationLease{
+				RegistrationToken: res.RegistrationToken,
+				Duration:          time.Duration(res.LeaseDurationMs) * time.Millisecond,
+			}, nil
+		},
+		Drain: func(ctx context.Context, toolset, providerID, incarnationID, expectedToken string, settlementDuration time.Duration) error {
+			return reg.DrainProvider(ctx, &genregistry.DrainProviderPayload{
+				Name:                      toolset,
+				ProviderID:                providerID,
+				ProviderIncarnationID:     incarnationID,
+				ExpectedRegistrationToken: expectedToken,
+				SettlementDurationMs:      settlementDuration.Milliseconds(),
+			})
+		},
+		Release: func(ctx context.Context, toolset, providerID, incarnationID, expectedToken string) error {
+			return reg.ReleaseProvider(ctx, &genregistry.ReleaseProviderPayload{
+				Name:                      toolset,
+				ProviderID:                providerID,
+				ProviderIncarnationID:     incarnationID,
+				ExpectedRegistrationToken: expectedToken,
+			})
+		},
+		Complete: func(ctx context.Context, toolset, providerID, incarnationID, providerToken, requestEventID string, result toolregistry.ToolResultMessage) error {
+			resultJSON, err := json.Marshal(result)
+			if err != nil {
+				return err
+			}
+			return reg.CompleteToolCall(ctx, &genregistry.CompleteToolCallPayload{
+				Toolset:                   toolset,
+				ProviderID:                providerID,
+				ProviderIncarnationID:     incarnationID,
+				RegistrationToken:         result.RegistrationToken,
+				ToolUseID:                 result.ToolUseID,
+				ResultJSON:                resultJSON,
+				RequestEventID:            requestEventID,
+				ProviderRegistrationToken: providerToken,
+			})
+		},
+		PublishOutputDelta: func(ctx context.Context, toolset, providerID, incarnationID, providerToken, callToken, toolUseID, requestEventID, stream, delta string) error {
+			return reg.PublishToolOutputDelta(ctx, &genregistry.PublishToolOutputDeltaPayload{
+				Toolset:                   toolset,
+				ProviderID:                providerID,
+				ProviderIncarnationID:     incarnationID,
+				ProviderRegistrationToken: providerToken,
+				CallRegistrationToken:     callToken,
+				ToolUseID:                 toolUseID,
+				RequestEventID:            requestEventID,
+				Stream:                    stream,
+				Delta:                     delta,
+			})
+		},
+		ReportOverload: func(ctx context.Context, toolset, providerID, incarnationID, providerToken, callToken, toolUseID, requestEventID string) error {
+			return reg.ReportToolCallOverload(ctx, claimPayload(toolset, providerID, incarnationID, providerToken, callToken, toolUseID, requestEventID))
+		},
+		Claim: func(ctx context.Context, toolset, providerID, incarnationID, providerToken, callToken, toolUseID, requestEventID string) (provider.ClaimDisposition, error) {
+			res, err := reg.ClaimToolCall(ctx, claimPayload(toolset, providerID, incarnationID, providerToken, callToken, toolUseID, requestEventID))
+			if err != nil {
+				return "", err
+			}
+			return provider.ClaimDisposition(res.Disposition), nil
+		},
+	}
+}
+
+// claimPayload builds the payload identifying one claimed call.
+func claimPayload(toolset, providerID, incarnationID, providerToken, callToken, toolUseID, requestEventID string) *genregistry.ProviderToolCallClaimPayload {
+	return &genregistry.ProviderToolCallClaimPayload{
+		Toolset:                   toolset,
+		ProviderID:                providerID,
+		ProviderIncarnationID:     incarnationID,
+		ProviderRegistrationToken: providerToken,
+		CallRegistrationToken:     callToken,
+		ToolUseID:                 toolUseID,
+		RequestEventID:            requestEventID,
+	}
+}
@@ -0,0 +1,168 @@
+package mcpprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/mcp"
+	"goa.design/goa-ai/runtime/toolregistry"
+)
+
+const testRegistrationToken = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+type (
+	fakeServer struct {
+		calls []mcp.CallRequest
+		resp  mcp.CallResponse
+		err   error
+	}
+
+	recordingRegistry struct {
+		Registry
+		register *genregistry.RegisterPayload
+	}
+)
+
+func (s *fakeServer) CallTool(_ context.Context, req mcp.CallRequest) (mcp.CallResponse, error) {
+	s.calls = append(s.calls, req)
+	return s.resp, s.err
+}
+
+func (r *recordingRegistry) Register(_ context.Context, p *genregistry.RegisterPayload) (*genregistry.RegisterResult, error) {
+	r.register = p
+	return &genregistry.RegisterResult{RegistrationToken: testRegistrationToken, LeaseDurationMs: 60000}, nil
+}
+
+func TestImportTools(t *testing.T) {
+	t.Parallel()
+
+	schemas, err := ImportTools("github", []mcp.Tool{
+		{Name: "search_issues", Description: "Searches issues.", InputSchema: json.RawMessage(`{"type":"object"}`)},
+		{Name: "get_issue", InputSchema: json.RawMessage(`{"type":"object"}`), OutputSchema: json.RawMessage(`{"type":"object","properties":{"title":{"type":"string"}}}`)},
+	})
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+	assert.Equal(t, "github.search_issues", schemas[0].Name)
+	require.NotNil(t, schemas[0].Description)
+	assert.Equal(t, "Searches issues.", *schemas[0].Description)
+	assert.JSONEq(t, `{"type":"object"}`, string(schemas[0].PayloadSchema))
+	assert.JSONEq(t, `{}`, string(schemas[0].ResultSchema), "tools without output schema accept any result")
+	assert.Nil(t, schemas[1].Description)
+	assert.JSONEq(t, `{"type":"object","properties":{"title":{"type":"string"}}}`, string(schemas[1].ResultSchema))
+
+	for name, tools := range map[string][]mcp.Tool{
+		"no tools":       nil,
+		"missing name":   {{InputSchema: json.RawMessage(`{}`)}},
+		"missing schema": {{Name: "a"}},
+		"duplicate tool": {{Name: "a", InputSchema: json.RawMessage(`{}`)}, {Name: "a", InputSchema: json.RawMessage(`{}`)}},
+	} {
+		_, err := ImportTools("github", tools)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestSchemaRevision(t *testing.T) {
+	t.Parallel()
+
+	tools := []mcp.Tool{{Name: "a", InputSchema: json.RawMessage(`{"type":"object"}`)}}
+	schemas, err := ImportTools("ts", tools)
+	require.NoError(t, err)
+	first, err := SchemaRevision(schemas)
+	require.NoError(t, err)
+	again, err := SchemaRevision(schemas)
+	require.NoError(t, err)
+	assert.Equal(t, first, again)
+	require.NoError(t, toolregistry.ValidateAdmissionRevision(first))
+
+	tools[0].Description = "changed"
+	changed, err := ImportTools("ts", tools)
+	require.NoError(t, err)
+	other, err := SchemaRevision(changed)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, other)
+}
+
+func TestHandleToolCall(t *testing.T) {
+	t.Parallel()
+
+	msg := toolregistry.ToolCallMessage{
+		RegistrationToken: testRegistrationToken,
+		ToolUseID:         "tool-use",
+		Tool:              "github.search_issues",
+		Payload:           json.RawMessage(`{"q":"bug"}`),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		server := &fakeServer{resp: mcp.CallResponse{Result: json.RawMessage(`{"total":3}`)}}
+		res, err := NewHandler("github", server).HandleToolCall(context.Background(), msg)
+		require.NoError(t, err)
+		require.NoError(t, toolregistry.ValidateToolResultMessage(res))
+		assert.Nil(t, res.Error)
+		assert.JSONEq(t, `{"total":3}`, string(res.Result))
+		require.Len(t, server.calls, 1)
+		assert.Equal(t, mcp.CallRequest{Suite: "github", Tool: "search_issues", Payload: json.RawMessage(`{"q":"bug"}`)}, server.calls[0])
+	})
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		code string
+		kind planner.FailureKind
+	}{
+		{"tool error", mcp.NewToolExecutionError(json.RawMessage(`"not found"`)), "invalid_input", planner.FailureDomainRejection},
+		{"invalid params", &mcp.Error{Code: mcp.JSONRPCInvalidParams, Message: "bad"}, "invalid_arguments", planner.FailureInvalidCall},
+		{"rpc error", &mcp.Error{Code: mcp.JSONRPCInternalError, Message: "boom"}, "execution_failed", planner.FailureInternal},
+		{"timeout", context.DeadlineExceeded, "timeout", planner.FailureTimeout},
+		{"unreachable", errors.New("connection refused"), "service_unavailable", planner.FailureUnavailable},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := NewHandler("github", &fakeServer{err: tc.err}).HandleToolCall(context.Background(), msg)
+			require.NoError(t, err)
+			require.NoError(t, toolregistry.ValidateToolResultMessage(res))
+			require.NotNil(t, res.Error)
+			assert.Equal(t, tc.code, res.Error.Code)
+			assert.Equal(t, tc.kind, res.Error.Failure.Kind)
+		})
+	}
+
+	t.Run("unknown tool", func(t *testing.T) {
+		server := &fakeServer{}
+		other := msg
+		other.Tool = "gitlab.search_issues"
+		res, err := NewHandler("github", server).HandleToolCall(context.Background(), other)
+		require.NoError(t, err)
+		require.NotNil(t, res.Error)
+		assert.Equal(t, "unknown_tool", res.Error.Code)
+		assert.Empty(t, server.calls)
+	})
+}
+
+func TestRegistrationRegistersImportedSchemas(t *testing.T) {
+	t.Parallel()
+
+	reg := &recordingRegistry{}
+	payload := &genregistry.RegisterPayload{
+		Tools:               []*genregistry.ToolSchema{{Name: "github.a"}},
+		WireProtocolVersion: toolregistry.WireProtocolVersion,
+	}
+	registration := Registration(reg, payload, "rev-1")
+	assert.Equal(t, "rev-1", registration.AdmissionRevision)
+
+	lease, err := registration.Register(context.Background(), "github", "pod-1/github", "incarnation", "rev-1")
+	require.NoError(t, err)
+	assert.Equal(t, testRegistrationToken, lease.RegistrationToken)
+	assert.Equal(t, int64(60000), lease.Duration.Milliseconds())
+	require.NotNil(t, reg.register)
+	assert.Equal(t, "github", reg.register.Name)
+	assert.Equal(t, "pod-1/github", reg.register.ProviderID)
+	assert.Equal(t, "incarnation", reg.register.ProviderIncarnationID)
+	assert.Equal(t, "rev-1", reg.register.AdmissionRevision)
+	assert.Equal(t, payload.Tools, reg.register.Tools)
+	assert.Empty(t, payload.ProviderID, "the shared payload is not mutated")
+}
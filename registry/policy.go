@@ -0,0 +1,211 @@
+// Package registry authorizes registry callers.
+//
+// A Policy grants authenticated callers three kinds of rights over toolsets:
+// call rights let consumers discover and invoke toolsets or individual tools,
+// register rights let providers admit, serve, and retire toolsets in a
+// namespace, and admin rights let operators inspect and intervene in the
+// leases, calls, and health of toolsets. Rights are keyed by toolset name; side-by-side versions
+// ("name@1.2.0") share the rights of their base name.
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"goa.design/goa-ai/runtime/toolregistry"
+)
+
+type (
+	// Policy maps caller identities to toolset rights. A caller may do
+	// whatever the union of its matching rules allows; callers matched by no
+	// rule may do nothing.
+	Policy struct {
+		// Rules lists the grants in no particular order.
+		Rules []PolicyRule `yaml:"rules" json:"rules"`
+	}
+
+	// PolicyRule grants rights to a set of callers. All patterns use path.Match
+	// syntax, so "*" matches any run of characters other than "/".
+	PolicyRule struct {
+		// Callers matches Caller.Subject, for example "svc-billing" or
+		// "spiffe://prod.example.com/ns/agents/sa/*".
+		Callers []string `yaml:"callers" json:"callers"`
+		// Call grants discovery and invocation. A "toolset" pattern grants
+		// every tool of matching toolsets; a "toolset/tool" pattern grants
+		// only matching tools, named without the toolset qualifier.
+		Call []string `yaml:"call,omitempty" json:"call,omitempty"`
+		// Register grants provider rights (Register, lease, health, claim, and
+		// result operations, and Unregister) over matching toolset names, for
+		// example "billing.*".
+		Register []string `yaml:"register,omitempty" json:"register,omitempty"`
+		// Admin grants operator rights (ListProviders, ForceDrainProvider,
+		// RetireRegistration, ListToolCalls, InspectToolCall, and
+		// ListHealthTransitions) over matching toolset names.
+		Admin []string `yaml:"admin,omitempty" json:"admin,omitempty"`
+	}
+)
+
+// LoadPolicy reads a YAML or JSON policy file.
+func LoadPolicy(file string) (*Policy, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read policy: %w", err)
+	}
+	return ParsePolicy(data)
+}
+
+// ParsePolicy decodes and validates a YAML or JSON policy document.
+func ParsePolicy(data []byte) (*Policy, error) {
+	var p Policy
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("decode policy: %w", err)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Validate rejects rules without callers and malformed patterns.
+func (p *Policy) Validate() error {
+	var errs []error
+	for i, rule := range p.Rules {
+		if len(rule.Callers) == 0 {
+			errs = append(errs, fmt.Errorf("rule %d: callers is required", i))
+		}
+		for _, pattern := range rule.Callers {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errs = append(errs, fmt.Errorf("rule %d: caller pattern %q: %w", i, pattern, err))
+			}
+		}
+		for _, pattern := range rule.Call {
+			toolset, tool, _ := strings.Cut(pattern, "/")
+			for _, part := range []string{toolset, tool} {
+				if _, err := path.Match(part, ""); err != nil {
+					errs = append(errs, fmt.Errorf("rule %d: call pattern %q: %w", i, pattern, err))
+				}
+			}
+		}
+		for _, pattern := range rule.Register {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errs = append(errs, fmt.Errorf("rule %d: register pattern %q: %w", i, pattern, err))
+			}
+		}
+		for _, pattern := range rule.Admin {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errs = append(errs, fmt.Errorf("rule %d: admin pattern %q: %w", i, pattern, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CanCall reports whether caller may invoke tool on the toolset named by
+// ref. An empty tool asks whether caller may invoke every tool.
+func (p *Policy) CanCall(caller Caller, ref, tool string) bool {
+	toolset := policyToolset(ref)
+	tool = strings.TrimPrefix(tool, toolset+".")
+	for _, rule := range p.rulesFor(caller) {
+		for _, pattern := range rule.Call {
+			toolsetPattern, toolPattern, scoped := strings.Cut(pattern, "/")
+			if !policyMatch(toolsetPattern, toolset) {
+				continue
+			}
+			if !scoped || (tool != "" && policyMatch(toolPattern, tool)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CanDiscover reports whether caller may see the toolset named by ref in
+// discovery results: callers see toolsets they may call at least one tool of,
+// and providers see toolsets they may register.
+func (p *Policy) CanDiscover(caller Caller, ref string) bool {
+	toolset := policyToolset(ref)
+	for _, rule := range p.rulesFor(caller) {
+		for _, pattern := range rule.Call {
+			toolsetPattern, _, _ := strings.Cut(pattern, "/")
+			if policyMatch(toolsetPattern, toolset) {
+				return true
+			}
+		}
+		for _, pattern := range rule.Register {
+			if policyMatch(pattern, toolset) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CanRegister reports whether caller may act as a provider of the toolset
+// named by ref.
+func (p *Policy) CanRegister(caller Caller, ref string) bool {
+	toolset := policyToolset(ref)
+	for _, rule := range p.rulesFor(caller) {
+		for _, pattern := range rule.Register {
+			if policyMatch(pattern, toolset) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CanAdmin reports whether caller may administer the toolset named by ref.
+func (p *Policy) CanAdmin(caller Caller, ref string) bool {
+	toolset := policyToolset(ref)
+	for _, rule := range p.rulesFor(caller) {
+		for _, pattern := range rule.Admin {
+			if policyMatch(pattern, toolset) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rulesFor returns the rules whose caller patterns match caller.
+func (p *Policy) rulesFor(caller Caller) []PolicyRule {
+	if caller.Subject == "" {
+		return nil
+	}
+	var rules []PolicyRule
+	for _, rule := range p.Rules {
+		for _, pattern := range rule.Callers {
+			if policyMatch(pattern, caller.Subject) {
+				rules = append(rules, rule)
+				break
+			}
+		}
+	}
+	return rules
+}
+
+// policyToolset strips a side-by-side version or constraint suffix from ref.
+// Suffixes that are not version constraints are part of a literal name, as in
+// registration.
+func policyToolset(ref string) string {
+	name, constraint := toolregistry.SplitToolsetRef(ref)
+	if constraint == "" {
+		return ref
+	}
+	if _, err := toolregistry.ParseVersionConstraint(constraint); err != nil {
+		return ref
+	}
+	return name
+}
+
+// policyMatch reports whether name matches pattern. Validate rejects
+// malformed patterns, so match errors simply deny.
+func policyMatch(pattern, name string) bool {
+	ok, err := path.Match(pattern, name)
+	return err == nil && ok
+}
@@ -0,0 +1,88 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicyYAML = `
+rules:
+  - callers: ["spiffe://prod/ns/agents/sa/*"]
+    call: ["data.*", "billing.invoices/list_*"]
+  - callers: ["svc-data"]
+    register: ["data.*"]
+  - callers: ["ops-*"]
+    admin: ["data.*"]
+`
+
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := ParsePolicy([]byte(testPolicyYAML))
+	require.NoError(t, err)
+	require.Len(t, policy.Rules, 3)
+	assert.Equal(t, []string{"data.*"}, policy.Rules[1].Register)
+	assert.Equal(t, []string{"data.*"}, policy.Rules[2].Admin)
+
+	for name, doc := range map[string]string{
+		"missing callers":  `rules: [{call: ["data.*"]}]`,
+		"bad caller":       `rules: [{callers: ["["], call: ["data.*"]}]`,
+		"bad call tool":    `rules: [{callers: ["a"], call: ["data.*/["]}]`,
+		"bad register":     `rules: [{callers: ["a"], register: ["["]}]`,
+		"bad admin":        `rules: [{callers: ["a"], admin: ["["]}]`,
+		"malformed policy": `rules: {`,
+	} {
+		_, err := ParsePolicy([]byte(doc))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestPolicyRights(t *testing.T) {
+	t.Parallel()
+
+	policy, err := ParsePolicy([]byte(testPolicyYAML))
+	require.NoError(t, err)
+	agent := Caller{Subject: "spiffe://prod/ns/agents/sa/planner", Scheme: CallerSchemeMTLS}
+	provider := Caller{Subject: "svc-data", Scheme: CallerSchemeJWT}
+	stranger := Caller{Subject: "svc-other", Scheme: CallerSchemeJWT}
+	operator := Caller{Subject: "ops-oncall", Scheme: CallerSchemeJWT}
+
+	t.Run("call", func(t *testing.T) {
+		assert.True(t, policy.CanCall(agent, "data.tools", "query"))
+		assert.True(t, policy.CanCall(agent, "data.tools@^1.2", "data.tools.query"))
+		assert.True(t, policy.CanCall(agent, "billing.invoices", "list_open"))
+		assert.True(t, policy.CanCall(agent, "billing.invoices", "billing.invoices.list_open"))
+		assert.False(t, policy.CanCall(agent, "billing.invoices", "void"))
+		assert.False(t, policy.CanCall(agent, "billing.invoices", ""))
+		assert.False(t, policy.CanCall(provider, "data.tools", "query"))
+		assert.False(t, policy.CanCall(stranger, "data.tools", "query"))
+		assert.False(t, policy.CanCall(Caller{}, "data.tools", "query"))
+	})
+
+	t.Run("discover", func(t *testing.T) {
+		assert.True(t, policy.CanDiscover(agent, "data.tools@2.0.0"))
+		assert.True(t, policy.CanDiscover(agent, "billing.invoices"))
+		assert.False(t, policy.CanDiscover(agent, "billing.payments"))
+		assert.True(t, policy.CanDiscover(provider, "data.tools"))
+		assert.False(t, policy.CanDiscover(stranger, "data.tools"))
+	})
+
+	t.Run("register", func(t *testing.T) {
+		assert.True(t, policy.CanRegister(provider, "data.tools"))
+		assert.True(t, policy.CanRegister(provider, "data.tools@1.3.0"))
+		assert.False(t, policy.CanRegister(provider, "billing.invoices"))
+		assert.False(t, policy.CanRegister(provider, "billing@data.tools"), "non-version suffixes are literal names")
+		assert.False(t, policy.CanRegister(agent, "data.tools"))
+	})
+
+	t.Run("admin", func(t *testing.T) {
+		assert.True(t, policy.CanAdmin(operator, "data.tools"))
+		assert.True(t, policy.CanAdmin(operator, "data.tools@1.3.0"))
+		assert.False(t, policy.CanAdmin(operator, "billing.invoices"))
+		assert.False(t, policy.CanAdmin(provider, "data.tools"))
+		assert.False(t, policy.CanRegister(operator, "data.tools"))
+		assert.False(t, policy.CanDiscover(operator, "data.tools"))
+	})
+}
@@ -0,0 +1,218 @@
+// Package registry rate-limits routed tool calls per caller.
+//
+// Quotas are token buckets kept in the registry's Redis so every node draws
+// from the same bucket. One bucket exists per (caller, toolset) pair; it holds
+// at most Burst calls and refills at Rate calls per second. Only new calls
+// take a token: exact retries attach to their existing admission for free.
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gopkg.in/yaml.v3"
+)
+
+type (
+	// Quotas maps callers and toolsets to call rate limits. Calls matched by
+	// no rule are not limited.
+	Quotas struct {
+		// Rules lists the limits. The first rule matching both the caller and
+		// the toolset applies, so list specific rules before general ones.
+		Rules []QuotaRule `yaml:"rules" json:"rules"`
+	}
+
+	// QuotaRule gives every matching (caller, toolset) pair its own token
+	// bucket. Patterns use path.Match syntax.
+	QuotaRule struct {
+		// Callers matches Caller.Subject. Without authentication every caller
+		// has the empty subject, which "*" matches, so all callers of a
+		// toolset share one bucket.
+		Callers []string `yaml:"callers" json:"callers"`
+		// Toolsets matches toolset names. Side-by-side versions share the
+		// bucket of their base name.
+		Toolsets []string `yaml:"toolsets" json:"toolsets"`
+		// Rate is the sustained number of calls per second.
+		Rate float64 `yaml:"rate" json:"rate"`
+		// Burst is the number of calls a full bucket admits at once.
+		Burst int `yaml:"burst" json:"burst"`
+	}
+
+	// quotaLimiter takes tokens from shared quota buckets.
+	quotaLimiter interface {
+		Take(ctx context.Context, caller, toolset string, rule QuotaRule) (quotaDecision, error)
+	}
+
+	// quotaDecision is the outcome of taking one token from a bucket.
+	quotaDecision struct {
+		// allowed reports whether the bucket held a token.
+		allowed bool
+		// remaining is the number of tokens left in the bucket.
+		remaining float64
+		// retryAfter is how long until the bucket holds a token again when
+		// the call was not allowed.
+		retryAfter time.Duration
+	}
+
+	// quotaStore keeps token buckets in Redis.
+	quotaStore struct {
+		redis  *redis.Client
+		prefix string
+	}
+)
+
+// takeQuotaTokenScript refills one bucket from Redis time and takes a token
+// if one is available. Idle buckets expire once they would be full again.
+var takeQuotaTokenScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = redis.call("TIME")
+local now_millis = (tonumber(now[1]) * 1000) + math.floor(tonumber(now[2]) / 1000)
+local tokens = tonumber(redis.call("HGET", KEYS[1], "tokens"))
+local updated = tonumber(redis.call("HGET", KEYS[1], "updated_unix_milli"))
+if not tokens or not updated then
+  tokens = burst
+  updated = now_millis
+end
+if now_millis > updated then
+  tokens = math.min(burst, tokens + ((now_millis - updated) * rate / 1000))
+end
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  retry_after = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated_unix_milli", now_millis)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, retry_after, tostring(tokens)}
+`)
+
+// LoadQuotas reads a YAML or JSON quota file.
+func LoadQuotas(file string) (*Quotas, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read quotas: %w", err)
+	}
+	return ParseQuotas(data)
+}
+
+// ParseQuotas decodes and validates a YAML or JSON quota document.
+func ParseQuotas(data []byte) (*Quotas, error) {
+	var q Quotas
+	if err := yaml.Unmarshal(data, &q); err != nil {
+		return nil, fmt.Errorf("decode quotas: %w", err)
+	}
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+// Validate rejects rules without callers or toolsets, malformed patterns, and
+// non-positive limits.
+func (q *Quotas) Validate() error {
+	var errs []error
+	for i, rule := range q.Rules {
+		if len(rule.Callers) == 0 {
+			errs = append(errs, fmt.Errorf("rule %d: callers is required", i))
+		}
+		if len(rule.Toolsets) == 0 {
+			errs = append(errs, fmt.Errorf("rule %d: toolsets is required", i))
+		}
+		for _, patterns := range [][]string{rule.Callers, rule.Toolsets} {
+			for _, pattern := range patterns {
+				if _, err := path.Match(pattern, ""); err != nil {
+					errs = append(errs, fmt.Errorf("rule %d: pattern %q: %w", i, pattern, err))
+				}
+			}
+		}
+		if rule.Rate <= 0 || math.IsInf(rule.Rate, 0) || math.IsNaN(rule.Rate) {
+			errs = append(errs, fmt.Errorf("rule %d: rate must be positive", i))
+		}
+		if rule.Burst < 1 {
+			errs = append(errs, fmt.Errorf("rule %d: burst must be at least 1", i))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Limit returns the rule limiting calls from caller to the toolset named by
+// ref, if any.
+func (q *Quotas) Limit(caller Caller, ref string) (QuotaRule, bool) {
+	toolset := policyToolset(ref)
+	for _, rule := range q.Rules {
+		if quotaMatchAny(rule.Callers, caller.Subject) && quotaMatchAny(rule.Toolsets, toolset) {
+			return rule, true
+		}
+	}
+	return QuotaRule{}, false
+}
+
+// quotaMatchAny reports whether name matches one of patterns.
+func quotaMatchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if policyMatch(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// newQuotaStore returns the Redis quota store of one registry cluster.
+func newQuotaStore(redisClient *redis.Client, registryName string) *quotaStore {
+	return &quotaStore{
+		redis:  redisClient,
+		prefix: "registry:" + registryName + ":quota:",
+	}
+}
+
+// Take takes one token from the bucket of caller and toolset.
+func (s *quotaStore) Take(ctx context.Context, caller, toolset string, rule QuotaRule) (quotaDecision, error) {
+	value, err := takeQuotaTokenScript.Run(
+		ctx,
+		s.redis,
+		[]string{s.bucketKey(caller, toolset)},
+		strconv.FormatFloat(rule.Rate, 'g', -1, 64),
+		rule.Burst,
+	).Slice()
+	if err != nil {
+		return quotaDecision{}, fmt.Errorf("take quota token: %w", err)
+	}
+	if len(value) != 3 {
+		return quotaDecision{}, fmt.Errorf("take quota token returned %d values", len(value))
+	}
+	allowed, err := redisResultInt64(value[0])
+	if err != nil {
+		return quotaDecision{}, err
+	}
+	retryAfter, err := redisResultInt64(value[1])
+	if err != nil {
+		return quotaDecision{}, err
+	}
+	raw, _ := value[2].(string)
+	remaining, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return quotaDecision{}, fmt.Errorf("take quota token returned remaining %q: %w", raw, err)
+	}
+	return quotaDecision{
+		allowed:    allowed == 1,
+		remaining:  remaining,
+		retryAfter: time.Duration(retryAfter) * time.Millisecond,
+	}, nil
+}
+
+// bucketKey returns the Redis key of one bucket. The toolset length prefix
+// keeps keys unambiguous for caller subjects containing separators.
+func (s *quotaStore) bucketKey(caller, toolset string) string {
+	return s.prefix + strconv.Itoa(len(toolset)) + ":" + toolset + ":" + caller
+}
@@ -0,0 +1,47 @@
+//go:build integration
+
+package registry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaStoreSharesBucketsAcrossNodes(t *testing.T) {
+	ctx := context.Background()
+	rdb := getRedis(t)
+	nodeA := newQuotaStore(rdb, "quota-test")
+	nodeB := newQuotaStore(rdb, "quota-test")
+	rule := QuotaRule{Rate: 1, Burst: 2}
+
+	decision, err := nodeA.Take(ctx, "svc-batch", "data.tools", rule)
+	require.NoError(t, err)
+	assert.True(t, decision.allowed)
+	decision, err = nodeB.Take(ctx, "svc-batch", "data.tools", rule)
+	require.NoError(t, err)
+	assert.True(t, decision.allowed)
+	assert.Less(t, decision.remaining, 1.0)
+
+	decision, err = nodeA.Take(ctx, "svc-batch", "data.tools", rule)
+	require.NoError(t, err)
+	assert.False(t, decision.allowed)
+	assert.Greater(t, decision.retryAfter, time.Duration(0))
+	assert.LessOrEqual(t, decision.retryAfter, time.Second)
+	refill := decision.retryAfter
+
+	decision, err = nodeB.Take(ctx, "svc-other", "data.tools", rule)
+	require.NoError(t, err)
+	assert.True(t, decision.allowed, "callers have separate buckets")
+	decision, err = nodeB.Take(ctx, "svc-batch", "billing.invoices", rule)
+	require.NoError(t, err)
+	assert.True(t, decision.allowed, "toolsets have separate buckets")
+
+	time.Sleep(refill + 100*time.Millisecond)
+	decision, err = nodeB.Take(ctx, "svc-batch", "data.tools", rule)
+	require.NoError(t, err)
+	assert.True(t, decision.allowed, "bucket refills at rate")
+}
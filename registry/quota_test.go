@@ -0,0 +1,63 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testQuotasYAML = `
+rules:
+  - callers: ["svc-batch"]
+    toolsets: ["data.*"]
+    rate: 0.5
+    burst: 2
+  - callers: ["*"]
+    toolsets: ["*"]
+    rate: 10
+    burst: 20
+`
+
+func TestParseQuotas(t *testing.T) {
+	t.Parallel()
+
+	quotas, err := ParseQuotas([]byte(testQuotasYAML))
+	require.NoError(t, err)
+	require.Len(t, quotas.Rules, 2)
+	assert.Equal(t, QuotaRule{Callers: []string{"svc-batch"}, Toolsets: []string{"data.*"}, Rate: 0.5, Burst: 2}, quotas.Rules[0])
+
+	for name, doc := range map[string]string{
+		"missing callers":  `rules: [{toolsets: ["*"], rate: 1, burst: 1}]`,
+		"missing toolsets": `rules: [{callers: ["*"], rate: 1, burst: 1}]`,
+		"bad pattern":      `rules: [{callers: ["["], toolsets: ["*"], rate: 1, burst: 1}]`,
+		"zero rate":        `rules: [{callers: ["*"], toolsets: ["*"], burst: 1}]`,
+		"zero burst":       `rules: [{callers: ["*"], toolsets: ["*"], rate: 1}]`,
+		"malformed":        `rules: {`,
+	} {
+		_, err := ParseQuotas([]byte(doc))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestQuotasLimit(t *testing.T) {
+	t.Parallel()
+
+	quotas, err := ParseQuotas([]byte(testQuotasYAML))
+	require.NoError(t, err)
+
+	rule, ok := quotas.Limit(Caller{Subject: "svc-batch"}, "data.tools@^1.2")
+	require.True(t, ok)
+	assert.Equal(t, 2, rule.Burst, "specific rule wins and versions share the base name")
+
+	rule, ok = quotas.Limit(Caller{Subject: "svc-batch"}, "billing.invoices")
+	require.True(t, ok)
+	assert.Equal(t, 20, rule.Burst)
+
+	rule, ok = quotas.Limit(Caller{}, "data.tools")
+	require.True(t, ok, "anonymous callers match *")
+	assert.Equal(t, 20, rule.Burst)
+
+	_, ok = (&Quotas{}).Limit(Caller{Subject: "svc-batch"}, "data.tools")
+	assert.False(t, ok)
+}
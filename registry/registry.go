@@ -7,7 +7,7 @@
 //   - Toolset catalog (catalog.go) — Pulse-backed metadata persistence
 //   - Health tracking (health_tracker.go) — provider liveness detection
 //   - Stream management (stream_manager.go) — Pulse stream handling
-//   - Generated code (gen/) — Goa-generated types and gRPC transport
+//   - Generated code (gen/) — Goa-generated types and gRPC and HTTP transports
 //   - Design (design/) — Goa DSL service definition
 //
 // # Multi-Node Clustering
@@ -41,6 +41,7 @@ import (
 
 	"github.com/redis/go-redis/v9"
 	clientspulse "goa.design/goa-ai/features/stream/pulse/clients/pulse"
+	"goa.design/goa-ai/registry/audit"
 	registrypb "goa.design/goa-ai/registry/gen/grpc/registry/pb"
 	grpcserver "goa.design/goa-ai/registry/gen/grpc/registry/server"
 	genregistry "goa.design/goa-ai/registry/gen/registry"
@@ -81,6 +82,10 @@ type (
 		// Logger receives health tracker logs (pings, transitions, failures).
 		// When nil, health tracking logs are suppressed.
 		Logger telemetry.Logger
+		// Metrics records registry metrics such as tool call admission
+		// outcomes (see telemetry.MetricCatalog). When nil, metrics are
+		// discarded.
+		Metrics telemetry.Metrics
 		// PingInterval is the interval between health check pings.
 		// Defaults to 10 seconds if not provided.
 		PingInterval time.Duration
@@ -99,6 +104,23 @@ type (
 		// provider instance without renewal. Provider Serve derives its renewal
 		// schedule from this duration; the default is two minutes.
 		ProviderLeaseDuration time.Duration
+		// Auth enables caller authentication (mTLS client certificates and/or
+		// bearer JWTs) and per-caller authorization of every registry method.
+		// When nil, any peer that can reach the registry may register
+		// providers and invoke any toolset. Pair it with transport credentials
+		// passed to Run, for example grpc.Creds(credentials.NewTLS(cfg)) with
+		// cfg from ServerTLSConfig.
+		Auth *AuthConfig
+		// Quotas rate-limits new tool calls with token buckets shared by all
+		// nodes through Redis. Buckets are keyed by caller subject (from Auth)
+		// and toolset. When nil, calls are not rate-limited.
+		Quotas *Quotas
+		// Audit receives one event per tool call, retry, call settlement,
+		// and provider Register, Unregister, or DrainProvider request, with
+		// the caller subject, trace IDs, and tool arguments redacted by the
+		// tool payload schemas (see audit.Redact). When nil, no audit
+		// events are recorded.
+		Audit audit.Sink
 	}
 )
 
@@ -176,6 +198,8 @@ func New(ctx context.Context, cfg Config) (*Registry, error) {
 	if cfg.Logger != nil {
 		healthOpts = append(healthOpts, WithHealthLogger(cfg.Logger))
 	}
+	healthHistory := newHealthHistoryStore(cfg.Redis, name)
+	healthOpts = append(healthOpts, withHealthHistory(healthHistory))
 
 	clock := newRedisTimeSource(cfg.Redis)
 
@@ -204,7 +228,15 @@ func New(ctx context.Context, cfg Config) (*Registry, error) {
 		StreamManager:         streamManager,
 		HealthTracker:         healthTracker,
 		CallAdmissions:        callAdmissions,
+		CallInspector:         callAdmissions,
+		HealthHistory:         healthHistory,
 		PulseClient:           pulseClient,
+		Metrics:               cfg.Metrics,
+		Auth:                  cfg.Auth,
+		Quotas:                cfg.Quotas,
+		QuotaLimiter:          newQuotaStore(cfg.Redis, name),
+		Audit:                 cfg.Audit,
+		Logger:                cfg.Logger,
 		ExecutionTimeout:      cfg.ExecutionTimeout,
 		ResultStreamTTL:       cfg.ResultStreamTTL,
 		ProviderLeaseDuration: cfg.ProviderLeaseDuration,
@@ -275,7 +307,8 @@ func (r *Registry) Close(ctx context.Context) error {
 // a termination signal is received. It handles graceful shutdown automatically.
 //
 // The addr parameter specifies the network address to listen on (e.g., ":9090").
-// Optional gRPC server options can be passed to customize the server.
+// Optional gRPC server options can be passed to customize the server; pass
+// TLS transport credentials here when Config.Auth authenticates callers.
 //
 // Example:
 //
@@ -321,8 +354,8 @@ func (r *Registry) Run(ctx context.Context, addr string, opts ...grpc.ServerOpti
 	// Graceful shutdown: stop accepting new connections and drain existing ones.
 	grpcServer.GracefulStop()
 
-	// Close registry resources.
-	if err := r.Close(ctx); err != nil {
+	// Close registry resources. ctx may already be canceled.
+	if err := r.Close(context.WithoutCancel(ctx)); err != nil {
 		return fmt.Errorf("close registry: %w", err)
 	}
 
@@ -0,0 +1,386 @@
+// Package registry classifies tool schema changes between registrations.
+//
+// Re-registration diffs the compiled payload and result schemas of the new
+// admission against the registration it replaces or extends. Payload schemas
+// describe what agents send, so narrowing them breaks callers; result schemas
+// describe what agents receive, so widening them does. The classification is
+// conservative: it reports only changes it can prove from types, enums,
+// required properties, and declared properties, and ignores composition
+// keywords and numeric or string bounds.
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+)
+
+// Schema change kinds reported in SchemaChange.Kind.
+const (
+	schemaChangeToolAdded       = "tool_added"
+	schemaChangeToolRemoved     = "tool_removed"
+	schemaChangePropertyAdded   = "property_added"
+	schemaChangePropertyRemoved = "property_removed"
+	schemaChangeRequiredAdded   = "required_added"
+	schemaChangeRequiredRemoved = "required_removed"
+	schemaChangeTypeNarrowed    = "type_narrowed"
+	schemaChangeTypeWidened     = "type_widened"
+	schemaChangeEnumNarrowed    = "enum_narrowed"
+	schemaChangeEnumWidened     = "enum_widened"
+)
+
+// maxSchemaDiffDepth bounds recursion through nested and recursive schemas.
+const maxSchemaDiffDepth = 32
+
+type (
+	// schemaDiff accumulates the classified changes of one tool schema.
+	schemaDiff struct {
+		tool    string
+		changes []*genregistry.SchemaChange
+		visited map[[2]*jsonschema.Schema]struct{}
+	}
+
+	// schemaDirection tells a diff whether agents send or receive the
+	// documents a schema describes.
+	schemaDirection int
+)
+
+const (
+	// schemaInput describes tool payloads: agents send them.
+	schemaInput schemaDirection = iota
+	// schemaOutput describes tool results: agents receive them.
+	schemaOutput
+)
+
+// DiffToolSchemas classifies the payload and result schema changes from base
+// to next, ordered by tool and path. Both tool lists must already have passed
+// ValidateToolSchemas.
+func (v *schemaValidator) DiffToolSchemas(base, next []*genregistry.ToolSchema) ([]*genregistry.SchemaChange, error) {
+	nextTools := make(map[string]*genregistry.ToolSchema, len(next))
+	for _, tool := range next {
+		nextTools[tool.Name] = tool
+	}
+	baseTools := make(map[string]struct{}, len(base))
+	changes := []*genregistry.SchemaChange{}
+	for _, baseTool := range base {
+		baseTools[baseTool.Name] = struct{}{}
+		nextTool, ok := nextTools[baseTool.Name]
+		if !ok {
+			changes = append(changes, &genregistry.SchemaChange{
+				Tool:     baseTool.Name,
+				Kind:     schemaChangeToolRemoved,
+				Path:     "",
+				Breaking: true,
+				Detail:   "tool removed",
+			})
+			continue
+		}
+		diff := &schemaDiff{tool: baseTool.Name, visited: make(map[[2]*jsonschema.Schema]struct{})}
+		if err := v.diffSchema(diff, "payload", schemaInput, baseTool.PayloadSchema, nextTool.PayloadSchema); err != nil {
+			return nil, err
+		}
+		if err := v.diffSchema(diff, "result", schemaOutput, baseTool.ResultSchema, nextTool.ResultSchema); err != nil {
+			return nil, err
+		}
+		changes = append(changes, diff.changes...)
+	}
+	for _, tool := range next {
+		if _, ok := baseTools[tool.Name]; ok {
+			continue
+		}
+		changes = append(changes, &genregistry.SchemaChange{
+			Tool:   tool.Name,
+			Kind:   schemaChangeToolAdded,
+			Path:   "",
+			Detail: "tool added",
+		})
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Tool != changes[j].Tool {
+			return changes[i].Tool < changes[j].Tool
+		}
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+// diffSchema compiles one base and next schema pair and records their
+// changes under path. Byte-identical schemas are skipped.
+func (v *schemaValidator) diffSchema(
+	diff *schemaDiff,
+	path string,
+	direction schemaDirection,
+	baseBytes, nextBytes []byte,
+) error {
+	if string(baseBytes) == string(nextBytes) {
+		return nil
+	}
+	base, err := v.compiledSchema(baseBytes)
+	if err != nil {
+		return fmt.Errorf("tool %q: base %s schema: %w", diff.tool, path, err)
+	}
+	next, err := v.compiledSchema(nextBytes)
+	if err != nil {
+		return fmt.Errorf("tool %q: %s schema: %w", diff.tool, path, err)
+	}
+	diff.compare(path, direction, base, next, 0)
+	return nil
+}
+
+// compare records the changes between two compiled schemas at path and
+// recurses into properties and array items both schemas declare.
+func (d *schemaDiff) compare(path string, direction schemaDirection, base, next *jsonschema.Schema, depth int) {
+	base, next = resolveSchemaRef(base), resolveSchemaRef(next)
+	if base == nil || next == nil || depth > maxSchemaDiffDepth {
+		return
+	}
+	pair := [2]*jsonschema.Schema{base, next}
+	if _, seen := d.visited[pair]; seen {
+		return
+	}
+	d.visited[pair] = struct{}{}
+
+	d.compareTypes(path, direction, base, next)
+	d.compareEnums(path, direction, base, next)
+	d.compareProperties(path, direction, base, next, depth)
+	if baseItems, nextItems := schemaItems(base), schemaItems(next); baseItems != nil && nextItems != nil {
+		d.compare(path+"[]", direction, baseItems, nextItems, depth+1)
+	}
+}
+
+// compareTypes records types a schema stopped or started accepting.
+func (d *schemaDiff) compareTypes(path string, direction schemaDirection, base, next *jsonschema.Schema) {
+	baseTypes, nextTypes := schemaTypes(base), schemaTypes(next)
+	if baseTypes == nil && nextTypes == nil {
+		return
+	}
+	var lost, gained []string
+	for _, typ := range schemaTypeNames(baseTypes) {
+		if !schemaTypeAccepted(nextTypes, typ) {
+			lost = append(lost, typ)
+		}
+	}
+	for _, typ := range schemaTypeNames(nextTypes) {
+		if !schemaTypeAccepted(baseTypes, typ) {
+			gained = append(gained, typ)
+		}
+	}
+	if len(lost) > 0 {
+		d.add(path, schemaChangeTypeNarrowed, direction == schemaInput,
+			fmt.Sprintf("no longer accepts %s", strings.Join(lost, ", ")))
+	}
+	if len(gained) > 0 {
+		d.add(path, schemaChangeTypeWidened, direction == schemaOutput,
+			fmt.Sprintf("now accepts %s", strings.Join(gained, ", ")))
+	}
+}
+
+// compareEnums records enumerated values a schema stopped or started
+// accepting. Introducing an enum narrows and dropping one widens.
+func (d *schemaDiff) compareEnums(path string, direction schemaDirection, base, next *jsonschema.Schema) {
+	switch {
+	case base.Enum == nil && next.Enum == nil:
+		return
+	case base.Enum == nil:
+		d.add(path, schemaChangeEnumNarrowed, direction == schemaInput,
+			fmt.Sprintf("restricted to %d enumerated values", len(next.Enum.Values)))
+		return
+	case next.Enum == nil:
+		d.add(path, schemaChangeEnumWidened, direction == schemaOutput, "enumeration removed")
+		return
+	}
+	baseValues, nextValues := enumValueSet(base.Enum), enumValueSet(next.Enum)
+	removed := missingEnumValues(baseValues, nextValues)
+	added := missingEnumValues(nextValues, baseValues)
+	if len(removed) > 0 {
+		d.add(path, schemaChangeEnumNarrowed, direction == schemaInput,
+			fmt.Sprintf("values %s removed", strings.Join(removed, ", ")))
+	}
+	if len(added) > 0 {
+		d.add(path, schemaChangeEnumWidened, direction == schemaOutput,
+			fmt.Sprintf("values %s added", strings.Join(added, ", ")))
+	}
+}
+
+// compareProperties records declared and required property changes and
+// recurses into properties both schemas declare.
+func (d *schemaDiff) compareProperties(
+	path string,
+	direction schemaDirection,
+	base, next *jsonschema.Schema,
+	depth int,
+) {
+	baseRequired, nextRequired := stringSet(base.Required), stringSet(next.Required)
+	for _, name := range sortedKeys(nextRequired) {
+		if _, ok := baseRequired[name]; ok {
+			continue
+		}
+		if direction == schemaInput {
+			d.add(path, schemaChangeRequiredAdded, true, fmt.Sprintf("required property %q added", name))
+		} else {
+			d.add(path, schemaChangeRequiredAdded, false, fmt.Sprintf("property %q is now always present", name))
+		}
+	}
+	for _, name := range sortedKeys(baseRequired) {
+		if _, ok := nextRequired[name]; ok {
+			continue
+		}
+		if _, declared := next.Properties[name]; !declared && direction == schemaOutput {
+			// Reported once below as a removed property.
+			continue
+		}
+		if direction == schemaInput {
+			d.add(path, schemaChangeRequiredRemoved, false, fmt.Sprintf("property %q is now optional", name))
+		} else {
+			d.add(path, schemaChangeRequiredRemoved, true, fmt.Sprintf("property %q may now be absent", name))
+		}
+	}
+
+	for _, name := range sortedKeys(base.Properties) {
+		nextProp, ok := next.Properties[name]
+		if !ok {
+			_, breaking := baseRequired[name]
+			if direction == schemaInput {
+				breaking = closedObject(next)
+			}
+			d.add(joinSchemaPath(path, name), schemaChangePropertyRemoved, breaking,
+				fmt.Sprintf("property %q removed", name))
+			continue
+		}
+		d.compare(joinSchemaPath(path, name), direction, base.Properties[name], nextProp, depth+1)
+	}
+	for _, name := range sortedKeys(next.Properties) {
+		if _, ok := base.Properties[name]; ok {
+			continue
+		}
+		if _, required := nextRequired[name]; required {
+			// Already reported as a required addition.
+			continue
+		}
+		d.add(joinSchemaPath(path, name), schemaChangePropertyAdded, false,
+			fmt.Sprintf("property %q added", name))
+	}
+}
+
+// add appends one classified change.
+func (d *schemaDiff) add(path, kind string, breaking bool, detail string) {
+	d.changes = append(d.changes, &genregistry.SchemaChange{
+		Tool:     d.tool,
+		Kind:     kind,
+		Path:     path,
+		Breaking: breaking,
+		Detail:   detail,
+	})
+}
+
+// resolveSchemaRef follows $ref chains to the schema that declares the
+// constraints.
+func resolveSchemaRef(s *jsonschema.Schema) *jsonschema.Schema {
+	for i := 0; s != nil && s.Ref != nil && i < maxSchemaDiffDepth; i++ {
+		s = s.Ref
+	}
+	if s != nil && s.Bool != nil {
+		return nil
+	}
+	return s
+}
+
+// schemaItems returns the schema of every array item, or nil when the schema
+// does not constrain items uniformly.
+func schemaItems(s *jsonschema.Schema) *jsonschema.Schema {
+	if s.Items2020 != nil {
+		return s.Items2020
+	}
+	if items, ok := s.Items.(*jsonschema.Schema); ok {
+		return items
+	}
+	return nil
+}
+
+// schemaTypes returns the declared types of s, or nil when s accepts every
+// type.
+func schemaTypes(s *jsonschema.Schema) *jsonschema.Types {
+	if s.Types == nil || s.Types.IsEmpty() {
+		return nil
+	}
+	return s.Types
+}
+
+// schemaTypeNames lists declared types; nil means every type, which has no
+// finite list and therefore loses or gains nothing by name.
+func schemaTypeNames(types *jsonschema.Types) []string {
+	if types == nil {
+		return nil
+	}
+	return types.ToStrings()
+}
+
+// schemaTypeAccepted reports whether types admits values of typ. Integers
+// are numbers, so a number type accepts them.
+func schemaTypeAccepted(types *jsonschema.Types, typ string) bool {
+	if types == nil {
+		return true
+	}
+	for _, accepted := range types.ToStrings() {
+		if accepted == typ || (accepted == "number" && typ == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// closedObject reports whether s rejects properties it does not declare.
+func closedObject(s *jsonschema.Schema) bool {
+	allowed, ok := s.AdditionalProperties.(bool)
+	return ok && !allowed
+}
+
+// enumValueSet keys enumerated values by their canonical JSON encoding.
+func enumValueSet(enum *jsonschema.Enum) map[string]struct{} {
+	values := make(map[string]struct{}, len(enum.Values))
+	for _, value := range enum.Values {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			encoded = []byte(fmt.Sprint(value))
+		}
+		values[string(encoded)] = struct{}{}
+	}
+	return values
+}
+
+// missingEnumValues returns the sorted values of from absent in to.
+func missingEnumValues(from, to map[string]struct{}) []string {
+	var missing []string
+	for _, value := range sortedKeys(from) {
+		if _, ok := to[value]; !ok {
+			missing = append(missing, value)
+		}
+	}
+	return missing
+}
+
+// joinSchemaPath appends one property name to a schema path.
+func joinSchemaPath(path, name string) string {
+	return path + "." + name
+}
+
+func stringSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,147 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+)
+
+func TestDiffToolSchemasClassifiesPayloadAndResultChanges(t *testing.T) {
+	t.Parallel()
+
+	base := []*genregistry.ToolSchema{
+		{
+			Name: "data.series",
+			PayloadSchema: []byte(`{
+				"type": "object",
+				"properties": {
+					"metric": {"type": "string"},
+					"unit": {"type": "string", "enum": ["c", "f", "k"]},
+					"limit": {"type": "number"},
+					"window": {"$ref": "#/$defs/Window"}
+				},
+				"required": ["metric"],
+				"$defs": {"Window": {"type": "object", "properties": {"from": {"type": "string"}}}}
+			}`),
+			ResultSchema: []byte(`{
+				"type": "object",
+				"properties": {
+					"points": {"type": "array", "items": {"type": "object", "properties": {"value": {"type": "number"}}}},
+					"total": {"type": "integer"}
+				},
+				"required": ["points", "total"]
+			}`),
+		},
+		{
+			Name:          "data.legacy",
+			PayloadSchema: []byte(`{"type":"object"}`),
+			ResultSchema:  []byte(`{"type":"object"}`),
+		},
+	}
+	next := []*genregistry.ToolSchema{
+		{
+			Name: "data.series",
+			PayloadSchema: []byte(`{
+				"type": "object",
+				"properties": {
+					"metric": {"type": "string"},
+					"unit": {"type": "string", "enum": ["c", "f"]},
+					"limit": {"type": "integer"},
+					"window": {"$ref": "#/$defs/Window"},
+					"step": {"type": "string"},
+					"tenant": {"type": "string"}
+				},
+				"required": ["tenant"],
+				"$defs": {"Window": {"type": "object", "properties": {"from": {"type": ["string", "null"]}}}}
+			}`),
+			ResultSchema: []byte(`{
+				"type": "object",
+				"properties": {
+					"points": {"type": "array", "items": {"type": "object", "properties": {"value": {"type": ["number", "string"]}}}}
+				},
+				"required": ["points"]
+			}`),
+		},
+		{
+			Name:          "data.summary",
+			PayloadSchema: []byte(`{"type":"object"}`),
+			ResultSchema:  []byte(`{"type":"object"}`),
+		},
+	}
+
+	changes, err := newSchemaValidator().DiffToolSchemas(base, next)
+	require.NoError(t, err)
+
+	type change struct {
+		tool, kind, path string
+		breaking         bool
+	}
+	got := make([]change, len(changes))
+	for i, c := range changes {
+		got[i] = change{c.Tool, c.Kind, c.Path, c.Breaking}
+	}
+	assert.Equal(t, []change{
+		{"data.legacy", "tool_removed", "", true},
+		{"data.series", "required_added", "payload", true},
+		{"data.series", "required_removed", "payload", false},
+		{"data.series", "type_narrowed", "payload.limit", true},
+		{"data.series", "property_added", "payload.step", false},
+		{"data.series", "enum_narrowed", "payload.unit", true},
+		{"data.series", "type_widened", "payload.window.from", false},
+		{"data.series", "type_widened", "result.points[].value", true},
+		{"data.series", "property_removed", "result.total", true},
+		{"data.summary", "tool_added", "", false},
+	}, got)
+}
+
+func TestDiffToolSchemasPropertyRemovalBreaksOnlyClosedPayloads(t *testing.T) {
+	t.Parallel()
+
+	tool := func(payload string) []*genregistry.ToolSchema {
+		return []*genregistry.ToolSchema{{
+			Name:          "data.get",
+			PayloadSchema: []byte(payload),
+			ResultSchema:  []byte(`{"type":"object"}`),
+		}}
+	}
+	validator := newSchemaValidator()
+
+	changes, err := validator.DiffToolSchemas(
+		tool(`{"type":"object","properties":{"id":{"type":"string"},"trace":{"type":"boolean"}}}`),
+		tool(`{"type":"object","properties":{"id":{"type":"string"}}}`),
+	)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "property_removed", changes[0].Kind)
+	assert.False(t, changes[0].Breaking, "open payloads still accept the removed property")
+
+	changes, err = validator.DiffToolSchemas(
+		tool(`{"type":"object","properties":{"id":{"type":"string"},"trace":{"type":"boolean"}},"additionalProperties":false}`),
+		tool(`{"type":"object","properties":{"id":{"type":"string"}},"additionalProperties":false}`),
+	)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.True(t, changes[0].Breaking, "closed payloads reject the removed property")
+
+	changes, err = validator.DiffToolSchemas(tool(`{"type":"object"}`), tool(`{"type":"object"}`))
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestBreakingVersionBump(t *testing.T) {
+	t.Parallel()
+
+	version := func(v string) *genregistry.SemVer {
+		semver := genregistry.SemVer(v)
+		return &semver
+	}
+	assert.True(t, breakingVersionBump(version("1.4.2"), version("2.0.0")))
+	assert.True(t, breakingVersionBump(version("0.3.0"), version("0.4.0")))
+	assert.True(t, breakingVersionBump(nil, version("1.0.0")))
+	assert.False(t, breakingVersionBump(version("1.4.2"), version("1.5.0")))
+	assert.False(t, breakingVersionBump(version("0.3.0"), version("0.3.1")))
+	assert.False(t, breakingVersionBump(version("2.0.0"), version("1.9.0")))
+	assert.False(t, breakingVersionBump(version("1.0.0"), nil))
+}
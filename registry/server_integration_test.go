@@ -13,7 +13,6 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	grpcclient "goa.design/goa-ai/registry/gen/grpc/registry/client"
 	registrypb "goa.design/goa-ai/registry/gen/grpc/registry/pb"
 	grpcserver "goa.design/goa-ai/registry/gen/grpc/registry/server"
 	genregistry "goa.design/goa-ai/registry/gen/registry"
@@ -638,24 +637,7 @@ func startServiceAndClients(
 	}
 	t.Cleanup(func() { _ = conn.Close() })
 
-	grpcCli := grpcclient.NewClient(conn)
-	client := genregistry.NewClient(
-		grpcCli.Register(),
-		grpcCli.ReleaseProvider(),
-		grpcCli.DrainProvider(),
-		grpcCli.Unregister(),
-		grpcCli.Pong(),
-		grpcCli.ListToolsets(),
-		grpcCli.GetToolset(),
-		grpcCli.Search(),
-		grpcCli.CallTool(),
-		grpcCli.RetryTool(),
-		grpcCli.CompleteToolCall(),
-		grpcCli.PublishToolOutputDelta(),
-		grpcCli.ReportToolCallOverload(),
-		grpcCli.ClaimToolCall(),
-	)
-	return client, registrypb.NewRegistryClient(conn)
+	return toolregistry.NewClient(conn), registrypb.NewRegistryClient(conn)
 }
 
 func grpcRegisterRequest(
@@ -12,10 +12,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	clientspulse "goa.design/goa-ai/features/stream/pulse/clients/pulse"
+	"goa.design/goa-ai/registry/audit"
 	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/agent/telemetry"
 	"goa.design/goa-ai/runtime/agent/tools"
 	"goa.design/goa-ai/runtime/toolregistry"
 	goa "goa.design/goa/v3/pkg"
@@ -28,7 +31,7 @@ type (
 	callAdmissionRepository interface {
 		Ensure(
 			ctx context.Context,
-			toolset, toolUseID, registrationToken, digest string,
+			toolset, tool, toolUseID, registrationToken, digest string,
 			executionTimeout, ttl time.Duration,
 			outcomeUnknownPayload []byte,
 		) (callAdmission, bool, error)
@@ -50,7 +53,7 @@ type (
 			toolset, toolUseID, callRegistrationToken, providerRegistrationToken,
 			providerLease, requestEventID, resultStreamID string,
 			payload []byte,
-		) error
+		) (string, error)
 		PublishLiveEvent(
 			ctx context.Context,
 			toolset, toolUseID, callRegistrationToken, providerRegistrationToken,
@@ -79,8 +82,17 @@ type (
 		streamManager  StreamManager
 		healthTracker  HealthTracker
 		callAdmissions callAdmissionRepository
+		authenticator  Authenticator
+		policy         *Policy
+		quotas         *Quotas
+		quotaLimiter   quotaLimiter
+		auditSink      audit.Sink
+		callInspector  callInspector
+		healthHistory  healthHistory
 
 		pulseClient           clientspulse.Client
+		metrics               telemetry.Metrics
+		logger                telemetry.Logger
 		executionTimeout      time.Duration
 		resultStreamTTL       time.Duration
 		providerLeaseDuration time.Duration
@@ -96,8 +108,30 @@ type (
 		HealthTracker HealthTracker
 		// CallAdmissions atomically coordinates call publication across replicas.
 		CallAdmissions callAdmissionRepository
+		// CallInspector reads admitted calls for the admin API. When nil, the
+		// call inspection methods report service_unavailable.
+		CallInspector callInspector
+		// HealthHistory lists recorded health transitions for the admin API.
+		// When nil, ListHealthTransitions reports service_unavailable.
+		HealthHistory healthHistory
 		// PulseClient creates/opens Pulse streams. Required for CallTool.
 		PulseClient clientspulse.Client
+		// Metrics records call admission outcomes. Defaults to no-op metrics.
+		Metrics telemetry.Metrics
+		// Auth enables caller authentication and authorization. When nil,
+		// every request is accepted.
+		Auth *AuthConfig
+		// Quotas rate-limits new tool calls per caller and toolset. When nil,
+		// calls are not limited.
+		Quotas *Quotas
+		// QuotaLimiter keeps the quota buckets shared by registry nodes.
+		// Required when Quotas is set.
+		QuotaLimiter quotaLimiter
+		// Audit records tool calls, settlements, and provider lifecycle
+		// requests. When nil, no audit events are recorded.
+		Audit audit.Sink
+		// Logger reports audit sink failures. Defaults to no-op logging.
+		Logger telemetry.Logger
 		// ResultStreamTTL selects the retention used to derive each call record's
 		// Redis-owned absolute expiration. When zero, it defaults to
 		// toolregistry.DefaultResultStreamTTL.
@@ -121,6 +155,9 @@ type (
 		payload           json.RawMessage
 		meta              *toolregistry.ToolCallMeta
 		admissionDigest   string
+		// writeAhead records the audit event of the request before the call
+		// is first published. Nil when the request is not audited.
+		writeAhead func(context.Context) error
 	}
 
 	// providerUnavailableError reports a valid tool call that cannot yet be
@@ -142,6 +179,10 @@ const (
 	// observe that an active toolset has regained a healthy provider without
 	// making every waiting call poll Redis aggressively during a handoff.
 	providerHealthRetryInterval = time.Second
+
+	// unknownToolset tags call admission metrics of rejected calls, whose
+	// requested toolset may name no registration.
+	unknownToolset = "unknown"
 )
 
 // Compile-time check that Service implements the generated interface.
@@ -206,13 +247,55 @@ func newService(opts serviceOptions) (*Service, error) {
 			toolregistry.MaxProviderLeaseDuration,
 		)
 	}
+	metrics := opts.Metrics
+	if metrics == nil {
+		metrics = telemetry.NewNoopMetrics()
+	}
+	logger := opts.Logger
+	if logger == nil {
+		logger = telemetry.NewNoopLogger()
+	}
+	var (
+		authenticator Authenticator
+		policy        *Policy
+	)
+	if opts.Auth != nil {
+		if opts.Auth.Authenticator == nil {
+			return nil, fmt.Errorf("auth authenticator is required")
+		}
+		if opts.Auth.Policy == nil {
+			return nil, fmt.Errorf("auth policy is required")
+		}
+		if err := opts.Auth.Policy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid auth policy: %w", err)
+		}
+		authenticator = opts.Auth.Authenticator
+		policy = opts.Auth.Policy
+	}
+	if opts.Quotas != nil {
+		if opts.QuotaLimiter == nil {
+			return nil, fmt.Errorf("quota limiter is required")
+		}
+		if err := opts.Quotas.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid quotas: %w", err)
+		}
+	}
 	return &Service{
 		catalog:               opts.catalog,
 		validator:             newSchemaValidator(),
 		streamManager:         opts.StreamManager,
 		healthTracker:         opts.HealthTracker,
 		callAdmissions:        opts.CallAdmissions,
+		authenticator:         authenticator,
+		policy:                policy,
+		quotas:                opts.Quotas,
+		quotaLimiter:          opts.QuotaLimiter,
+		auditSink:             opts.Audit,
+		callInspector:         opts.CallInspector,
+		healthHistory:         opts.HealthHistory,
 		pulseClient:           opts.PulseClient,
+		metrics:               metrics,
+		logger:                logger,
 		executionTimeout:      executionTimeout,
 		resultStreamTTL:       ttl,
 		providerLeaseDuration: providerLeaseDuration,
@@ -222,20 +305,41 @@ func newService(opts serviceOptions) (*Service, error) {
 // Register prepares routing and atomically creates, renews, or replaces the
 // catalog-owned admission and provider lease.
 func (s *Service) Register(ctx context.Context, p *genregistry.RegisterPayload) (*genregistry.RegisterResult, error) {
+	caller, err := s.authenticate(ctx)
+	var res *genregistry.RegisterResult
+	if err == nil {
+		res, err = s.register(ctx, caller, p)
+	}
+	if err = s.recordAudit(ctx, caller, audit.Event{
+		Type:       audit.EventRegister,
+		Toolset:    p.Name,
+		ProviderID: p.ProviderID,
+	}, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// register implements Register.
+func (s *Service) register(
+	ctx context.Context,
+	caller Caller,
+	p *genregistry.RegisterPayload,
+) (*genregistry.RegisterResult, error) {
+	if err := s.allowRegister(caller, p.Name); err != nil {
+		return nil, err
+	}
 	if err := toolregistry.ValidateWireProtocolVersion(p.WireProtocolVersion); err != nil {
 		return nil, genregistry.MakeValidationError(err)
 	}
+	if err := validateVersionedRegistration(p.Name, p.Version); err != nil {
+		return nil, genregistry.MakeValidationError(err)
+	}
 	// Validate tool schemas.
 	if err := s.validator.ValidateToolSchemas(p.Tools); err != nil {
 		return nil, genregistry.MakeValidationError(fmt.Errorf("invalid tool schema: %w", err))
 	}
 
-	// Ensure the Pulse request stream for this toolset exists.
-	_, _, err := s.streamManager.GetOrCreateStream(ctx, p.Name)
-	if err != nil {
-		return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("create stream for toolset: %w", err))
-	}
-
 	toolset := &genregistry.Toolset{
 		Name:        p.Name,
 		Description: p.Description,
@@ -243,6 +347,16 @@ func (s *Service) Register(ctx context.Context, p *genregistry.RegisterPayload)
 		Tags:        p.Tags,
 		Tools:       p.Tools,
 	}
+	compatibility, err := s.schemaCompatibility(ctx, toolset)
+	if err != nil {
+		return nil, err
+	}
+	toolset.Compatibility = compatibility
+
+	// Ensure the Pulse request stream for this toolset exists.
+	if _, _, err := s.streamManager.GetOrCreateStream(ctx, p.Name); err != nil {
+		return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("create stream for toolset: %w", err))
+	}
 
 	admission, err := s.catalog.Register(
 		ctx,
@@ -276,6 +390,9 @@ func (s *Service) Register(ctx context.Context, p *genregistry.RegisterPayload)
 // ReleaseProvider removes one exact provider lease after its Serve lifecycle
 // has stopped claiming and settled work.
 func (s *Service) ReleaseProvider(ctx context.Context, p *genregistry.ReleaseProviderPayload) error {
+	if err := s.authorizeRegister(ctx, p.Name); err != nil {
+		return err
+	}
 	if err := s.catalog.ReleaseProvider(
 		ctx,
 		p.Name,
@@ -298,6 +415,22 @@ func (s *Service) ReleaseProvider(ctx context.Context, p *genregistry.ReleasePro
 // DrainProvider marks one exact provider lease non-routable before its request
 // sink closes while preserving authority to settle already-claimed work.
 func (s *Service) DrainProvider(ctx context.Context, p *genregistry.DrainProviderPayload) error {
+	caller, err := s.authenticate(ctx)
+	if err == nil {
+		err = s.drainProvider(ctx, caller, p)
+	}
+	return s.recordAudit(ctx, caller, audit.Event{
+		Type:       audit.EventDrainProvider,
+		Toolset:    p.Name,
+		ProviderID: p.ProviderID,
+	}, err)
+}
+
+// drainProvider implements DrainProvider.
+func (s *Service) drainProvider(ctx context.Context, caller Caller, p *genregistry.DrainProviderPayload) error {
+	if err := s.allowRegister(caller, p.Name); err != nil {
+		return err
+	}
 	if err := s.catalog.DrainProvider(
 		ctx,
 		p.Name,
@@ -313,6 +446,18 @@ func (s *Service) DrainProvider(ctx context.Context, p *genregistry.DrainProvide
 
 // Unregister intentionally retires exactly the expected admission.
 func (s *Service) Unregister(ctx context.Context, p *genregistry.UnregisterPayload) error {
+	caller, err := s.authenticate(ctx)
+	if err == nil {
+		err = s.unregister(ctx, caller, p)
+	}
+	return s.recordAudit(ctx, caller, audit.Event{Type: audit.EventUnregister, Toolset: p.Name}, err)
+}
+
+// unregister implements Unregister.
+func (s *Service) unregister(ctx context.Context, caller Caller, p *genregistry.UnregisterPayload) error {
+	if err := s.allowRegister(caller, p.Name); err != nil {
+		return err
+	}
 	err := s.catalog.Retire(ctx, p.Name, p.ExpectedRegistrationToken)
 	if err != nil {
 		switch {
@@ -328,6 +473,9 @@ func (s *Service) Unregister(ctx context.Context, p *genregistry.UnregisterPaylo
 // Pong records generation-level consumer-group liveness only when the
 // responding provider has an unexpired application lease.
 func (s *Service) Pong(ctx context.Context, p *genregistry.PongPayload) error {
+	if err := s.authorizeRegister(ctx, p.Toolset); err != nil {
+		return err
+	}
 	return s.healthTracker.RecordPong(
 		ctx,
 		p.Toolset,
@@ -342,14 +490,20 @@ func (s *Service) Pong(ctx context.Context, p *genregistry.PongPayload) error {
 // an empty list when the catalog is empty.
 // **Validates: Requirements 6.1, 6.2, 6.3**
 func (s *Service) ListToolsets(ctx context.Context, p *genregistry.ListToolsetsPayload) (*genregistry.ListToolsetsResult, error) {
+	visible, err := s.discoveryFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
 	toolsets, err := s.catalog.ListToolsets(ctx, p.Tags)
 	if err != nil {
 		return nil, fmt.Errorf("list toolsets: %w", err)
 	}
 
-	infos := make([]*genregistry.ToolsetInfo, len(toolsets))
-	for i, ts := range toolsets {
-		infos[i] = toolsetToInfo(ts)
+	infos := make([]*genregistry.ToolsetInfo, 0, len(toolsets))
+	for _, ts := range toolsets {
+		if visible(ts.Name) {
+			infos = append(infos, toolsetToInfo(ts))
+		}
 	}
 
 	return &genregistry.ListToolsetsResult{
@@ -371,10 +525,21 @@ func toolsetToInfo(ts *genregistry.Toolset) *genregistry.ToolsetInfo {
 
 // GetToolset returns a specific toolset by name including all tool schemas.
 // Returns the complete toolset with tool schemas, or not-found error if
-// the toolset doesn't exist.
+// the toolset doesn't exist. Versioned references resolve like CallTool.
 // **Validates: Requirements 7.1, 7.2**
 func (s *Service) GetToolset(ctx context.Context, p *genregistry.GetToolsetPayload) (*genregistry.Toolset, error) {
-	toolset, err := s.catalog.GetToolset(ctx, p.Name)
+	visible, err := s.discoveryFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !visible(p.Name) {
+		return nil, genregistry.MakePermissionDenied(fmt.Errorf("caller may not discover toolset %q", p.Name))
+	}
+	name, err := s.resolveToolset(ctx, p.Name, "")
+	if err != nil {
+		return nil, err
+	}
+	toolset, err := s.catalog.GetToolset(ctx, name)
 	if err != nil {
 		if errors.Is(err, errToolsetNotFound) {
 			return nil, genregistry.MakeNotFound(fmt.Errorf("toolset %q not found", p.Name))
@@ -388,14 +553,20 @@ func (s *Service) GetToolset(ctx context.Context, p *genregistry.GetToolsetPaylo
 // Returns matching toolsets or an empty list when no matches are found.
 // **Validates: Requirements 8.1, 8.2**
 func (s *Service) Search(ctx context.Context, p *genregistry.SearchPayload) (*genregistry.SearchResult, error) {
+	visible, err := s.discoveryFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
 	toolsets, err := s.catalog.SearchToolsets(ctx, p.Query)
 	if err != nil {
 		return nil, fmt.Errorf("search toolsets: %w", err)
 	}
 
-	infos := make([]*genregistry.ToolsetInfo, len(toolsets))
-	for i, ts := range toolsets {
-		infos[i] = toolsetToInfo(ts)
+	infos := make([]*genregistry.ToolsetInfo, 0, len(toolsets))
+	for _, ts := range toolsets {
+		if visible(ts.Name) {
+			infos = append(infos, toolsetToInfo(ts))
+		}
 	}
 
 	return &genregistry.SearchResult{
@@ -407,18 +578,58 @@ func (s *Service) Search(ctx context.Context, p *genregistry.SearchPayload) (*ge
 // It validates the payload against the tool's payload schema, checks provider health,
 // creates the per-call result stream, and publishes the request to the toolset stream.
 func (s *Service) CallTool(ctx context.Context, p *genregistry.CallToolPayload) (*genregistry.CallToolResult, error) {
+	caller, err := s.authenticate(ctx)
+	var (
+		res     *genregistry.CallToolResult
+		toolset string
+	)
+	writeAhead := s.newToolCallAudit(caller, audit.EventCallTool, p.Toolset, p.Tool, p.PayloadJSON, p.Meta)
+	if err == nil {
+		res, toolset, err = s.callTool(ctx, caller, p, writeAhead.record)
+	}
+	if err != nil {
+		toolset = unknownToolset
+	}
+	if s.metrics != nil {
+		s.metrics.IncCounter(telemetry.MetricRegistryCallAdmission, 1,
+			telemetry.TagToolset, toolset,
+			telemetry.TagOutcome, callAdmissionOutcome(err),
+		)
+	}
+	if err = writeAhead.finish(ctx, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// callTool implements CallTool. It also returns the toolset registration the
+// call was admitted to.
+func (s *Service) callTool(
+	ctx context.Context,
+	caller Caller,
+	p *genregistry.CallToolPayload,
+	writeAhead func(context.Context) error,
+) (*genregistry.CallToolResult, string, error) {
+	if err := s.allowCall(caller, p.Toolset, p.Tool); err != nil {
+		return nil, "", err
+	}
 	if err := toolregistry.ValidateWireProtocolVersion(p.WireProtocolVersion); err != nil {
-		return nil, genregistry.MakeValidationError(err)
+		return nil, "", genregistry.MakeValidationError(err)
+	}
+	toolset, err := s.resolveToolset(ctx, p.Toolset, toolUseIDForCall(p.Meta))
+	if err != nil {
+		return nil, "", err
 	}
 	prepared, err := prepareToolCallIdentity(
-		p.Toolset,
+		toolset,
 		p.Tool,
 		p.PayloadJSON,
 		p.Meta,
 	)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
+	prepared.writeAhead = writeAhead
 	admission, err := s.callAdmissions.Attach(
 		ctx,
 		prepared.toolset,
@@ -426,26 +637,73 @@ func (s *Service) CallTool(ctx context.Context, p *genregistry.CallToolPayload)
 		prepared.admissionDigest,
 	)
 	if err == nil {
+		var res *genregistry.CallToolResult
 		if admission.terminal || admission.published {
-			return s.replayCallToolResult(ctx, prepared.toolUseID, prepared.resultStreamID, admission)
+			res, err = s.replayCallToolResult(ctx, prepared.toolUseID, prepared.resultStreamID, admission)
+		} else {
+			res, err = s.routeUnpublishedToolCall(ctx, prepared, admission.executionDeadline)
 		}
-		return s.routeUnpublishedToolCall(ctx, prepared, admission.executionDeadline)
+		return res, toolset, err
 	}
 	if !errors.Is(err, errCallAdmissionNotFound) {
-		return nil, callDecisionError(err)
+		return nil, "", callDecisionError(err)
 	}
+	if err := s.takeQuota(ctx, caller, toolset); err != nil {
+		return nil, "", err
+	}
+
+	res, err := s.routeUnpublishedToolCall(ctx, prepared, time.Now().Add(s.executionTimeout))
+	return res, toolset, err
+}
 
-	return s.routeUnpublishedToolCall(ctx, prepared, time.Now().Add(s.executionTimeout))
+// callAdmissionOutcome classifies a CallTool result for metrics: "admitted"
+// on success, the registry error name for service errors, and "error"
+// otherwise.
+func callAdmissionOutcome(err error) string {
+	if err == nil {
+		return "admitted"
+	}
+	var serviceErr *goa.ServiceError
+	if errors.As(err, &serviceErr) && serviceErr.Name != "" {
+		return serviceErr.Name
+	}
+	return telemetry.OutcomeError
 }
 
 // RetryTool republishes only the exact original admission after a provider
 // reports overload. A replacement admission is never eligible for this retry.
 func (s *Service) RetryTool(ctx context.Context, p *genregistry.RetryToolPayload) (*genregistry.CallToolResult, error) {
+	caller, err := s.authenticate(ctx)
+	var res *genregistry.CallToolResult
+	writeAhead := s.newToolCallAudit(caller, audit.EventRetryTool, p.Toolset, p.Tool, p.PayloadJSON, p.Meta)
+	if err == nil {
+		res, err = s.retryTool(ctx, caller, p, writeAhead.record)
+	}
+	if err = writeAhead.finish(ctx, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// retryTool implements RetryTool.
+func (s *Service) retryTool(
+	ctx context.Context,
+	caller Caller,
+	p *genregistry.RetryToolPayload,
+	writeAhead func(context.Context) error,
+) (*genregistry.CallToolResult, error) {
+	if err := s.allowCall(caller, p.Toolset, p.Tool); err != nil {
+		return nil, err
+	}
 	if err := toolregistry.ValidateWireProtocolVersion(p.WireProtocolVersion); err != nil {
 		return nil, genregistry.MakeValidationError(err)
 	}
+	toolset, err := s.resolveRetryToolset(ctx, p.Toolset, p.ExpectedRegistrationToken)
+	if err != nil {
+		return nil, err
+	}
 	prepared, err := prepareToolCallIdentity(
-		p.Toolset,
+		toolset,
 		p.Tool,
 		p.PayloadJSON,
 		p.Meta,
@@ -453,6 +711,7 @@ func (s *Service) RetryTool(ctx context.Context, p *genregistry.RetryToolPayload
 	if err != nil {
 		return nil, err
 	}
+	prepared.writeAhead = writeAhead
 	admission, err := s.callAdmissions.Attach(
 		ctx,
 		prepared.toolset,
@@ -472,7 +731,7 @@ func (s *Service) RetryTool(ctx context.Context, p *genregistry.RetryToolPayload
 	if admission.registrationToken != p.ExpectedRegistrationToken {
 		return nil, genregistry.MakeAdmissionConflict(fmt.Errorf(
 			"toolset %q retained registration %s does not match retry admission %s",
-			p.Toolset,
+			toolset,
 			admission.registrationToken,
 			p.ExpectedRegistrationToken,
 		))
@@ -482,14 +741,14 @@ func (s *Service) RetryTool(ctx context.Context, p *genregistry.RetryToolPayload
 		return s.replayCallToolResult(ctx, prepared.toolUseID, prepared.resultStreamID, admission)
 	}
 
-	registration, err := s.activeRegistration(ctx, p.Toolset)
+	registration, err := s.activeRegistration(ctx, toolset)
 	if err != nil {
 		return s.retryTerminalOrError(ctx, prepared, err)
 	}
 	if registration.RegistrationToken != admission.registrationToken {
 		return s.retryTerminalOrError(ctx, prepared, genregistry.MakeAdmissionConflict(fmt.Errorf(
 			"toolset %q active registration %s does not match retry admission %s",
-			p.Toolset,
+			toolset,
 			registration.RegistrationToken,
 			admission.registrationToken,
 		)))
@@ -522,20 +781,34 @@ func (s *Service) retryTerminalOrError(
 
 // CompleteToolCall atomically commits one exact provider terminal result.
 func (s *Service) CompleteToolCall(ctx context.Context, p *genregistry.CompleteToolCallPayload) error {
+	caller, err := s.authenticate(ctx)
+	var tool string
+	if err == nil {
+		tool, err = s.completeToolCall(ctx, caller, p)
+	}
+	return s.auditCompletion(ctx, caller, p, tool, err)
+}
+
+// completeToolCall implements CompleteToolCall. It returns the tool the call
+// record names.
+func (s *Service) completeToolCall(ctx context.Context, caller Caller, p *genregistry.CompleteToolCallPayload) (string, error) {
+	if err := s.allowRegister(caller, p.Toolset); err != nil {
+		return "", err
+	}
 	var result toolregistry.ToolResultMessage
 	if err := json.Unmarshal(p.ResultJSON, &result); err != nil {
-		return genregistry.MakeValidationError(fmt.Errorf("decode terminal result: %w", err))
+		return "", genregistry.MakeValidationError(fmt.Errorf("decode terminal result: %w", err))
 	}
 	if err := toolregistry.ValidateToolResultMessage(result); err != nil {
-		return genregistry.MakeValidationError(fmt.Errorf("validate terminal result: %w", err))
+		return "", genregistry.MakeValidationError(fmt.Errorf("validate terminal result: %w", err))
 	}
 	if result.Retry != nil {
-		return genregistry.MakeValidationError(fmt.Errorf("terminal result must not contain retry control"))
+		return "", genregistry.MakeValidationError(fmt.Errorf("terminal result must not contain retry control"))
 	}
 	if result.ToolUseID != p.ToolUseID || result.RegistrationToken != p.RegistrationToken {
-		return genregistry.MakeValidationError(fmt.Errorf("terminal result identity does not match payload"))
+		return "", genregistry.MakeValidationError(fmt.Errorf("terminal result identity does not match payload"))
 	}
-	if err := s.callAdmissions.Complete(
+	tool, err := s.callAdmissions.Complete(
 		ctx,
 		p.Toolset,
 		p.ToolUseID,
@@ -545,18 +818,22 @@ func (s *Service) CompleteToolCall(ctx context.Context, p *genregistry.CompleteT
 		p.RequestEventID,
 		toolregistry.ResultStreamID(p.ToolUseID),
 		p.ResultJSON,
-	); err != nil {
+	)
+	if err != nil {
 		if errors.Is(err, errCallTerminalConflict) {
-			return genregistry.MakeValidationError(err)
+			return "", genregistry.MakeValidationError(err)
 		}
-		return genregistry.MakeServiceUnavailable(fmt.Errorf("complete tool call: %w", err))
+		return "", genregistry.MakeServiceUnavailable(fmt.Errorf("complete tool call: %w", err))
 	}
-	return nil
+	return tool, nil
 }
 
 // PublishToolOutputDelta appends a provider output fragment only while its
 // claimed call remains live and nonterminal.
 func (s *Service) PublishToolOutputDelta(ctx context.Context, p *genregistry.PublishToolOutputDeltaPayload) error {
+	if err := s.authorizeRegister(ctx, p.Toolset); err != nil {
+		return err
+	}
 	if len(p.Delta) > toolregistry.MaxToolOutputDeltaBytes {
 		return genregistry.MakeValidationError(fmt.Errorf(
 			"output delta exceeds %d bytes",
@@ -590,6 +867,9 @@ func (s *Service) PublishToolOutputDelta(ctx context.Context, p *genregistry.Pub
 // ReportToolCallOverload appends canonical retry control only before dispatch.
 // Stale generations receive their canonical terminal result instead.
 func (s *Service) ReportToolCallOverload(ctx context.Context, p *genregistry.ProviderToolCallClaimPayload) error {
+	if err := s.authorizeRegister(ctx, p.Toolset); err != nil {
+		return err
+	}
 	overload := toolregistry.NewToolResultRetryMessage(
 		p.CallRegistrationToken,
 		p.ToolUseID,
@@ -632,6 +912,9 @@ func (s *Service) ClaimToolCall(
 	ctx context.Context,
 	p *genregistry.ProviderToolCallClaimPayload,
 ) (*genregistry.ClaimToolCallResult, error) {
+	if err := s.authorizeRegister(ctx, p.Toolset); err != nil {
+		return nil, err
+	}
 	stale := toolregistry.NewToolResultErrorMessage(
 		p.CallRegistrationToken,
 		p.ToolUseID,
@@ -662,6 +945,146 @@ func (s *Service) ClaimToolCall(
 	return &genregistry.ClaimToolCallResult{Disposition: string(disposition)}, nil
 }
 
+// authenticate identifies the caller of one request. It returns the zero
+// Caller when the registry does not enforce authentication.
+func (s *Service) authenticate(ctx context.Context) (Caller, error) {
+	if s.authenticator == nil {
+		return Caller{}, nil
+	}
+	caller, err := s.authenticator.Authenticate(ctx)
+	if err != nil {
+		return Caller{}, genregistry.MakeUnauthenticated(err)
+	}
+	return caller, nil
+}
+
+// authorizeCall identifies the caller and rejects callers that may not invoke
+// tool on toolset.
+func (s *Service) authorizeCall(ctx context.Context, toolset, tool string) error {
+	caller, err := s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+	return s.allowCall(caller, toolset, tool)
+}
+
+// allowCall rejects an authenticated caller that may not invoke tool on
+// toolset.
+func (s *Service) allowCall(caller Caller, toolset, tool string) error {
+	if s.policy == nil || s.policy.CanCall(caller, toolset, tool) {
+		return nil
+	}
+	return genregistry.MakePermissionDenied(fmt.Errorf(
+		"caller %q may not call tool %q of toolset %q",
+		caller.Subject,
+		tool,
+		toolset,
+	))
+}
+
+// authorizeRegister rejects callers that may not act as a provider of
+// toolset.
+func (s *Service) authorizeRegister(ctx context.Context, toolset string) error {
+	caller, err := s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+	return s.allowRegister(caller, toolset)
+}
+
+// allowRegister rejects an authenticated caller that may not act as a
+// provider of toolset.
+func (s *Service) allowRegister(caller Caller, toolset string) error {
+	if s.policy == nil || s.policy.CanRegister(caller, toolset) {
+		return nil
+	}
+	return genregistry.MakePermissionDenied(fmt.Errorf(
+		"caller %q may not register toolset %q",
+		caller.Subject,
+		toolset,
+	))
+}
+
+// authorizeAdmin authenticates the caller and, when a policy is configured,
+// requires admin rights on toolset.
+func (s *Service) authorizeAdmin(ctx context.Context, toolset string) error {
+	caller, err := s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+	return s.allowAdmin(caller, toolset)
+}
+
+// allowAdmin rejects an authenticated caller without admin rights on toolset
+// when a policy is configured.
+func (s *Service) allowAdmin(caller Caller, toolset string) error {
+	if s.policy == nil || s.policy.CanAdmin(caller, toolset) {
+		return nil
+	}
+	return genregistry.MakePermissionDenied(fmt.Errorf(
+		"caller %q may not administer toolset %q",
+		caller.Subject,
+		toolset,
+	))
+}
+
+// takeQuota charges one new call from caller to the quota bucket of toolset
+// and rejects the call when the bucket is empty.
+func (s *Service) takeQuota(ctx context.Context, caller Caller, toolset string) error {
+	if s.quotas == nil {
+		return nil
+	}
+	rule, ok := s.quotas.Limit(caller, toolset)
+	if !ok {
+		return nil
+	}
+	toolset = policyToolset(toolset)
+	decision, err := s.quotaLimiter.Take(ctx, caller.Subject, toolset, rule)
+	if err != nil {
+		return genregistry.MakeServiceUnavailable(fmt.Errorf("check call quota: %w", err))
+	}
+	outcome := "allowed"
+	if !decision.allowed {
+		outcome = "exceeded"
+	}
+	if s.metrics != nil {
+		s.metrics.IncCounter(telemetry.MetricRegistryQuotaDecision, 1,
+			telemetry.TagToolset, toolset,
+			telemetry.TagCaller, caller.Subject,
+			telemetry.TagOutcome, outcome,
+		)
+		s.metrics.RecordGauge(telemetry.MetricRegistryQuotaRemaining, decision.remaining,
+			telemetry.TagToolset, toolset,
+			telemetry.TagCaller, caller.Subject,
+		)
+	}
+	if !decision.allowed {
+		return genregistry.MakeQuotaExceeded(fmt.Errorf(
+			"caller %q exceeded its quota of %g calls per second for toolset %q; retry after %s",
+			caller.Subject,
+			rule.Rate,
+			toolset,
+			decision.retryAfter,
+		))
+	}
+	return nil
+}
+
+// discoveryFilter returns the predicate selecting toolsets the caller may see
+// in discovery results.
+func (s *Service) discoveryFilter(ctx context.Context) (func(toolset string) bool, error) {
+	caller, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.policy == nil {
+		return func(string) bool { return true }, nil
+	}
+	return func(toolset string) bool {
+		return s.policy.CanDiscover(caller, toolset)
+	}, nil
+}
+
 // routeUnpublishedToolCall waits for a healthy provider and publishes the call
 // before its one absolute execution deadline. A provider change may replace
 // the assignment only while Redis still proves that publication never began.
@@ -681,6 +1104,7 @@ func (s *Service) routeUnpublishedToolCall(
 		admission, _, err := s.callAdmissions.Ensure(
 			ctx,
 			prepared.toolset,
+			string(prepared.tool),
 			prepared.toolUseID,
 			registration.RegistrationToken,
 			prepared.admissionDigest,
@@ -812,12 +1236,19 @@ func (s *Service) publishLiveProviderEvent(
 
 // publishPreparedToolCall performs one exact initial or overload publication.
 // The stream append and admission commit share one Redis linearization point.
+// The audit event of the request is recorded first, so a call whose event
+// cannot be recorded is never published.
 func (s *Service) publishPreparedToolCall(
 	ctx context.Context,
 	prepared preparedToolCall,
 	admission callAdmission,
 	overloadEventID string,
 ) error {
+	if prepared.writeAhead != nil {
+		if err := prepared.writeAhead(ctx); err != nil {
+			return err
+		}
+	}
 	return s.publishAdmittedCall(
 		ctx,
 		prepared.toolset,
@@ -913,6 +1344,291 @@ func (s *Service) waitForHealthyProvider(
 	}
 }
 
+// resolveToolset maps a CallTool or GetToolset toolset reference onto the
+// registration that serves it. A reference naming an active registration
+// routes to it unchanged. Otherwise "name" and "name@constraint" select among
+// the active name@version registrations using the catalog route table, keyed
+// by routingKey so every retry of one call selects the same version. Versions
+// without a healthy provider are excluded from the selection unless none is
+// healthy. When no name@version registration satisfies the constraint, a
+// provider registered under the plain name serves the reference if its
+// declared Version does. A constraint that does not parse is a validation
+// error; any other reference that matches nothing is returned unchanged so
+// routing reports the canonical not-found rejection.
+func (s *Service) resolveToolset(ctx context.Context, ref, routingKey string) (string, error) {
+	_, err := s.catalog.ActiveRegistration(ctx, ref)
+	if err == nil {
+		return ref, nil
+	}
+	if !errors.Is(err, errToolsetNotFound) {
+		return "", genregistry.MakeServiceUnavailable(fmt.Errorf("get toolset: %w", err))
+	}
+	name, constraint := toolregistry.SplitToolsetRef(ref)
+	parsed, err := toolregistry.ParseVersionConstraint(constraint)
+	if err != nil {
+		return "", genregistry.MakeValidationError(fmt.Errorf("toolset reference %q: %w", ref, err))
+	}
+	entries, err := s.catalog.ActiveVersions(ctx, name)
+	if err != nil {
+		return "", genregistry.MakeServiceUnavailable(fmt.Errorf("list toolset versions: %w", err))
+	}
+	if len(entries) == 0 {
+		return s.resolvePlainToolset(ctx, ref, name, parsed)
+	}
+	active, err := s.healthyVersions(ctx, name, parsed, entries)
+	if err != nil {
+		return "", err
+	}
+	routes, err := s.catalog.ToolsetRoutes(ctx, name)
+	if err != nil {
+		return "", genregistry.MakeServiceUnavailable(fmt.Errorf("get toolset routes: %w", err))
+	}
+	version, ok := toolregistry.SelectToolsetVersion(active, parsed, routes, routingKey)
+	if !ok {
+		return s.resolvePlainToolset(ctx, ref, name, parsed)
+	}
+	return toolregistry.VersionedToolset(name, version), nil
+}
+
+// healthyVersions returns the versions of the side-by-side registrations of
+// name that satisfy constraint and whose providers are healthy, so canary
+// weights never route calls to a version that cannot serve them. It returns
+// every version when no satisfying version is healthy, leaving the call to
+// report the provider outage of the version it selects.
+func (s *Service) healthyVersions(
+	ctx context.Context,
+	name string,
+	constraint toolregistry.VersionConstraint,
+	entries []catalogEntry,
+) ([]toolregistry.SemVer, error) {
+	all := make([]toolregistry.SemVer, 0, len(entries))
+	healthy := make([]toolregistry.SemVer, 0, len(entries))
+	for _, entry := range entries {
+		v, ok := registrationVersion(name, entry.Toolset.Name)
+		if !ok {
+			continue
+		}
+		all = append(all, v)
+		if !constraint.Allows(v) {
+			continue
+		}
+		health, err := s.healthTracker.Health(ctx, entry.Toolset.Name, entry.RegistrationToken)
+		if err != nil {
+			return nil, genregistry.MakeServiceUnavailable(fmt.Errorf(
+				"check toolset %q health: %w",
+				entry.Toolset.Name,
+				err,
+			))
+		}
+		if health.Healthy {
+			healthy = append(healthy, v)
+		}
+	}
+	if len(healthy) == 0 {
+		return all, nil
+	}
+	return healthy, nil
+}
+
+// resolvePlainToolset routes a pinned reference to the toolset registered
+// under its plain name when the declared Version of that registration
+// satisfies the constraint. Plain-name registrations predate side-by-side
+// versions, so generated specs that pin a version still reach providers that
+// never adopted name@version registration. It returns ref unchanged when the
+// plain registration is missing, unversioned, or out of range.
+func (s *Service) resolvePlainToolset(
+	ctx context.Context,
+	ref, name string,
+	constraint toolregistry.VersionConstraint,
+) (string, error) {
+	if ref == name {
+		return ref, nil
+	}
+	entry, err := s.catalog.ActiveRegistration(ctx, name)
+	if errors.Is(err, errToolsetNotFound) {
+		return ref, nil
+	}
+	if err != nil {
+		return "", genregistry.MakeServiceUnavailable(fmt.Errorf("get toolset: %w", err))
+	}
+	if entry.Toolset == nil || entry.Toolset.Version == nil {
+		return ref, nil
+	}
+	declared, err := toolregistry.ParseSemVer(string(*entry.Toolset.Version))
+	if err != nil || !constraint.Allows(declared) {
+		return ref, nil
+	}
+	return name, nil
+}
+
+// resolveRetryToolset maps a RetryTool toolset reference onto the version
+// registration that admitted the original call. Retries never re-run canary
+// selection: the expected registration token identifies the exact version.
+func (s *Service) resolveRetryToolset(ctx context.Context, ref, expectedToken string) (string, error) {
+	_, err := s.catalog.ActiveRegistration(ctx, ref)
+	if err == nil {
+		return ref, nil
+	}
+	if !errors.Is(err, errToolsetNotFound) {
+		return "", genregistry.MakeServiceUnavailable(fmt.Errorf("get toolset: %w", err))
+	}
+	name, _ := toolregistry.SplitToolsetRef(ref)
+	entries, err := s.catalog.ActiveVersions(ctx, name)
+	if err != nil {
+		return "", genregistry.MakeServiceUnavailable(fmt.Errorf("list toolset versions: %w", err))
+	}
+	for _, entry := range entries {
+		if entry.RegistrationToken == expectedToken {
+			return entry.Toolset.Name, nil
+		}
+	}
+	return ref, nil
+}
+
+// validateVersionedRegistration requires a name@version registration to carry
+// the same version in its Version field and to spell it canonically, so
+// routing can derive the version from the registration name alone. Names
+// whose suffix is not a semantic version are not versioned registrations.
+func validateVersionedRegistration(name string, version *genregistry.SemVer) error {
+	_, suffix := toolregistry.SplitToolsetRef(name)
+	if suffix == "" {
+		return nil
+	}
+	v, err := toolregistry.ParseSemVer(suffix)
+	if err != nil {
+		return nil
+	}
+	if v.String() != suffix {
+		return fmt.Errorf("versioned toolset %q must use canonical version %q", name, v.String())
+	}
+	if version == nil {
+		return fmt.Errorf("versioned toolset %q requires version %s", name, v)
+	}
+	declared, err := toolregistry.ParseSemVer(string(*version))
+	if err != nil || declared.Compare(v) != 0 {
+		return fmt.Errorf("versioned toolset %q does not match version %q", name, string(*version))
+	}
+	return nil
+}
+
+// schemaCompatibility classifies the tool schema changes of toolset against
+// its baseline: the last admission of the same name in any state, so retiring
+// a toolset does not reset its compatibility history, or else the highest
+// active lower version of a versioned toolset. Breaking changes require a
+// version bump that signals them (a major bump, or a minor bump before 1.0.0).
+// A re-registration with an identical schema keeps the classification of the
+// admission it renews.
+func (s *Service) schemaCompatibility(
+	ctx context.Context,
+	toolset *genregistry.Toolset,
+) (*genregistry.SchemaCompatibility, error) {
+	base, found, err := s.compatibilityBaseline(ctx, toolset)
+	if err != nil {
+		return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("read compatibility baseline: %w", err))
+	}
+	if !found {
+		return nil, nil
+	}
+	if base.Toolset.Name == toolset.Name {
+		fingerprint, err := toolsetSchemaFingerprint(toolset)
+		if err != nil {
+			return nil, genregistry.MakeValidationError(fmt.Errorf("fingerprint toolset schema: %w", err))
+		}
+		if fingerprint == base.SchemaFingerprint {
+			return base.Toolset.Compatibility, nil
+		}
+	}
+	changes, err := s.validator.DiffToolSchemas(base.Toolset.Tools, toolset.Tools)
+	if err != nil {
+		return nil, genregistry.MakeServiceUnavailable(fmt.Errorf("diff tool schemas: %w", err))
+	}
+	compatibility := &genregistry.SchemaCompatibility{
+		BaseName:    base.Toolset.Name,
+		BaseVersion: base.Toolset.Version,
+		Changes:     changes,
+	}
+	var breaking []string
+	for _, change := range changes {
+		if !change.Breaking {
+			continue
+		}
+		compatibility.Breaking = true
+		where := change.Tool
+		if change.Path != "" {
+			where += " " + change.Path
+		}
+		breaking = append(breaking, fmt.Sprintf("%s: %s (%s)", where, change.Detail, change.Kind))
+	}
+	if compatibility.Breaking && !breakingVersionBump(base.Toolset.Version, toolset.Version) {
+		return nil, genregistry.MakeValidationError(fmt.Errorf(
+			"toolset %q makes breaking schema changes against %q without a major version bump: %s",
+			toolset.Name,
+			base.Toolset.Name,
+			strings.Join(breaking, "; "),
+		))
+	}
+	return compatibility, nil
+}
+
+// compatibilityBaseline returns the registration toolset is compared with.
+// found is false for the first registration of a toolset.
+func (s *Service) compatibilityBaseline(
+	ctx context.Context,
+	toolset *genregistry.Toolset,
+) (base catalogEntry, found bool, err error) {
+	current, _, err := s.catalog.Admission(ctx, toolset.Name)
+	switch {
+	case err == nil && current.Toolset != nil:
+		return current, true, nil
+	case err != nil && !errors.Is(err, errToolsetNotFound):
+		return catalogEntry{}, false, err
+	}
+	name, _ := toolregistry.SplitToolsetRef(toolset.Name)
+	version, ok := registrationVersion(name, toolset.Name)
+	if !ok {
+		return catalogEntry{}, false, nil
+	}
+	versions, err := s.catalog.ActiveVersions(ctx, name)
+	if err != nil {
+		return catalogEntry{}, false, err
+	}
+	var baseVersion toolregistry.SemVer
+	for _, entry := range versions {
+		v, ok := registrationVersion(name, entry.Toolset.Name)
+		if !ok || v.Compare(version) >= 0 {
+			continue
+		}
+		if !found || v.Compare(baseVersion) > 0 {
+			base, baseVersion, found = entry, v, true
+		}
+	}
+	return base, found, nil
+}
+
+// breakingVersionBump reports whether next declares a version that signals
+// breaking changes relative to base. An unversioned baseline accepts any
+// declared version.
+func breakingVersionBump(base, next *genregistry.SemVer) bool {
+	if next == nil {
+		return false
+	}
+	nextVersion, err := toolregistry.ParseSemVer(string(*next))
+	if err != nil {
+		return false
+	}
+	if base == nil {
+		return true
+	}
+	baseVersion, err := toolregistry.ParseSemVer(string(*base))
+	if err != nil {
+		return true
+	}
+	if nextVersion.Major != baseVersion.Major {
+		return nextVersion.Major > baseVersion.Major
+	}
+	return nextVersion.Major == 0 && nextVersion.Minor > baseVersion.Minor
+}
+
 // activeRegistration loads the exact catalog generation used for validation
 // and maps catalog failures onto the public registry contract.
 func (s *Service) activeRegistration(ctx context.Context, toolset string) (catalogEntry, error) {
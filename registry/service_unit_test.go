@@ -4,17 +4,22 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 	clientspulse "goa.design/goa-ai/features/stream/pulse/clients/pulse"
 	mockpulse "goa.design/goa-ai/features/stream/pulse/clients/pulse/mocks"
+	"goa.design/goa-ai/registry/audit"
 	genregistrypb "goa.design/goa-ai/registry/gen/grpc/registry/pb"
 	genregistryserver "goa.design/goa-ai/registry/gen/grpc/registry/server"
 	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/agent/telemetry"
 	"goa.design/goa-ai/runtime/toolregistry"
 	goa "goa.design/goa/v3/pkg"
 	streamopts "goa.design/pulse/streaming/options"
@@ -35,8 +40,40 @@ type (
 		publications  int
 	}
 
-	// unitHealthTracker reports healthy provider routing without background work.
-	unitHealthTracker struct{}
+	// unitHealthTracker reports healthy provider routing without background
+	// work, except for the toolsets listed in unhealthy.
+	unitHealthTracker struct {
+		unhealthy map[string]bool
+	}
+
+	// countingQuotaLimiter admits a fixed number of calls per bucket.
+	countingQuotaLimiter struct {
+		taken map[string]int
+	}
+
+	// recordingAuditSink captures audit events in order.
+	recordingAuditSink struct {
+		events []audit.Event
+	}
+
+	// admissionMetrics records the toolset tag of call admission counters.
+	admissionMetrics struct {
+		telemetry.NoopMetrics
+		toolsets []string
+	}
+
+	// failingAuditSink rejects every audit event with err.
+	failingAuditSink struct {
+		err error
+	}
+
+	// publicationAuditSink records, for each audit event, how many calls the
+	// stream manager had published when the event was recorded.
+	publicationAuditSink struct {
+		streams      *unitStreamManager
+		outcomes     []string
+		publications []int
+	}
 )
 
 func TestGeneratedCallToolRejectsMissingWireProtocolVersion(t *testing.T) {
@@ -186,6 +223,517 @@ func TestCallToolEnsuresAdmissionWithActiveRegistrationToken(t *testing.T) {
 	assert.Equal(t, registration.RegistrationToken, streams.message.RegistrationToken)
 }
 
+func TestCallToolRecordsAuditBeforePublishing(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	newService := func(t *testing.T, streams *unitStreamManager, sink audit.Sink) *Service {
+		t.Helper()
+		catalog := newToolsetCatalog(
+			newTestCatalogMap(),
+			newTestTimeSource(time.Unix(1_700_000_000, 0)),
+		)
+		_, err := catalog.Register(
+			ctx,
+			&genregistry.Toolset{
+				Name: "test.toolset",
+				Tools: []*genregistry.ToolSchema{{
+					Name:          "lookup",
+					PayloadSchema: []byte(`{"type":"object"}`),
+					ResultSchema:  []byte(`{"type":"object"}`),
+				}},
+			},
+			testAdmissionRevisionA,
+			"provider-a",
+			testIncarnationA,
+			time.Hour,
+		)
+		require.NoError(t, err)
+		resultStream := mockpulse.NewStream(t)
+		resultStream.SetAdd(func(context.Context, string, []byte) (string, error) {
+			return "1-0", nil
+		})
+		resultStream.SetOpen(func(context.Context) error { return nil })
+		pulseClient := mockpulse.NewClient(t)
+		pulseClient.SetStream(func(string, ...streamopts.Stream) (clientspulse.Stream, error) {
+			return resultStream, nil
+		})
+		return &Service{
+			catalog:               catalog,
+			validator:             newSchemaValidator(),
+			streamManager:         streams,
+			healthTracker:         unitHealthTracker{},
+			callAdmissions:        &recordingCallAdmissions{},
+			pulseClient:           pulseClient,
+			executionTimeout:      toolregistry.MaxToolCallWait,
+			resultStreamTTL:       toolregistry.DefaultResultStreamTTL,
+			providerLeaseDuration: DefaultProviderLeaseDuration,
+			auditSink:             sink,
+			logger:                telemetry.NewNoopLogger(),
+		}
+	}
+	call := func(svc *Service) error {
+		_, err := svc.CallTool(ctx, &genregistry.CallToolPayload{
+			Toolset:             "test.toolset",
+			Tool:                "lookup",
+			PayloadJSON:         []byte(`{"query":"status"}`),
+			WireProtocolVersion: toolregistry.WireProtocolVersion,
+			Meta: &genregistry.ToolCallMeta{
+				RunID:      "run-1",
+				SessionID:  "session-1",
+				ToolCallID: "call-1",
+			},
+		})
+		return err
+	}
+
+	// The event is recorded once, before the first publication attempt.
+	streams := &unitStreamManager{publishErrors: []error{errRoutingUnavailable}}
+	sink := &publicationAuditSink{streams: streams}
+	require.NoError(t, call(newService(t, streams, sink)))
+	assert.Equal(t, []string{audit.OutcomeOK}, sink.outcomes)
+	assert.Equal(t, []int{0}, sink.publications)
+	assert.Equal(t, 2, streams.publications)
+
+	// A call whose event cannot be recorded is never published.
+	streams = &unitStreamManager{}
+	err := call(newService(t, streams, failingAuditSink{err: errors.New("sink down")}))
+	var serviceErr *goa.ServiceError
+	require.ErrorAs(t, err, &serviceErr)
+	assert.Equal(t, "service_unavailable", serviceErr.Name)
+	assert.Zero(t, streams.publications)
+}
+
+func TestResolveToolsetSelectsSideBySideVersions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	catalog := newToolsetCatalog(
+		newTestCatalogMap(),
+		newTestTimeSource(time.Unix(1_700_000_000, 0)),
+	)
+	tokens := make(map[string]string)
+	for i, name := range []string{"data.tools@1.2.0", "data.tools@1.3.0", "data.tools@2.0.0"} {
+		registration, err := catalog.Register(
+			ctx,
+			testCatalogToolset(name, "versioned", nil),
+			fmt.Sprintf("2026-07-23.%d", i+1),
+			"provider-"+name,
+			testIncarnationA,
+			time.Hour,
+		)
+		require.NoError(t, err)
+		tokens[name] = registration.RegistrationToken
+	}
+	svc := &Service{catalog: catalog, healthTracker: unitHealthTracker{}}
+
+	for _, tc := range []struct {
+		ref    string
+		routes toolregistry.ToolsetRoutes
+		want   string
+	}{
+		{ref: "data.tools", want: "data.tools@2.0.0"},
+		{ref: "data.tools@^1", want: "data.tools@1.3.0"},
+		{ref: "data.tools@v1.2.0", want: "data.tools@1.2.0"},
+		{ref: "data.tools@1.2.0", routes: toolregistry.ToolsetRoutes{"1.3.0": 100}, want: "data.tools@1.2.0"},
+		{ref: "data.tools", routes: toolregistry.ToolsetRoutes{"1.3.0": 100, "2.0.0": 0}, want: "data.tools@1.3.0"},
+		{ref: "data.tools@^2", routes: toolregistry.ToolsetRoutes{"1.3.0": 100}, want: "data.tools@2.0.0"},
+		{ref: "data.tools@^3", want: "data.tools@^3"},
+	} {
+		require.NoError(t, catalog.SetToolsetRoutes(ctx, "data.tools", tc.routes))
+		got, err := svc.resolveToolset(ctx, tc.ref, "call-1")
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got, "ref %q routes %v", tc.ref, tc.routes)
+	}
+
+	retry, err := svc.resolveRetryToolset(ctx, "data.tools", tokens["data.tools@1.2.0"])
+	require.NoError(t, err)
+	assert.Equal(t, "data.tools@1.2.0", retry)
+
+	_, err = svc.resolveToolset(ctx, "data.tools@>=x", "call-1")
+	var serviceErr *goa.ServiceError
+	require.ErrorAs(t, err, &serviceErr)
+	assert.Equal(t, "validation_error", serviceErr.Name)
+}
+
+func TestResolveToolsetSkipsUnhealthyVersions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	catalog := newToolsetCatalog(
+		newTestCatalogMap(),
+		newTestTimeSource(time.Unix(1_700_000_000, 0)),
+	)
+	for i, name := range []string{"data.tools@1.2.0", "data.tools@1.3.0", "data.tools@2.0.0"} {
+		_, err := catalog.Register(
+			ctx,
+			testCatalogToolset(name, "versioned", nil),
+			fmt.Sprintf("2026-07-23.%d", i+1),
+			"provider-"+name,
+			testIncarnationA,
+			time.Hour,
+		)
+		require.NoError(t, err)
+	}
+	require.NoError(t, catalog.SetToolsetRoutes(ctx, "data.tools", toolregistry.ToolsetRoutes{"1.2.0": 50, "1.3.0": 50}))
+	svc := &Service{
+		catalog:       catalog,
+		healthTracker: unitHealthTracker{unhealthy: map[string]bool{"data.tools@1.3.0": true, "data.tools@2.0.0": true}},
+	}
+
+	for i := range 20 {
+		got, err := svc.resolveToolset(ctx, "data.tools", fmt.Sprintf("call-%d", i))
+		require.NoError(t, err)
+		assert.Equal(t, "data.tools@1.2.0", got, "canary weights skip unhealthy versions")
+	}
+	got, err := svc.resolveToolset(ctx, "data.tools@^2", "call-1")
+	require.NoError(t, err)
+	assert.Equal(t, "data.tools@2.0.0", got, "a constraint without healthy versions still selects one")
+}
+
+func TestResolveToolsetFallsBackToPlainRegistration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	catalog := newToolsetCatalog(
+		newTestCatalogMap(),
+		newTestTimeSource(time.Unix(1_700_000_000, 0)),
+	)
+	toolset := testCatalogToolset("data.tools", "plain", nil)
+	version := genregistry.SemVer("1.2.0")
+	toolset.Version = &version
+	_, err := catalog.Register(ctx, toolset, testAdmissionRevisionA, "provider", testIncarnationA, time.Hour)
+	require.NoError(t, err)
+	svc := &Service{catalog: catalog, healthTracker: unitHealthTracker{}}
+
+	for _, tc := range []struct {
+		ref  string
+		want string
+	}{
+		{ref: "data.tools", want: "data.tools"},
+		{ref: "data.tools@1.2.0", want: "data.tools"},
+		{ref: "data.tools@^1", want: "data.tools"},
+		{ref: "data.tools@^2", want: "data.tools@^2"},
+	} {
+		got, err := svc.resolveToolset(ctx, tc.ref, "call-1")
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got, "ref %q", tc.ref)
+	}
+}
+
+func TestValidateVersionedRegistration(t *testing.T) {
+	t.Parallel()
+
+	version := genregistry.SemVer("v1.2.0")
+	other := genregistry.SemVer("1.3.0")
+	require.NoError(t, validateVersionedRegistration("data.tools", nil))
+	require.NoError(t, validateVersionedRegistration("team@data.tools", nil))
+	require.NoError(t, validateVersionedRegistration("data.tools@1.2.0", &version))
+	require.Error(t, validateVersionedRegistration("data.tools@1.2.0", nil))
+	require.Error(t, validateVersionedRegistration("data.tools@1.2.0", &other))
+	require.Error(t, validateVersionedRegistration("data.tools@v1.2.0", &version))
+}
+
+func TestServiceEnforcesCallerPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	catalog := newToolsetCatalog(
+		newTestCatalogMap(),
+		newTestTimeSource(time.Unix(1_700_000_000, 0)),
+	)
+	for _, name := range []string{"data.tools", "billing.invoices"} {
+		_, err := catalog.Register(
+			ctx,
+			testCatalogToolset(name, "policy", nil),
+			testAdmissionRevisionA,
+			"provider-"+name,
+			testIncarnationA,
+			time.Hour,
+		)
+		require.NoError(t, err)
+	}
+	secret := []byte("registry-test-secret")
+	svc := &Service{
+		catalog:       catalog,
+		authenticator: &JWTAuthenticator{Keys: map[string]any{"": secret}},
+		policy: &Policy{Rules: []PolicyRule{
+			{Callers: []string{"agent"}, Call: []string{"data.*"}},
+			{Callers: []string{"svc-billing"}, Register: []string{"billing.*"}},
+		}},
+	}
+	as := func(subject string) context.Context {
+		return bearerContext(signHS256(t, secret, map[string]any{
+			"sub": subject,
+			"exp": time.Now().Add(time.Minute).Unix(),
+		}))
+	}
+	requireServiceError := func(t *testing.T, err error, name string) {
+		t.Helper()
+		var serviceErr *goa.ServiceError
+		require.ErrorAs(t, err, &serviceErr)
+		assert.Equal(t, name, serviceErr.Name)
+	}
+	call := func(toolset string) *genregistry.CallToolPayload {
+		return &genregistry.CallToolPayload{
+			Toolset:             toolset,
+			Tool:                "lookup",
+			PayloadJSON:         []byte(`{}`),
+			WireProtocolVersion: toolregistry.WireProtocolVersion,
+			Meta:                &genregistry.ToolCallMeta{RunID: "run-1", SessionID: "session-1", ToolCallID: "call-1"},
+		}
+	}
+
+	_, err := svc.CallTool(ctx, call("data.tools"))
+	requireServiceError(t, err, "unauthenticated")
+	_, err = svc.CallTool(as("agent"), call("billing.invoices"))
+	requireServiceError(t, err, "permission_denied")
+	_, err = svc.Register(as("agent"), &genregistry.RegisterPayload{Name: "data.tools"})
+	requireServiceError(t, err, "permission_denied")
+	_, err = svc.Register(as("svc-billing"), &genregistry.RegisterPayload{Name: "data.tools"})
+	requireServiceError(t, err, "permission_denied")
+	err = svc.Pong(as("agent"), &genregistry.PongPayload{Toolset: "data.tools"})
+	requireServiceError(t, err, "permission_denied")
+	_, err = svc.GetToolset(as("agent"), &genregistry.GetToolsetPayload{Name: "billing.invoices"})
+	requireServiceError(t, err, "permission_denied")
+
+	toolset, err := svc.GetToolset(as("agent"), &genregistry.GetToolsetPayload{Name: "data.tools"})
+	require.NoError(t, err)
+	assert.Equal(t, "data.tools", toolset.Name)
+
+	listed, err := svc.ListToolsets(as("agent"), &genregistry.ListToolsetsPayload{})
+	require.NoError(t, err)
+	require.Len(t, listed.Toolsets, 1)
+	assert.Equal(t, "data.tools", listed.Toolsets[0].Name)
+
+	found, err := svc.Search(as("svc-billing"), &genregistry.SearchPayload{Query: "policy"})
+	require.NoError(t, err)
+	require.Len(t, found.Toolsets, 1)
+	assert.Equal(t, "billing.invoices", found.Toolsets[0].Name)
+}
+
+func TestServiceAuditsCallsWithRedactedArguments(t *testing.T) {
+	t.Parallel()
+
+	catalog := newToolsetCatalog(
+		newTestCatalogMap(),
+		newTestTimeSource(time.Unix(1_700_000_000, 0)),
+	)
+	_, err := catalog.Register(
+		context.Background(),
+		&genregistry.Toolset{
+			Name: "data.tools",
+			Tools: []*genregistry.ToolSchema{{
+				Name: "login",
+				PayloadSchema: []byte(`{
+					"type": "object",
+					"properties": {
+						"user": {"type": "string"},
+						"credentials": {"$ref": "#/$defs/Credentials"}
+					},
+					"$defs": {
+						"Credentials": {
+							"type": "object",
+							"properties": {"password": {"type": "string", "format": "password"}}
+						}
+					}
+				}`),
+				ResultSchema: []byte(`{
+					"type": "object",
+					"properties": {"secret": {"type": "string", "x-redact": true}}
+				}`),
+			}},
+		},
+		testAdmissionRevisionA,
+		"provider-a",
+		testIncarnationA,
+		time.Hour,
+	)
+	require.NoError(t, err)
+	secret := []byte("registry-test-secret")
+	sink := &recordingAuditSink{}
+	svc := &Service{
+		catalog:       catalog,
+		authenticator: &JWTAuthenticator{Keys: map[string]any{"": secret}},
+		policy: &Policy{Rules: []PolicyRule{
+			{Callers: []string{"agent"}, Call: []string{"data.*"}},
+		}},
+		auditSink: sink,
+		logger:    telemetry.NewNoopLogger(),
+	}
+	traceID := trace.TraceID{1, 2, 3}
+	ctx := trace.ContextWithSpanContext(
+		bearerContext(signHS256(t, secret, map[string]any{
+			"sub": "agent",
+			"exp": time.Now().Add(time.Minute).Unix(),
+		})),
+		trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{4}}),
+	)
+	meta := &genregistry.ToolCallMeta{RunID: "run-1", SessionID: "session-1", ToolCallID: "call-1"}
+
+	_, err = svc.CallTool(ctx, &genregistry.CallToolPayload{
+		Toolset:             "data.tools",
+		Tool:                "login",
+		PayloadJSON:         []byte(`{"user":"ada","credentials":{"password":"hunter2"}}`),
+		WireProtocolVersion: toolregistry.WireProtocolVersion + 1,
+		Meta:                meta,
+	})
+	require.Error(t, err)
+	_, err = svc.CallTool(ctx, &genregistry.CallToolPayload{
+		Toolset:             "data.tools",
+		Tool:                "unknown",
+		PayloadJSON:         []byte(`{"password":"hunter2"}`),
+		WireProtocolVersion: toolregistry.WireProtocolVersion + 1,
+		Meta:                meta,
+	})
+	require.Error(t, err)
+	err = svc.Unregister(ctx, &genregistry.UnregisterPayload{Name: "data.tools"})
+	require.Error(t, err)
+	result := []byte(`{"secret":"s"}`)
+	err = svc.CompleteToolCall(ctx, &genregistry.CompleteToolCallPayload{
+		Toolset:    "data.tools",
+		ProviderID: "provider-a",
+		ToolUseID:  toolUseIDForCall(meta),
+		ResultJSON: []byte(`{"tool_use_id":"` + toolUseIDForCall(meta) + `","result_json":` + string(result) + `}`),
+	})
+	require.Error(t, err)
+
+	require.Len(t, sink.events, 4)
+	call := sink.events[0]
+	assert.Equal(t, audit.EventCallTool, call.Type)
+	assert.Equal(t, "agent", call.Caller)
+	assert.Equal(t, string(CallerSchemeJWT), call.CallerScheme)
+	assert.Equal(t, traceID.String(), call.TraceID)
+	assert.Equal(t, "data.tools", call.Toolset)
+	assert.Equal(t, "login", call.Tool)
+	assert.Equal(t, toolUseIDForCall(meta), call.ToolUseID)
+	assert.Equal(t, "run-1", call.RunID)
+	assert.Equal(t, "validation_error", call.Outcome)
+	assert.JSONEq(t, `{"user":"ada","credentials":{"password":"[REDACTED]"}}`, string(call.Arguments))
+	assert.Nil(t, sink.events[1].Arguments, "arguments without a schema are not recorded")
+	assert.Equal(t, audit.EventUnregister, sink.events[2].Type)
+	assert.Equal(t, "permission_denied", sink.events[2].Outcome)
+	assert.Equal(t, "agent", sink.events[2].Caller)
+	completion := sink.events[3]
+	assert.Equal(t, audit.EventCompleteToolCall, completion.Type)
+	assert.Equal(t, "provider-a", completion.ProviderID)
+	digest := sha256.Sum256(result)
+	assert.Equal(t, len(result), completion.ResultSize)
+	assert.Equal(t, hex.EncodeToString(digest[:]), completion.ResultSHA256)
+	assert.Empty(t, completion.Tool, "failed settlements do not name the tool")
+	assert.Nil(t, completion.Result, "results of unknown tools are not recorded")
+	assert.Nil(t, completion.Arguments)
+}
+
+func TestAuditCompletionRedactsResultWithSettledToolSchema(t *testing.T) {
+	t.Parallel()
+
+	catalog := newToolsetCatalog(
+		newTestCatalogMap(),
+		newTestTimeSource(time.Unix(1_700_000_000, 0)),
+	)
+	_, err := catalog.Register(
+		context.Background(),
+		&genregistry.Toolset{
+			Name: "data.tools",
+			Tools: []*genregistry.ToolSchema{
+				{
+					Name:          "login",
+					PayloadSchema: []byte(`{"type":"object"}`),
+					ResultSchema: []byte(`{
+						"type": "object",
+						"properties": {"secret": {"type": "string", "x-redact": true}}
+					}`),
+				},
+				{
+					Name:          "lookup",
+					PayloadSchema: []byte(`{"type":"object"}`),
+					ResultSchema: []byte(`{
+						"type": "object",
+						"properties": {"user": {"type": "string", "x-redact": true}}
+					}`),
+				},
+			},
+		},
+		testAdmissionRevisionA,
+		"provider-a",
+		testIncarnationA,
+		time.Hour,
+	)
+	require.NoError(t, err)
+	sink := &recordingAuditSink{}
+	svc := &Service{
+		catalog:   catalog,
+		auditSink: sink,
+		logger:    telemetry.NewNoopLogger(),
+	}
+	payload := &genregistry.CompleteToolCallPayload{
+		Toolset:    "data.tools",
+		ProviderID: "provider-a",
+		ToolUseID:  "call-1",
+		ResultJSON: []byte(`{"tool_use_id":"call-1","result_json":{"user":"ada","secret":"s"}}`),
+	}
+
+	require.NoError(t, svc.auditCompletion(context.Background(), Caller{}, payload, "login", nil))
+	require.NoError(t, svc.auditCompletion(context.Background(), Caller{}, payload, "missing", nil))
+
+	require.Len(t, sink.events, 2)
+	assert.Equal(t, "login", sink.events[0].Tool)
+	assert.JSONEq(t, `{"user":"ada","secret":"[REDACTED]"}`, string(sink.events[0].Result))
+	assert.Equal(t, "missing", sink.events[1].Tool)
+	assert.Nil(t, sink.events[1].Result, "results without a tool schema are not recorded")
+}
+
+func TestCallToolChargesQuotaOnlyForNewCalls(t *testing.T) {
+	t.Parallel()
+
+	limiter := &countingQuotaLimiter{taken: make(map[string]int)}
+	admissions := &recordingCallAdmissions{}
+	metrics := &admissionMetrics{}
+	svc := &Service{
+		catalog: newToolsetCatalog(
+			newTestCatalogMap(),
+			newTestTimeSource(time.Unix(1_700_000_000, 0)),
+		),
+		callAdmissions: admissions,
+		quotas: &Quotas{Rules: []QuotaRule{
+			{Callers: []string{"*"}, Toolsets: []string{"missing.*"}, Rate: 1, Burst: 1},
+		}},
+		quotaLimiter: limiter,
+		metrics:      metrics,
+	}
+	call := func(toolset, callID string) string {
+		_, err := svc.CallTool(context.Background(), &genregistry.CallToolPayload{
+			Toolset:             toolset,
+			Tool:                "lookup",
+			PayloadJSON:         []byte(`{}`),
+			WireProtocolVersion: toolregistry.WireProtocolVersion,
+			Meta: &genregistry.ToolCallMeta{
+				RunID:      "run-1",
+				SessionID:  "session-1",
+				ToolCallID: callID,
+			},
+		})
+		var serviceErr *goa.ServiceError
+		require.ErrorAs(t, err, &serviceErr)
+		return serviceErr.Name
+	}
+
+	// The first call passes its quota and reaches routing.
+	assert.Equal(t, "call_not_admitted", call("missing.toolset", "call-1"))
+	assert.Equal(t, "quota_exceeded", call("missing.toolset@1.0.0", "call-2"))
+	assert.Equal(t, "call_not_admitted", call("other.toolset", "call-3"))
+	assert.Equal(t, map[string]int{"missing.toolset": 2}, limiter.taken)
+
+	// Exact retries attach to their existing admission without a token.
+	admissions.attached = &callAdmission{registrationToken: strings.Repeat("a", 64)}
+	assert.Equal(t, "call_not_admitted", call("missing.toolset", "call-1"))
+	assert.Equal(t, map[string]int{"missing.toolset": 2}, limiter.taken)
+
+	// Rejected calls never tag metrics with caller-supplied toolset names.
+	assert.Equal(t, []string{"unknown", "unknown", "unknown", "unknown"}, metrics.toolsets)
+}
+
 func TestCallToolRejectsUnpublishedCallWithoutProvider(t *testing.T) {
 	t.Parallel()
 
@@ -426,7 +974,7 @@ func TestPublishToolOutputDeltaRejectsByteOversizeBeforeDependencies(t *testing.
 
 func (r *recordingCallAdmissions) Ensure(
 	_ context.Context,
-	toolset, toolUseID, registrationToken, digest string,
+	toolset, _, toolUseID, registrationToken, digest string,
 	executionTimeout, ttl time.Duration,
 	_ []byte,
 ) (callAdmission, bool, error) {
@@ -474,8 +1022,8 @@ func (r *recordingCallAdmissions) Complete(
 	context.Context,
 	string, string, string, string, string, string, string,
 	[]byte,
-) error {
-	return nil
+) (string, error) {
+	return "", nil
 }
 
 func (r *recordingCallAdmissions) PublishLiveEvent(
@@ -525,8 +1073,8 @@ func (m *unitStreamManager) PublishAdmittedToolCall(
 	return nil
 }
 
-func (unitHealthTracker) Health(context.Context, string, string) (ToolsetHealth, error) {
-	return ToolsetHealth{Healthy: true}, nil
+func (t unitHealthTracker) Health(_ context.Context, toolset, _ string) (ToolsetHealth, error) {
+	return ToolsetHealth{Healthy: !t.unhealthy[toolset]}, nil
 }
 
 func (unitHealthTracker) RecordPong(context.Context, string, string, string, string) error {
@@ -540,3 +1088,110 @@ func (unitHealthTracker) EnsurePingLoop(context.Context, string) error {
 func (unitHealthTracker) Close() error {
 	return nil
 }
+
+func (l *countingQuotaLimiter) Take(_ context.Context, caller, toolset string, rule QuotaRule) (quotaDecision, error) {
+	key := caller + toolset
+	l.taken[key]++
+	if l.taken[key] > rule.Burst {
+		return quotaDecision{retryAfter: time.Second}, nil
+	}
+	return quotaDecision{allowed: true, remaining: float64(rule.Burst - l.taken[key])}, nil
+}
+
+func (r *recordingAuditSink) Record(_ context.Context, e audit.Event) error {
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (m *admissionMetrics) IncCounter(name string, _ float64, tags ...string) {
+	if name != telemetry.MetricRegistryCallAdmission {
+		return
+	}
+	for i := 0; i+1 < len(tags); i += 2 {
+		if tags[i] == telemetry.TagToolset {
+			m.toolsets = append(m.toolsets, tags[i+1])
+		}
+	}
+}
+
+func (f failingAuditSink) Record(context.Context, audit.Event) error {
+	return f.err
+}
+
+func (p *publicationAuditSink) Record(_ context.Context, e audit.Event) error {
+	p.outcomes = append(p.outcomes, e.Outcome)
+	p.publications = append(p.publications, p.streams.publications)
+	return nil
+}
+
+func TestServiceSchemaCompatibilityRequiresVersionBumpForBreakingChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	catalog := newToolsetCatalog(newTestCatalogMap(), newTestTimeSource(time.Unix(1_700_000_000, 0)))
+	svc := &Service{catalog: catalog, validator: newSchemaValidator()}
+	toolset := func(name, version, payload string) *genregistry.Toolset {
+		semver := genregistry.SemVer(version)
+		return &genregistry.Toolset{
+			Name:    name,
+			Version: &semver,
+			Tools: []*genregistry.ToolSchema{{
+				Name:          "data.get",
+				PayloadSchema: []byte(payload),
+				ResultSchema:  []byte(`{"type":"object"}`),
+			}},
+		}
+	}
+	const (
+		optionalID = `{"type":"object","properties":{"id":{"type":"string"}}}`
+		requiredID = `{"type":"object","properties":{"id":{"type":"string"}},"required":["id"]}`
+	)
+	for i, registered := range []*genregistry.Toolset{
+		toolset("data.tools", "1.0.0", optionalID),
+		toolset("data.tools@1.0.0", "1.0.0", optionalID),
+		toolset("data.tools@3.0.0", "3.0.0", requiredID),
+	} {
+		_, err := catalog.Register(ctx, registered, fmt.Sprintf("2026-07-23.%d", i+1), "provider", testIncarnationA, time.Hour)
+		require.NoError(t, err)
+	}
+
+	compatibility, err := svc.schemaCompatibility(ctx, toolset("data.tools", "1.0.0", optionalID))
+	require.NoError(t, err)
+	assert.Nil(t, compatibility, "an identical schema keeps the renewed classification")
+
+	_, err = svc.schemaCompatibility(ctx, toolset("data.tools", "1.1.0", requiredID))
+	var serviceErr *goa.ServiceError
+	require.ErrorAs(t, err, &serviceErr)
+	assert.Equal(t, "validation_error", serviceErr.Name)
+	assert.Contains(t, serviceErr.Message, `required property "id" added`)
+
+	compatibility, err = svc.schemaCompatibility(ctx, toolset("data.tools", "2.0.0", requiredID))
+	require.NoError(t, err)
+	require.NotNil(t, compatibility)
+	assert.Equal(t, "data.tools", compatibility.BaseName)
+	assert.Equal(t, genregistry.SemVer("1.0.0"), *compatibility.BaseVersion)
+	assert.True(t, compatibility.Breaking)
+	require.Len(t, compatibility.Changes, 1)
+	assert.Equal(t, "required_added", compatibility.Changes[0].Kind)
+
+	_, err = svc.schemaCompatibility(ctx, toolset("data.tools@1.1.0", "1.1.0", requiredID))
+	require.ErrorAs(t, err, &serviceErr)
+	assert.Equal(t, "validation_error", serviceErr.Name)
+
+	compatibility, err = svc.schemaCompatibility(ctx, toolset("data.tools@2.0.0", "2.0.0", requiredID))
+	require.NoError(t, err)
+	require.NotNil(t, compatibility)
+	assert.Equal(t, "data.tools@1.0.0", compatibility.BaseName, "the highest lower version is the baseline")
+	assert.True(t, compatibility.Breaking)
+
+	compatibility, err = svc.schemaCompatibility(ctx, toolset("other.tools", "1.0.0", requiredID))
+	require.NoError(t, err)
+	assert.Nil(t, compatibility, "first registrations have no baseline")
+
+	retired, _, err := catalog.Admission(ctx, "data.tools")
+	require.NoError(t, err)
+	require.NoError(t, catalog.Retire(ctx, "data.tools", retired.RegistrationToken))
+	_, err = svc.schemaCompatibility(ctx, toolset("data.tools", "1.1.0", requiredID))
+	require.ErrorAs(t, err, &serviceErr, "a retired registration remains the baseline")
+	assert.Equal(t, "validation_error", serviceErr.Name)
+}
@@ -247,6 +247,27 @@ type (
 		// external input. The caller continues by starting a new workflow with this
 		// value and one matching PendingInputResponse.
 		Suspension *RunSuspension
+
+		// Handoff is set alongside Final when the planner transferred the
+		// conversation to another agent. Callers start the target run with
+		// Runtime.StartHandoff and route the session's subsequent turns to
+		// Handoff.TargetAgentID.
+		Handoff *RunHandoff
+	}
+
+	// RunHandoff describes a transfer of conversation ownership requested by a
+	// planner at the end of a run.
+	RunHandoff struct {
+		// TargetAgentID identifies the agent that takes over the conversation.
+		TargetAgentID agent.Ident
+
+		// TargetRunID is the deterministic run identifier reserved for the
+		// target agent's first run. Session stores link it to the source run
+		// so lineage survives regardless of who starts the target run.
+		TargetRunID string
+
+		// Reason is the optional planner-provided explanation for the handoff.
+		Reason string
 	}
 
 	// RunSuspension is the complete workflow-safe result of stopping for external
@@ -521,6 +542,10 @@ type (
 
 		// ParentToolCallID is the identifier of the parent tool call when this invocation is nested.
 		ParentToolCallID string
+
+		// Attempt is the planner.ToolRequest attempt of this execution. Zero and
+		// one denote the first execution.
+		Attempt int
 	}
 
 	// ToolOutput is returned by tool executors after invoking the tool implementation.
@@ -549,6 +574,10 @@ type (
 		// result.
 		Failure *planner.ToolFailure
 
+		// Cached reports that the result was served from the tool result cache
+		// instead of executing the tool.
+		Cached bool
+
 		// Clarification carries an optional user question emitted with this tool
 		// result.
 		//
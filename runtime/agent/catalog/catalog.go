@@ -0,0 +1,602 @@
+// Package catalog exports the tool and completion contracts of a design as a
+// single document for consumers that do not link the generated Go packages:
+// reviewers, registries, and other agent frameworks.
+//
+// A Document is an OpenAPI 3.1 document. Every payload, result, server-data,
+// and completion schema is a component schema with a stable `$id` derived from
+// the tool or completion identifier (for example
+// `urn:goa-ai:tool:helpers.summarize_doc:payload`), so references survive
+// merges and re-generation. Toolsets, tools, and completions are described by
+// the `x-goa-ai-toolsets` and `x-goa-ai-completions` extensions, which
+// reference schemas by `$id` and name the generated Goa type in `x-goa-type`.
+// Bundle converts a Document into an equivalent JSON Schema bundle.
+//
+// goa gen emits the catalogue of a design to gen/catalog/openapi.json; the
+// goa-ai-catalog command merges catalogues and converts them to bundles.
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+const (
+	// OpenAPIVersion is the OpenAPI version of catalogue documents.
+	OpenAPIVersion = "3.1.0"
+	// SchemaDialect is the JSON Schema dialect of catalogue schemas.
+	SchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+)
+
+type (
+	// Document is an OpenAPI 3.1 catalogue of toolsets and completions.
+	Document struct {
+		// OpenAPI is the OpenAPI version, always OpenAPIVersion.
+		OpenAPI string `json:"openapi"`
+		// Info describes the catalogue.
+		Info Info `json:"info"`
+		// JSONSchemaDialect is the default dialect of component schemas.
+		JSONSchemaDialect string `json:"jsonSchemaDialect"`
+		// Components holds the schemas referenced by toolsets and completions.
+		Components Components `json:"components"`
+		// Toolsets lists the toolsets sorted by ID.
+		Toolsets []*Toolset `json:"x-goa-ai-toolsets,omitempty"`
+		// Completions lists the completions sorted by service and name.
+		Completions []*Completion `json:"x-goa-ai-completions,omitempty"`
+	}
+
+	// Info is the OpenAPI info object of a catalogue.
+	Info struct {
+		// Title names the catalogue, typically the Goa API name.
+		Title string `json:"title"`
+		// Version is the catalogue version, typically the Goa API version.
+		Version string `json:"version"`
+		// Description describes the catalogue.
+		Description string `json:"description,omitempty"`
+	}
+
+	// Components holds the catalogue schemas keyed by a name derived from
+	// their `$id`.
+	Components struct {
+		// Schemas maps component names to JSON Schema documents.
+		Schemas map[string]json.RawMessage `json:"schemas"`
+	}
+
+	// Toolset describes one toolset and its tools.
+	Toolset struct {
+		// ID is the qualified toolset name.
+		ID string `json:"id"`
+		// Service is the Goa service that declares the toolset.
+		Service string `json:"service,omitempty"`
+		// Description describes the toolset.
+		Description string `json:"description,omitempty"`
+		// Tools lists the toolset tools sorted by ID.
+		Tools []*Tool `json:"tools"`
+	}
+
+	// Tool describes one tool contract.
+	Tool struct {
+		// ID is the globally unique tool identifier (`toolset.tool`).
+		ID string `json:"id"`
+		// Description describes the tool.
+		Description string `json:"description,omitempty"`
+		// Tags lists the tool tags.
+		Tags []string `json:"tags,omitempty"`
+		// Meta carries the design-time tool metadata.
+		Meta map[string][]string `json:"meta,omitempty"`
+		// ReadOnly indicates the tool does not modify its environment.
+		ReadOnly bool `json:"read_only,omitempty"`
+		// Idempotent indicates repeated calls have no additional effect.
+		Idempotent bool `json:"idempotent,omitempty"`
+		// Destructive indicates the tool may perform irreversible updates.
+		Destructive bool `json:"destructive,omitempty"`
+		// TerminalRun indicates the run ends after the tool executes.
+		TerminalRun bool `json:"terminal_run,omitempty"`
+		// Bookkeeping indicates the tool does not consume the retrieval budget.
+		Bookkeeping bool `json:"bookkeeping,omitempty"`
+		// Agent is the ID of the agent implementing the tool, if any.
+		Agent string `json:"agent,omitempty"`
+		// Payload references the payload schema.
+		Payload *SchemaRef `json:"payload,omitempty"`
+		// Result references the result schema.
+		Result *SchemaRef `json:"result,omitempty"`
+		// Bounds describes the bounded-result contract of the tool.
+		Bounds *Bounds `json:"bounds,omitempty"`
+		// ServerData lists the server-only payloads emitted with results.
+		ServerData []*ServerData `json:"server_data,omitempty"`
+		// Confirmation describes the confirmation protocol of the tool.
+		Confirmation *Confirmation `json:"confirmation,omitempty"`
+	}
+
+	// SchemaRef references a catalogue schema by `$id`.
+	SchemaRef struct {
+		// Ref is the `$id` of the referenced schema.
+		Ref string `json:"$ref"`
+		// GoType is the generated Goa type, qualified by its import path when
+		// known.
+		GoType string `json:"x-goa-type,omitempty"`
+	}
+
+	// Bounds describes a bounded-result contract.
+	Bounds struct {
+		// Paging describes cursor-based pagination, if any.
+		Paging *Paging `json:"paging,omitempty"`
+	}
+
+	// Paging describes cursor-based pagination of a bounded tool.
+	Paging struct {
+		// CursorField is the payload field requesting subsequent pages.
+		CursorField string `json:"cursor_field"`
+		// NextCursorField is the result field holding the next-page cursor.
+		NextCursorField string `json:"next_cursor_field"`
+		// ContinueTool is the tool advancing the result set, if not the tool
+		// itself.
+		ContinueTool string `json:"continue_tool,omitempty"`
+		// SourceTool is the query tool a continuation tool advances.
+		SourceTool string `json:"source_tool,omitempty"`
+		// ReplayPayload reports whether continuations replay the source payload.
+		ReplayPayload bool `json:"replay_payload,omitempty"`
+	}
+
+	// ServerData describes one server-only payload emitted with tool results.
+	ServerData struct {
+		// Kind identifies the server-data kind.
+		Kind string `json:"kind"`
+		// Audience is the server-data audience.
+		Audience string `json:"audience"`
+		// Description describes the server data.
+		Description string `json:"description,omitempty"`
+		// Type references the server-data schema.
+		Type *SchemaRef `json:"type"`
+	}
+
+	// Confirmation describes the confirmation protocol of a tool.
+	Confirmation struct {
+		// Title is the confirmation title.
+		Title string `json:"title,omitempty"`
+		// PromptTemplate renders the confirmation prompt.
+		PromptTemplate string `json:"prompt_template"`
+		// DeniedResultTemplate renders the denied tool result.
+		DeniedResultTemplate string `json:"denied_result_template"`
+	}
+
+	// Completion describes one typed completion contract.
+	Completion struct {
+		// ID is the completion name.
+		ID string `json:"id"`
+		// Service is the Goa service that declares the completion.
+		Service string `json:"service"`
+		// Description describes the completion.
+		Description string `json:"description,omitempty"`
+		// Result references the completion result schema.
+		Result *SchemaRef `json:"result"`
+	}
+
+	// Bundle is the JSON Schema form of a Document. Schemas live under
+	// `$defs` with the same `$id`s and names as the document components.
+	Bundle struct {
+		// Schema is the bundle dialect, always SchemaDialect.
+		Schema string `json:"$schema"`
+		// ID identifies the bundle.
+		ID string `json:"$id"`
+		// Title names the bundle.
+		Title string `json:"title,omitempty"`
+		// Description describes the bundle.
+		Description string `json:"description,omitempty"`
+		// Version is the catalogue version.
+		Version string `json:"x-goa-ai-version,omitempty"`
+		// Defs holds the catalogue schemas.
+		Defs map[string]json.RawMessage `json:"$defs"`
+		// Toolsets lists the toolsets sorted by ID.
+		Toolsets []*Toolset `json:"x-goa-ai-toolsets,omitempty"`
+		// Completions lists the completions sorted by service and name.
+		Completions []*Completion `json:"x-goa-ai-completions,omitempty"`
+	}
+
+	// ToolsetSource is the input of Document.AddToolset.
+	ToolsetSource struct {
+		// ID is the qualified toolset name.
+		ID string
+		// Service is the Goa service that declares the toolset.
+		Service string
+		// Description describes the toolset.
+		Description string
+		// Package is the import path of the generated specs package. It
+		// qualifies the Goa type names recorded in x-goa-type.
+		Package string
+		// Specs are the toolset tool specs, typically the generated Specs.
+		Specs []tools.ToolSpec
+	}
+
+	// CompletionSource is the input of Document.AddCompletion.
+	CompletionSource struct {
+		// Name is the completion name.
+		Name string
+		// Service is the Goa service that declares the completion.
+		Service string
+		// Description describes the completion.
+		Description string
+		// Package is the import path of the generated completions package.
+		Package string
+		// Result describes the completion result type.
+		Result tools.TypeSpec
+	}
+)
+
+// New returns an empty catalogue.
+func New(info Info) *Document {
+	return &Document{
+		OpenAPI:           OpenAPIVersion,
+		Info:              info,
+		JSONSchemaDialect: SchemaDialect,
+		Components:        Components{Schemas: make(map[string]json.RawMessage)},
+	}
+}
+
+// Parse decodes a catalogue document.
+func Parse(data []byte) (*Document, error) {
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("catalog: decode document: %w", err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.1") {
+		return nil, fmt.Errorf("catalog: unsupported OpenAPI version %q", doc.OpenAPI)
+	}
+	if doc.Components.Schemas == nil {
+		doc.Components.Schemas = make(map[string]json.RawMessage)
+	}
+	return &doc, nil
+}
+
+// AddToolset adds a toolset and the schemas of its tools. It returns an error
+// if the toolset is already present or a tool schema is not a JSON object.
+func (d *Document) AddToolset(src ToolsetSource) error {
+	if src.ID == "" {
+		return errors.New("catalog: toolset id is required")
+	}
+	if d.toolset(src.ID) != nil {
+		return fmt.Errorf("catalog: duplicate toolset %q", src.ID)
+	}
+	schemas := make(map[string]json.RawMessage)
+	ts := &Toolset{
+		ID:          src.ID,
+		Service:     src.Service,
+		Description: src.Description,
+		Tools:       make([]*Tool, 0, len(src.Specs)),
+	}
+	for i := range src.Specs {
+		tool, err := buildTool(&src.Specs[i], src.Package, schemas)
+		if err != nil {
+			return err
+		}
+		ts.Tools = append(ts.Tools, tool)
+	}
+	slices.SortFunc(ts.Tools, func(a, b *Tool) int { return strings.Compare(a.ID, b.ID) })
+	if err := d.addSchemas(schemas); err != nil {
+		return err
+	}
+	d.Toolsets = append(d.Toolsets, ts)
+	d.sort()
+	return nil
+}
+
+// AddCompletion adds a completion and its result schema. It returns an error
+// if the completion is already present.
+func (d *Document) AddCompletion(src CompletionSource) error {
+	if src.Name == "" {
+		return errors.New("catalog: completion name is required")
+	}
+	if d.completion(src.Service, src.Name) != nil {
+		return fmt.Errorf("catalog: duplicate completion %q", src.Service+"."+src.Name)
+	}
+	schemas := make(map[string]json.RawMessage)
+	id := schemaID("completion", src.Service+"."+src.Name, "result")
+	ref, err := addSchema(schemas, id, src.Package, &src.Result)
+	if err != nil {
+		return err
+	}
+	if err := d.addSchemas(schemas); err != nil {
+		return err
+	}
+	d.Completions = append(d.Completions, &Completion{
+		ID:          src.Name,
+		Service:     src.Service,
+		Description: src.Description,
+		Result:      ref,
+	})
+	d.sort()
+	return nil
+}
+
+// Merge adds the toolsets and completions of other to d. Entries present in
+// both documents must be identical, including their schemas.
+func (d *Document) Merge(other *Document) error {
+	for _, ts := range other.Toolsets {
+		schemas, err := other.schemasOf(toolsetRefs(ts))
+		if err != nil {
+			return err
+		}
+		if existing := d.toolset(ts.ID); existing != nil {
+			if err := d.sameEntry(existing, ts, schemas); err != nil {
+				return fmt.Errorf("catalog: toolset %q: %w", ts.ID, err)
+			}
+			continue
+		}
+		if err := d.addSchemas(schemas); err != nil {
+			return err
+		}
+		d.Toolsets = append(d.Toolsets, ts)
+	}
+	for _, c := range other.Completions {
+		schemas, err := other.schemasOf([]*SchemaRef{c.Result})
+		if err != nil {
+			return err
+		}
+		if existing := d.completion(c.Service, c.ID); existing != nil {
+			if err := d.sameEntry(existing, c, schemas); err != nil {
+				return fmt.Errorf("catalog: completion %q: %w", c.Service+"."+c.ID, err)
+			}
+			continue
+		}
+		if err := d.addSchemas(schemas); err != nil {
+			return err
+		}
+		d.Completions = append(d.Completions, c)
+	}
+	d.sort()
+	return nil
+}
+
+// Bundle returns the JSON Schema bundle form of the document.
+func (d *Document) Bundle() *Bundle {
+	return &Bundle{
+		Schema:      SchemaDialect,
+		ID:          "urn:goa-ai:catalog:" + escapeNSS(d.Info.Title),
+		Title:       d.Info.Title,
+		Description: d.Info.Description,
+		Version:     d.Info.Version,
+		Defs:        d.Components.Schemas,
+		Toolsets:    d.Toolsets,
+		Completions: d.Completions,
+	}
+}
+
+// SchemaKey returns the component (and bundle `$defs`) name of the schema
+// with the given `$id`.
+func SchemaKey(id string) string {
+	key := strings.ReplaceAll(strings.TrimPrefix(id, "urn:goa-ai:"), ":", ".")
+	return strings.Map(func(r rune) rune {
+		if isNameRune(r) {
+			return r
+		}
+		return '_'
+	}, key)
+}
+
+// buildTool converts spec into a catalogue tool and records its schemas.
+func buildTool(spec *tools.ToolSpec, pkg string, schemas map[string]json.RawMessage) (*Tool, error) {
+	id := string(spec.Name)
+	if id == "" {
+		return nil, errors.New("catalog: tool name is required")
+	}
+	tool := &Tool{
+		ID:          id,
+		Description: spec.Description,
+		Tags:        spec.Tags,
+		Meta:        spec.Meta,
+		ReadOnly:    spec.ReadOnly,
+		Idempotent:  spec.Idempotent,
+		Destructive: spec.Destructive,
+		TerminalRun: spec.TerminalRun,
+		Bookkeeping: spec.Bookkeeping,
+	}
+	if spec.IsAgentTool {
+		tool.Agent = spec.AgentID
+	}
+	var err error
+	if tool.Payload, err = addSchema(schemas, schemaID("tool", id, "payload"), pkg, &spec.Payload); err != nil {
+		return nil, err
+	}
+	if tool.Result, err = addSchema(schemas, schemaID("tool", id, "result"), pkg, &spec.Result); err != nil {
+		return nil, err
+	}
+	for _, sd := range spec.ServerData {
+		if sd == nil {
+			continue
+		}
+		ref, err := addSchema(schemas, schemaID("tool", id, "server-data:"+escapeNSS(sd.Kind)), pkg, &sd.Type)
+		if err != nil {
+			return nil, err
+		}
+		tool.ServerData = append(tool.ServerData, &ServerData{
+			Kind:        sd.Kind,
+			Audience:    string(sd.Audience),
+			Description: sd.Description,
+			Type:        ref,
+		})
+	}
+	if b := spec.Bounds; b != nil {
+		tool.Bounds = &Bounds{}
+		if p := b.Paging; p != nil {
+			tool.Bounds.Paging = &Paging{
+				CursorField:     p.CursorField,
+				NextCursorField: p.NextCursorField,
+				ContinueTool:    string(p.ContinueTool),
+				SourceTool:      string(p.SourceTool),
+				ReplayPayload:   p.ReplayPayload,
+			}
+		}
+	}
+	if c := spec.Confirmation; c != nil {
+		tool.Confirmation = &Confirmation{
+			Title:                c.Title,
+			PromptTemplate:       c.PromptTemplate,
+			DeniedResultTemplate: c.DeniedResultTemplate,
+		}
+	}
+	return tool, nil
+}
+
+// addSchema records the schema of ts under id and returns a reference to it.
+// It returns nil when ts describes no type. The recorded schema carries the
+// `$id` so relative references inside it resolve against the schema itself
+// wherever it is embedded.
+func addSchema(schemas map[string]json.RawMessage, id, pkg string, ts *tools.TypeSpec) (*SchemaRef, error) {
+	if ts.Name == "" && len(ts.Schema) == 0 {
+		return nil, nil
+	}
+	doc := make(map[string]json.RawMessage)
+	if len(bytes.TrimSpace(ts.Schema)) > 0 {
+		if err := json.Unmarshal(ts.Schema, &doc); err != nil {
+			return nil, fmt.Errorf("catalog: decode schema %s: %w", id, err)
+		}
+	}
+	ref := &SchemaRef{Ref: id}
+	if ts.Name != "" {
+		ref.GoType = ts.Name
+		if pkg != "" {
+			ref.GoType = pkg + "." + ts.Name
+		}
+		doc["x-goa-type"], _ = json.Marshal(ref.GoType)
+	}
+	doc["$id"], _ = json.Marshal(id)
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("catalog: encode schema %s: %w", id, err)
+	}
+	schemas[SchemaKey(id)] = raw
+	return ref, nil
+}
+
+// addSchemas adds schemas to the document components. Existing schemas must
+// be identical.
+func (d *Document) addSchemas(schemas map[string]json.RawMessage) error {
+	for key, schema := range schemas {
+		if existing, ok := d.Components.Schemas[key]; ok && !jsonEqual(existing, schema) {
+			return fmt.Errorf("catalog: conflicting definitions for schema %q", key)
+		}
+	}
+	for key, schema := range schemas {
+		d.Components.Schemas[key] = schema
+	}
+	return nil
+}
+
+// schemasOf returns the component schemas referenced by refs.
+func (d *Document) schemasOf(refs []*SchemaRef) (map[string]json.RawMessage, error) {
+	schemas := make(map[string]json.RawMessage, len(refs))
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		key := SchemaKey(ref.Ref)
+		schema, ok := d.Components.Schemas[key]
+		if !ok {
+			return nil, fmt.Errorf("catalog: missing schema %q", ref.Ref)
+		}
+		schemas[key] = schema
+	}
+	return schemas, nil
+}
+
+// sameEntry reports an error if existing and entry differ or if schemas
+// conflict with the document schemas.
+func (d *Document) sameEntry(existing, entry any, schemas map[string]json.RawMessage) error {
+	a, err := json.Marshal(existing)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(a, b) {
+		return errors.New("conflicting definitions")
+	}
+	for key, schema := range schemas {
+		if !jsonEqual(d.Components.Schemas[key], schema) {
+			return fmt.Errorf("conflicting definitions for schema %q", key)
+		}
+	}
+	return nil
+}
+
+func (d *Document) toolset(id string) *Toolset {
+	for _, ts := range d.Toolsets {
+		if ts.ID == id {
+			return ts
+		}
+	}
+	return nil
+}
+
+func (d *Document) completion(service, name string) *Completion {
+	for _, c := range d.Completions {
+		if c.Service == service && c.ID == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// sort orders toolsets and completions deterministically.
+func (d *Document) sort() {
+	slices.SortFunc(d.Toolsets, func(a, b *Toolset) int { return strings.Compare(a.ID, b.ID) })
+	slices.SortFunc(d.Completions, func(a, b *Completion) int {
+		if c := strings.Compare(a.Service, b.Service); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+}
+
+// toolsetRefs returns the schema references of the toolset tools.
+func toolsetRefs(ts *Toolset) []*SchemaRef {
+	var refs []*SchemaRef
+	for _, t := range ts.Tools {
+		refs = append(refs, t.Payload, t.Result)
+		for _, sd := range t.ServerData {
+			refs = append(refs, sd.Type)
+		}
+	}
+	return refs
+}
+
+// schemaID returns the stable `$id` of a catalogue schema.
+func schemaID(kind, ident, part string) string {
+	return "urn:goa-ai:" + kind + ":" + escapeNSS(ident) + ":" + part
+}
+
+// escapeNSS percent-encodes s for use in a URN namespace-specific string.
+func escapeNSS(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if isNameRune(rune(c)) {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+// isNameRune reports whether r is valid in component names and URNs as is.
+func isNameRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '_' || r == '-'
+}
+
+// jsonEqual reports whether a and b encode the same JSON value.
+func jsonEqual(a, b json.RawMessage) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	ca, _ := json.Marshal(va)
+	cb, _ := json.Marshal(vb)
+	return bytes.Equal(ca, cb)
+}
@@ -0,0 +1,124 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+const docPayloadSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"type": "object",
+	"properties": {"doc": {"$ref": "#/$defs/Doc"}},
+	"required": ["doc"],
+	"$defs": {"Doc": {"type": "object", "properties": {"id": {"type": "string"}}, "required": ["id"]}}
+}`
+
+func helpersSource() ToolsetSource {
+	return ToolsetSource{
+		ID:      "helpers",
+		Service: "calc",
+		Package: "example.com/calc/gen/calc/toolsets/helpers",
+		Specs: []tools.ToolSpec{
+			{
+				Name:       "helpers.summarize_doc",
+				ReadOnly:   true,
+				Idempotent: true,
+				Payload:    tools.TypeSpec{Name: "SummarizeDocPayload", Schema: tools.RawJSON(docPayloadSchema)},
+				Result:     tools.TypeSpec{Name: "SummarizeDocResult", Schema: tools.RawJSON(`{"type":"object"}`)},
+				Bounds:     &tools.BoundsSpec{Paging: &tools.PagingSpec{CursorField: "cursor", NextCursorField: "next_cursor"}},
+				ServerData: []*tools.ServerDataSpec{{
+					Kind:     "doc.preview",
+					Audience: tools.AudienceTimeline,
+					Type:     tools.TypeSpec{Name: "DocPreview", Schema: tools.RawJSON(`{"type":"string"}`)},
+				}},
+			},
+		},
+	}
+}
+
+func TestAddToolset(t *testing.T) {
+	doc := New(Info{Title: "calc", Version: "1.0"})
+	require.NoError(t, doc.AddToolset(helpersSource()))
+	require.NoError(t, doc.AddCompletion(CompletionSource{
+		Name:    "draft_reply",
+		Service: "calc",
+		Result:  tools.TypeSpec{Name: "DraftReply", Schema: tools.RawJSON(`{"type":"object"}`)},
+	}))
+
+	require.Len(t, doc.Toolsets, 1)
+	tool := doc.Toolsets[0].Tools[0]
+	assert.Equal(t, &SchemaRef{
+		Ref:    "urn:goa-ai:tool:helpers.summarize_doc:payload",
+		GoType: "example.com/calc/gen/calc/toolsets/helpers.SummarizeDocPayload",
+	}, tool.Payload)
+	assert.Equal(t, "urn:goa-ai:tool:helpers.summarize_doc:server-data:doc.preview", tool.ServerData[0].Type.Ref)
+	assert.Equal(t, "next_cursor", tool.Bounds.Paging.NextCursorField)
+	assert.Equal(t, "urn:goa-ai:completion:calc.draft_reply:result", doc.Completions[0].Result.Ref)
+
+	assert.ElementsMatch(t, []string{
+		"tool.helpers.summarize_doc.payload",
+		"tool.helpers.summarize_doc.result",
+		"tool.helpers.summarize_doc.server-data.doc.preview",
+		"completion.calc.draft_reply.result",
+	}, keys(doc.Components.Schemas))
+
+	assert.EqualError(t, doc.AddToolset(helpersSource()), `catalog: duplicate toolset "helpers"`)
+}
+
+func TestBundleResolvesReferences(t *testing.T) {
+	doc := New(Info{Title: "calc", Version: "1.0"})
+	require.NoError(t, doc.AddToolset(helpersSource()))
+	bundle := doc.Bundle()
+	data, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	parsed, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	c := jsonschema.NewCompiler()
+	require.NoError(t, c.AddResource(bundle.ID, parsed))
+	schema, err := c.Compile(bundle.ID + "#/$defs/" + SchemaKey(doc.Toolsets[0].Tools[0].Payload.Ref))
+	require.NoError(t, err)
+	assert.NoError(t, schema.Validate(map[string]any{"doc": map[string]any{"id": "d1"}}))
+	assert.Error(t, schema.Validate(map[string]any{"doc": map[string]any{}}))
+}
+
+func TestMergeAndParse(t *testing.T) {
+	a := New(Info{Title: "a", Version: "1"})
+	require.NoError(t, a.AddToolset(helpersSource()))
+	data, err := json.Marshal(a)
+	require.NoError(t, err)
+	parsed, err := Parse(data)
+	require.NoError(t, err)
+
+	b := New(Info{Title: "b", Version: "1"})
+	require.NoError(t, b.AddToolset(ToolsetSource{
+		ID:    "search",
+		Specs: []tools.ToolSpec{{Name: "search.query", Payload: tools.TypeSpec{Name: "QueryPayload"}}},
+	}))
+	require.NoError(t, b.Merge(parsed))
+	require.NoError(t, b.Merge(parsed), "identical entries merge")
+	require.Len(t, b.Toolsets, 2)
+	assert.Equal(t, "helpers", b.Toolsets[0].ID)
+	assert.Len(t, b.Components.Schemas, 4)
+
+	conflict := New(Info{Title: "c", Version: "1"})
+	src := helpersSource()
+	src.Specs[0].Result.Schema = tools.RawJSON(`{"type":"string"}`)
+	require.NoError(t, conflict.AddToolset(src))
+	assert.ErrorContains(t, b.Merge(conflict), `catalog: toolset "helpers": conflicting definitions`)
+}
+
+func keys(m map[string]json.RawMessage) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
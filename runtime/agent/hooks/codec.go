@@ -62,6 +62,7 @@ type (
 		Duration            time.Duration            `json:"duration"`
 		Telemetry           *telemetry.ToolTelemetry `json:"telemetry,omitempty"`
 		Failure             *planner.ToolFailure     `json:"failure,omitempty"`
+		Cached              bool                     `json:"cached,omitempty"`
 	}
 )
 
@@ -121,6 +122,7 @@ func EncodeToRecordInput(evt Event, opts EncodeOptions) (*runlog.ActivityInput,
 			Duration:            e.Duration,
 			Telemetry:           e.Telemetry,
 			Failure:             e.Failure,
+			Cached:              e.Cached,
 		}
 		b, err := json.Marshal(p)
 		if err != nil {
@@ -210,6 +212,13 @@ func DecodeFromRecordInput(input *runlog.ActivityInput) (Event, error) {
 		}
 		evt = NewChildRunLinkedEvent(input.RunID, input.AgentID, input.SessionID, p.ToolName, p.ToolCallID, p.ChildRunID, p.ChildAgentID)
 
+	case AgentHandoff:
+		var p AgentHandoffEvent
+		if err := json.Unmarshal(input.Payload, &p); err != nil {
+			return nil, fmt.Errorf("decode %s payload: %w", AgentHandoff, err)
+		}
+		evt = NewAgentHandoffEvent(input.RunID, input.AgentID, input.SessionID, p.TargetAgentID, p.TargetRunID, p.Reason)
+
 	case ToolCallArgsDelta:
 		var p ToolCallArgsDeltaEvent
 		if err := json.Unmarshal(input.Payload, &p); err != nil {
@@ -217,6 +226,13 @@ func DecodeFromRecordInput(input *runlog.ActivityInput) (Event, error) {
 		}
 		evt = NewToolCallArgsDeltaEvent(input.RunID, input.AgentID, input.SessionID, p.ToolCallID, p.ToolName, p.Delta)
 
+	case ToolCallProgress:
+		var p ToolCallProgressEvent
+		if err := json.Unmarshal(input.Payload, &p); err != nil {
+			return nil, fmt.Errorf("decode %s payload: %w", ToolCallProgress, err)
+		}
+		evt = NewToolCallProgressEvent(input.RunID, input.AgentID, input.SessionID, p.ToolName, p.ToolCallID, p.ParentToolCallID, p.Progress)
+
 	case AwaitClarification:
 		var p AwaitClarificationEvent
 		if err := json.Unmarshal(input.Payload, &p); err != nil {
@@ -313,7 +329,7 @@ func DecodeFromRecordInput(input *runlog.ActivityInput) (Event, error) {
 		if err := json.Unmarshal(input.Payload, &p); err != nil {
 			return nil, fmt.Errorf("decode %s payload: %w", ToolResultReceived, err)
 		}
-		evt = NewToolResultReceivedEvent(
+		e := NewToolResultReceivedEvent(
 			input.RunID,
 			input.AgentID,
 			input.SessionID,
@@ -332,6 +348,8 @@ func DecodeFromRecordInput(input *runlog.ActivityInput) (Event, error) {
 			p.Telemetry,
 			p.Failure,
 		)
+		e.Cached = p.Cached
+		evt = e
 
 	case PolicyDecision:
 		var p PolicyDecisionEvent
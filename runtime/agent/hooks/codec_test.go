@@ -76,6 +76,7 @@ func TestDecodeFromRecordInput_ToolResultReceivedPreservesServerDataBytes(t *tes
 		nil,
 		nil,
 	)
+	ev.Cached = true
 
 	in, err := EncodeToRecordInput(ev, EncodeOptions{
 		EventKey:    "evt-tool-result",
@@ -96,6 +97,7 @@ func TestDecodeFromRecordInput_ToolResultReceivedPreservesServerDataBytes(t *tes
 	require.False(t, tr.ResultOmitted)
 	require.Empty(t, tr.ResultOmittedReason)
 	require.JSONEq(t, string(serverData), string(tr.ServerData))
+	require.True(t, tr.Cached)
 }
 
 func TestDecodeFromRecordInput_PromptRenderedRoundTrip(t *testing.T) {
@@ -150,6 +150,20 @@ type (
 		ChildAgentID agent.Ident
 	}
 
+	// AgentHandoffEvent records that a run ended by transferring the
+	// conversation to another agent. It is emitted on the source run before
+	// the terminal RunCompletedEvent; the target run belongs to the same
+	// session and is identified by TargetRunID.
+	AgentHandoffEvent struct {
+		baseEvent
+		// TargetAgentID is the identifier of the agent taking over.
+		TargetAgentID agent.Ident
+		// TargetRunID is the run identifier reserved for the target agent.
+		TargetRunID string
+		// Reason is the optional planner-provided explanation.
+		Reason string
+	}
+
 	// RunPhaseChangedEvent fires when a run transitions between lifecycle phases
 	// (prompted, planning, executing_tools, synthesizing, completed, failed,
 	// canceled). This is a higher-fidelity signal than Status and is primarily
@@ -257,6 +271,9 @@ type (
 		// Failure is the canonical failure classification and recovery contract.
 		// Nil on success.
 		Failure *planner.ToolFailure
+		// Cached reports that the result was served from the tool result cache
+		// instead of executing the tool.
+		Cached bool
 	}
 
 	// ToolCallUpdatedEvent fires when a tool call's metadata is updated after
@@ -291,6 +308,28 @@ type (
 		Delta string
 	}
 
+	// ToolCallProgressEvent fires when a running tool reports a typed partial
+	// result (for example, percent complete or a batch of rows).
+	//
+	// Contract:
+	//   - This event is best-effort and may be ignored or dropped entirely.
+	//   - Progress is canonical JSON validated against the tool's progress
+	//     schema (tools.ToolSpec.Progress).
+	//   - The canonical tool outcome is still emitted via
+	//     ToolResultReceivedEvent.
+	ToolCallProgressEvent struct {
+		baseEvent
+		// ToolCallID identifies the tool call reporting progress.
+		ToolCallID string
+		// ParentToolCallID optionally identifies the parent tool call when the
+		// tool runs as part of an agent-as-tool run.
+		ParentToolCallID string
+		// ToolName is the canonical tool identifier.
+		ToolName tools.Ident
+		// Progress is the canonical JSON progress value.
+		Progress rawjson.Message
+	}
+
 	// PlannerNoteEvent fires when the planner emits an annotation or
 	// intermediate thought during execution.
 	PlannerNoteEvent struct {
@@ -715,6 +754,19 @@ func NewChildRunLinkedEvent(runID string, agentID agent.Ident, sessionID string,
 	}
 }
 
+// NewAgentHandoffEvent constructs an AgentHandoffEvent for the given source
+// run and handoff target.
+func NewAgentHandoffEvent(runID string, agentID agent.Ident, sessionID string, targetAgentID agent.Ident, targetRunID, reason string) *AgentHandoffEvent {
+	be := newBaseEvent(runID, agentID)
+	be.sessionID = sessionID
+	return &AgentHandoffEvent{
+		baseEvent:     be,
+		TargetAgentID: targetAgentID,
+		TargetRunID:   targetRunID,
+		Reason:        reason,
+	}
+}
+
 // NewRunPhaseChangedEvent constructs a RunPhaseChangedEvent for the given run
 // and agent.
 func NewRunPhaseChangedEvent(runID string, agentID agent.Ident, sessionID string, phase run.Phase) *RunPhaseChangedEvent {
@@ -933,6 +985,19 @@ func NewToolCallArgsDeltaEvent(runID string, agentID agent.Ident, sessionID stri
 	}
 }
 
+// NewToolCallProgressEvent constructs a ToolCallProgressEvent.
+func NewToolCallProgressEvent(runID string, agentID agent.Ident, sessionID string, toolName tools.Ident, toolCallID, parentToolCallID string, progress rawjson.Message) *ToolCallProgressEvent {
+	be := newBaseEvent(runID, agentID)
+	be.sessionID = sessionID
+	return &ToolCallProgressEvent{
+		baseEvent:        be,
+		ToolCallID:       toolCallID,
+		ParentToolCallID: parentToolCallID,
+		ToolName:         toolName,
+		Progress:         progress,
+	}
+}
+
 // NewUsageEvent constructs a UsageEvent from an attributed usage snapshot.
 func NewUsageEvent(runID string, agentID agent.Ident, sessionID string, usage model.TokenUsage) *UsageEvent {
 	be := newBaseEvent(runID, agentID)
@@ -1149,6 +1214,7 @@ func (e *ToolCallScheduledEvent) Type() EventType  { return ToolCallScheduled }
 func (e *ToolResultReceivedEvent) Type() EventType { return ToolResultReceived }
 func (e *ToolCallUpdatedEvent) Type() EventType    { return ToolCallUpdated }
 func (e *ToolCallArgsDeltaEvent) Type() EventType  { return ToolCallArgsDelta }
+func (e *ToolCallProgressEvent) Type() EventType   { return ToolCallProgress }
 func (e *PlannerNoteEvent) Type() EventType        { return PlannerNote }
 func (e *AssistantMessageEvent) Type() EventType   { return AssistantMessage }
 func (e *AssistantTurnCommittedEvent) Type() EventType {
@@ -1161,4 +1227,5 @@ func (e *UsageEvent) Type() EventType           { return Usage }
 func (e *HardProtectionEvent) Type() EventType  { return HardProtectionTriggered }
 func (e *RunPhaseChangedEvent) Type() EventType { return RunPhaseChanged }
 func (e *ChildRunLinkedEvent) Type() EventType  { return ChildRunLinked }
+func (e *AgentHandoffEvent) Type() EventType    { return AgentHandoff }
 func (e *PromptRenderedEvent) Type() EventType  { return PromptRendered }
@@ -113,6 +113,14 @@ const (
 	// tools. The Payload contains the updated expected child count.
 	ToolCallUpdated EventType = "tool_call_updated"
 
+	// ToolCallProgress fires when a running tool reports a typed partial
+	// result declared with the Progress DSL. The Payload carries the progress
+	// JSON validated against the tool's progress schema.
+	//
+	// Like ToolCallArgsDelta, this event is best-effort and intended for
+	// streaming UX only; the canonical outcome is still ToolResultReceived.
+	ToolCallProgress EventType = "tool_call_progress"
+
 	// PlannerNote fires when the planner emits an annotation or intermediate
 	// thought. The Payload contains the note text and optional labels for
 	// categorization.
@@ -161,6 +169,10 @@ const (
 	// Payload is a ChildRunLinkedEvent.
 	ChildRunLinked EventType = "child_run_linked"
 
+	// AgentHandoff fires when a planner transfers the conversation to another
+	// agent. Payload is an AgentHandoffEvent.
+	AgentHandoff EventType = "agent_handoff"
+
 	// PromptRendered fires when the runtime resolves and renders a prompt
 	// template for a run.
 	PromptRendered EventType = "prompt_rendered"
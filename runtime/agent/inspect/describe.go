@@ -0,0 +1,112 @@
+package inspect
+
+import (
+	"fmt"
+	"strings"
+
+	"goa.design/goa-ai/runtime/agent/hooks"
+)
+
+// maxSummaryText bounds free text (notes, assistant messages, prompts) copied
+// into one-line summaries.
+const maxSummaryText = 120
+
+// describe fills the summary and detail of entry from a decoded hook event and
+// accumulates token usage.
+func describe(entry *Entry, evt hooks.Event, usage *Usage) {
+	switch e := evt.(type) {
+	case *hooks.RunStartedEvent:
+		entry.Summary = "run started"
+		if e.RunContext.ParentRunID != "" {
+			entry.Summary += fmt.Sprintf(" (parent run %s)", e.RunContext.ParentRunID)
+		}
+	case *hooks.RunPhaseChangedEvent:
+		entry.Summary = "phase " + string(e.Phase)
+	case *hooks.PromptRenderedEvent:
+		entry.Summary = fmt.Sprintf("prompt %s@%s rendered", e.PromptID, e.Version)
+	case *hooks.ToolCallScheduledEvent:
+		entry.Summary = fmt.Sprintf("tool call %s [%s]", e.ToolName, e.ToolCallID)
+		if e.ParentToolCallID != "" {
+			entry.Summary += " parent=" + e.ParentToolCallID
+		}
+		entry.Detail = e.Payload
+	case *hooks.ToolCallUpdatedEvent:
+		entry.Summary = fmt.Sprintf("tool call [%s] expects %d child call(s)", e.ToolCallID, e.ExpectedChildrenTotal)
+	case *hooks.ToolResultReceivedEvent:
+		entry.Summary = fmt.Sprintf("tool result %s [%s] in %s", e.ToolName, e.ToolCallID, e.Duration)
+		if e.Failure != nil {
+			entry.Failed = true
+			msg := ""
+			if e.Failure.Error != nil {
+				msg = e.Failure.Error.Message
+			}
+			entry.Summary = fmt.Sprintf("tool error %s [%s]: %s", e.ToolName, e.ToolCallID, msg)
+		}
+		if e.ResultOmitted {
+			entry.Summary += " (result omitted: " + e.ResultOmittedReason + ")"
+		}
+		entry.Detail = e.ResultJSON
+	case *hooks.ChildRunLinkedEvent:
+		entry.Summary = fmt.Sprintf("child run %s (%s) for %s [%s]", e.ChildRunID, e.ChildAgentID, e.ToolName, e.ToolCallID)
+		entry.childRunID = e.ChildRunID
+	case *hooks.AgentHandoffEvent:
+		entry.Summary = fmt.Sprintf("handoff to %s (run %s)", e.TargetAgentID, e.TargetRunID)
+		if e.Reason != "" {
+			entry.Summary += ": " + truncate(e.Reason)
+		}
+		entry.handoffRunID = e.TargetRunID
+	case *hooks.AwaitClarificationEvent:
+		entry.Summary = "await clarification: " + truncate(e.Question)
+	case *hooks.AwaitQuestionsEvent:
+		entry.Summary = fmt.Sprintf("await %d question(s) for %s [%s]", len(e.Questions), e.ToolName, e.ToolCallID)
+	case *hooks.AwaitConfirmationEvent:
+		entry.Summary = fmt.Sprintf("await confirmation for %s [%s]", e.ToolName, e.ToolCallID)
+		entry.Detail = e.Payload
+	case *hooks.AwaitExternalToolsEvent:
+		names := make([]string, len(e.Items))
+		for i, item := range e.Items {
+			names[i] = string(item.ToolName)
+		}
+		entry.Summary = "await external tools: " + strings.Join(names, ", ")
+	case *hooks.ToolAuthorizationEvent:
+		decision := "denied"
+		if e.Approved {
+			decision = "approved"
+		}
+		entry.Summary = fmt.Sprintf("tool %s [%s] %s by %s", e.ToolName, e.ToolCallID, decision, e.ApprovedBy)
+	case *hooks.RunSuspendedEvent:
+		entry.Summary = fmt.Sprintf("run suspended with %d pending item(s)", e.PendingCount)
+	case *hooks.PlannerNoteEvent:
+		entry.Summary = "planner note: " + truncate(e.Note)
+	case *hooks.ThinkingBlockEvent:
+		entry.Summary = "thinking: " + truncate(e.Text)
+	case *hooks.AssistantMessageEvent:
+		entry.Summary = "assistant: " + truncate(e.Message)
+	case *hooks.UsageEvent:
+		usage.InputTokens += e.InputTokens
+		usage.OutputTokens += e.OutputTokens
+		usage.CacheReadTokens += e.CacheReadTokens
+		usage.CacheWriteTokens += e.CacheWriteTokens
+		entry.Summary = fmt.Sprintf("usage %s (%s): input=%d output=%d", e.Model, e.ModelClass, e.InputTokens, e.OutputTokens)
+	case *hooks.RunCompletedEvent:
+		entry.Summary = "run " + e.Status
+		switch {
+		case e.Failure != nil:
+			entry.Failed = true
+			entry.Summary += ": " + e.Failure.Message
+		case e.Cancellation != nil && e.Cancellation.Reason != "":
+			entry.Summary += ": " + e.Cancellation.Reason
+		}
+	default:
+		entry.Summary = string(evt.Type())
+	}
+}
+
+// truncate collapses whitespace and bounds text for one-line summaries.
+func truncate(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if r := []rune(s); len(r) > maxSummaryText {
+		return string(r[:maxSummaryText]) + "…"
+	}
+	return s
+}
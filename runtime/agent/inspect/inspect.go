@@ -0,0 +1,246 @@
+// Package inspect renders run timelines from the session and run log stores.
+//
+// It backs the goa-ai-inspect command and can be embedded in operator tooling.
+// Timelines are derived from canonical run log records only; the inspector
+// never writes to the stores.
+package inspect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goa.design/goa-ai/runtime/agent/hooks"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/runlog"
+	"goa.design/goa-ai/runtime/agent/session"
+	"goa.design/goa-ai/runtime/agent/transcript"
+)
+
+type (
+	// Inspector reads sessions, runs, and run logs for debugging.
+	Inspector struct {
+		sessions session.Store
+		runs     runlog.Store
+	}
+
+	// Timeline is the ordered history of one run. Children and Handoff are
+	// populated by RunTree only.
+	Timeline struct {
+		// RunID identifies the run.
+		RunID string `json:"run_id"`
+		// AgentID identifies the agent that executed the run.
+		AgentID string `json:"agent_id,omitempty"`
+		// SessionID identifies the session that owns the run.
+		SessionID string `json:"session_id,omitempty"`
+		// Run is the session store metadata of the run. Nil for one-shot runs
+		// or when the inspector has no session store.
+		Run *session.RunMeta `json:"run,omitempty"`
+		// Entries lists the run log records, oldest first.
+		Entries []Entry `json:"entries"`
+		// Usage sums the token usage reported by the run.
+		Usage Usage `json:"usage"`
+		// Children lists the timelines of nested agent runs.
+		Children []*Timeline `json:"children,omitempty"`
+		// Handoff is the timeline of the run that took over the conversation
+		// when this run ended with a handoff.
+		Handoff *Timeline `json:"handoff,omitempty"`
+	}
+
+	// Entry is one run log record rendered for humans.
+	Entry struct {
+		// Time is the record timestamp.
+		Time time.Time `json:"time"`
+		// Type is the run log record type.
+		Type runlog.Type `json:"type"`
+		// Summary is a one-line description of the record.
+		Summary string `json:"summary"`
+		// Detail carries the record's JSON body worth showing in full: tool
+		// arguments, tool results, or the raw payload of records the inspector
+		// does not know how to decode.
+		Detail rawjson.Message `json:"detail,omitempty"`
+		// Failed reports whether the record describes a failure.
+		Failed bool `json:"failed,omitempty"`
+
+		childRunID   string
+		handoffRunID string
+	}
+
+	// Usage sums token counts.
+	Usage struct {
+		InputTokens      int `json:"input_tokens"`
+		OutputTokens     int `json:"output_tokens"`
+		CacheReadTokens  int `json:"cache_read_tokens,omitempty"`
+		CacheWriteTokens int `json:"cache_write_tokens,omitempty"`
+	}
+)
+
+// ErrListSessionsUnsupported indicates the session store does not implement
+// session.Lister.
+var ErrListSessionsUnsupported = errors.New("session store does not support listing sessions")
+
+// pageSize is the number of run log records fetched per List call.
+const pageSize = 500
+
+// New returns an Inspector reading from the given stores. sessions may be nil
+// when only run logs are available.
+func New(sessions session.Store, runs runlog.Store) *Inspector {
+	return &Inspector{sessions: sessions, runs: runs}
+}
+
+// Sessions lists sessions, most recent first, optionally filtered by status.
+// It returns ErrListSessionsUnsupported when the session store cannot
+// enumerate sessions.
+func (i *Inspector) Sessions(ctx context.Context, statuses []session.SessionStatus, limit int) ([]session.Session, error) {
+	lister, ok := i.sessions.(session.Lister)
+	if !ok {
+		return nil, ErrListSessionsUnsupported
+	}
+	return lister.ListSessions(ctx, statuses, limit)
+}
+
+// Runs lists the runs of a session, optionally filtered by status.
+func (i *Inspector) Runs(ctx context.Context, sessionID string, statuses []session.RunStatus) ([]session.RunMeta, error) {
+	if i.sessions == nil {
+		return nil, errors.New("session store is required to list runs")
+	}
+	return i.sessions.ListRunsBySession(ctx, sessionID, statuses)
+}
+
+// Timeline returns the timeline of a single run.
+func (i *Inspector) Timeline(ctx context.Context, runID string) (*Timeline, error) {
+	if runID == "" {
+		return nil, errors.New("run id is required")
+	}
+	events, err := i.events(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("run %q has no run log records", runID)
+	}
+	t := &Timeline{
+		RunID:     runID,
+		AgentID:   string(events[0].AgentID),
+		SessionID: events[0].SessionID,
+		Entries:   make([]Entry, 0, len(events)),
+	}
+	if i.sessions != nil {
+		meta, err := i.sessions.LoadRun(ctx, runID)
+		switch {
+		case err == nil:
+			t.Run = &meta
+		case !errors.Is(err, session.ErrRunNotFound):
+			return nil, err
+		}
+	}
+	for _, e := range events {
+		t.Entries = append(t.Entries, t.entry(e))
+	}
+	return t, nil
+}
+
+// RunTree returns the timeline of a run together with the timelines of its
+// nested agent runs and of the run it handed off to, recursively. The result
+// is self-contained and suitable for attaching to bug reports.
+func (i *Inspector) RunTree(ctx context.Context, runID string) (*Timeline, error) {
+	return i.runTree(ctx, runID, make(map[string]struct{}))
+}
+
+func (i *Inspector) runTree(ctx context.Context, runID string, seen map[string]struct{}) (*Timeline, error) {
+	seen[runID] = struct{}{}
+	t, err := i.Timeline(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range t.Entries {
+		if e.childRunID != "" {
+			if _, ok := seen[e.childRunID]; ok {
+				continue
+			}
+			child, err := i.runTree(ctx, e.childRunID, seen)
+			if err != nil {
+				return nil, fmt.Errorf("child run %q: %w", e.childRunID, err)
+			}
+			t.Children = append(t.Children, child)
+		}
+	}
+	target := ""
+	if t.Run != nil {
+		target = t.Run.HandoffToRunID
+	}
+	for _, e := range t.Entries {
+		if e.handoffRunID != "" {
+			target = e.handoffRunID
+		}
+	}
+	if target != "" {
+		if _, ok := seen[target]; !ok {
+			// The handoff target may not have started yet; its absence is
+			// not an inspection failure.
+			if h, err := i.runTree(ctx, target, seen); err == nil {
+				t.Handoff = h
+			}
+		}
+	}
+	return t, nil
+}
+
+// events loads every run log record of the run, oldest first.
+func (i *Inspector) events(ctx context.Context, runID string) ([]*runlog.Event, error) {
+	var (
+		out    []*runlog.Event
+		cursor string
+	)
+	for {
+		page, err := i.runs.List(ctx, runID, cursor, pageSize)
+		if err != nil {
+			return nil, fmt.Errorf("list run log %q: %w", runID, err)
+		}
+		out = append(out, page.Events...)
+		if page.NextCursor == "" {
+			return out, nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
+// entry renders one run log record and accumulates usage into t.
+func (t *Timeline) entry(e *runlog.Event) Entry {
+	entry := Entry{Time: e.Timestamp, Type: e.Type}
+	switch e.Type {
+	case transcript.RunLogMessagesSeeded, transcript.RunLogMessagesAppended:
+		messages, err := transcript.DecodeRunLogDelta(e.Payload)
+		if err != nil {
+			return undecoded(entry, e, err)
+		}
+		verb := "appended"
+		if e.Type == transcript.RunLogMessagesSeeded {
+			verb = "seeded"
+		}
+		entry.Summary = fmt.Sprintf("transcript %s %d message(s)", verb, len(messages))
+		return entry
+	}
+	evt, err := hooks.DecodeFromRecordInput(&runlog.ActivityInput{
+		Type:      e.Type,
+		EventKey:  e.EventKey,
+		RunID:     e.RunID,
+		AgentID:   e.AgentID,
+		SessionID: e.SessionID,
+		TurnID:    e.TurnID,
+		Payload:   e.Payload,
+	})
+	if err != nil {
+		return undecoded(entry, e, err)
+	}
+	describe(&entry, evt, &t.Usage)
+	return entry
+}
+
+// undecoded renders a record the inspector cannot decode with its raw payload.
+func undecoded(entry Entry, e *runlog.Event, err error) Entry {
+	entry.Summary = fmt.Sprintf("%s (undecoded: %v)", e.Type, err)
+	entry.Detail = e.Payload
+	return entry
+}
@@ -0,0 +1,120 @@
+package inspect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"goa.design/goa-ai/runtime/agent/hooks"
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/run"
+	"goa.design/goa-ai/runtime/agent/runlog"
+	runloginmem "goa.design/goa-ai/runtime/agent/runlog/inmem"
+	"goa.design/goa-ai/runtime/agent/session"
+	sessioninmem "goa.design/goa-ai/runtime/agent/session/inmem"
+)
+
+var t0 = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func appendEvents(t *testing.T, store runlog.Store, events ...hooks.Event) {
+	t.Helper()
+	for i, evt := range events {
+		in, err := hooks.EncodeToRecordInput(evt, hooks.EncodeOptions{
+			EventKey:    fmt.Sprintf("%s/%d", evt.RunID(), i),
+			TimestampMS: t0.Add(time.Duration(i) * time.Second).UnixMilli(),
+		})
+		require.NoError(t, err)
+		_, err = store.Append(context.Background(), &runlog.Event{
+			EventKey:  in.EventKey,
+			RunID:     in.RunID,
+			AgentID:   in.AgentID,
+			SessionID: in.SessionID,
+			Type:      in.Type,
+			Payload:   in.Payload,
+			Timestamp: time.UnixMilli(in.TimestampMS).UTC(),
+		})
+		require.NoError(t, err)
+	}
+}
+
+func newTestInspector(t *testing.T) *Inspector {
+	t.Helper()
+	ctx := context.Background()
+	sessions := sessioninmem.New()
+	_, err := sessions.CreateSession(ctx, "sess-1", t0)
+	require.NoError(t, err)
+	require.NoError(t, sessions.UpsertRun(ctx, session.RunMeta{
+		AgentID: "svc.chat", RunID: "run-1", SessionID: "sess-1", Status: session.RunStatusFailed, StartedAt: t0,
+	}))
+	runs := runloginmem.New()
+	appendEvents(t, runs,
+		hooks.NewRunStartedEvent("run-1", "svc.chat", run.Context{RunID: "run-1", SessionID: "sess-1"}, nil),
+		hooks.NewToolCallScheduledEvent("run-1", "svc.chat", "sess-1", "svc.research", "call-1", rawjson.Message(`{"q":"go"}`), "", "", 0),
+		hooks.NewChildRunLinkedEvent("run-1", "svc.chat", "sess-1", "svc.research", "call-1", "run-1/child", "svc.researcher"),
+		hooks.NewToolResultReceivedEvent("run-1", "svc.chat", "sess-1", "run-1", "svc.research", "call-1", "", rawjson.Message(`{"ok":false}`), 12, false, "", nil, "", nil, time.Second,
+			nil, &planner.ToolFailure{Error: planner.NewToolError("upstream timeout")}),
+		hooks.NewUsageEvent("run-1", "svc.chat", "sess-1", model.TokenUsage{Model: "m", ModelClass: model.ModelClassDefault, InputTokens: 100, OutputTokens: 20}),
+		hooks.NewRunCompletedEvent("run-1", "svc.chat", "sess-1", "failed", run.PhaseFailed, nil, errors.New("planner gave up"), nil),
+	)
+	appendEvents(t, runs,
+		hooks.NewRunStartedEvent("run-1/child", "svc.researcher", run.Context{RunID: "run-1/child", SessionID: "sess-1", ParentRunID: "run-1"}, nil),
+		hooks.NewUsageEvent("run-1/child", "svc.researcher", "sess-1", model.TokenUsage{InputTokens: 7, OutputTokens: 3}),
+		hooks.NewRunCompletedEvent("run-1/child", "svc.researcher", "sess-1", "success", run.PhaseCompleted, nil, nil, nil),
+	)
+	return New(sessions, runs)
+}
+
+func TestTimelineDescribesRun(t *testing.T) {
+	tl, err := newTestInspector(t).Timeline(context.Background(), "run-1")
+	require.NoError(t, err)
+
+	require.Equal(t, "svc.chat", tl.AgentID)
+	require.NotNil(t, tl.Run)
+	require.Equal(t, session.RunStatusFailed, tl.Run.Status)
+	require.Len(t, tl.Entries, 6)
+	require.Equal(t, "tool call svc.research [call-1]", tl.Entries[1].Summary)
+	require.JSONEq(t, `{"q":"go"}`, string(tl.Entries[1].Detail))
+	require.True(t, tl.Entries[3].Failed)
+	require.Contains(t, tl.Entries[3].Summary, "upstream timeout")
+	require.True(t, tl.Entries[5].Failed)
+	require.Equal(t, Usage{InputTokens: 100, OutputTokens: 20}, tl.Usage)
+	require.Empty(t, tl.Children)
+}
+
+func TestRunTreeIncludesChildRuns(t *testing.T) {
+	tree, err := newTestInspector(t).RunTree(context.Background(), "run-1")
+	require.NoError(t, err)
+	require.Len(t, tree.Children, 1)
+	require.Equal(t, "svc.researcher", tree.Children[0].AgentID)
+	require.Nil(t, tree.Children[0].Run, "child run has no session metadata in this fixture")
+	require.Equal(t, 7, tree.Children[0].Usage.InputTokens)
+
+	var text bytes.Buffer
+	require.NoError(t, WriteText(&text, tree, true))
+	require.Contains(t, text.String(), "run run-1 agent=svc.chat session=sess-1 status=failed")
+	require.Contains(t, text.String(), `      {"q":"go"}`)
+	require.Contains(t, text.String(), "    run run-1/child agent=svc.researcher")
+
+	var out bytes.Buffer
+	require.NoError(t, WriteJSON(&out, tree))
+	var decoded Timeline
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	require.Len(t, decoded.Children, 1)
+}
+
+func TestSessionsRequiresLister(t *testing.T) {
+	_, err := New(nil, runloginmem.New()).Sessions(context.Background(), nil, 0)
+	require.ErrorIs(t, err, ErrListSessionsUnsupported)
+
+	sessions, err := newTestInspector(t).Sessions(context.Background(), []session.SessionStatus{session.StatusActive}, 10)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+}
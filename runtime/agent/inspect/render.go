@@ -0,0 +1,95 @@
+package inspect
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"goa.design/goa-ai/runtime/agent/session"
+)
+
+// WriteJSON writes v as indented JSON.
+func WriteJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// WriteText writes a human-readable rendering of the timeline and, for run
+// trees, of its child and handoff timelines. When details is true, tool
+// arguments, tool results, and undecoded payloads are printed below their
+// entries.
+func WriteText(w io.Writer, t *Timeline, details bool) error {
+	bw := bufio.NewWriter(w)
+	writeTimeline(bw, t, details, "")
+	return bw.Flush()
+}
+
+// WriteSessions writes one line per session.
+func WriteSessions(w io.Writer, sessions []session.Session) error {
+	bw := bufio.NewWriter(w)
+	for _, s := range sessions {
+		line := fmt.Sprintf("%s\t%s\tcreated=%s", s.ID, s.Status, s.CreatedAt.Format(time.RFC3339))
+		if s.EndedAt != nil {
+			line += "\tended=" + s.EndedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintln(bw, line)
+	}
+	return bw.Flush()
+}
+
+// WriteRuns writes one line per run.
+func WriteRuns(w io.Writer, runs []session.RunMeta) error {
+	bw := bufio.NewWriter(w)
+	for _, r := range runs {
+		line := fmt.Sprintf("%s\t%s\t%s\tstarted=%s", r.RunID, r.AgentID, r.Status, r.StartedAt.Format(time.RFC3339))
+		if len(r.ChildRunIDs) > 0 {
+			line += fmt.Sprintf("\tchildren=%d", len(r.ChildRunIDs))
+		}
+		if r.HandoffToRunID != "" {
+			line += "\thandoff=" + r.HandoffToRunID
+		}
+		fmt.Fprintln(bw, line)
+	}
+	return bw.Flush()
+}
+
+func writeTimeline(w io.Writer, t *Timeline, details bool, indent string) {
+	fmt.Fprintf(w, "%srun %s agent=%s", indent, t.RunID, t.AgentID)
+	if t.SessionID != "" {
+		fmt.Fprintf(w, " session=%s", t.SessionID)
+	}
+	if t.Run != nil {
+		fmt.Fprintf(w, " status=%s", t.Run.Status)
+	}
+	fmt.Fprintln(w)
+	var start time.Time
+	if len(t.Entries) > 0 {
+		start = t.Entries[0].Time
+	}
+	for _, e := range t.Entries {
+		marker := " "
+		if e.Failed {
+			marker = "!"
+		}
+		fmt.Fprintf(w, "%s  %s +%-9s %s\n", indent, marker, e.Time.Sub(start).Round(time.Millisecond), e.Summary)
+		if details && len(e.Detail) > 0 {
+			for _, line := range strings.Split(string(e.Detail), "\n") {
+				fmt.Fprintf(w, "%s      %s\n", indent, line)
+			}
+		}
+	}
+	u := t.Usage
+	fmt.Fprintf(w, "%s  tokens: input=%d output=%d cache_read=%d cache_write=%d\n",
+		indent, u.InputTokens, u.OutputTokens, u.CacheReadTokens, u.CacheWriteTokens)
+	for _, child := range t.Children {
+		writeTimeline(w, child, details, indent+"    ")
+	}
+	if t.Handoff != nil {
+		fmt.Fprintf(w, "%s  handed off to:\n", indent)
+		writeTimeline(w, t.Handoff, details, indent)
+	}
+}
@@ -0,0 +1,212 @@
+package longterm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"goa.design/goa-ai/runtime/agent/model"
+)
+
+type (
+	// Extractor distills durable facts from a completed run transcript.
+	Extractor interface {
+		Extract(ctx context.Context, messages []*model.Message) ([]Candidate, error)
+	}
+
+	// Candidate is a fact proposed by an Extractor before it is embedded and
+	// stored.
+	Candidate struct {
+		// Text is the fact statement.
+		Text string `json:"text"`
+		// Kind optionally categorizes the fact.
+		Kind string `json:"kind,omitempty"`
+	}
+
+	// ModelExtractor is an Extractor that asks a model to list durable facts
+	// using structured output.
+	ModelExtractor struct {
+		client       model.Client
+		modelClass   model.ModelClass
+		instructions string
+		maxFacts     int
+	}
+
+	// ModelExtractorOption configures a ModelExtractor.
+	ModelExtractorOption func(*ModelExtractor)
+
+	extractionOutput struct {
+		Facts []Candidate `json:"facts"`
+	}
+)
+
+// DefaultExtractionInstructions is the system prompt used by ModelExtractor
+// when no override is configured.
+const DefaultExtractionInstructions = `You maintain long-term memory for an assistant.
+Read the conversation and list durable facts about the user that will still be
+useful in future, unrelated conversations: stable preferences, profile
+attributes, standing instructions, and long-lived decisions. Do not record
+transient task details, tool outputs, secrets, credentials, or anything the
+user asked not to be remembered. Phrase each fact as a short standalone
+sentence in the third person. Return an empty list when nothing qualifies.`
+
+const extractionSchema = `{
+  "type": "object",
+  "properties": {
+    "facts": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "text": {"type": "string", "description": "Standalone fact sentence."},
+          "kind": {"type": "string", "description": "Fact category such as preference or profile."}
+        },
+        "required": ["text"]
+      }
+    }
+  },
+  "required": ["facts"]
+}`
+
+// NewModelExtractor returns an Extractor that uses client to extract facts.
+// By default it targets model.ModelClassSmall and keeps at most 10 facts per
+// transcript.
+func NewModelExtractor(client model.Client, opts ...ModelExtractorOption) *ModelExtractor {
+	if client == nil {
+		panic("longterm: model client is required")
+	}
+	e := &ModelExtractor{
+		client:       client,
+		modelClass:   model.ModelClassSmall,
+		instructions: DefaultExtractionInstructions,
+		maxFacts:     10,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(e)
+		}
+	}
+	return e
+}
+
+// WithExtractionModelClass selects the model class used for extraction.
+func WithExtractionModelClass(class model.ModelClass) ModelExtractorOption {
+	return func(e *ModelExtractor) { e.modelClass = class }
+}
+
+// WithExtractionInstructions overrides the extraction system prompt.
+func WithExtractionInstructions(text string) ModelExtractorOption {
+	return func(e *ModelExtractor) { e.instructions = text }
+}
+
+// WithMaxFacts caps how many facts a single extraction may return.
+func WithMaxFacts(n int) ModelExtractorOption {
+	return func(e *ModelExtractor) { e.maxFacts = n }
+}
+
+// Extract renders the user and assistant text of messages into a single
+// conversation, asks the model for durable facts, and returns the
+// de-duplicated candidates. Tool calls, tool results, and thinking blocks are
+// not shown to the extraction model.
+func (e *ModelExtractor) Extract(ctx context.Context, messages []*model.Message) ([]Candidate, error) {
+	conversation := renderConversation(messages)
+	if conversation == "" {
+		return nil, nil
+	}
+	resp, err := e.client.Complete(ctx, &model.Request{
+		ModelClass: e.modelClass,
+		Messages: []*model.Message{
+			{Role: model.ConversationRoleSystem, Parts: []model.Part{model.TextPart{Text: e.instructions}}},
+			{Role: model.ConversationRoleUser, Parts: []model.Part{model.TextPart{Text: conversation}}},
+		},
+		StructuredOutput: &model.StructuredOutput{
+			Schema:                   []byte(extractionSchema),
+			SchemaWithoutRootExample: []byte(extractionSchema),
+			Name:                     "long_term_memory_facts",
+			Description:              "Durable facts to remember about the user.",
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("longterm: extract facts: %w", err)
+	}
+	text := responseText(resp)
+	if text == "" {
+		return nil, errors.New("longterm: extract facts: empty model response")
+	}
+	var out extractionOutput
+	if err := json.Unmarshal([]byte(text), &out); err != nil {
+		return nil, fmt.Errorf("longterm: decode extracted facts: %w", err)
+	}
+	seen := make(map[string]struct{}, len(out.Facts))
+	facts := make([]Candidate, 0, len(out.Facts))
+	for _, c := range out.Facts {
+		c.Text = strings.TrimSpace(c.Text)
+		if c.Text == "" {
+			continue
+		}
+		key := strings.ToLower(c.Text)
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		facts = append(facts, c)
+		if e.maxFacts > 0 && len(facts) == e.maxFacts {
+			break
+		}
+	}
+	return facts, nil
+}
+
+// renderConversation flattens the user and assistant text parts of messages
+// into a "role: text" transcript.
+func renderConversation(messages []*model.Message) string {
+	var b strings.Builder
+	for _, m := range messages {
+		if m == nil {
+			continue
+		}
+		if m.Role != model.ConversationRoleUser && m.Role != model.ConversationRoleAssistant {
+			continue
+		}
+		text := messageText(m)
+		if text == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(string(m.Role))
+		b.WriteString(": ")
+		b.WriteString(text)
+	}
+	return b.String()
+}
+
+// messageText joins the text parts of m.
+func messageText(m *model.Message) string {
+	var parts []string
+	for _, p := range m.Parts {
+		if tp, ok := p.(model.TextPart); ok {
+			if t := strings.TrimSpace(tp.Text); t != "" {
+				parts = append(parts, t)
+			}
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// responseText joins the text of every assistant message in resp.
+func responseText(resp *model.Response) string {
+	if resp == nil {
+		return ""
+	}
+	var parts []string
+	for i := range resp.Content {
+		if t := messageText(&resp.Content[i]); t != "" {
+			parts = append(parts, t)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
@@ -0,0 +1,114 @@
+// Package inmem provides an in-memory implementation of longterm.Store for
+// testing and local development. Similarity search is a linear scan over all
+// facts, so it is unsuitable for large corpora. Data is lost when the process
+// exits.
+package inmem
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"goa.design/goa-ai/runtime/agent/memory/longterm"
+)
+
+// Store implements longterm.Store using an in-process map keyed by fact ID.
+// It is thread-safe and copies facts on the way in and out.
+type Store struct {
+	mu    sync.RWMutex
+	facts map[string]longterm.Fact
+}
+
+// New returns an empty store.
+func New() *Store {
+	return &Store{facts: make(map[string]longterm.Fact)}
+}
+
+// Put implements longterm.Store.
+func (s *Store) Put(_ context.Context, facts ...longterm.Fact) error {
+	for _, f := range facts {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range facts {
+		s.facts[f.ID] = f.Clone()
+	}
+	return nil
+}
+
+// Search implements longterm.Store.
+func (s *Store) Search(_ context.Context, q longterm.Query) ([]longterm.Match, error) {
+	if len(q.Embedding) == 0 {
+		return nil, errors.New("longterm: query embedding is required")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	all := make([]longterm.Fact, 0, len(s.facts))
+	for _, f := range s.facts {
+		all = append(all, f)
+	}
+	return longterm.Rank(all, q), nil
+}
+
+// List implements longterm.Store.
+func (s *Store) List(_ context.Context, scope longterm.Scope) ([]longterm.Fact, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]longterm.Fact, 0, len(s.facts))
+	for _, f := range s.facts {
+		if scope.Sees(f.Scope) {
+			out = append(out, f.Clone())
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.Before(out[j].CreatedAt)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out, nil
+}
+
+// Forget implements longterm.Store.
+func (s *Store) Forget(_ context.Context, ids ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		delete(s.facts, id)
+	}
+	return nil
+}
+
+// PurgeScope implements longterm.Store.
+func (s *Store) PurgeScope(_ context.Context, scope longterm.Scope) error {
+	if len(scope) == 0 {
+		return longterm.ErrScopeRequired
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, f := range s.facts {
+		if f.Scope.Contains(scope) {
+			delete(s.facts, id)
+		}
+	}
+	return nil
+}
+
+// PurgeSession implements longterm.Store.
+func (s *Store) PurgeSession(_ context.Context, sessionID string) error {
+	if sessionID == "" {
+		return errors.New("session id is required")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, f := range s.facts {
+		if f.SessionID == sessionID {
+			delete(s.facts, id)
+		}
+	}
+	return nil
+}
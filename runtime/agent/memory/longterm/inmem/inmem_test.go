@@ -0,0 +1,54 @@
+package inmem
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"goa.design/goa-ai/runtime/agent/memory/longterm"
+)
+
+func TestStoreSearchScopesAndRanks(t *testing.T) {
+	ctx := context.Background()
+	store := New()
+	now := time.Now()
+	require.NoError(t, store.Put(ctx,
+		longterm.Fact{ID: "a", Text: "a", Embedding: []float32{1, 0}, Scope: longterm.Scope{"user_id": "u1"}, CreatedAt: now},
+		longterm.Fact{ID: "b", Text: "b", Embedding: []float32{1, 1}, Scope: longterm.Scope{"user_id": "u1"}, CreatedAt: now},
+		longterm.Fact{ID: "c", Text: "c", Embedding: []float32{1, 0}, Scope: longterm.Scope{"user_id": "u2"}, CreatedAt: now},
+		longterm.Fact{ID: "d", Text: "d", Embedding: []float32{0, 1}, Scope: longterm.Scope{"user_id": "u1"}, CreatedAt: now},
+	))
+	matches, err := store.Search(ctx, longterm.Query{
+		Scope:     longterm.Scope{"user_id": "u1", "tenant": "acme"},
+		Embedding: []float32{1, 0},
+		MinScore:  0.1,
+	})
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	require.Equal(t, "a", matches[0].Fact.ID)
+	require.Equal(t, "b", matches[1].Fact.ID)
+}
+
+func TestStoreRejectsInvalidFacts(t *testing.T) {
+	store := New()
+	err := store.Put(context.Background(), longterm.Fact{ID: "a", Text: "a"})
+	require.ErrorIs(t, err, longterm.ErrInvalidFact)
+}
+
+func TestStoreIsolation(t *testing.T) {
+	ctx := context.Background()
+	store := New()
+	fact := longterm.Fact{ID: "a", Text: "a", Embedding: []float32{1}, Scope: longterm.Scope{"user_id": "u1"}}
+	require.NoError(t, store.Put(ctx, fact))
+	fact.Embedding[0] = 0
+	fact.Scope["user_id"] = "u2"
+	listed, err := store.List(ctx, longterm.Scope{"user_id": "u1"})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.InDelta(t, 1, listed[0].Embedding[0], 0)
+}
+
+func TestStorePurgeScopeRequiresScope(t *testing.T) {
+	require.ErrorIs(t, New().PurgeScope(context.Background(), nil), longterm.ErrScopeRequired)
+}
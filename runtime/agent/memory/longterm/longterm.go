@@ -0,0 +1,177 @@
+// Package longterm defines durable, cross-session agent memory. Where
+// memory.Store records the chronological event log of a single run, long-term
+// memory keeps the distilled facts an agent should remember about a user or
+// tenant across sessions: preferences, stable attributes, and decisions.
+//
+// Facts are extracted from completed run transcripts by an Extractor backed by
+// a model.Client, embedded by an Embedder, and persisted in a Store scoped by
+// run labels (for example {"user_id": "u1", "tenant": "acme"}). At run start
+// the runtime retrieves the facts most relevant to the incoming user message
+// and injects them as run-start reminders through the reminder package.
+//
+// Long-term memory is privacy sensitive. Stores must support explicit removal
+// by fact ID, by scope, and by source session so applications can honor
+// forget requests and purge facts together with session.Store.PurgeSession.
+package longterm
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"time"
+)
+
+type (
+	// Fact is one durable memory item remembered across sessions.
+	Fact struct {
+		// ID uniquely identifies the fact within the store.
+		ID string
+		// Text is the natural-language statement of the fact, phrased so it can
+		// be injected into a prompt verbatim (e.g., "Prefers metric units.").
+		Text string
+		// Kind optionally categorizes the fact (e.g., "preference", "profile").
+		Kind string
+		// Embedding is the vector representation of Text used for retrieval.
+		Embedding []float32
+		// Scope holds the label subset that owns this fact. Retrieval only
+		// returns facts whose scope is fully contained in the query scope.
+		Scope Scope
+		// AgentID identifies the agent whose run produced the fact.
+		AgentID string
+		// SessionID identifies the session whose run produced the fact. It is
+		// used to purge facts together with their source session.
+		SessionID string
+		// RunID identifies the run whose transcript produced the fact.
+		RunID string
+		// CreatedAt records when the fact was stored.
+		CreatedAt time.Time
+		// Metadata carries implementation- or application-defined attributes.
+		Metadata map[string]string
+	}
+
+	// Scope is the set of run labels that partitions long-term memory, for
+	// example {"tenant": "acme", "user_id": "u1"}. An empty scope matches every
+	// fact and should only be used by administrative callers.
+	Scope map[string]string
+
+	// Query describes a similarity search over stored facts.
+	Query struct {
+		// Scope restricts results to facts owned by this scope. A fact matches
+		// when every key/value pair of its scope is present in Scope.
+		Scope Scope
+		// Embedding is the query vector compared against fact embeddings.
+		Embedding []float32
+		// Limit caps the number of returned matches. Zero means DefaultLimit.
+		Limit int
+		// MinScore drops matches whose similarity is lower than this value.
+		MinScore float64
+	}
+
+	// Match pairs a stored fact with its similarity score for a query.
+	Match struct {
+		// Fact is the matched fact.
+		Fact Fact
+		// Score is the cosine similarity between the query and the fact
+		// embedding, in [-1, 1]. Higher is more relevant.
+		Score float64
+	}
+
+	// Store persists long-term facts and serves similarity queries.
+	// Implementations must be safe for concurrent use.
+	Store interface {
+		// Put inserts or replaces facts by ID. Facts must carry an ID, text, and
+		// embedding.
+		Put(ctx context.Context, facts ...Fact) error
+		// Search returns facts visible to q.Scope ordered by descending score.
+		Search(ctx context.Context, q Query) ([]Match, error)
+		// List returns every fact visible to scope ordered by creation time.
+		List(ctx context.Context, scope Scope) ([]Fact, error)
+		// Forget removes the facts with the given IDs. Unknown IDs are ignored.
+		Forget(ctx context.Context, ids ...string) error
+		// PurgeScope removes every fact whose scope includes all key/value
+		// pairs of scope, so purging {"user_id": "u1"} removes that user's
+		// facts across tenants and agents. It returns ErrScopeRequired when
+		// scope is empty to prevent accidental wipes.
+		PurgeScope(ctx context.Context, scope Scope) error
+		// PurgeSession removes every fact extracted from runs of the session.
+		// It is idempotent.
+		PurgeSession(ctx context.Context, sessionID string) error
+	}
+
+	// Embedder turns texts into embedding vectors. Returned vectors must be in
+	// the same order as texts and share a single dimension.
+	Embedder interface {
+		Embed(ctx context.Context, texts []string) ([][]float32, error)
+	}
+)
+
+// DefaultLimit is the number of matches returned when Query.Limit is zero.
+const DefaultLimit = 5
+
+var (
+	// ErrScopeRequired indicates a purge was requested with an empty scope.
+	ErrScopeRequired = errors.New("longterm: scope is required")
+	// ErrInvalidFact indicates a fact is missing its ID, text, or embedding.
+	ErrInvalidFact = errors.New("longterm: fact requires id, text, and embedding")
+)
+
+// ScopeFromLabels projects the given label keys out of run labels. It returns
+// nil when any key is missing so callers never store or retrieve facts under a
+// partial scope (for example a tenant without a user).
+func ScopeFromLabels(labels map[string]string, keys []string) Scope {
+	if len(keys) == 0 {
+		return nil
+	}
+	scope := make(Scope, len(keys))
+	for _, k := range keys {
+		v, ok := labels[k]
+		if !ok || v == "" {
+			return nil
+		}
+		scope[k] = v
+	}
+	return scope
+}
+
+// Contains reports whether every key/value pair of other is present in s.
+func (s Scope) Contains(other Scope) bool {
+	for k, v := range other {
+		if s[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Sees reports whether a fact owned by owner is visible to s: s is empty or
+// every key/value pair of owner is present in s.
+func (s Scope) Sees(owner Scope) bool {
+	return len(s) == 0 || s.Contains(owner)
+}
+
+// Clone returns a copy of s.
+func (s Scope) Clone() Scope {
+	if s == nil {
+		return nil
+	}
+	return maps.Clone(s)
+}
+
+// Validate reports whether the fact carries the fields every store requires.
+func (f Fact) Validate() error {
+	if f.ID == "" || f.Text == "" || len(f.Embedding) == 0 {
+		return ErrInvalidFact
+	}
+	return nil
+}
+
+// Clone returns a deep copy of f.
+func (f Fact) Clone() Fact {
+	out := f
+	out.Embedding = append([]float32(nil), f.Embedding...)
+	out.Scope = f.Scope.Clone()
+	if f.Metadata != nil {
+		out.Metadata = maps.Clone(f.Metadata)
+	}
+	return out
+}
@@ -0,0 +1,281 @@
+package longterm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/reminder"
+)
+
+type (
+	// Config configures a Manager.
+	Config struct {
+		// Store persists facts. Required.
+		Store Store
+		// Embedder embeds fact texts and retrieval queries. Required.
+		Embedder Embedder
+		// Extractor distills facts from completed runs. When nil, Remember is
+		// disabled and the manager only serves retrieval and removal.
+		Extractor Extractor
+		// ScopeLabels lists the run label keys that partition memory (for
+		// example "tenant" and "user_id"). Runs missing any of these labels are
+		// neither remembered nor recalled. Required.
+		ScopeLabels []string
+		// RecallLimit caps the number of facts injected at run start. Zero
+		// means DefaultLimit.
+		RecallLimit int
+		// MinScore drops recalled facts below this similarity.
+		MinScore float64
+	}
+
+	// Manager coordinates extraction, storage, retrieval, and removal of
+	// long-term facts. It is safe for concurrent use.
+	Manager struct {
+		store       Store
+		embedder    Embedder
+		extractor   Extractor
+		scopeLabels []string
+		recallLimit int
+		minScore    float64
+		now         func() time.Time
+	}
+
+	// RunRef identifies the run a set of facts was extracted from.
+	RunRef struct {
+		// AgentID identifies the agent that executed the run.
+		AgentID string
+		// RunID identifies the run.
+		RunID string
+		// SessionID identifies the session that owns the run.
+		SessionID string
+		// Labels are the run labels used to derive the memory scope.
+		Labels map[string]string
+	}
+)
+
+// ReminderID is the reminder identifier used for recalled facts.
+const ReminderID = "long_term_memory"
+
+// NewManager validates cfg and returns a Manager.
+func NewManager(cfg Config) (*Manager, error) {
+	if cfg.Store == nil {
+		return nil, errors.New("longterm: store is required")
+	}
+	if cfg.Embedder == nil {
+		return nil, errors.New("longterm: embedder is required")
+	}
+	if len(cfg.ScopeLabels) == 0 {
+		return nil, errors.New("longterm: at least one scope label is required")
+	}
+	return &Manager{
+		store:       cfg.Store,
+		embedder:    cfg.Embedder,
+		extractor:   cfg.Extractor,
+		scopeLabels: append([]string(nil), cfg.ScopeLabels...),
+		recallLimit: cfg.RecallLimit,
+		minScore:    cfg.MinScore,
+		now:         func() time.Time { return time.Now().UTC() },
+	}, nil
+}
+
+// Scope returns the memory scope for the given run labels, or nil when the
+// labels do not carry every configured scope key.
+func (m *Manager) Scope(labels map[string]string) Scope {
+	return ScopeFromLabels(labels, m.scopeLabels)
+}
+
+// Remember extracts facts from the run transcript, embeds them, and stores
+// them under the run scope. Fact IDs are derived from the scope, the source
+// session, and the normalized fact text so re-extracting the same fact within a
+// session replaces it instead of duplicating it, while the same fact learned in
+// another session is kept as a separate record and survives when either
+// session is purged. Remember returns the stored facts; it is a no-op when no
+// extractor is configured or the run labels do not define a scope.
+func (m *Manager) Remember(ctx context.Context, ref RunRef, messages []*model.Message) ([]Fact, error) {
+	if m.extractor == nil {
+		return nil, nil
+	}
+	scope := m.Scope(ref.Labels)
+	if scope == nil {
+		return nil, nil
+	}
+	candidates, err := m.extractor.Extract(ctx, messages)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	texts := make([]string, len(candidates))
+	for i, c := range candidates {
+		texts[i] = c.Text
+	}
+	vectors, err := m.embedder.Embed(ctx, texts)
+	if err != nil {
+		return nil, fmt.Errorf("longterm: embed facts: %w", err)
+	}
+	if len(vectors) != len(texts) {
+		return nil, fmt.Errorf("longterm: embedder returned %d vectors for %d texts", len(vectors), len(texts))
+	}
+	now := m.now()
+	facts := make([]Fact, len(candidates))
+	for i, c := range candidates {
+		facts[i] = Fact{
+			ID:        FactID(scope, ref.SessionID, c.Text),
+			Text:      c.Text,
+			Kind:      c.Kind,
+			Embedding: vectors[i],
+			Scope:     scope.Clone(),
+			AgentID:   ref.AgentID,
+			SessionID: ref.SessionID,
+			RunID:     ref.RunID,
+			CreatedAt: now,
+		}
+	}
+	if err := m.store.Put(ctx, facts...); err != nil {
+		return nil, fmt.Errorf("longterm: store facts: %w", err)
+	}
+	return facts, nil
+}
+
+// Recall returns the facts most relevant to query within the scope derived
+// from labels, without repeating facts stored by several sessions. It returns
+// nil when labels do not define a scope or query is blank.
+func (m *Manager) Recall(ctx context.Context, labels map[string]string, query string) ([]Match, error) {
+	scope := m.Scope(labels)
+	query = strings.TrimSpace(query)
+	if scope == nil || query == "" {
+		return nil, nil
+	}
+	vectors, err := m.embedder.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, fmt.Errorf("longterm: embed query: %w", err)
+	}
+	if len(vectors) != 1 {
+		return nil, fmt.Errorf("longterm: embedder returned %d vectors for 1 query", len(vectors))
+	}
+	limit := m.recallLimit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	// The same fact learned in several sessions is stored once per session;
+	// recall it once, keeping the best-scored copy. Copies count against the
+	// store limit, so widen the search until limit distinct facts are found
+	// or the store has no more matches.
+	for fetch := limit; ; fetch *= 2 {
+		matches, err := m.store.Search(ctx, Query{
+			Scope:     scope,
+			Embedding: vectors[0],
+			Limit:     fetch,
+			MinScore:  m.minScore,
+		})
+		if err != nil {
+			return nil, err
+		}
+		out := distinctMatches(matches)
+		if len(out) >= limit || len(matches) < fetch {
+			return out[:min(len(out), limit)], nil
+		}
+	}
+}
+
+// distinctMatches returns matches without the later copies of facts whose
+// text case-insensitively repeats an earlier match.
+func distinctMatches(matches []Match) []Match {
+	seen := make(map[string]struct{}, len(matches))
+	out := matches[:0]
+	for _, match := range matches {
+		key := strings.ToLower(strings.TrimSpace(match.Fact.Text))
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, match)
+	}
+	return out
+}
+
+// Forget removes the facts with the given IDs.
+func (m *Manager) Forget(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return m.store.Forget(ctx, ids...)
+}
+
+// ForgetScope removes every fact owned by the scope derived from labels. It
+// returns ErrScopeRequired when labels do not define a scope.
+func (m *Manager) ForgetScope(ctx context.Context, labels map[string]string) error {
+	scope := m.Scope(labels)
+	if scope == nil {
+		return ErrScopeRequired
+	}
+	return m.store.PurgeScope(ctx, scope)
+}
+
+// PurgeSession removes every fact extracted from runs of the session.
+func (m *Manager) PurgeSession(ctx context.Context, sessionID string) error {
+	return m.store.PurgeSession(ctx, sessionID)
+}
+
+// List returns the facts owned by the scope derived from labels.
+func (m *Manager) List(ctx context.Context, labels map[string]string) ([]Fact, error) {
+	scope := m.Scope(labels)
+	if scope == nil {
+		return nil, ErrScopeRequired
+	}
+	return m.store.List(ctx, scope)
+}
+
+// RecallReminder renders matches as a run-start reminder. The reminder is not
+// capped so every planner turn of the run sees the same stable prefix. The
+// boolean result is false when there is nothing to inject.
+func RecallReminder(matches []Match) (reminder.Reminder, bool) {
+	if len(matches) == 0 {
+		return reminder.Reminder{}, false
+	}
+	var b strings.Builder
+	b.WriteString("Facts remembered from previous conversations with this user. ")
+	b.WriteString("Use them when relevant; the user's current instructions take precedence.\n")
+	for _, match := range matches {
+		b.WriteString("- ")
+		b.WriteString(match.Fact.Text)
+		b.WriteString("\n")
+	}
+	return reminder.Reminder{
+		ID:       ReminderID,
+		Text:     strings.TrimRight(b.String(), "\n"),
+		Priority: reminder.TierGuidance,
+		Attachment: reminder.Attachment{
+			Kind: reminder.AttachmentRunStart,
+		},
+	}, true
+}
+
+// FactID returns the deterministic identifier of a fact with the given text
+// extracted from a run of sessionID and stored under scope.
+func FactID(scope Scope, sessionID, text string) string {
+	keys := make([]string, 0, len(scope))
+	for k := range scope {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(scope[k]))
+		h.Write([]byte{0})
+	}
+	h.Write([]byte(sessionID))
+	h.Write([]byte{0})
+	h.Write([]byte(strings.ToLower(strings.TrimSpace(text))))
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
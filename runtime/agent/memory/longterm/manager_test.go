@@ -0,0 +1,231 @@
+package longterm_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"goa.design/goa-ai/runtime/agent/memory/longterm"
+	"goa.design/goa-ai/runtime/agent/memory/longterm/inmem"
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/reminder"
+)
+
+type (
+	stubClient struct {
+		response string
+		requests []*model.Request
+	}
+
+	// wordEmbedder embeds texts as bag-of-words vectors over a fixed
+	// vocabulary so tests get deterministic similarity ordering.
+	wordEmbedder struct {
+		vocab []string
+	}
+)
+
+func (c *stubClient) Complete(_ context.Context, req *model.Request) (*model.Response, error) {
+	c.requests = append(c.requests, req)
+	return &model.Response{
+		Content:    []model.Message{{Role: model.ConversationRoleAssistant, Parts: []model.Part{model.TextPart{Text: c.response}}}},
+		StopReason: "end_turn",
+	}, nil
+}
+
+func (c *stubClient) Stream(context.Context, *model.Request) (model.Streamer, error) {
+	return nil, errors.New("not supported")
+}
+
+func (e wordEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	out := make([][]float32, len(texts))
+	for i, text := range texts {
+		vec := make([]float32, len(e.vocab))
+		lower := strings.ToLower(text)
+		for j, w := range e.vocab {
+			if strings.Contains(lower, w) {
+				vec[j] = 1
+			}
+		}
+		out[i] = vec
+	}
+	return out, nil
+}
+
+func newManager(t *testing.T, response string) (*longterm.Manager, *stubClient) {
+	t.Helper()
+	client := &stubClient{response: response}
+	mgr, err := longterm.NewManager(longterm.Config{
+		Store:       inmem.New(),
+		Embedder:    wordEmbedder{vocab: []string{"metric", "units", "coffee", "vegetarian", "meal"}},
+		Extractor:   longterm.NewModelExtractor(client),
+		ScopeLabels: []string{"tenant", "user_id"},
+	})
+	require.NoError(t, err)
+	return mgr, client
+}
+
+func conversation() []*model.Message {
+	return []*model.Message{
+		{Role: model.ConversationRoleSystem, Parts: []model.Part{model.TextPart{Text: "You are helpful."}}},
+		{Role: model.ConversationRoleUser, Parts: []model.Part{model.TextPart{Text: "Always use metric units. I'm vegetarian."}}},
+		{Role: model.ConversationRoleAssistant, Parts: []model.Part{model.TextPart{Text: "Noted."}}},
+	}
+}
+
+func TestRememberAndRecall(t *testing.T) {
+	ctx := context.Background()
+	mgr, client := newManager(t, `{"facts":[
+		{"text":"Prefers metric units.","kind":"preference"},
+		{"text":"Is vegetarian.","kind":"profile"},
+		{"text":"prefers metric units."},
+		{"text":"  "}
+	]}`)
+	labels := map[string]string{"tenant": "acme", "user_id": "u1"}
+
+	facts, err := mgr.Remember(ctx, longterm.RunRef{AgentID: "svc.agent", RunID: "r1", SessionID: "s1", Labels: labels}, conversation())
+	require.NoError(t, err)
+	require.Len(t, facts, 2, "duplicates and blank facts are dropped")
+	require.Equal(t, longterm.FactID(longterm.Scope(labels), "s1", "Prefers metric units."), facts[0].ID)
+
+	require.Len(t, client.requests, 1)
+	req := client.requests[0]
+	require.Equal(t, model.ModelClassSmall, req.ModelClass)
+	require.NotNil(t, req.StructuredOutput)
+	user := req.Messages[1].Parts[0].(model.TextPart).Text
+	require.Contains(t, user, "user: Always use metric units.")
+	require.NotContains(t, user, "You are helpful.")
+
+	matches, err := mgr.Recall(ctx, labels, "suggest a vegetarian meal")
+	require.NoError(t, err)
+	require.NotEmpty(t, matches)
+	require.Equal(t, "Is vegetarian.", matches[0].Fact.Text)
+
+	other, err := mgr.Recall(ctx, map[string]string{"tenant": "acme", "user_id": "u2"}, "vegetarian meal")
+	require.NoError(t, err)
+	require.Empty(t, other, "facts must not leak across scopes")
+
+	partial, err := mgr.Recall(ctx, map[string]string{"tenant": "acme"}, "vegetarian meal")
+	require.NoError(t, err)
+	require.Nil(t, partial, "runs without every scope label recall nothing")
+}
+
+func TestRememberIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	mgr, _ := newManager(t, `{"facts":[{"text":"Prefers metric units."}]}`)
+	labels := map[string]string{"tenant": "acme", "user_id": "u1"}
+	for _, runID := range []string{"r1", "r2"} {
+		_, err := mgr.Remember(ctx, longterm.RunRef{RunID: runID, SessionID: "s1", Labels: labels}, conversation())
+		require.NoError(t, err)
+	}
+	facts, err := mgr.List(ctx, labels)
+	require.NoError(t, err)
+	require.Len(t, facts, 1)
+	require.Equal(t, "r2", facts[0].RunID)
+}
+
+func TestSameFactFromTwoSessionsIsPurgedPerSession(t *testing.T) {
+	ctx := context.Background()
+	mgr, _ := newManager(t, `{"facts":[{"text":"Prefers metric units."}]}`)
+	labels := map[string]string{"tenant": "acme", "user_id": "u1"}
+	for _, ref := range []longterm.RunRef{
+		{RunID: "r1", SessionID: "s1", Labels: labels},
+		{RunID: "r2", SessionID: "s2", Labels: labels},
+	} {
+		_, err := mgr.Remember(ctx, ref, conversation())
+		require.NoError(t, err)
+	}
+	facts, err := mgr.List(ctx, labels)
+	require.NoError(t, err)
+	require.Len(t, facts, 2, "each source session keeps its own copy")
+
+	matches, err := mgr.Recall(ctx, labels, "metric units")
+	require.NoError(t, err)
+	require.Len(t, matches, 1, "recall does not repeat the same fact")
+
+	require.NoError(t, mgr.PurgeSession(ctx, "s1"))
+	facts, err = mgr.List(ctx, labels)
+	require.NoError(t, err)
+	require.Len(t, facts, 1)
+	require.Equal(t, "s2", facts[0].SessionID)
+}
+
+func TestRecallFillsLimitWithDistinctFacts(t *testing.T) {
+	ctx := context.Background()
+	store := inmem.New()
+	mgr, err := longterm.NewManager(longterm.Config{
+		Store:       store,
+		Embedder:    wordEmbedder{vocab: []string{"metric", "units", "coffee", "vegetarian", "meal"}},
+		ScopeLabels: []string{"tenant", "user_id"},
+		RecallLimit: 2,
+	})
+	require.NoError(t, err)
+	labels := map[string]string{"tenant": "acme", "user_id": "u1"}
+	scope := longterm.Scope(labels)
+	put := func(id, text string, embedding ...float32) {
+		require.NoError(t, store.Put(ctx, longterm.Fact{ID: id, Text: text, Embedding: embedding, Scope: scope, SessionID: id}))
+	}
+	put("s1", "Prefers metric units.", 1, 1, 0, 0, 0)
+	put("s2", "Prefers metric units.", 1, 1, 0, 0, 0)
+	put("s3", "Prefers metric units.", 1, 1, 0, 0, 0)
+	put("s4", "Uses metric units at work.", 1, 0, 0, 0, 0)
+
+	matches, err := mgr.Recall(ctx, labels, "metric units")
+	require.NoError(t, err)
+	require.Len(t, matches, 2, "copies of one fact do not crowd out other facts")
+	require.Equal(t, "Prefers metric units.", matches[0].Fact.Text)
+	require.Equal(t, "Uses metric units at work.", matches[1].Fact.Text)
+}
+
+func TestForgetAndPurge(t *testing.T) {
+	ctx := context.Background()
+	mgr, _ := newManager(t, `{"facts":[{"text":"Prefers metric units."},{"text":"Drinks coffee."}]}`)
+	u1 := map[string]string{"tenant": "acme", "user_id": "u1"}
+	u2 := map[string]string{"tenant": "acme", "user_id": "u2"}
+	facts, err := mgr.Remember(ctx, longterm.RunRef{RunID: "r1", SessionID: "s1", Labels: u1}, conversation())
+	require.NoError(t, err)
+	_, err = mgr.Remember(ctx, longterm.RunRef{RunID: "r2", SessionID: "s2", Labels: u2}, conversation())
+	require.NoError(t, err)
+
+	require.NoError(t, mgr.Forget(ctx, facts[0].ID))
+	remaining, err := mgr.List(ctx, u1)
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	require.Equal(t, "Drinks coffee.", remaining[0].Text)
+
+	require.NoError(t, mgr.PurgeSession(ctx, "s1"))
+	remaining, err = mgr.List(ctx, u1)
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+
+	require.NoError(t, mgr.ForgetScope(ctx, u2))
+	remaining, err = mgr.List(ctx, u2)
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+
+	require.ErrorIs(t, mgr.ForgetScope(ctx, map[string]string{"tenant": "acme"}), longterm.ErrScopeRequired)
+}
+
+func TestRecallReminder(t *testing.T) {
+	_, ok := longterm.RecallReminder(nil)
+	require.False(t, ok)
+
+	rem, ok := longterm.RecallReminder([]longterm.Match{
+		{Fact: longterm.Fact{Text: "Prefers metric units."}, Score: 0.9},
+		{Fact: longterm.Fact{Text: "Is vegetarian."}, Score: 0.5},
+	})
+	require.True(t, ok)
+	require.Equal(t, longterm.ReminderID, rem.ID)
+	require.Equal(t, reminder.AttachmentRunStart, rem.Attachment.Kind)
+	require.Contains(t, rem.Text, "- Prefers metric units.\n- Is vegetarian.")
+}
+
+func TestNewManagerValidatesConfig(t *testing.T) {
+	_, err := longterm.NewManager(longterm.Config{Embedder: wordEmbedder{}, ScopeLabels: []string{"user_id"}})
+	require.Error(t, err)
+	_, err = longterm.NewManager(longterm.Config{Store: inmem.New(), ScopeLabels: []string{"user_id"}})
+	require.Error(t, err)
+	_, err = longterm.NewManager(longterm.Config{Store: inmem.New(), Embedder: wordEmbedder{}})
+	require.Error(t, err)
+}
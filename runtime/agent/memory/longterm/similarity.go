@@ -0,0 +1,57 @@
+package longterm
+
+import (
+	"math"
+	"sort"
+)
+
+// CosineSimilarity returns the cosine similarity of a and b. It returns 0 when
+// the vectors differ in dimension or either vector has zero magnitude.
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		x, y := float64(a[i]), float64(b[i])
+		dot += x * y
+		na += x * x
+		nb += y * y
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+// Rank scores facts against q and returns the matches that pass q.MinScore,
+// ordered by descending score (ties broken by most recent first) and capped at
+// q.Limit. Facts outside q.Scope are skipped. Store implementations that keep
+// facts in process memory use Rank to implement Search.
+func Rank(facts []Fact, q Query) []Match {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	matches := make([]Match, 0, len(facts))
+	for _, f := range facts {
+		if !q.Scope.Sees(f.Scope) {
+			continue
+		}
+		score := CosineSimilarity(q.Embedding, f.Embedding)
+		if score < q.MinScore {
+			continue
+		}
+		matches = append(matches, Match{Fact: f.Clone(), Score: score})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Fact.CreatedAt.After(matches[j].Fact.CreatedAt)
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
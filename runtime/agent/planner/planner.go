@@ -135,6 +135,11 @@ type PlannerContext interface {
 	// this when the conditions for a reminder no longer hold so future turns and
 	// prompts stop surfacing outdated guidance.
 	RemoveReminder(id string)
+
+	// HandoffTargets returns the agents the current agent may transfer the
+	// conversation to via PlanResult.Handoff. The result is empty when the
+	// agent declares no handoff targets.
+	HandoffTargets() []HandoffTarget
 }
 
 // AgentState provides ephemeral, per-run state storage for planners.
@@ -234,6 +239,13 @@ type ToolRequest struct {
 	// ContinuationRootToolCallID identifies the original bounded query advanced
 	// by a synthetic continuation action. It is empty for ordinary tool calls.
 	ContinuationRootToolCallID string
+
+	// Attempt numbers the executions of this call that the runtime started
+	// because an earlier execution failed with a kind listed in the tool's
+	// RetryPolicy. Zero and one denote the first execution. Executors that
+	// deduplicate calls by ToolCallID must treat each attempt as a new call.
+	// Planners leave it unset.
+	Attempt int
 }
 
 // TranscriptName returns the model-facing tool name recorded in provider
@@ -309,6 +321,10 @@ type ToolResult struct {
 	// Telemetry contains tool execution metrics (duration, token usage, model).
 	Telemetry *telemetry.ToolTelemetry
 
+	// Cached reports that the runtime served this result from the tool result
+	// cache instead of executing the tool.
+	Cached bool
+
 	// ToolCallID is the correlation identifier for this tool invocation.
 	ToolCallID string
 
@@ -388,6 +404,34 @@ type FinalResponse struct {
 	Message *model.Message
 }
 
+// Handoff transfers ownership of the conversation to another agent. The run
+// that returns a handoff ends normally with its FinalResponse (typically a
+// short notice such as "Transferring you to billing."); the target agent then
+// answers the same session's subsequent turns with the transcript carried over.
+//
+// Contract:
+//   - Target must be one of the agent's declared handoff targets (see
+//     PlannerContext.HandoffTargets).
+//   - A handoff is terminal: it cannot be combined with tool calls, an await,
+//     or a FinalToolResult.
+type Handoff struct {
+	// Target identifies the agent that takes over the conversation.
+	Target agent.Ident
+	// Reason is an optional explanation surfaced to stream subscribers and
+	// recorded on the run output.
+	Reason string
+}
+
+// HandoffTarget describes one agent the current agent may hand off to, as
+// declared in the design with the Handoff DSL.
+type HandoffTarget struct {
+	// Agent is the fully qualified target agent identifier (service.agent).
+	Agent agent.Ident
+	// Description explains when the target should take over. Planners
+	// typically surface it to the model when deciding whether to hand off.
+	Description string
+}
+
 // FinalToolResult contains the workflow-safe final tool result emitted by a
 // nested planner when it owns the parent tool contract directly.
 //
@@ -714,6 +758,11 @@ type PlanResult struct {
 	// instead of an assistant message.
 	FinalToolResult *FinalToolResult
 
+	// Handoff transfers the conversation to another agent once the run ends.
+	// It requires FinalResponse and must not be combined with tool calls or an
+	// await.
+	Handoff *Handoff
+
 	// Streamed reports whether assistant text for this result has already been
 	// streamed via PlannerEvents.AssistantChunk. When true, runtimes should
 	// avoid emitting an additional full AssistantMessageEvent for the
@@ -0,0 +1,128 @@
+// Package plannertest provides scripted planners for agent unit tests. A
+// Script plays back a fixed sequence of planner turns (tool calls, then a
+// final response) so tests can drive a runtime end to end, typically with the
+// in-memory engine and generated mock executors, without a model client.
+package plannertest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/planner"
+)
+
+type (
+	// Step produces the planner result for one turn of a Script.
+	Step func(ctx context.Context, turn *Turn) (*planner.PlanResult, error)
+
+	// Turn records the planner input observed by one step.
+	Turn struct {
+		// Index is the zero-based position of the turn in the script.
+		Index int
+		// Messages is the conversation history passed to the planner.
+		Messages []*model.Message
+		// ToolOutputs is the accumulated tool-call history. It is nil for the
+		// PlanStart turn.
+		ToolOutputs []*planner.ToolOutput
+		// Finalize is set when the runtime requested a final response.
+		Finalize *planner.Termination
+	}
+
+	// Script is a planner.Planner that answers each PlanStart and PlanResume
+	// call with the next step. Scripts are not retry-safe: engines that retry
+	// planner activities consume one step per attempt.
+	Script struct {
+		mu    sync.Mutex
+		steps []Step
+		turns []*Turn
+	}
+)
+
+var _ planner.Planner = (*Script)(nil)
+
+// New returns a Script that plays back steps in order.
+func New(steps ...Step) *Script {
+	return &Script{steps: steps}
+}
+
+// PlanStart implements planner.Planner.
+func (s *Script) PlanStart(ctx context.Context, input *planner.PlanInput) (*planner.PlanResult, error) {
+	return s.next(ctx, &Turn{Messages: input.Messages})
+}
+
+// PlanResume implements planner.Planner.
+func (s *Script) PlanResume(ctx context.Context, input *planner.PlanResumeInput) (*planner.PlanResult, error) {
+	return s.next(ctx, &Turn{
+		Messages:    input.Messages,
+		ToolOutputs: input.ToolOutputs,
+		Finalize:    input.Finalize,
+	})
+}
+
+// Turns returns the turns played so far in order.
+func (s *Script) Turns() []*Turn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Turn(nil), s.turns...)
+}
+
+// HasMore reports whether some steps have not been played yet. Tests
+// typically assert it is false once the run completes.
+func (s *Script) HasMore() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.turns) < len(s.steps)
+}
+
+// next records turn and runs the matching step.
+func (s *Script) next(ctx context.Context, turn *Turn) (*planner.PlanResult, error) {
+	s.mu.Lock()
+	turn.Index = len(s.turns)
+	if turn.Index >= len(s.steps) {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("plannertest: script exhausted after %d turns", len(s.steps))
+	}
+	s.turns = append(s.turns, turn)
+	step := s.steps[turn.Index]
+	s.mu.Unlock()
+	return step(ctx, turn)
+}
+
+// CallTools returns a step that requests the given tool calls. Use the
+// generated New<Tool>Call helpers to build typed requests.
+func CallTools(calls ...planner.ToolRequest) Step {
+	return func(context.Context, *Turn) (*planner.PlanResult, error) {
+		return &planner.PlanResult{ToolCalls: append([]planner.ToolRequest(nil), calls...)}, nil
+	}
+}
+
+// Final returns a step that ends the run with an assistant text response.
+func Final(text string) Step {
+	return func(context.Context, *Turn) (*planner.PlanResult, error) {
+		return &planner.PlanResult{
+			FinalResponse: &planner.FinalResponse{
+				Message: &model.Message{
+					Role:  model.ConversationRoleAssistant,
+					Parts: []model.Part{model.TextPart{Text: text}},
+				},
+			},
+		}, nil
+	}
+}
+
+// Result returns a step that answers with result as is. Use it for outcomes
+// not covered by the other helpers such as awaits or handoffs.
+func Result(result *planner.PlanResult) Step {
+	return func(context.Context, *Turn) (*planner.PlanResult, error) {
+		return result, nil
+	}
+}
+
+// Fail returns a step that fails the planner turn with err.
+func Fail(err error) Step {
+	return func(context.Context, *Turn) (*planner.PlanResult, error) {
+		return nil, err
+	}
+}
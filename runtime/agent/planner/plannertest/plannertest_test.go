@@ -0,0 +1,48 @@
+package plannertest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/planner"
+)
+
+func TestScriptPlaysStepsInOrder(t *testing.T) {
+	call := planner.ToolRequest{Name: "helpers.summarize_doc", Payload: []byte(`{"doc_id":"d1"}`)}
+	script := New(CallTools(call), Final("done"))
+	ctx := context.Background()
+
+	res, err := script.PlanStart(ctx, &planner.PlanInput{})
+	require.NoError(t, err)
+	require.Len(t, res.ToolCalls, 1)
+	assert.Equal(t, call.Name, res.ToolCalls[0].Name)
+	assert.True(t, script.HasMore())
+
+	outputs := []*planner.ToolOutput{{Name: call.Name}}
+	res, err = script.PlanResume(ctx, &planner.PlanResumeInput{ToolOutputs: outputs})
+	require.NoError(t, err)
+	require.NotNil(t, res.FinalResponse)
+	assert.Equal(t, model.ConversationRoleAssistant, res.FinalResponse.Message.Role)
+	assert.Equal(t, []model.Part{model.TextPart{Text: "done"}}, res.FinalResponse.Message.Parts)
+	assert.False(t, script.HasMore())
+
+	turns := script.Turns()
+	require.Len(t, turns, 2)
+	assert.Nil(t, turns[0].ToolOutputs)
+	assert.Equal(t, 1, turns[1].Index)
+	assert.Equal(t, outputs, turns[1].ToolOutputs)
+}
+
+func TestScriptExhausted(t *testing.T) {
+	script := New(Fail(errors.New("boom")))
+	_, err := script.PlanStart(context.Background(), &planner.PlanInput{})
+	require.EqualError(t, err, "boom")
+	_, err = script.PlanResume(context.Background(), &planner.PlanResumeInput{})
+	require.EqualError(t, err, "plannertest: script exhausted after 1 turns")
+	assert.Len(t, script.Turns(), 1)
+}
@@ -122,6 +122,17 @@ type (
 		// MaxToolCalls budget. Budgeted tools count against the cap; bookkeeping
 		// tools are exempt.
 		BudgetClass ToolBudgetClass
+
+		// ReadOnly reports that the tool does not modify its environment.
+		ReadOnly bool
+
+		// Idempotent reports that repeating a call with the same arguments has no
+		// additional effect.
+		Idempotent bool
+
+		// Destructive reports that the tool may perform irreversible updates.
+		// Policies may use it to require confirmation or restrict the tool.
+		Destructive bool
 	}
 
 	// CapsState tracks remaining execution budgets for a run. The runtime decrements
@@ -0,0 +1,72 @@
+package prompt
+
+import (
+	"context"
+	"errors"
+	"maps"
+)
+
+// overlayStore resolves fixed templates ahead of a base store. It backs
+// Registry.Overlay and is read-only.
+type overlayStore struct {
+	base      Store
+	templates map[Ident]string
+}
+
+// Overlay returns a registry that shares r's baseline specs but renders the
+// given templates in place of both the baseline and any stored override for
+// the corresponding prompt IDs. Prompts without an overlay template resolve as
+// they do in r. The returned registry has no render observer and rejects
+// writes to its store; it is intended for counterfactual evaluation such as
+// run replay.
+func (r *Registry) Overlay(templates map[Ident]string) *Registry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	specs := make(map[Ident]PromptSpec, len(r.specs))
+	for id, spec := range r.specs {
+		specs[id] = clonePromptSpec(spec)
+	}
+	return &Registry{
+		specs: specs,
+		store: &overlayStore{base: r.store, templates: maps.Clone(templates)},
+	}
+}
+
+// Resolve returns the overlay template for promptID when one is configured and
+// otherwise delegates to the base store.
+func (s *overlayStore) Resolve(ctx context.Context, promptID Ident, scope Scope) (*Override, error) {
+	if tmpl, ok := s.templates[promptID]; ok {
+		return &Override{
+			PromptID: promptID,
+			Scope:    scope,
+			Template: tmpl,
+			Version:  VersionFromTemplate(tmpl),
+		}, nil
+	}
+	if s.base == nil {
+		return nil, nil
+	}
+	return s.base.Resolve(ctx, promptID, scope)
+}
+
+// Set always fails: overlays are read-only.
+func (s *overlayStore) Set(context.Context, Ident, Scope, string, map[string]string) error {
+	return errors.New("prompt overlay is read-only")
+}
+
+// History delegates to the base store.
+func (s *overlayStore) History(ctx context.Context, promptID Ident) ([]*Override, error) {
+	if s.base == nil {
+		return nil, nil
+	}
+	return s.base.History(ctx, promptID)
+}
+
+// List delegates to the base store.
+func (s *overlayStore) List(ctx context.Context) ([]*Override, error) {
+	if s.base == nil {
+		return nil, nil
+	}
+	return s.base.List(ctx)
+}
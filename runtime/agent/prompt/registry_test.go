@@ -158,3 +158,48 @@ func TestRegistryRenderReturnsNotFound(t *testing.T) {
 		t.Fatalf("expected ErrPromptNotFound, got %v", err)
 	}
 }
+
+func TestRegistryOverlayReplacesSelectedPrompts(t *testing.T) {
+	t.Parallel()
+
+	store := NewInMemoryStore()
+	reg := NewRegistry(store)
+	for _, id := range []Ident{"example.agent.system", "example.agent.user"} {
+		if err := reg.Register(PromptSpec{
+			ID:       id,
+			AgentID:  "example.agent",
+			Role:     PromptRoleSystem,
+			Template: "baseline {{ .Name }}",
+		}); err != nil {
+			t.Fatalf("register spec: %v", err)
+		}
+	}
+	if err := store.Set(context.Background(), "example.agent.user", Scope{}, "stored {{ .Name }}", nil); err != nil {
+		t.Fatalf("set override: %v", err)
+	}
+
+	overlay := reg.Overlay(map[Ident]string{"example.agent.system": "candidate {{ .Name }}"})
+	data := map[string]any{"Name": "operator"}
+
+	out, err := overlay.Render(context.Background(), "example.agent.system", Scope{}, data)
+	if err != nil {
+		t.Fatalf("render overlay: %v", err)
+	}
+	if out.Text != "candidate operator" {
+		t.Fatalf("expected overlay render, got %q", out.Text)
+	}
+	out, err = overlay.Render(context.Background(), "example.agent.user", Scope{}, data)
+	if err != nil {
+		t.Fatalf("render stored override: %v", err)
+	}
+	if out.Text != "stored operator" {
+		t.Fatalf("expected stored override render, got %q", out.Text)
+	}
+	out, err = reg.Render(context.Background(), "example.agent.system", Scope{}, data)
+	if err != nil {
+		t.Fatalf("render baseline: %v", err)
+	}
+	if out.Text != "baseline operator" {
+		t.Fatalf("overlay leaked into source registry: %q", out.Text)
+	}
+}
@@ -52,6 +52,10 @@ type (
 
 		// ChildRuns links nested agent runs (agent-as-tool) started during this run.
 		ChildRuns []*ChildRunLink
+
+		// Handoff is set when the run ended by transferring the conversation to
+		// another agent.
+		Handoff *HandoffLink
 	}
 
 	// AwaitSnapshot describes the latest await state derived from run events.
@@ -107,4 +111,14 @@ type (
 		// ChildAgentID identifies the agent that executed the child run.
 		ChildAgentID agent.Ident
 	}
+
+	// HandoffLink links a run to the run that took over its conversation.
+	HandoffLink struct {
+		// TargetAgentID identifies the agent that took over.
+		TargetAgentID agent.Ident
+		// TargetRunID is the run identifier reserved for the target agent.
+		TargetRunID string
+		// Reason is the optional planner-provided explanation.
+		Reason string
+	}
 )
@@ -68,7 +68,13 @@ func (r *Runtime) PlanStartActivity(ctx context.Context, input *PlanActivityInpu
 			return nil, err
 		}
 	}
-	act, err := r.preparePlannerActivity(ctx, input, continuationActions, nil)
+	r.recallLongTermMemory(ctx, input)
+	act, err := r.preparePlannerActivity(
+		ctx,
+		input,
+		continuationActions,
+		r.conditionallyUnavailableTools(input.AgentID, input.RunContext.Labels, nil),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +140,10 @@ func (r *Runtime) PlanResumeActivity(ctx context.Context, input *PlanActivityInp
 		ctx,
 		input,
 		continuationActions,
-		replanUnavailableTools(recoveryOutputs),
+		append(
+			replanUnavailableTools(recoveryOutputs),
+			r.conditionallyUnavailableTools(input.AgentID, input.RunContext.Labels, toolOutputs)...,
+		),
 	)
 	if err != nil {
 		return nil, err
@@ -427,20 +436,23 @@ func (a *plannerActivityInvocation) notePlannerRateLimit(ctx context.Context, er
 //     rather than invoking activities directly.
 //
 // It decodes the tool payload, runs the registered tool implementation, and
-// encodes the result using the tool‑specific codec. Returns an error if the
-// toolset is not registered or if encoding/decoding fails.
+// encodes the result using the tool‑specific codec. Tools declaring a cache
+// policy are served from ToolResultCache when an earlier call with the same
+// arguments succeeded. Returns an error if the toolset is not registered or if
+// encoding/decoding fails; errors that executing the call again cannot fix are
+// non-retryable application errors.
 func (r *Runtime) ExecuteToolActivity(ctx context.Context, req *ToolInput) (*ToolOutput, error) {
 	stopHeartbeat := startActivityHeartbeat(ctx)
 	defer stopHeartbeat()
 
 	if req == nil {
-		return nil, errors.New("tool input is required")
+		return nil, nonRetryableToolError(errors.New("tool input is required"))
 	}
 	if req.ToolName == "" {
-		return nil, errors.New("tool name is required")
+		return nil, nonRetryableToolError(errors.New("tool name is required"))
 	}
 	if err := validatePlannerToolPayload(req.Payload); err != nil {
-		return nil, fmt.Errorf("tool payload is invalid: %w", err)
+		return nil, nonRetryableToolError(fmt.Errorf("tool payload is invalid: %w", err))
 	}
 	// Forbid agent-as-tool execution from activities. Agent-tools must execute inside
 	// the workflow thread so child workflows can be started legally.
@@ -449,20 +461,20 @@ func (r *Runtime) ExecuteToolActivity(ctx context.Context, req *ToolInput) (*Too
 		// ExecuteToolActivity, surface a precise error so callers fix the planner
 		// tool list instead of routing through activities.
 		if string(req.AgentID) == spec.AgentID {
-			return nil, fmt.Errorf(
+			return nil, nonRetryableToolError(fmt.Errorf(
 				"agent %q attempted to execute its own agent-as-tool %q via ExecuteToolActivity; "+
 					"agent-as-tools must run inline in workflow context and must not be exposed to the provider's planner tool list",
 				req.AgentID,
 				req.ToolName,
-			)
+			))
 		}
-		return nil, fmt.Errorf("agent-as-tool %q must run in workflow context", req.ToolName)
+		return nil, nonRetryableToolError(fmt.Errorf("agent-as-tool %q must run in workflow context", req.ToolName))
 	}
 	sName := req.ToolsetName
 	if sName == "" {
 		spec, ok := r.toolSpec(req.ToolName)
 		if !ok {
-			return nil, fmt.Errorf("unknown tool %q", req.ToolName)
+			return nil, nonRetryableToolError(fmt.Errorf("unknown tool %q", req.ToolName))
 		}
 		sName = spec.Toolset
 	}
@@ -470,7 +482,7 @@ func (r *Runtime) ExecuteToolActivity(ctx context.Context, req *ToolInput) (*Too
 	reg, ok := r.toolsets[sName]
 	r.mu.RUnlock()
 	if !ok {
-		return nil, fmt.Errorf("toolset %q is not registered", sName)
+		return nil, nonRetryableToolError(fmt.Errorf("toolset %q is not registered", sName))
 	}
 
 	// The generated payload codec owns the model-authored JSON boundary. Tool
@@ -484,7 +496,7 @@ func (r *Runtime) ExecuteToolActivity(ctx context.Context, req *ToolInput) (*Too
 	if !reg.DecodeInExecutor {
 		spec, ok := r.toolSpec(req.ToolName)
 		if !ok {
-			return nil, fmt.Errorf("tool %q has no registered ToolSpec", req.ToolName)
+			return nil, nonRetryableToolError(fmt.Errorf("tool %q has no registered ToolSpec", req.ToolName))
 		}
 		if _, decErr := r.unmarshalToolValue(ctx, req.ToolName, raw.RawMessage(), true); decErr != nil {
 			return &ToolOutput{
@@ -493,6 +505,15 @@ func (r *Runtime) ExecuteToolActivity(ctx context.Context, req *ToolInput) (*Too
 		}
 	}
 
+	// Tools declaring a cache policy reuse the result of an earlier call with
+	// the same canonical arguments in the same scope.
+	cacheKey, cacheable := r.toolResultCacheKey(req)
+	if cacheable {
+		if out, ok := r.cachedToolOutput(ctx, req, cacheKey); ok {
+			return out, nil
+		}
+	}
+
 	// Populate run context fields so tool implementations can access metadata.
 	// Agent-tools use these to construct nested contexts; regular tools use
 	// them for logging/telemetry. Payload is always canonical JSON.
@@ -506,8 +527,12 @@ func (r *Runtime) ExecuteToolActivity(ctx context.Context, req *ToolInput) (*Too
 		TurnID:           req.TurnID,
 		ParentToolCallID: req.ParentToolCallID,
 		ToolCallID:       req.ToolCallID,
+		Attempt:          req.Attempt,
 	}
 	meta := toolCallMeta(call)
+	if spec, ok := r.toolSpec(req.ToolName); ok {
+		ctx = r.withToolProgress(ctx, call, spec)
+	}
 	start := time.Now()
 	execResult, err := reg.Execute(ctx, &call)
 	if err != nil {
@@ -538,6 +563,9 @@ func (r *Runtime) ExecuteToolActivity(ctx context.Context, req *ToolInput) (*Too
 	if clarification != nil {
 		out.Clarification = clarification
 	}
+	if cacheable {
+		r.cacheToolOutput(ctx, req, cacheKey, out)
+	}
 	return out, nil
 }
 
@@ -630,7 +658,10 @@ func (r *Runtime) planStart(ctx context.Context, reg *AgentRegistration, input *
 	}
 	ctx, span := tracer.Start(ctx, "planner.plan_start")
 	defer span.End()
-	return reg.Planner.PlanStart(ctx, input)
+	startedAt := time.Now()
+	result, err := reg.Planner.PlanStart(ctx, input)
+	r.recordPlannerDuration(reg, "plan_start", startedAt, err)
+	return result, err
 }
 
 // planResume invokes the planner's PlanResume method with tracing.
@@ -647,7 +678,10 @@ func (r *Runtime) planResume(ctx context.Context, reg *AgentRegistration, input
 	}
 	ctx, span := tracer.Start(ctx, "planner.plan_resume")
 	defer span.End()
-	return reg.Planner.PlanResume(ctx, input)
+	startedAt := time.Now()
+	result, err := reg.Planner.PlanResume(ctx, input)
+	r.recordPlannerDuration(reg, "plan_resume", startedAt, err)
+	return result, err
 }
 
 // plannerContext constructs the agent registration and context needed for planner execution.
@@ -184,6 +184,17 @@ func (c *simplePlannerContext) RemoveReminder(id string) {
 	c.rt.removeReminder(c.runID, id)
 }
 
+func (c *simplePlannerContext) HandoffTargets() []planner.HandoffTarget {
+	if c.rt == nil {
+		return nil
+	}
+	reg, ok := c.rt.agentByID(c.agent)
+	if !ok || len(reg.Handoffs) == 0 {
+		return nil
+	}
+	return slices.Clone(reg.Handoffs)
+}
+
 // noopAgentState implements planner.AgentState with no persistence.
 type noopAgentState struct{}
 
@@ -61,6 +61,7 @@ func TestExecuteToolCalls_AgentToolPreChildValidatorReturnsToolError(t *testing.
 		wfCtx,
 		"execute",
 		engine.ActivityOptions{},
+		false,
 		"agent-1",
 		&run.Context{
 			RunID:     "run-1",
@@ -97,7 +97,7 @@ func TestExecuteToolCalls_AgentToolsPublishResultsAsComplete(t *testing.T) {
 	}
 	done := make(chan out, 1)
 	go func() {
-		results, _, err := rt.executeToolCalls(wfCtx, "execute", engine.ActivityOptions{}, agent.Ident("parent.agent"), runCtx, nil, calls, 0, nil, time.Time{})
+		results, _, err := rt.executeToolCalls(wfCtx, "execute", engine.ActivityOptions{}, false, agent.Ident("parent.agent"), runCtx, nil, calls, 0, nil, time.Time{})
 		done <- out{results: results, err: err}
 	}()
 
@@ -188,6 +188,7 @@ func TestExecuteToolCalls_CancelsAgentToolAtParentDeadline(t *testing.T) {
 		wfCtx,
 		"execute",
 		engine.ActivityOptions{},
+		false,
 		agent.Ident("parent.agent"),
 		runCtx,
 		nil,
@@ -96,7 +96,7 @@ func TestExecuteToolCalls_MixedBatch_DoesNotRegressOrderingWithinCategories(t *t
 	}
 	done := make(chan out, 1)
 	go func() {
-		results, timedOut, err := rt.executeToolCalls(wfCtx, "execute", engine.ActivityOptions{}, agent.Ident("agent-1"), runCtx, nil, calls, 0, nil, time.Time{})
+		results, timedOut, err := rt.executeToolCalls(wfCtx, "execute", engine.ActivityOptions{}, false, agent.Ident("agent-1"), runCtx, nil, calls, 0, nil, time.Time{})
 		done <- out{results: results, timedOut: timedOut, err: err}
 	}()
 
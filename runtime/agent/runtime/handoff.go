@@ -0,0 +1,187 @@
+package runtime
+
+// handoff.go implements agent handoff: a planner ends its run with a
+// planner.Handoff and the target agent takes over the session. Unlike
+// agent-as-tool execution, the source agent does not resume; the target run
+// starts from the source transcript projected to user/assistant text.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"goa.design/goa-ai/runtime/agent"
+	"goa.design/goa-ai/runtime/agent/engine"
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/run"
+	"goa.design/goa-ai/runtime/agent/session"
+	"goa.design/goa-ai/runtime/agent/transcript"
+)
+
+// ErrNoHandoff indicates StartHandoff was called with a run output that did
+// not end with a handoff.
+var ErrNoHandoff = errors.New("run output has no handoff")
+
+// HandoffRunID returns the deterministic run ID reserved for the target of a
+// handoff. Format: "{fromRunID}/handoff/{target}". The workflow computes it when
+// the planner hands off so session lineage can be recorded before the target
+// run starts.
+func HandoffRunID(fromRunID string, target agent.Ident) string {
+	if fromRunID == "" {
+		fromRunID = unknownID
+	}
+	return fmt.Sprintf("%s/handoff/%s", fromRunID, target)
+}
+
+// StartHandoff starts the target run of a handoff and returns immediately
+// with its workflow handle.
+//
+// The target run:
+//   - belongs to the same session as the source run and inherits its labels,
+//   - uses the reserved out.Handoff.TargetRunID (a WithRunID option is ignored),
+//   - receives the source transcript projected to user and assistant text.
+//     Tool calls, tool results, and reasoning belong to the source agent's tool
+//     contract and are dropped; trailing assistant messages (typically the
+//     handoff notice) are dropped so the transcript ends on the user turn the
+//     target must answer.
+//
+// The target agent must be registered locally. StartHandoff returns
+// ErrNoHandoff when out does not carry a handoff.
+func (r *Runtime) StartHandoff(ctx context.Context, out *RunOutput, opts ...RunOption) (engine.WorkflowHandle, error) {
+	if out == nil || out.Handoff == nil {
+		return nil, ErrNoHandoff
+	}
+	handoff := out.Handoff
+	reg, ok := r.agentByID(handoff.TargetAgentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrAgentNotFound, handoff.TargetAgentID)
+	}
+	from, err := r.SessionStore.LoadRun(ctx, out.RunID)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := transcript.BuildMessagesFromRunLog(ctx, r.RunEventStore, out.RunID)
+	if err != nil {
+		return nil, fmt.Errorf("load handoff transcript: %w", err)
+	}
+	input := buildSessionRunInput(handoff.TargetAgentID, from.SessionID, handoffTranscript(messages), opts)
+	input.RunID = handoff.TargetRunID
+	input.Labels = mergeLabels(cloneLabels(from.Labels), input.Labels)
+	handle, err := r.startRunOn(ctx, &input, reg.Workflow.Name, reg.Workflow.TaskQueue, true)
+	if err != nil {
+		return nil, err
+	}
+	// The source workflow already published the handoff event; linking again
+	// is idempotent and guarantees lineage when hook delivery lags behind.
+	if err := r.SessionStore.LinkHandoffRun(ctx, out.RunID, session.RunMeta{
+		AgentID:   string(handoff.TargetAgentID),
+		RunID:     handoff.TargetRunID,
+		SessionID: from.SessionID,
+		Status:    session.RunStatusPending,
+	}); err != nil {
+		return nil, err
+	}
+	return handle, nil
+}
+
+// ActiveAgent returns the agent that owns the session's subsequent turns: the
+// target of the most recent handoff in the session. It returns an empty
+// identifier when no handoff occurred, in which case callers keep routing to
+// the agent that started the session.
+func (r *Runtime) ActiveAgent(ctx context.Context, sessionID string) (agent.Ident, error) {
+	if sessionID == "" {
+		return "", ErrMissingSessionID
+	}
+	runs, err := r.SessionStore.ListRunsBySession(ctx, sessionID, nil)
+	if err != nil {
+		return "", err
+	}
+	var latest *session.RunMeta
+	for i := range runs {
+		meta := &runs[i]
+		if meta.HandoffFromRunID == "" {
+			continue
+		}
+		if latest == nil || meta.StartedAt.After(latest.StartedAt) {
+			latest = meta
+		}
+	}
+	if latest == nil {
+		return "", nil
+	}
+	return agent.Ident(latest.AgentID), nil
+}
+
+// validatePlannerHandoff enforces that a planner hands off only from a
+// top-level sessionful run and only to a target declared in its registration.
+func (r *Runtime) validatePlannerHandoff(agentID agent.Ident, rc run.Context, handoff *planner.Handoff) error {
+	if handoff == nil {
+		return nil
+	}
+	if rc.SessionID == "" {
+		return errors.New("planner handoff requires a sessionful run")
+	}
+	if rc.ParentRunID != "" {
+		return errors.New("planner handoff is not allowed in nested agent runs")
+	}
+	reg, ok := r.agentByID(agentID)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrAgentNotFound, agentID)
+	}
+	allowed := slices.ContainsFunc(reg.Handoffs, func(t planner.HandoffTarget) bool {
+		return t.Agent == handoff.Target
+	})
+	if !allowed {
+		return fmt.Errorf("agent %q is not allowed to hand off to %q", agentID, handoff.Target)
+	}
+	return nil
+}
+
+// validateHandoffTargets checks the generated handoff targets of an agent
+// registration.
+func validateHandoffTargets(id agent.Ident, targets []planner.HandoffTarget) error {
+	seen := make(map[agent.Ident]struct{}, len(targets))
+	for _, t := range targets {
+		switch {
+		case t.Agent == "":
+			return fmt.Errorf("%w: agent %q declares a handoff without target", ErrInvalidConfig, id)
+		case t.Agent == id:
+			return fmt.Errorf("%w: agent %q cannot hand off to itself", ErrInvalidConfig, id)
+		}
+		if _, dup := seen[t.Agent]; dup {
+			return fmt.Errorf("%w: agent %q declares handoff target %q twice", ErrInvalidConfig, id, t.Agent)
+		}
+		seen[t.Agent] = struct{}{}
+	}
+	return nil
+}
+
+// handoffTranscript projects a source transcript to the user and assistant
+// text the target agent can consume.
+func handoffTranscript(messages []*model.Message) []*model.Message {
+	out := make([]*model.Message, 0, len(messages))
+	for _, m := range messages {
+		if m == nil {
+			continue
+		}
+		if m.Role != model.ConversationRoleUser && m.Role != model.ConversationRoleAssistant {
+			continue
+		}
+		var parts []model.Part
+		for _, p := range m.Parts {
+			if tp, ok := p.(model.TextPart); ok && tp.Text != "" {
+				parts = append(parts, tp)
+			}
+		}
+		if len(parts) == 0 {
+			continue
+		}
+		out = append(out, &model.Message{Role: m.Role, Parts: parts})
+	}
+	for len(out) > 0 && out[len(out)-1].Role == model.ConversationRoleAssistant {
+		out = out[:len(out)-1]
+	}
+	return out
+}
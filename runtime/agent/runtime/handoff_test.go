@@ -0,0 +1,139 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"goa.design/goa-ai/runtime/agent"
+	"goa.design/goa-ai/runtime/agent/engine"
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/run"
+	"goa.design/goa-ai/runtime/agent/runlog"
+	runloginmem "goa.design/goa-ai/runtime/agent/runlog/inmem"
+	"goa.design/goa-ai/runtime/agent/session"
+	sessioninmem "goa.design/goa-ai/runtime/agent/session/inmem"
+	"goa.design/goa-ai/runtime/agent/telemetry"
+	"goa.design/goa-ai/runtime/agent/transcript"
+)
+
+func newHandoffRuntime(t *testing.T) (*Runtime, *stubEngine) {
+	t.Helper()
+	eng := &stubEngine{}
+	rt := &Runtime{
+		Engine:        eng,
+		logger:        telemetry.NoopLogger{},
+		metrics:       telemetry.NoopMetrics{},
+		tracer:        telemetry.NoopTracer{},
+		RunEventStore: runloginmem.New(),
+		SessionStore:  sessioninmem.New(),
+		agents: map[agent.Ident]AgentRegistration{
+			"svc.triage": {
+				ID:       "svc.triage",
+				Workflow: engine.WorkflowDefinition{Name: "triage.workflow", TaskQueue: "q"},
+				Handoffs: []planner.HandoffTarget{{Agent: "svc.billing", Description: "Billing questions"}},
+			},
+			"svc.billing": {
+				ID:       "svc.billing",
+				Workflow: engine.WorkflowDefinition{Name: "billing.workflow", TaskQueue: "billing"},
+			},
+		},
+	}
+	_, err := rt.CreateSession(context.Background(), "sess-1")
+	require.NoError(t, err)
+	return rt, eng
+}
+
+func TestStartHandoffCarriesTranscriptAndLineage(t *testing.T) {
+	ctx := context.Background()
+	rt, eng := newHandoffRuntime(t)
+	require.NoError(t, rt.SessionStore.UpsertRun(ctx, session.RunMeta{
+		AgentID:   "svc.triage",
+		RunID:     "run-1",
+		SessionID: "sess-1",
+		Status:    session.RunStatusCompleted,
+		Labels:    map[string]string{"tenant": "acme"},
+	}))
+	payload, err := transcript.EncodeRunLogDelta([]*model.Message{
+		{Role: model.ConversationRoleUser, Parts: []model.Part{model.TextPart{Text: "I was double charged."}}},
+		{Role: model.ConversationRoleAssistant, Parts: []model.Part{
+			model.ThinkingPart{Text: "billing issue", Signature: "sig"},
+			model.ToolUsePart{ID: "t1", Name: "svc.lookup", Input: rawjson.Message(`{}`)},
+		}},
+		{Role: model.ConversationRoleUser, Parts: []model.Part{model.ToolResultPart{ToolUseID: "t1", Content: "ok"}}},
+		{Role: model.ConversationRoleAssistant, Parts: []model.Part{model.TextPart{Text: "Transferring you to billing."}}},
+	})
+	require.NoError(t, err)
+	_, err = rt.RunEventStore.Append(ctx, &runlog.Event{
+		EventKey:  "run-1/transcript",
+		RunID:     "run-1",
+		AgentID:   "svc.triage",
+		SessionID: "sess-1",
+		Type:      transcript.RunLogMessagesAppended,
+		Payload:   payload,
+		Timestamp: time.Now().UTC(),
+	})
+	require.NoError(t, err)
+
+	out := &RunOutput{
+		AgentID: "svc.triage",
+		RunID:   "run-1",
+		Handoff: &RunHandoff{TargetAgentID: "svc.billing", TargetRunID: HandoffRunID("run-1", "svc.billing")},
+	}
+	_, err = rt.StartHandoff(ctx, out, WithRunID("ignored"), WithLabels(map[string]string{"channel": "web"}))
+	require.NoError(t, err)
+
+	require.Equal(t, "run-1/handoff/svc.billing", eng.last.ID)
+	require.Equal(t, "billing.workflow", eng.last.Workflow)
+	input := eng.last.Input
+	require.Equal(t, "sess-1", input.SessionID)
+	require.Equal(t, map[string]string{"tenant": "acme", "channel": "web"}, input.Labels)
+	require.Len(t, input.Messages, 1, "tool traffic and the trailing handoff notice are dropped")
+	require.Equal(t, "I was double charged.", input.Messages[0].Parts[0].(model.TextPart).Text)
+
+	from, err := rt.SessionStore.LoadRun(ctx, "run-1")
+	require.NoError(t, err)
+	require.Equal(t, eng.last.ID, from.HandoffToRunID)
+	to, err := rt.SessionStore.LoadRun(ctx, eng.last.ID)
+	require.NoError(t, err)
+	require.Equal(t, "run-1", to.HandoffFromRunID)
+	require.Equal(t, session.RunStatusPending, to.Status)
+
+	active, err := rt.ActiveAgent(ctx, "sess-1")
+	require.NoError(t, err)
+	require.Equal(t, agent.Ident("svc.billing"), active)
+}
+
+func TestStartHandoffRequiresHandoff(t *testing.T) {
+	rt, _ := newHandoffRuntime(t)
+	_, err := rt.StartHandoff(context.Background(), &RunOutput{RunID: "run-1"})
+	require.ErrorIs(t, err, ErrNoHandoff)
+
+	active, err := rt.ActiveAgent(context.Background(), "sess-1")
+	require.NoError(t, err)
+	require.Empty(t, active)
+}
+
+func TestValidatePlannerHandoff(t *testing.T) {
+	rt, _ := newHandoffRuntime(t)
+	top := run.Context{RunID: "run-1", SessionID: "sess-1"}
+
+	require.NoError(t, rt.validatePlannerHandoff("svc.triage", top, &planner.Handoff{Target: "svc.billing"}))
+	require.ErrorContains(t, rt.validatePlannerHandoff("svc.triage", top, &planner.Handoff{Target: "svc.other"}), "not allowed")
+	require.ErrorContains(t, rt.validatePlannerHandoff("svc.billing", top, &planner.Handoff{Target: "svc.triage"}), "not allowed")
+	require.ErrorContains(t, rt.validatePlannerHandoff("svc.triage", run.Context{RunID: "run-1"}, &planner.Handoff{Target: "svc.billing"}), "sessionful")
+	nested := top
+	nested.ParentRunID = "parent"
+	require.ErrorContains(t, rt.validatePlannerHandoff("svc.triage", nested, &planner.Handoff{Target: "svc.billing"}), "nested")
+}
+
+func TestValidateHandoffTargets(t *testing.T) {
+	require.NoError(t, validateHandoffTargets("svc.a", []planner.HandoffTarget{{Agent: "svc.b"}, {Agent: "svc.c"}}))
+	require.ErrorIs(t, validateHandoffTargets("svc.a", []planner.HandoffTarget{{Agent: "svc.a"}}), ErrInvalidConfig)
+	require.ErrorIs(t, validateHandoffTargets("svc.a", []planner.HandoffTarget{{Agent: "svc.b"}, {Agent: "svc.b"}}), ErrInvalidConfig)
+	require.ErrorIs(t, validateHandoffTargets("svc.a", []planner.HandoffTarget{{}}), ErrInvalidConfig)
+}
@@ -787,6 +787,9 @@ func canonicalToolMetadata(spec tools.ToolSpec, lookup ToolMetadataLookup) polic
 		Description: spec.Description,
 		Tags:        append([]string(nil), spec.Tags...),
 		BudgetClass: toolBudgetClass(spec.Bookkeeping),
+		ReadOnly:    spec.ReadOnly,
+		Idempotent:  spec.Idempotent,
+		Destructive: spec.Destructive,
 	}
 }
 
@@ -78,12 +78,13 @@ func (r *Runtime) recordActivity(ctx context.Context, input *RecordActivityInput
 			}
 		}
 	}
-	// Tool call argument deltas are best-effort UX signals. They are intentionally
-	// excluded from the canonical run event log to avoid bloating durable history.
+	// Tool call argument deltas and tool progress are best-effort UX signals.
+	// They are intentionally excluded from the canonical run event log to avoid
+	// bloating durable history.
 	//
-	// Consumers must treat ToolCallArgsDelta as optional; the canonical tool
-	// payload is still emitted via tool_start/tool_end and the finalized tool call.
-	if input.Type != hooks.ToolCallArgsDelta {
+	// Consumers must treat them as optional; the canonical tool payload and
+	// outcome are still emitted via tool_start/tool_end.
+	if !isStreamingOnlyHookType(input.Type) {
 		if _, err := r.RunEventStore.Append(ctx, &runlog.Event{
 			EventKey:  input.EventKey,
 			RunID:     input.RunID,
@@ -120,9 +121,8 @@ func (r *Runtime) recordActivity(ctx context.Context, input *RecordActivityInput
 		}
 	}
 
-	// Tool call argument deltas are streaming-only; they do not participate in
-	// derived stores like memory.
-	if input.Type != hooks.ToolCallArgsDelta {
+	// Streaming-only events do not participate in derived stores like memory.
+	if !isStreamingOnlyHookType(input.Type) {
 		if err := r.Bus.Publish(ctx, evt); err != nil {
 			r.logWarn(ctx, "hook publish failed", err, "event", evt.Type())
 		}
@@ -133,6 +133,12 @@ func (r *Runtime) recordActivity(ctx context.Context, input *RecordActivityInput
 	return nil
 }
 
+// isStreamingOnlyHookType reports whether hook events of type t are
+// published to stream sinks only.
+func isStreamingOnlyHookType(t hooks.EventType) bool {
+	return t == hooks.ToolCallArgsDelta || t == hooks.ToolCallProgress
+}
+
 // recordGenAITelemetryEvent projects durable hook records into standard GenAI
 // spans as a hook subscriber. Tool spans are reconstructed from result events so
 // inline, activity, and registry-backed tools share one observability shape.
@@ -342,6 +348,13 @@ func (r *Runtime) updateRunMetaFromHookEvent(ctx context.Context, evt hooks.Even
 			SessionID: e.SessionID(),
 			Status:    session.RunStatusPending,
 		})
+	case *hooks.AgentHandoffEvent:
+		return r.SessionStore.LinkHandoffRun(ctx, e.RunID(), session.RunMeta{
+			AgentID:   string(e.TargetAgentID),
+			RunID:     e.TargetRunID,
+			SessionID: e.SessionID(),
+			Status:    session.RunStatusPending,
+		})
 	case *hooks.RunSuspendedEvent:
 		return r.updateRunStatus(ctx, e.RunID(), session.RunStatusSuspended)
 	case *hooks.RunCompletedEvent:
@@ -0,0 +1,140 @@
+package runtime
+
+// long_term_memory.go wires the optional long-term memory manager into the run
+// lifecycle: facts are recalled as a run-start reminder before PlanStart and
+// extracted from the durable transcript after a run completes successfully.
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"goa.design/goa-ai/runtime/agent/hooks"
+	"goa.design/goa-ai/runtime/agent/memory/longterm"
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/session"
+	"goa.design/goa-ai/runtime/agent/transcript"
+)
+
+// longTermMemoryExtractionTimeout bounds the background extraction started
+// after a run completes.
+const longTermMemoryExtractionTimeout = 2 * time.Minute
+
+// recallLongTermMemory registers a run-start reminder listing the facts most
+// relevant to the latest user message. Recall failures are logged and never
+// fail the planner activity: long-term memory is advisory context.
+func (r *Runtime) recallLongTermMemory(ctx context.Context, input *PlanActivityInput) {
+	if r.LongTermMemory == nil || input == nil {
+		return
+	}
+	query := lastUserText(input.Messages)
+	if query == "" {
+		return
+	}
+	matches, err := r.LongTermMemory.Recall(ctx, input.RunContext.Labels, query)
+	if err != nil {
+		r.logWarn(ctx, "long-term memory recall failed", err, "run_id", input.RunID)
+		return
+	}
+	if rem, ok := longterm.RecallReminder(matches); ok {
+		r.addReminder(input.RunID, rem)
+	}
+}
+
+// rememberLongTermFacts is a hook subscriber that starts long-term fact
+// extraction once a run completes successfully. Extraction calls a model and
+// may take far longer than the hook activity allows, so it runs as tracked
+// background work, detached from the activity context and bounded by
+// longTermMemoryExtractionTimeout; Runtime.Close waits for it. The subscriber
+// is registered after the session subscriber so the run is marked completed
+// before extraction starts.
+func (r *Runtime) rememberLongTermFacts(ctx context.Context, event hooks.Event) error {
+	evt, ok := event.(*hooks.RunCompletedEvent)
+	if !ok || evt.Status != "success" {
+		return nil
+	}
+	ref := longterm.RunRef{
+		AgentID:   evt.AgentID(),
+		RunID:     evt.RunID(),
+		SessionID: evt.SessionID(),
+		Labels:    evt.Labels,
+	}
+	if r.LongTermMemory.Scope(ref.Labels) == nil {
+		return nil
+	}
+	started := r.goBackground(ctx, longTermMemoryExtractionTimeout, func(ctx context.Context) {
+		r.extractLongTermFacts(ctx, ref)
+	})
+	if !started {
+		r.logger.Warn(ctx, "long-term memory extraction skipped: runtime closed", "run_id", ref.RunID)
+	}
+	return nil
+}
+
+// extractLongTermFacts replays the run transcript from the run log and stores
+// the facts the extractor distills from it. Runs of purged sessions are
+// skipped, and when the session is purged while extraction is in flight the
+// facts just stored are removed again so PurgeSession never leaves facts of a
+// purged session behind. Failures are logged: long-term memory is advisory.
+// Fact IDs are deterministic, so duplicate deliveries are harmless.
+func (r *Runtime) extractLongTermFacts(ctx context.Context, ref longterm.RunRef) {
+	if !r.longTermSessionExists(ctx, ref) {
+		return
+	}
+	messages, err := transcript.BuildMessagesFromRunLog(ctx, r.RunEventStore, ref.RunID)
+	if err != nil {
+		r.logWarn(ctx, "long-term memory transcript load failed", err, "run_id", ref.RunID)
+		return
+	}
+	facts, err := r.LongTermMemory.Remember(ctx, ref, messages)
+	if err != nil {
+		r.logWarn(ctx, "long-term memory extraction failed", err, "run_id", ref.RunID)
+		return
+	}
+	if len(facts) == 0 || r.longTermSessionExists(ctx, ref) {
+		return
+	}
+	if err := r.LongTermMemory.PurgeSession(ctx, ref.SessionID); err != nil {
+		r.logWarn(ctx, "long-term memory purge of removed session failed", err, "run_id", ref.RunID)
+	}
+}
+
+// longTermSessionExists reports whether the session that owns ref is still
+// present in the session store. Runs without a session store or session ID
+// are always considered present. Load failures other than not-found are
+// logged and treated as present so transient errors do not drop facts.
+func (r *Runtime) longTermSessionExists(ctx context.Context, ref longterm.RunRef) bool {
+	if r.SessionStore == nil || ref.SessionID == "" {
+		return true
+	}
+	_, err := r.SessionStore.LoadSession(ctx, ref.SessionID)
+	if errors.Is(err, session.ErrSessionNotFound) {
+		return false
+	}
+	if err != nil {
+		r.logWarn(ctx, "long-term memory session load failed", err, "run_id", ref.RunID)
+	}
+	return true
+}
+
+// lastUserText returns the text of the last user message that is not a tool
+// result carrier.
+func lastUserText(messages []*model.Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		m := messages[i]
+		if m == nil || m.Role != model.ConversationRoleUser {
+			continue
+		}
+		var parts []string
+		for _, p := range m.Parts {
+			if tp, ok := p.(model.TextPart); ok && strings.TrimSpace(tp.Text) != "" {
+				parts = append(parts, tp.Text)
+			}
+		}
+		if len(parts) > 0 {
+			return strings.Join(parts, "\n")
+		}
+	}
+	return ""
+}
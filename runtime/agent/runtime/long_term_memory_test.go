@@ -0,0 +1,255 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"goa.design/goa-ai/runtime/agent/hooks"
+	"goa.design/goa-ai/runtime/agent/memory/longterm"
+	longterminmem "goa.design/goa-ai/runtime/agent/memory/longterm/inmem"
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/run"
+	"goa.design/goa-ai/runtime/agent/runlog"
+	"goa.design/goa-ai/runtime/agent/session"
+	sessioninmem "goa.design/goa-ai/runtime/agent/session/inmem"
+	"goa.design/goa-ai/runtime/agent/transcript"
+)
+
+type constantEmbedder struct{}
+
+func (constantEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	out := make([][]float32, len(texts))
+	for i := range texts {
+		out[i] = []float32{1, 0}
+	}
+	return out, nil
+}
+
+func newLongTermMemoryRuntime(t *testing.T) (*Runtime, *longterminmem.Store) {
+	t.Helper()
+	store := longterminmem.New()
+	mgr, err := longterm.NewManager(longterm.Config{
+		Store:       store,
+		Embedder:    constantEmbedder{},
+		ScopeLabels: []string{"user_id"},
+	})
+	require.NoError(t, err)
+	return New(WithLongTermMemory(mgr), WithSessionStore(sessioninmem.New())), store
+}
+
+func TestRecallLongTermMemoryAddsRunStartReminder(t *testing.T) {
+	ctx := context.Background()
+	rt, store := newLongTermMemoryRuntime(t)
+	require.NoError(t, store.Put(ctx, longterm.Fact{
+		ID:        "f1",
+		Text:      "Prefers metric units.",
+		Embedding: []float32{1, 0},
+		Scope:     longterm.Scope{"user_id": "u1"},
+		SessionID: "sess-old",
+	}))
+
+	rt.recallLongTermMemory(ctx, &PlanActivityInput{
+		RunID: "run-1",
+		Messages: []*model.Message{
+			{Role: model.ConversationRoleUser, Parts: []model.Part{model.TextPart{Text: "How far is Paris?"}}},
+		},
+		RunContext: run.Context{RunID: "run-1", Labels: map[string]string{"user_id": "u1"}},
+	})
+	rems := rt.reminders.Snapshot("run-1")
+	require.Len(t, rems, 1)
+	require.Equal(t, longterm.ReminderID, rems[0].ID)
+	require.Contains(t, rems[0].Text, "Prefers metric units.")
+
+	rt.recallLongTermMemory(ctx, &PlanActivityInput{
+		RunID: "run-2",
+		Messages: []*model.Message{
+			{Role: model.ConversationRoleUser, Parts: []model.Part{model.TextPart{Text: "How far is Paris?"}}},
+		},
+		RunContext: run.Context{RunID: "run-2", Labels: map[string]string{"user_id": "u2"}},
+	})
+	require.Empty(t, rt.reminders.Snapshot("run-2"))
+}
+
+func TestPurgeSessionRemovesLongTermFacts(t *testing.T) {
+	ctx := context.Background()
+	rt, store := newLongTermMemoryRuntime(t)
+	_, err := rt.CreateSession(ctx, "sess-1")
+	require.NoError(t, err)
+	_, err = rt.SessionStore.EndSession(ctx, "sess-1", time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx,
+		longterm.Fact{ID: "f1", Text: "a", Embedding: []float32{1}, Scope: longterm.Scope{"user_id": "u1"}, SessionID: "sess-1"},
+		longterm.Fact{ID: "f2", Text: "b", Embedding: []float32{1}, Scope: longterm.Scope{"user_id": "u1"}, SessionID: "sess-2"},
+	))
+
+	require.NoError(t, rt.PurgeSession(ctx, "sess-1"))
+
+	facts, err := store.List(ctx, longterm.Scope{"user_id": "u1"})
+	require.NoError(t, err)
+	require.Len(t, facts, 1)
+	require.Equal(t, "f2", facts[0].ID)
+}
+
+type purgingExtractor struct {
+	rt       *Runtime
+	calls    int
+	purgeErr error
+}
+
+func (e *purgingExtractor) Extract(ctx context.Context, _ []*model.Message) ([]longterm.Candidate, error) {
+	e.calls++
+	e.purgeErr = e.rt.PurgeSession(ctx, "sess-1")
+	return []longterm.Candidate{{Text: "Prefers metric units."}}, nil
+}
+
+func newExtractingRuntime(t *testing.T) (*Runtime, *longterminmem.Store, *purgingExtractor) {
+	t.Helper()
+	store := longterminmem.New()
+	extractor := &purgingExtractor{}
+	mgr, err := longterm.NewManager(longterm.Config{
+		Store:       store,
+		Embedder:    constantEmbedder{},
+		Extractor:   extractor,
+		ScopeLabels: []string{"user_id"},
+	})
+	require.NoError(t, err)
+	rt := New(WithLongTermMemory(mgr), WithSessionStore(sessioninmem.New()))
+	extractor.rt = rt
+	return rt, store, extractor
+}
+
+func TestPurgeSessionBeforeExtractionKeepsNoFacts(t *testing.T) {
+	ctx := context.Background()
+	rt, store, extractor := newExtractingRuntime(t)
+	_, err := rt.CreateSession(ctx, "sess-1")
+	require.NoError(t, err)
+	_, err = rt.SessionStore.EndSession(ctx, "sess-1", time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, rt.PurgeSession(ctx, "sess-1"))
+
+	rt.extractLongTermFacts(ctx, longterm.RunRef{
+		AgentID:   "svc.agent",
+		RunID:     "run-1",
+		SessionID: "sess-1",
+		Labels:    map[string]string{"user_id": "u1"},
+	})
+
+	require.Zero(t, extractor.calls)
+	facts, err := store.List(ctx, longterm.Scope{"user_id": "u1"})
+	require.NoError(t, err)
+	require.Empty(t, facts)
+}
+
+func TestPurgeSessionDuringExtractionKeepsNoFacts(t *testing.T) {
+	ctx := context.Background()
+	rt, store, extractor := newExtractingRuntime(t)
+	labels := map[string]string{"user_id": "u1"}
+	_, err := rt.CreateSession(ctx, "sess-1")
+	require.NoError(t, err)
+	require.NoError(t, rt.SessionStore.UpsertRun(ctx, session.RunMeta{
+		AgentID:   "svc.agent",
+		RunID:     "run-1",
+		SessionID: "sess-1",
+		Status:    session.RunStatusCompleted,
+		UpdatedAt: time.Now().UTC(),
+	}))
+	_, err = rt.SessionStore.EndSession(ctx, "sess-1", time.Now().UTC())
+	require.NoError(t, err)
+
+	rt.extractLongTermFacts(ctx, longterm.RunRef{
+		AgentID:   "svc.agent",
+		RunID:     "run-1",
+		SessionID: "sess-1",
+		Labels:    labels,
+	})
+
+	require.Equal(t, 1, extractor.calls)
+	require.NoError(t, extractor.purgeErr, "the run is completed before extraction starts")
+	facts, err := store.List(ctx, longterm.Scope{"user_id": "u1"})
+	require.NoError(t, err)
+	require.Empty(t, facts, "facts stored after the purge are removed again")
+}
+
+type blockingExtractor struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (e *blockingExtractor) Extract(ctx context.Context, _ []*model.Message) ([]longterm.Candidate, error) {
+	close(e.started)
+	select {
+	case <-e.release:
+		return []longterm.Candidate{{Text: "Prefers metric units."}}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// newBackgroundExtractionRuntime returns a runtime whose extractor blocks until
+// released, with a recorded transcript for run-1.
+func newBackgroundExtractionRuntime(t *testing.T) (*Runtime, *longterminmem.Store, *blockingExtractor) {
+	t.Helper()
+	store := longterminmem.New()
+	extractor := &blockingExtractor{started: make(chan struct{}), release: make(chan struct{})}
+	mgr, err := longterm.NewManager(longterm.Config{
+		Store:       store,
+		Embedder:    constantEmbedder{},
+		Extractor:   extractor,
+		ScopeLabels: []string{"user_id"},
+	})
+	require.NoError(t, err)
+	rt := New(WithLongTermMemory(mgr))
+	payload, err := transcript.EncodeRunLogDelta([]*model.Message{
+		{Role: model.ConversationRoleUser, Parts: []model.Part{model.TextPart{Text: "Use metric units."}}},
+	})
+	require.NoError(t, err)
+	_, err = rt.RunEventStore.Append(context.Background(), &runlog.Event{
+		EventKey: "run-1/1", RunID: "run-1", AgentID: "svc.agent",
+		Type: transcript.RunLogMessagesSeeded, Payload: payload,
+	})
+	require.NoError(t, err)
+	return rt, store, extractor
+}
+
+func completedRun(runID string) *hooks.RunCompletedEvent {
+	return hooks.NewRunCompletedEvent(runID, "svc.agent", "", "success", run.PhaseCompleted,
+		map[string]string{"user_id": "u1"}, nil, nil)
+}
+
+func TestCloseWaitsForLongTermExtraction(t *testing.T) {
+	ctx := context.Background()
+	rt, store, extractor := newBackgroundExtractionRuntime(t)
+
+	require.NoError(t, rt.rememberLongTermFacts(ctx, completedRun("run-1")))
+	<-extractor.started
+	closed := make(chan error, 1)
+	go func() { closed <- rt.Close(ctx) }()
+	select {
+	case <-closed:
+		t.Fatal("Close returned before the extraction finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(extractor.release)
+	require.NoError(t, <-closed)
+
+	facts, err := store.List(ctx, longterm.Scope{"user_id": "u1"})
+	require.NoError(t, err)
+	require.Len(t, facts, 1)
+	require.NoError(t, rt.rememberLongTermFacts(ctx, completedRun("run-2")), "no extraction starts after Close")
+}
+
+func TestCloseCancelsLongTermExtractionWhenContextEnds(t *testing.T) {
+	rt, store, extractor := newBackgroundExtractionRuntime(t)
+
+	require.NoError(t, rt.rememberLongTermFacts(context.Background(), completedRun("run-1")))
+	<-extractor.started
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, rt.Close(ctx), context.DeadlineExceeded)
+
+	facts, err := store.List(context.Background(), longterm.Scope{"user_id": "u1"})
+	require.NoError(t, err)
+	require.Empty(t, facts)
+}
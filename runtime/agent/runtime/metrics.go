@@ -0,0 +1,160 @@
+package runtime
+
+// metrics.go emits the standard runtime metric catalogue (see
+// telemetry.MetricCatalog). Run, tool, token, and confirmation metrics are
+// projected from hook events so inline, activity, and registry-backed
+// execution share one shape; planner latency is measured around planner
+// invocations inside the plan activities.
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"goa.design/goa-ai/runtime/agent/hooks"
+	"goa.design/goa-ai/runtime/agent/telemetry"
+)
+
+// maxAwaitingConfirmations bounds the confirmation waits a metrics subscriber
+// tracks. Waits of runs that never complete on this worker (suspended runs
+// resumed elsewhere, purged sessions) would otherwise accumulate forever.
+const maxAwaitingConfirmations = 4096
+
+// metricsSubscriber records catalogue metrics from hook events.
+type metricsSubscriber struct {
+	metrics telemetry.Metrics
+
+	mu sync.Mutex
+	// awaitingConfirmation records when each tool call started waiting for
+	// operator confirmation, keyed by run ID then tool call ID. Waits that
+	// start on one worker and resolve on another are not measured, and a
+	// run's waits are dropped when it completes.
+	awaitingConfirmation map[string]map[string]time.Time
+	// awaiting counts the waits in awaitingConfirmation.
+	awaiting int
+}
+
+// newMetricsSubscriber returns a hook subscriber recording catalogue metrics.
+func newMetricsSubscriber(metrics telemetry.Metrics) *metricsSubscriber {
+	return &metricsSubscriber{
+		metrics:              metrics,
+		awaitingConfirmation: make(map[string]map[string]time.Time),
+	}
+}
+
+// HandleEvent records the metrics derived from evt. It never fails so metric
+// recording cannot interfere with event delivery.
+func (s *metricsSubscriber) HandleEvent(_ context.Context, evt hooks.Event) error {
+	switch e := evt.(type) {
+	case *hooks.RunStartedEvent:
+		s.metrics.IncCounter(telemetry.MetricRunStarted, 1, telemetry.TagAgent, e.AgentID())
+	case *hooks.RunCompletedEvent:
+		s.mu.Lock()
+		s.awaiting -= len(s.awaitingConfirmation[e.RunID()])
+		delete(s.awaitingConfirmation, e.RunID())
+		s.mu.Unlock()
+		s.metrics.IncCounter(telemetry.MetricRunCompleted, 1,
+			telemetry.TagAgent, e.AgentID(),
+			telemetry.TagStatus, e.Status,
+		)
+	case *hooks.ToolResultReceivedEvent:
+		outcome := telemetry.OutcomeSuccess
+		if e.Failure != nil {
+			outcome = telemetry.OutcomeError
+		}
+		s.metrics.RecordTimer(telemetry.MetricToolDuration, e.Duration,
+			telemetry.TagToolset, e.ToolName.Toolset(),
+			telemetry.TagTool, e.ToolName.Tool(),
+			telemetry.TagOutcome, outcome,
+		)
+	case *hooks.UsageEvent:
+		class := string(e.ModelClass)
+		for _, c := range []struct {
+			kind  string
+			count int
+		}{
+			{"input", e.InputTokens},
+			{"output", e.OutputTokens},
+			{"cache_read", e.CacheReadTokens},
+			{"cache_write", e.CacheWriteTokens},
+		} {
+			if c.count > 0 {
+				s.metrics.IncCounter(telemetry.MetricModelTokens, float64(c.count),
+					telemetry.TagModelClass, class,
+					telemetry.TagTokenKind, c.kind,
+				)
+			}
+		}
+	case *hooks.AwaitConfirmationEvent:
+		s.startConfirmationWait(e.RunID(), e.ToolCallID, time.UnixMilli(e.Timestamp()))
+	case *hooks.ToolAuthorizationEvent:
+		startedAt, ok := s.endConfirmationWait(e.RunID(), e.ToolCallID)
+		if !ok {
+			return nil
+		}
+		decision := "denied"
+		if e.Approved {
+			decision = "approved"
+		}
+		s.metrics.RecordTimer(telemetry.MetricConfirmationWait, time.UnixMilli(e.Timestamp()).Sub(startedAt),
+			telemetry.TagTool, string(e.ToolName),
+			telemetry.TagDecision, decision,
+		)
+	}
+	return nil
+}
+
+// startConfirmationWait records that toolCallID of runID started waiting for
+// confirmation at startedAt. The wait is not measured when the subscriber
+// already tracks maxAwaitingConfirmations waits.
+func (s *metricsSubscriber) startConfirmationWait(runID, toolCallID string, startedAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	calls := s.awaitingConfirmation[runID]
+	if _, ok := calls[toolCallID]; !ok {
+		if s.awaiting >= maxAwaitingConfirmations {
+			return
+		}
+		s.awaiting++
+	}
+	if calls == nil {
+		calls = make(map[string]time.Time)
+		s.awaitingConfirmation[runID] = calls
+	}
+	calls[toolCallID] = startedAt
+}
+
+// endConfirmationWait removes and returns the start of the confirmation wait
+// of toolCallID in runID, if tracked.
+func (s *metricsSubscriber) endConfirmationWait(runID, toolCallID string) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	calls := s.awaitingConfirmation[runID]
+	startedAt, ok := calls[toolCallID]
+	if !ok {
+		return time.Time{}, false
+	}
+	s.awaiting--
+	if len(calls) == 1 {
+		delete(s.awaitingConfirmation, runID)
+	} else {
+		delete(calls, toolCallID)
+	}
+	return startedAt, true
+}
+
+// recordPlannerDuration records the latency of one planner invocation.
+func (r *Runtime) recordPlannerDuration(reg *AgentRegistration, operation string, startedAt time.Time, err error) {
+	if r.metrics == nil {
+		return
+	}
+	outcome := telemetry.OutcomeSuccess
+	if err != nil {
+		outcome = telemetry.OutcomeError
+	}
+	r.metrics.RecordTimer(telemetry.MetricPlannerDuration, time.Since(startedAt),
+		telemetry.TagAgent, string(reg.ID),
+		telemetry.TagOperation, operation,
+		telemetry.TagOutcome, outcome,
+	)
+}
@@ -0,0 +1,88 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"goa.design/goa-ai/runtime/agent/hooks"
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/run"
+	"goa.design/goa-ai/runtime/agent/telemetry"
+)
+
+func TestMetricsSubscriberRecordsCatalogue(t *testing.T) {
+	ctx := context.Background()
+	prom := telemetry.NewPrometheusMetrics(telemetry.WithPrometheusBuckets(1))
+	sub := newMetricsSubscriber(prom)
+
+	await := hooks.NewAwaitConfirmationEvent("run-1", "svc.chat", "sess-1", "await-1", "", "Delete?", "ops.delete", "call-1", nil)
+	await.SetTimestampMS(1_000)
+	authz := hooks.NewToolAuthorizationEvent("run-1", "svc.chat", "sess-1", "ops.delete", "call-1", true, "", "user:alice")
+	authz.SetTimestampMS(3_500)
+
+	for _, evt := range []hooks.Event{
+		hooks.NewRunStartedEvent("run-1", "svc.chat", run.Context{RunID: "run-1"}, nil),
+		hooks.NewToolResultReceivedEvent("run-1", "svc.chat", "", "run-1", "ops.lookup", "call-0", "", nil, 0, false, "", nil, "", nil, 200*time.Millisecond, nil, nil),
+		hooks.NewToolResultReceivedEvent("run-1", "svc.chat", "", "run-1", "ops.lookup", "call-2", "", nil, 0, false, "", nil, "", nil, 2*time.Second, nil, &planner.ToolFailure{Error: planner.NewToolError("boom")}),
+		hooks.NewUsageEvent("run-1", "svc.chat", "", model.TokenUsage{ModelClass: model.ModelClassDefault, InputTokens: 10, OutputTokens: 4}),
+		await,
+		authz,
+		hooks.NewRunCompletedEvent("run-1", "svc.chat", "", "success", run.PhaseCompleted, nil, nil, nil),
+	} {
+		require.NoError(t, sub.HandleEvent(ctx, evt))
+	}
+
+	out := string(prom.Expose())
+	require.Contains(t, out, `agent_run_started_total{agent="svc.chat"} 1`)
+	require.Contains(t, out, `agent_run_completed_total{agent="svc.chat",status="success"} 1`)
+	require.Contains(t, out, `agent_tool_duration_seconds_bucket{outcome="success",tool="lookup",toolset="ops",le="1"} 1`)
+	require.Contains(t, out, `agent_tool_duration_seconds_count{outcome="error",tool="lookup",toolset="ops"} 1`)
+	require.Contains(t, out, `agent_model_tokens_total{kind="input",model_class="default"} 10`)
+	require.Contains(t, out, `agent_model_tokens_total{kind="output",model_class="default"} 4`)
+	require.NotContains(t, out, `kind="cache_read"`)
+	require.Contains(t, out, `agent_confirmation_wait_seconds_sum{decision="approved",tool="ops.delete"} 2.5`)
+}
+
+func TestPlannerDurationMetric(t *testing.T) {
+	prom := telemetry.NewPrometheusMetrics()
+	rt := &Runtime{metrics: prom, tracer: telemetry.NoopTracer{}}
+	reg := &AgentRegistration{ID: "svc.chat", Planner: &stubPlanner{}}
+
+	_, err := rt.planStart(context.Background(), reg, &planner.PlanInput{})
+	require.NoError(t, err)
+
+	require.Contains(t, string(prom.Expose()),
+		`agent_planner_duration_seconds_count{agent="svc.chat",operation="plan_start",outcome="success"} 1`)
+}
+
+func TestMetricsSubscriberReleasesConfirmationWaits(t *testing.T) {
+	ctx := context.Background()
+	sub := newMetricsSubscriber(telemetry.NewNoopMetrics())
+
+	await := func(runID, callID string) hooks.Event {
+		return hooks.NewAwaitConfirmationEvent(runID, "svc.chat", "sess-1", "await-"+callID, "", "Delete?", "ops.delete", callID, nil)
+	}
+	require.NoError(t, sub.HandleEvent(ctx, await("run-1", "call-1")))
+	require.NoError(t, sub.HandleEvent(ctx, await("run-1", "call-2")))
+	require.NoError(t, sub.HandleEvent(ctx, await("run-2", "call-1")))
+	require.Equal(t, 3, sub.awaiting)
+
+	require.NoError(t, sub.HandleEvent(ctx, hooks.NewRunCompletedEvent("run-1", "svc.chat", "", "canceled", run.PhaseCanceled, nil, nil, nil)))
+	require.NotContains(t, sub.awaitingConfirmation, "run-1")
+	require.Equal(t, 1, sub.awaiting)
+
+	require.NoError(t, sub.HandleEvent(ctx, hooks.NewToolAuthorizationEvent("run-2", "svc.chat", "sess-1", "ops.delete", "call-1", false, "", "user:alice")))
+	require.Empty(t, sub.awaitingConfirmation)
+	require.Zero(t, sub.awaiting)
+
+	for i := range maxAwaitingConfirmations + 1 {
+		require.NoError(t, sub.HandleEvent(ctx, await("run-3", fmt.Sprintf("call-%d", i))))
+	}
+	require.Equal(t, maxAwaitingConfirmations, sub.awaiting)
+	require.Len(t, sub.awaitingConfirmation["run-3"], maxAwaitingConfirmations)
+}
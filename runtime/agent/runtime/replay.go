@@ -0,0 +1,659 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"goa.design/goa-ai/runtime/agent"
+	"goa.design/goa-ai/runtime/agent/hooks"
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/prompt"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/reminder"
+	"goa.design/goa-ai/runtime/agent/run"
+	"goa.design/goa-ai/runtime/agent/runlog"
+	"goa.design/goa-ai/runtime/agent/tools"
+	"goa.design/goa-ai/runtime/agent/transcript"
+)
+
+type (
+	// ReplayOptions configures a counterfactual re-execution of a recorded run.
+	ReplayOptions struct {
+		// RunID identifies the recorded run to replay. Required.
+		RunID string
+		// Model, when set, substitutes every model client the planner requests
+		// through its PlannerContext.
+		Model model.Client
+		// Models substitutes model clients by model ID. Entries take precedence
+		// over Model. Model IDs with no substitute resolve to the clients
+		// registered with the runtime.
+		Models map[string]model.Client
+		// PromptOverrides renders the given templates in place of the registered
+		// prompts (and of any stored override) for the corresponding prompt IDs.
+		PromptOverrides map[prompt.Ident]string
+		// MaxTurns bounds the number of planner turns executed by the replay.
+		// Defaults to DefaultReplayMaxTurns.
+		MaxTurns int
+	}
+
+	// ReplayResult pairs the decisions of a recorded run with the decisions
+	// the planner made when re-executed.
+	ReplayResult struct {
+		// RunID identifies the recorded run.
+		RunID string
+		// AgentID identifies the agent whose planner was re-executed.
+		AgentID agent.Ident
+		// Original lists the recorded planner decisions, in order.
+		Original []ReplayDecision
+		// Replayed lists the decisions made during the replay, in order.
+		Replayed []ReplayDecision
+		// Turns pairs original and replayed decisions by turn index.
+		Turns []ReplayTurn
+		// Messages is the transcript produced by the replay, starting with the
+		// recorded seed messages.
+		Messages []*model.Message
+	}
+
+	// ReplayDecision summarizes one planner turn.
+	ReplayDecision struct {
+		// ToolCalls lists the tool calls requested by the turn, using their
+		// model-facing names and payloads.
+		ToolCalls []ReplayToolCall
+		// Text is the assistant text produced by the turn.
+		Text string
+		// Handoff is the agent the turn handed the conversation to, if any.
+		Handoff agent.Ident
+		// Await reports whether the turn ended the run waiting for external
+		// input.
+		Await bool
+	}
+
+	// ReplayToolCall is one tool call requested by a planner turn.
+	ReplayToolCall struct {
+		// Name is the model-facing tool identifier.
+		Name tools.Ident
+		// Payload is the model-facing tool payload.
+		Payload rawjson.Message
+		// Stub describes how the replay obtained the tool result. Empty for
+		// recorded calls.
+		Stub ReplayStub
+	}
+
+	// ReplayTurn pairs the original and replayed decisions of a turn. Either
+	// side is nil when one run took more turns than the other.
+	ReplayTurn struct {
+		// Index is the zero-based planner turn index.
+		Index int
+		// Original is the recorded decision.
+		Original *ReplayDecision
+		// Replayed is the decision made during the replay.
+		Replayed *ReplayDecision
+		// Changed reports whether the decisions differ.
+		Changed bool
+	}
+
+	// ReplayStub describes how a replayed tool call was satisfied.
+	ReplayStub string
+
+	// replayRecording is the planner-relevant content of a recorded run.
+	replayRecording struct {
+		agentID   agent.Ident
+		runCtx    run.Context
+		seed      []*model.Message
+		decisions []ReplayDecision
+		results   []*recordedToolResult
+	}
+
+	// recordedToolResult is one recorded top-level tool result with the
+	// payload of the call that produced it and the tool_result part the
+	// planner saw.
+	recordedToolResult struct {
+		payload rawjson.Message
+		event   *hooks.ToolResultReceivedEvent
+		part    model.ToolResultPart
+		used    bool
+	}
+
+	// replayPlannerContext substitutes model clients and prompts for a
+	// replayed planner turn and delegates everything else to the runtime
+	// planner context.
+	replayPlannerContext struct {
+		planner.PlannerContext
+		model   model.Client
+		models  map[string]model.Client
+		prompts *prompt.Registry
+		scope   prompt.Scope
+		events  planner.PlannerEvents
+	}
+)
+
+const (
+	// ReplayStubExact indicates the replay reused the recorded result of a
+	// call with the same tool and an equivalent payload.
+	ReplayStubExact ReplayStub = "exact"
+	// ReplayStubTool indicates the replay reused the next unused recorded
+	// result of the same tool because no call with an equivalent payload was
+	// recorded.
+	ReplayStubTool ReplayStub = "tool"
+	// ReplayStubMissing indicates the recorded run never called the tool; the
+	// replay returned a tool failure to the planner.
+	ReplayStubMissing ReplayStub = "missing"
+)
+
+// DefaultReplayMaxTurns is the default bound on replayed planner turns.
+const DefaultReplayMaxTurns = 16
+
+// replayRunSuffix is appended to the recorded run ID to scope runtime state
+// (for example, reminders) created by replayed planner turns.
+const replayRunSuffix = "/replay"
+
+// Replay re-executes the planner of a recorded run and compares its decisions
+// with the recorded ones.
+//
+// The replay rebuilds the run's seed transcript from the run event store,
+// invokes the registered planner directly (no workflow engine, hooks, or
+// stream events), and answers tool calls with the results recorded in the
+// original run instead of executing tools. Substitute model clients and prompt
+// overrides come from opts. Planner errors end the replay and are returned
+// with the index of the failing turn.
+func (r *Runtime) Replay(ctx context.Context, opts ReplayOptions) (*ReplayResult, error) {
+	if opts.RunID == "" {
+		return nil, errors.New("run id is required")
+	}
+	if r.RunEventStore == nil {
+		return nil, errors.New("run event store is required to replay runs")
+	}
+	rec, err := r.loadReplayRecording(ctx, opts.RunID)
+	if err != nil {
+		return nil, err
+	}
+	reg, ok := r.agentByID(rec.agentID)
+	if !ok {
+		return nil, fmt.Errorf("agent %q is not registered", rec.agentID)
+	}
+	if reg.Planner == nil {
+		return nil, errors.New("planner not configured")
+	}
+	maxTurns := opts.MaxTurns
+	if maxTurns <= 0 {
+		maxTurns = DefaultReplayMaxTurns
+	}
+
+	replayRunID := opts.RunID + replayRunSuffix
+	if r.reminders != nil {
+		defer r.reminders.ClearRun(replayRunID)
+	}
+	reader, err := r.memoryReader(ctx, string(rec.agentID), opts.RunID)
+	if err != nil {
+		return nil, err
+	}
+	events := planner.NoopEvents()
+	runCtx := rec.runCtx
+	runCtx.RunID = replayRunID
+	agentCtx := &replayPlannerContext{
+		PlannerContext: newAgentContext(agentContextOptions{
+			runtime:   r,
+			agentID:   rec.agentID,
+			runID:     replayRunID,
+			memory:    reader,
+			sessionID: runCtx.SessionID,
+			labels:    runCtx.Labels,
+			turnID:    runCtx.TurnID,
+			events:    events,
+			cache:     reg.Policy.Cache,
+		}),
+		model:  opts.Model,
+		models: opts.Models,
+		scope:  prompt.Scope{SessionID: runCtx.SessionID, Labels: cloneLabels(runCtx.Labels)},
+		events: events,
+	}
+	if len(opts.PromptOverrides) > 0 && r.PromptRegistry != nil {
+		agentCtx.prompts = r.PromptRegistry.Overlay(opts.PromptOverrides)
+	}
+
+	out := &ReplayResult{RunID: opts.RunID, AgentID: rec.agentID, Original: rec.decisions}
+	messages, err := model.CloneMessages(rec.seed)
+	if err != nil {
+		return nil, fmt.Errorf("clone seed transcript: %w", err)
+	}
+	var outputs []*planner.ToolOutput
+	result, err := reg.Planner.PlanStart(ctx, &planner.PlanInput{
+		Messages:   messages,
+		RunContext: runCtx,
+		Agent:      agentCtx,
+		Events:     events,
+		Reminders:  r.replayReminders(replayRunID),
+	})
+	for turn := 0; ; turn++ {
+		if err != nil {
+			return nil, fmt.Errorf("replay turn %d: %w", turn, err)
+		}
+		decision := replayDecisionFromPlan(result)
+		if len(result.ToolCalls) == 0 || result.Await != nil || turn+1 >= maxTurns {
+			out.Replayed = append(out.Replayed, decision)
+			if result.FinalResponse != nil && result.FinalResponse.Message != nil {
+				messages = append(messages, result.FinalResponse.Message)
+			}
+			break
+		}
+		for i := range result.ToolCalls {
+			if result.ToolCalls[i].ToolCallID == "" {
+				result.ToolCalls[i].ToolCallID = fmt.Sprintf("replay-%d-%d", turn, i)
+			}
+		}
+		var uses []*model.Message
+		if uses, err = plannerAuthoredResponseMessages(result); err != nil {
+			return nil, fmt.Errorf("build replayed tool calls: %w", err)
+		}
+		results := make([]model.Part, 0, len(result.ToolCalls))
+		for i, call := range result.ToolCalls {
+			output, match, stub := rec.stub(replayRunID, call)
+			decision.ToolCalls[i].Stub = stub
+			outputs = append(outputs, output)
+			var part model.ToolResultPart
+			if match != nil {
+				part = match.part
+			} else {
+				content, err := r.toolResultContent(&call, &planner.ToolResult{
+					Name:       call.Name,
+					ToolCallID: call.ToolCallID,
+					Failure:    output.Failure,
+				})
+				if err != nil {
+					return nil, err
+				}
+				part = model.ToolResultPart{Content: content, IsError: true}
+			}
+			part.ToolUseID = call.ToolCallID
+			results = append(results, part)
+		}
+		out.Replayed = append(out.Replayed, decision)
+		messages = append(messages, uses...)
+		messages = append(messages, &model.Message{Role: model.ConversationRoleUser, Parts: results})
+		result, err = reg.Planner.PlanResume(ctx, &planner.PlanResumeInput{
+			Messages:    messages,
+			RunContext:  runCtx,
+			Agent:       agentCtx,
+			Events:      events,
+			ToolOutputs: outputs,
+			Reminders:   r.replayReminders(replayRunID),
+		})
+	}
+	out.Messages = messages
+	out.Turns = pairReplayTurns(out.Original, out.Replayed)
+	return out, nil
+}
+
+// WriteDiff writes a side-by-side comparison of the original and replayed
+// decisions, one block per planner turn. Changed turns are flagged with "*".
+func (res *ReplayResult) WriteDiff(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "\tturn\toriginal (run %s)\treplayed\n", res.RunID)
+	for _, t := range res.Turns {
+		marker := " "
+		if t.Changed {
+			marker = "*"
+		}
+		left, right := t.Original.lines(), t.Replayed.lines()
+		for i := 0; i < max(len(left), len(right)); i++ {
+			var l, r string
+			if i < len(left) {
+				l = left[i]
+			}
+			if i < len(right) {
+				r = right[i]
+			}
+			if i == 0 {
+				fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", marker, t.Index+1, l, r)
+				continue
+			}
+			fmt.Fprintf(tw, "\t\t%s\t%s\n", l, r)
+		}
+	}
+	return tw.Flush()
+}
+
+// lines renders the decision as one line per tool call, text, handoff, or
+// await. A nil decision renders as a single "-".
+func (d *ReplayDecision) lines() []string {
+	if d == nil {
+		return []string{"-"}
+	}
+	var out []string
+	for _, c := range d.ToolCalls {
+		line := fmt.Sprintf("call %s %s", c.Name, compactJSON(c.Payload))
+		if c.Stub != "" {
+			line += fmt.Sprintf(" [%s]", c.Stub)
+		}
+		out = append(out, line)
+	}
+	if d.Text != "" {
+		out = append(out, "say "+strings.Join(strings.Fields(d.Text), " "))
+	}
+	if d.Handoff != "" {
+		out = append(out, "handoff "+string(d.Handoff))
+	}
+	if d.Await {
+		out = append(out, "await")
+	}
+	if len(out) == 0 {
+		out = append(out, "(empty)")
+	}
+	return out
+}
+
+// equal reports whether two decisions requested the same tool calls with
+// equivalent payloads and produced the same text, handoff, and await.
+func (d *ReplayDecision) equal(o *ReplayDecision) bool {
+	if d == nil || o == nil {
+		return d == o
+	}
+	if d.Text != o.Text || d.Handoff != o.Handoff || d.Await != o.Await {
+		return false
+	}
+	if len(d.ToolCalls) != len(o.ToolCalls) {
+		return false
+	}
+	for i := range d.ToolCalls {
+		if d.ToolCalls[i].Name != o.ToolCalls[i].Name || !sameJSON(d.ToolCalls[i].Payload, o.ToolCalls[i].Payload) {
+			return false
+		}
+	}
+	return true
+}
+
+// ModelClient returns the substitute client for id, falling back to the
+// runtime-configured client.
+func (c *replayPlannerContext) ModelClient(id string) (model.Client, bool) {
+	if cli, ok := c.substitute(id); ok {
+		return cli, true
+	}
+	return c.PlannerContext.ModelClient(id)
+}
+
+// PlannerModelClient returns the substitute client for id wrapped for planner
+// event emission, falling back to the runtime-configured client.
+func (c *replayPlannerContext) PlannerModelClient(id string) (planner.PlannerModelClient, bool) {
+	if cli, ok := c.substitute(id); ok {
+		return newPlannerModelClient(cli, c.events), true
+	}
+	return c.PlannerContext.PlannerModelClient(id)
+}
+
+// RenderPrompt renders through the prompt overlay when overrides are
+// configured.
+func (c *replayPlannerContext) RenderPrompt(ctx context.Context, id prompt.Ident, data any) (*prompt.PromptContent, error) {
+	if c.prompts == nil {
+		return c.PlannerContext.RenderPrompt(ctx, id, data)
+	}
+	return c.prompts.Render(ctx, id, c.scope, data)
+}
+
+func (c *replayPlannerContext) substitute(id string) (model.Client, bool) {
+	if cli, ok := c.models[id]; ok && cli != nil {
+		return cli, true
+	}
+	if c.model != nil {
+		return c.model, true
+	}
+	return nil, false
+}
+
+// replayReminders returns the reminders registered by earlier replayed turns.
+func (r *Runtime) replayReminders(runID string) []reminder.Reminder {
+	if r.reminders == nil {
+		return nil
+	}
+	return r.reminders.Snapshot(runID)
+}
+
+// loadReplayRecording reads the run log of runID and extracts the seed
+// transcript, the recorded decisions, and the recorded tool results.
+func (r *Runtime) loadReplayRecording(ctx context.Context, runID string) (*replayRecording, error) {
+	events, err := r.listRunEvents(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("list run events for %q: %w", runID, err)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("run %q has no recorded events", runID)
+	}
+	messages, err := transcript.BuildMessagesFromRunLog(ctx, r.RunEventStore, runID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		rec       = replayRecording{agentID: events[0].AgentID}
+		seeded    []*runlog.Event
+		results   []*recordedToolResult
+		payloads  = make(map[string]rawjson.Message)
+		handoff   agent.Ident
+		suspended bool
+	)
+	for _, e := range events {
+		switch e.Type {
+		case transcript.RunLogMessagesSeeded:
+			seeded = append(seeded, e)
+			continue
+		case runlog.Type(hooks.RunStarted), runlog.Type(hooks.ToolCallScheduled),
+			runlog.Type(hooks.ToolResultReceived), runlog.Type(hooks.AgentHandoff),
+			runlog.Type(hooks.RunSuspended):
+		default:
+			continue
+		}
+		evt, err := hooks.DecodeFromRecordInput(&runlog.ActivityInput{
+			Type:      e.Type,
+			EventKey:  e.EventKey,
+			RunID:     e.RunID,
+			AgentID:   e.AgentID,
+			SessionID: e.SessionID,
+			TurnID:    e.TurnID,
+			Payload:   e.Payload,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("decode run event %q: %w", e.EventKey, err)
+		}
+		switch ev := evt.(type) {
+		case *hooks.RunStartedEvent:
+			rec.runCtx = ev.RunContext
+		case *hooks.ToolCallScheduledEvent:
+			if ev.ParentToolCallID == "" {
+				payloads[ev.ToolCallID] = ev.Payload
+			}
+		case *hooks.ToolResultReceivedEvent:
+			if ev.ParentToolCallID == "" {
+				results = append(results, &recordedToolResult{payload: payloads[ev.ToolCallID], event: ev})
+			}
+		case *hooks.AgentHandoffEvent:
+			handoff = agent.Ident(ev.TargetAgentID)
+		case *hooks.RunSuspendedEvent:
+			suspended = true
+		}
+	}
+
+	// The workflow publishes the seed before any appended message, so the
+	// seed is a prefix of the recorded transcript.
+	seed, _, err := transcript.ReplayRunLogEvents(seeded)
+	if err != nil {
+		return nil, err
+	}
+	if len(seed) == 0 {
+		return nil, fmt.Errorf("run %q has no recorded transcript seed", runID)
+	}
+	if len(messages) < len(seed) {
+		return nil, fmt.Errorf("run %q transcript is shorter than its seed (%d < %d messages)", runID, len(messages), len(seed))
+	}
+	rec.seed = messages[:len(seed)]
+	parts := make(map[string]model.ToolResultPart)
+	for i, m := range messages {
+		if m == nil {
+			continue
+		}
+		for _, p := range m.Parts {
+			if part, ok := p.(model.ToolResultPart); ok {
+				parts[part.ToolUseID] = part
+			}
+		}
+		if i >= len(seed) && m.Role == model.ConversationRoleAssistant {
+			rec.decisions = append(rec.decisions, replayDecisionFromMessage(m))
+		}
+	}
+	// Only results that reached the transcript were seen by the planner.
+	for _, res := range results {
+		if part, ok := parts[res.event.ToolCallID]; ok {
+			res.part = part
+			rec.results = append(rec.results, res)
+		}
+	}
+	if handoff != "" || suspended {
+		if len(rec.decisions) == 0 {
+			rec.decisions = append(rec.decisions, ReplayDecision{})
+		}
+		last := &rec.decisions[len(rec.decisions)-1]
+		last.Handoff = handoff
+		last.Await = suspended
+	}
+	return &rec, nil
+}
+
+// stub answers a replayed tool call with a recorded result. It prefers an
+// unused result of the same tool with an equivalent payload, then any result
+// of the same tool with an equivalent payload, then the next unused result of
+// the same tool. It returns the matched recording, or nil with a tool failure
+// for tools the recorded run never called.
+func (rec *replayRecording) stub(runID string, call planner.ToolRequest) (*planner.ToolOutput, *recordedToolResult, ReplayStub) {
+	match, stub := rec.find(call, func(res *recordedToolResult) bool {
+		return !res.used && sameJSON(res.payload, call.Payload)
+	}), ReplayStubExact
+	if match == nil {
+		match = rec.find(call, func(res *recordedToolResult) bool { return sameJSON(res.payload, call.Payload) })
+	}
+	if match == nil {
+		match, stub = rec.find(call, func(res *recordedToolResult) bool { return !res.used }), ReplayStubTool
+	}
+	output := &planner.ToolOutput{
+		CallRunID:                  runID,
+		ResultRunID:                runID,
+		Name:                       call.Name,
+		ToolCallID:                 call.ToolCallID,
+		ContinuationRootToolCallID: call.ContinuationRootToolCallID,
+		Payload:                    call.Payload,
+	}
+	if match == nil {
+		output.Failure = &planner.ToolFailure{
+			Error: planner.NewToolError(fmt.Sprintf("replay: run has no recorded result for tool %s", call.Name)),
+		}
+		return output, nil, ReplayStubMissing
+	}
+	match.used = true
+	ev := match.event
+	output.Result = ev.ResultJSON
+	output.ResultBytes = ev.ResultBytes
+	output.ResultOmitted = ev.ResultOmitted
+	output.ResultOmittedReason = ev.ResultOmittedReason
+	output.ServerData = ev.ServerData
+	output.Bounds = ev.Bounds
+	output.Failure = ev.Failure
+	output.Telemetry = ev.Telemetry
+	return output, match, stub
+}
+
+// find returns the first recorded result of the called tool accepted by ok.
+func (rec *replayRecording) find(call planner.ToolRequest, ok func(*recordedToolResult) bool) *recordedToolResult {
+	for _, res := range rec.results {
+		if res.event.ToolName == call.Name && ok(res) {
+			return res
+		}
+	}
+	return nil
+}
+
+// replayDecisionFromMessage summarizes a recorded assistant message.
+func replayDecisionFromMessage(m *model.Message) ReplayDecision {
+	var (
+		d    ReplayDecision
+		text []string
+	)
+	for _, p := range m.Parts {
+		switch part := p.(type) {
+		case model.ToolUsePart:
+			d.ToolCalls = append(d.ToolCalls, ReplayToolCall{Name: tools.Ident(part.Name), Payload: part.Input})
+		case model.TextPart:
+			text = append(text, part.Text)
+		}
+	}
+	d.Text = strings.Join(text, "")
+	return d
+}
+
+// replayDecisionFromPlan summarizes a replayed planner result.
+func replayDecisionFromPlan(res *planner.PlanResult) ReplayDecision {
+	var d ReplayDecision
+	for _, call := range res.ToolCalls {
+		d.ToolCalls = append(d.ToolCalls, ReplayToolCall{Name: call.TranscriptName(), Payload: call.TranscriptPayload()})
+	}
+	if res.FinalResponse != nil && res.FinalResponse.Message != nil {
+		d.Text = replayDecisionFromMessage(res.FinalResponse.Message).Text
+	}
+	if res.Handoff != nil {
+		d.Handoff = res.Handoff.Target
+	}
+	d.Await = res.Await != nil
+	return d
+}
+
+// pairReplayTurns aligns original and replayed decisions by index.
+func pairReplayTurns(original, replayed []ReplayDecision) []ReplayTurn {
+	turns := make([]ReplayTurn, max(len(original), len(replayed)))
+	for i := range turns {
+		t := ReplayTurn{Index: i}
+		if i < len(original) {
+			t.Original = &original[i]
+		}
+		if i < len(replayed) {
+			t.Replayed = &replayed[i]
+		}
+		t.Changed = !t.Original.equal(t.Replayed)
+		turns[i] = t
+	}
+	return turns
+}
+
+// sameJSON reports whether a and b encode equivalent JSON values. Empty
+// payloads are equivalent to each other and to null.
+func sameJSON(a, b rawjson.Message) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var va, vb any
+	if len(a) > 0 {
+		if err := json.Unmarshal(a, &va); err != nil {
+			return false
+		}
+	}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &vb); err != nil {
+			return false
+		}
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// compactJSON renders a payload on one line.
+func compactJSON(raw rawjson.Message) string {
+	if len(raw) == 0 {
+		return "{}"
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return string(raw)
+	}
+	return buf.String()
+}
@@ -0,0 +1,168 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"goa.design/goa-ai/runtime/agent/hooks"
+	"goa.design/goa-ai/runtime/agent/model"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/run"
+	"goa.design/goa-ai/runtime/agent/runlog"
+	runloginmem "goa.design/goa-ai/runtime/agent/runlog/inmem"
+	"goa.design/goa-ai/runtime/agent/telemetry"
+	"goa.design/goa-ai/runtime/agent/transcript"
+)
+
+// textModel returns a model client that always answers with text.
+func textModel(text string) model.Client {
+	return stubModelClient{
+		complete: func(context.Context, *model.Request) (*model.Response, error) {
+			return &model.Response{Content: []model.Message{{
+				Role:  model.ConversationRoleAssistant,
+				Parts: []model.Part{model.TextPart{Text: text}},
+			}}}, nil
+		},
+	}
+}
+
+// newReplayRuntime records a run in which svc.chat searched for "go" and
+// answered "See go.dev", and registers a planner that searches for whatever
+// the "default" model answers.
+func newReplayRuntime(t *testing.T) *Runtime {
+	t.Helper()
+	ctx := context.Background()
+	store := runloginmem.New()
+	seq := 0
+	appendRecord := func(typ runlog.Type, payload rawjson.Message) {
+		seq++
+		_, err := store.Append(ctx, &runlog.Event{
+			EventKey: fmt.Sprintf("run-1/%d", seq), RunID: "run-1", AgentID: "svc.chat", SessionID: "sess-1",
+			Type: typ, Payload: payload,
+		})
+		require.NoError(t, err)
+	}
+	appendHook := func(evt hooks.Event) {
+		in, err := hooks.EncodeToRecordInput(evt, hooks.EncodeOptions{EventKey: "hook"})
+		require.NoError(t, err)
+		appendRecord(in.Type, in.Payload)
+	}
+	appendMessages := func(typ runlog.Type, msgs ...*model.Message) {
+		payload, err := transcript.EncodeRunLogDelta(msgs)
+		require.NoError(t, err)
+		appendRecord(typ, payload)
+	}
+
+	appendHook(hooks.NewRunStartedEvent("run-1", "svc.chat", run.Context{RunID: "run-1", SessionID: "sess-1"}, nil))
+	appendMessages(transcript.RunLogMessagesSeeded, &model.Message{
+		Role: model.ConversationRoleUser, Parts: []model.Part{model.TextPart{Text: "where are the go docs?"}},
+	})
+	appendMessages(transcript.RunLogMessagesAppended, &model.Message{
+		Role:  model.ConversationRoleAssistant,
+		Parts: []model.Part{model.ToolUsePart{ID: "call-1", Name: "svc.search", Input: rawjson.Message(`{"q":"go"}`)}},
+	})
+	appendHook(hooks.NewToolCallScheduledEvent("run-1", "svc.chat", "sess-1", "svc.search", "call-1", rawjson.Message(`{"q":"go"}`), "", "", 0))
+	appendHook(hooks.NewToolResultReceivedEvent("run-1", "svc.chat", "sess-1", "run-1", "svc.search", "call-1", "",
+		rawjson.Message(`{"hits":["go.dev"]}`), 19, false, "", nil, "", nil, 0, nil, nil))
+	appendMessages(transcript.RunLogMessagesAppended,
+		&model.Message{
+			Role:  model.ConversationRoleUser,
+			Parts: []model.Part{model.ToolResultPart{ToolUseID: "call-1", Content: map[string]any{"hits": []any{"go.dev"}}}},
+		},
+		&model.Message{Role: model.ConversationRoleAssistant, Parts: []model.Part{model.TextPart{Text: "See go.dev"}}},
+	)
+
+	rt := New(WithLogger(telemetry.NoopLogger{}), WithRunEventStore(store))
+	rt.models["default"] = textModel("go")
+	rt.agents["svc.chat"] = AgentRegistration{
+		ID: "svc.chat",
+		Planner: &stubPlanner{
+			start: func(ctx context.Context, in *planner.PlanInput) (*planner.PlanResult, error) {
+				cli, ok := in.Agent.ModelClient("default")
+				require.True(t, ok)
+				resp, err := cli.Complete(ctx, &model.Request{Model: "m", Messages: in.Messages})
+				if err != nil {
+					return nil, err
+				}
+				query := resp.Content[0].Parts[0].(model.TextPart).Text
+				return &planner.PlanResult{ToolCalls: []planner.ToolRequest{{
+					Name: "svc.search", Payload: rawjson.Message(fmt.Sprintf(`{"q":%q}`, query)), ToolCallID: "c1",
+				}}}, nil
+			},
+			resume: func(_ context.Context, in *planner.PlanResumeInput) (*planner.PlanResult, error) {
+				require.Len(t, in.Messages, 3)
+				out := in.ToolOutputs[len(in.ToolOutputs)-1]
+				text := "See go.dev"
+				if out.Failure != nil || string(out.Result) != `{"hits":["go.dev"]}` {
+					text = "nothing found"
+				}
+				return &planner.PlanResult{FinalResponse: &planner.FinalResponse{Message: &model.Message{
+					Role: model.ConversationRoleAssistant, Parts: []model.Part{model.TextPart{Text: text}},
+				}}}, nil
+			},
+		},
+	}
+	return rt
+}
+
+func TestReplayReproducesRecordedRun(t *testing.T) {
+	res, err := newReplayRuntime(t).Replay(context.Background(), ReplayOptions{RunID: "run-1"})
+	require.NoError(t, err)
+
+	require.Len(t, res.Original, 2)
+	require.Len(t, res.Replayed, 2)
+	require.Equal(t, ReplayStubExact, res.Replayed[0].ToolCalls[0].Stub)
+	for _, turn := range res.Turns {
+		require.False(t, turn.Changed, "turn %d", turn.Index)
+	}
+	require.Len(t, res.Messages, 4)
+}
+
+func TestReplayWithSubstituteModelReportsDiff(t *testing.T) {
+	res, err := newReplayRuntime(t).Replay(context.Background(), ReplayOptions{
+		RunID: "run-1",
+		Model: textModel("golang"),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, res.Turns, 2)
+	require.True(t, res.Turns[0].Changed)
+	require.JSONEq(t, `{"q":"golang"}`, string(res.Replayed[0].ToolCalls[0].Payload))
+	require.Equal(t, ReplayStubTool, res.Replayed[0].ToolCalls[0].Stub)
+	require.False(t, res.Turns[1].Changed, "recorded search result is reused for the new query")
+
+	var buf bytes.Buffer
+	require.NoError(t, res.WriteDiff(&buf))
+	require.Contains(t, buf.String(), `call svc.search {"q":"go"}`)
+	require.Contains(t, buf.String(), `call svc.search {"q":"golang"} [tool]`)
+}
+
+func TestReplayStubsUnrecordedToolsAsFailures(t *testing.T) {
+	rt := newReplayRuntime(t)
+	rec, err := rt.loadReplayRecording(context.Background(), "run-1")
+	require.NoError(t, err)
+
+	out, match, stub := rec.stub("run-1/replay", planner.ToolRequest{Name: "svc.fetch", ToolCallID: "c9"})
+	require.Equal(t, ReplayStubMissing, stub)
+	require.Nil(t, match)
+	require.NotNil(t, out.Failure)
+	require.Equal(t, "c9", out.ToolCallID)
+}
+
+func TestReplayReturnsPlannerErrors(t *testing.T) {
+	boom := errors.New("model unavailable")
+	_, err := newReplayRuntime(t).Replay(context.Background(), ReplayOptions{
+		RunID: "run-1",
+		Model: stubModelClient{
+			complete: func(context.Context, *model.Request) (*model.Response, error) { return nil, boom },
+		},
+	})
+	require.ErrorIs(t, err, boom)
+	require.ErrorContains(t, err, "replay turn 0")
+}
@@ -71,6 +71,17 @@ func newRunSnapshot(events []*runlog.Event) (*run.Snapshot, error) {
 				ChildAgentID: p.ChildAgentID,
 			})
 
+		case hooks.AgentHandoff:
+			var p hooks.AgentHandoffEvent
+			if err := json.Unmarshal(e.Payload, &p); err != nil {
+				return nil, fmt.Errorf("decode %s payload: %w", hooks.AgentHandoff, err)
+			}
+			s.Handoff = &run.HandoffLink{
+				TargetAgentID: p.TargetAgentID,
+				TargetRunID:   p.TargetRunID,
+				Reason:        p.Reason,
+			}
+
 		case hooks.AwaitClarification:
 			var p hooks.AwaitClarificationEvent
 			if err := json.Unmarshal(e.Payload, &p); err != nil {
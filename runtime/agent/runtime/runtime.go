@@ -47,6 +47,7 @@ import (
 	engineinmem "goa.design/goa-ai/runtime/agent/engine/inmem"
 	"goa.design/goa-ai/runtime/agent/hooks"
 	"goa.design/goa-ai/runtime/agent/memory"
+	"goa.design/goa-ai/runtime/agent/memory/longterm"
 	"goa.design/goa-ai/runtime/agent/model"
 	"goa.design/goa-ai/runtime/agent/planner"
 	"goa.design/goa-ai/runtime/agent/policy"
@@ -59,6 +60,8 @@ import (
 	sessioninmem "goa.design/goa-ai/runtime/agent/session/inmem"
 	"goa.design/goa-ai/runtime/agent/stream"
 	"goa.design/goa-ai/runtime/agent/telemetry"
+	"goa.design/goa-ai/runtime/agent/toolcache"
+	toolcacheinmem "goa.design/goa-ai/runtime/agent/toolcache/inmem"
 	"goa.design/goa-ai/runtime/agent/tools"
 	"goa.design/goa-ai/runtime/agent/transcript"
 	"google.golang.org/genai"
@@ -108,6 +111,10 @@ type (
 		Engine engine.Engine
 		// MemoryStore persists run transcripts and annotations.
 		Memory memory.Store
+		// LongTermMemory remembers durable facts across sessions. When set, the
+		// runtime injects recalled facts as run-start reminders and extracts
+		// new facts after successful runs.
+		LongTermMemory *longterm.Manager
 		// PromptRegistry resolves prompt specs and optional scoped overrides.
 		PromptRegistry *prompt.Registry
 		// SessionStore persists session lifecycle state and run metadata.
@@ -116,6 +123,8 @@ type (
 		Policy policy.Engine
 		// RunEventStore is the canonical append-only run event log.
 		RunEventStore runlog.Store
+		// ToolResultCache stores results of tools declaring a cache policy.
+		ToolResultCache toolcache.Store
 		// Bus is the bus used for streaming runtime events.
 		Bus hooks.Bus
 		// Stream publishes planner/tool/assistant events to the caller.
@@ -182,6 +191,19 @@ type (
 		// runtime default.
 		recordActivityTimeout time.Duration
 
+		// backgroundMu guards backgroundClosed and the start of background
+		// work tracked by background.
+		backgroundMu sync.Mutex
+		// background tracks in-flight background work, such as long-term fact
+		// extraction, so Close can wait for it.
+		background sync.WaitGroup
+		// backgroundClosed is set by Close; no background work starts afterwards.
+		backgroundClosed bool
+		// backgroundCtx is canceled when Close stops waiting for background
+		// work. Created lazily under backgroundMu.
+		backgroundCtx    context.Context
+		backgroundCancel context.CancelFunc
+
 		// reminders manages run-scoped system reminders used for backstage
 		// guidance (safety, correctness, workflow) injected into prompts by
 		// planners. It is internal to the runtime; planners interact with it
@@ -204,6 +226,9 @@ type (
 		Engine engine.Engine
 		// MemoryStore persists run transcripts and annotations.
 		MemoryStore memory.Store
+		// LongTermMemory remembers durable facts across sessions. Nil disables
+		// cross-session memory.
+		LongTermMemory *longterm.Manager
 		// PromptStore resolves scoped prompt overrides. When nil, prompt rendering
 		// uses baseline registered PromptSpecs only.
 		PromptStore prompt.Store
@@ -213,6 +238,10 @@ type (
 		Policy policy.Engine
 		// RunEventStore is the canonical append-only run event log.
 		RunEventStore runlog.Store
+		// ToolResultCache stores results of tools declaring a cache policy.
+		// Defaults to a process-local in-memory cache; use a shared store when
+		// several workers execute tools.
+		ToolResultCache toolcache.Store
 		// Hooks is the Pulse-backed bus used for streaming runtime events.
 		Hooks hooks.Bus
 		// Stream publishes planner/tool/assistant events to the caller.
@@ -311,6 +340,11 @@ type (
 		// workflow or activity. Empty when no used toolset injects a
 		// label-backed field.
 		RequiredLabels []string
+
+		// Handoffs lists the agents this agent may transfer the conversation
+		// to via planner.PlanResult.Handoff (generated from the Handoff DSL).
+		// The runtime rejects handoffs to any other agent.
+		Handoffs []planner.HandoffTarget
 	}
 
 	// ToolsetRegistration holds the metadata and execution logic for a toolset.
@@ -712,13 +746,18 @@ func newFromOptions(opts Options) *Runtime {
 	if opts.SessionStore == nil {
 		opts.SessionStore = sessioninmem.New()
 	}
+	if opts.ToolResultCache == nil {
+		opts.ToolResultCache = toolcacheinmem.New()
+	}
 	rt := &Runtime{
 		Engine:                eng,
 		Memory:                opts.MemoryStore,
+		LongTermMemory:        opts.LongTermMemory,
 		PromptRegistry:        prompt.NewRegistry(opts.PromptStore),
 		SessionStore:          opts.SessionStore,
 		Policy:                opts.Policy,
 		RunEventStore:         opts.RunEventStore,
+		ToolResultCache:       opts.ToolResultCache,
 		Bus:                   bus,
 		Stream:                opts.Stream,
 		recordActivityTimeout: opts.RecordActivityTimeout,
@@ -748,6 +787,9 @@ func newFromOptions(opts Options) *Runtime {
 	if _, err := bus.Register(hooks.SubscriberFunc(rt.recordGenAITelemetryEvent)); err != nil {
 		panic(fmt.Errorf("register GenAI telemetry subscriber: %w", err))
 	}
+	if _, err := bus.Register(newMetricsSubscriber(metrics)); err != nil {
+		panic(fmt.Errorf("register metrics subscriber: %w", err))
+	}
 	if rt.SessionStore != nil {
 		sessionSub := hooks.SubscriberFunc(func(ctx context.Context, event hooks.Event) error {
 			if event.SessionID() == "" {
@@ -869,6 +911,14 @@ func newFromOptions(opts Options) *Runtime {
 			rt.logger.Warn(context.Background(), "failed to register memory subscriber", "err", err)
 		}
 	}
+	// Long-term memory extraction is registered after the session subscriber:
+	// it only starts a background worker, so the run is marked completed first
+	// and a slow extraction never consumes the hook activity deadline.
+	if rt.LongTermMemory != nil {
+		if _, err := bus.Register(hooks.SubscriberFunc(rt.rememberLongTermFacts)); err != nil {
+			rt.logger.Warn(context.Background(), "failed to register long-term memory subscriber", "err", err)
+		}
+	}
 	if rt.Stream != nil {
 		streamSub, err := stream.NewSubscriber(newHintingSink(rt, rt.Stream))
 		if err != nil {
@@ -911,6 +961,11 @@ func WithRecordActivityTimeout(d time.Duration) RuntimeOption {
 // WithMemoryStore sets the memory store.
 func WithMemoryStore(m memory.Store) RuntimeOption { return func(o *Options) { o.MemoryStore = m } }
 
+// WithLongTermMemory sets the cross-session long-term memory manager.
+func WithLongTermMemory(m *longterm.Manager) RuntimeOption {
+	return func(o *Options) { o.LongTermMemory = m }
+}
+
 // WithPromptStore sets the prompt override store.
 func WithPromptStore(s prompt.Store) RuntimeOption { return func(o *Options) { o.PromptStore = s } }
 
@@ -920,6 +975,12 @@ func WithSessionStore(s session.Store) RuntimeOption { return func(o *Options) {
 // WithRunEventStore sets the canonical run event store.
 func WithRunEventStore(s runlog.Store) RuntimeOption { return func(o *Options) { o.RunEventStore = s } }
 
+// WithToolResultCache sets the store caching results of tools that declare a
+// cache policy.
+func WithToolResultCache(s toolcache.Store) RuntimeOption {
+	return func(o *Options) { o.ToolResultCache = s }
+}
+
 // WithPolicy sets the policy engine.
 func WithPolicy(p policy.Engine) RuntimeOption { return func(o *Options) { o.Policy = p } }
 
@@ -1018,6 +1079,61 @@ func (r *Runtime) Seal(ctx context.Context) error {
 	return nil
 }
 
+// Close waits for background work started by the runtime, such as long-term
+// fact extraction after completed runs, and refuses to start more. When ctx
+// ends first, Close cancels the remaining work and returns ctx's error. Call
+// Close after the engine workers have stopped.
+func (r *Runtime) Close(ctx context.Context) error {
+	r.backgroundMu.Lock()
+	r.backgroundClosed = true
+	_, cancel := r.backgroundContextLocked()
+	r.backgroundMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.background.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// goBackground runs fn in a goroutine tracked by Close. The context passed to
+// fn keeps the values of ctx but not its cancellation, is bounded by timeout,
+// and is canceled when Close stops waiting. goBackground reports false
+// without running fn once Close has been called.
+func (r *Runtime) goBackground(ctx context.Context, timeout time.Duration, fn func(context.Context)) bool {
+	r.backgroundMu.Lock()
+	defer r.backgroundMu.Unlock()
+	if r.backgroundClosed {
+		return false
+	}
+	base, _ := r.backgroundContextLocked()
+	r.background.Go(func() {
+		bgCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+		stop := context.AfterFunc(base, cancel)
+		defer stop()
+		fn(bgCtx)
+	})
+	return true
+}
+
+// backgroundContextLocked returns the context canceled when Close stops
+// waiting for background work. Caller must hold r.backgroundMu.
+func (r *Runtime) backgroundContextLocked() (context.Context, context.CancelFunc) {
+	if r.backgroundCtx == nil {
+		r.backgroundCtx, r.backgroundCancel = context.WithCancel(context.Background())
+	}
+	return r.backgroundCtx, r.backgroundCancel
+}
+
 // RegisterAgent validates the registration, registers workflows and activities with
 // the engine, and stores the agent metadata for later lookup. Returns an error if
 // required fields are missing or if engine registration fails.
@@ -1055,6 +1171,9 @@ func (r *Runtime) RegisterAgent(ctx context.Context, reg AgentRegistration) erro
 	if err := validateSpecs(reg.Specs, reg.ToolMetadataLookup); err != nil {
 		return err
 	}
+	if err := validateHandoffTargets(reg.ID, reg.Handoffs); err != nil {
+		return err
+	}
 	if r.Engine == nil {
 		return ErrEngineNotConfigured
 	}
@@ -1222,6 +1341,18 @@ func validateSpecs(specs []tools.ToolSpec, lookup ToolMetadataLookup) error {
 		if spec.TerminalRun && !spec.Bookkeeping {
 			return fmt.Errorf("%w: terminal tool %q must also declare bookkeeping", ErrInvalidConfig, spec.Name)
 		}
+		if spec.ReadOnly && !spec.Idempotent {
+			return fmt.Errorf("%w: read-only tool %q must also declare idempotent", ErrInvalidConfig, spec.Name)
+		}
+		if spec.ReadOnly && spec.Destructive {
+			return fmt.Errorf("%w: tool %q cannot be both read-only and destructive", ErrInvalidConfig, spec.Name)
+		}
+		if err := validateToolRetry(spec); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+		}
+		if err := validateToolCache(spec); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+		}
 		if lookup == nil {
 			if strings.TrimSpace(defaultToolTitle(spec.Name)) == "" {
 				return fmt.Errorf("%w: tool %q must have a non-empty display title", ErrInvalidConfig, spec.Name)
@@ -1247,6 +1378,13 @@ func validateSpecs(specs []tools.ToolSpec, lookup ToolMetadataLookup) error {
 				spec.Bookkeeping,
 			)
 		}
+		if meta.ReadOnly != spec.ReadOnly || meta.Idempotent != spec.Idempotent || meta.Destructive != spec.Destructive {
+			return fmt.Errorf(
+				"%w: policy metadata side effects for tool %q do not match its spec",
+				ErrInvalidConfig,
+				spec.Name,
+			)
+		}
 	}
 	return nil
 }
@@ -1789,6 +1927,16 @@ func (r *Runtime) GetRunSnapshot(ctx context.Context, runID string) (*run.Snapsh
 // loadRunSnapshot replays the canonical run log without attempting terminal
 // repair. Callers that serve external reads should repair first.
 func (r *Runtime) loadRunSnapshot(ctx context.Context, runID string) (*run.Snapshot, error) {
+	events, err := r.listRunEvents(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	return newRunSnapshot(events)
+}
+
+// listRunEvents returns every event of the canonical run log of runID in
+// order.
+func (r *Runtime) listRunEvents(ctx context.Context, runID string) ([]*runlog.Event, error) {
 	const pageSize = 512
 
 	var (
@@ -1806,7 +1954,7 @@ func (r *Runtime) loadRunSnapshot(ctx context.Context, runID string) (*run.Snaps
 		}
 		cursor = page.NextCursor
 	}
-	return newRunSnapshot(events)
+	return events, nil
 }
 
 // runEventPageNeedsTerminalRepair reports whether a caller is currently reading
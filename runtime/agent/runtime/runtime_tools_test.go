@@ -588,7 +588,7 @@ func TestServiceToolEventsUseChildRunContext(t *testing.T) {
 		Name:       tools.Ident("svc.tools.fetch_time_series"),
 		ToolCallID: "child-call",
 	}}
-	_, _, err := rt.executeToolCalls(wfCtx, "execute", engine.ActivityOptions{}, "child.agent", parentCtx, nil, calls, 0, nil, time.Time{})
+	_, _, err := rt.executeToolCalls(wfCtx, "execute", engine.ActivityOptions{}, false, "child.agent", parentCtx, nil, calls, 0, nil, time.Time{})
 	require.NoError(t, err)
 
 	var scheduled *hooks.ToolCallScheduledEvent
@@ -648,7 +648,7 @@ func TestServiceToolEventsPropagateServerData(t *testing.T) {
 		Name:       tools.Ident("svc.tools.example"),
 		ToolCallID: "child-call",
 	}}
-	_, _, err := rt.executeToolCalls(wfCtx, "execute", engine.ActivityOptions{}, "child.agent", parentCtx, nil, calls, 0, nil, time.Time{})
+	_, _, err := rt.executeToolCalls(wfCtx, "execute", engine.ActivityOptions{}, false, "child.agent", parentCtx, nil, calls, 0, nil, time.Time{})
 	require.NoError(t, err)
 
 	var resultEvt *hooks.ToolResultReceivedEvent
@@ -932,7 +932,7 @@ func TestServiceToolEventsPropagateBounds(t *testing.T) {
 		Name:       tools.Ident("svc.tools.example"),
 		ToolCallID: "child-call",
 	}}
-	results, _, err := rt.executeToolCalls(wfCtx, "execute", engine.ActivityOptions{}, "child.agent", parentCtx, nil, calls, 0, nil, time.Time{})
+	results, _, err := rt.executeToolCalls(wfCtx, "execute", engine.ActivityOptions{}, false, "child.agent", parentCtx, nil, calls, 0, nil, time.Time{})
 	require.NoError(t, err)
 	require.Len(t, results, 1)
 	require.NotNil(t, results[0].ToolResult)
@@ -69,9 +69,10 @@ func (r *Runtime) DeleteSession(ctx context.Context, sessionID string) (session.
 	return ended, nil
 }
 
-// PurgeSession permanently removes an ended session's runtime metadata and
-// continuation checkpoints. It rejects sessions with active runs so a later
-// workflow activity cannot recreate run data after the session is gone.
+// PurgeSession permanently removes an ended session's runtime metadata,
+// continuation checkpoints, and any long-term facts extracted from its runs.
+// It rejects sessions with active runs so a later workflow activity cannot
+// recreate run data after the session is gone.
 func (r *Runtime) PurgeSession(ctx context.Context, sessionID string) error {
 	if ctx == nil {
 		ctx = context.Background()
@@ -101,6 +102,13 @@ func (r *Runtime) PurgeSession(ctx context.Context, sessionID string) error {
 	if len(active) > 0 {
 		return errors.New("runtime: session has active runs")
 	}
+	// Purge long-term facts first: once the session record is gone a retry
+	// returns early and would leave facts extracted from its runs behind.
+	if r.LongTermMemory != nil {
+		if err := r.LongTermMemory.PurgeSession(ctx, id); err != nil {
+			return err
+		}
+	}
 	return r.SessionStore.PurgeSession(ctx, id)
 }
 
@@ -0,0 +1,99 @@
+package runtime
+
+// tool_availability.go evaluates design-declared availability conditions
+// (tools.AvailabilitySpec) against run state.
+//
+// Contract:
+//   - A tool is available when every condition holds: each tool listed in
+//     ToolSucceeded produced a successful output earlier in the run and each
+//     label in Labels carries the declared value.
+//   - Planner activities omit unavailable tools from the advertised tool
+//     definitions; the workflow loop rewrites calls to unavailable tools to
+//     tool_unavailable using the same predicate, so a tool that became
+//     unavailable is never executed.
+
+import (
+	"context"
+
+	"goa.design/goa-ai/runtime/agent"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+// toolAvailability is the run state availability conditions are evaluated
+// against.
+type toolAvailability struct {
+	labels    map[string]string
+	succeeded map[tools.Ident]struct{}
+}
+
+// newToolAvailability indexes the successful outputs of the run.
+func newToolAvailability(labels map[string]string, outputs []*planner.ToolOutput) toolAvailability {
+	succeeded := make(map[tools.Ident]struct{}, len(outputs))
+	for _, output := range outputs {
+		if output != nil && output.Failure == nil {
+			succeeded[output.Name] = struct{}{}
+		}
+	}
+	return toolAvailability{labels: labels, succeeded: succeeded}
+}
+
+// allows reports whether every condition of spec holds. A nil spec always
+// holds.
+func (a toolAvailability) allows(spec *tools.AvailabilitySpec) bool {
+	if spec == nil {
+		return true
+	}
+	for _, name := range spec.ToolSucceeded {
+		if _, ok := a.succeeded[name]; !ok {
+			return false
+		}
+	}
+	for key, value := range spec.Labels {
+		if got, ok := a.labels[key]; !ok || got != value {
+			return false
+		}
+	}
+	return true
+}
+
+// conditionallyUnavailableTools returns the tools of agentID whose
+// availability conditions do not hold for labels and outputs.
+func (r *Runtime) conditionallyUnavailableTools(agentID agent.Ident, labels map[string]string, outputs []*planner.ToolOutput) []tools.Ident {
+	avail := newToolAvailability(labels, outputs)
+	var unavailable []tools.Ident
+	for _, spec := range r.ToolSpecsForAgent(agentID) {
+		if !avail.allows(spec.Availability) {
+			unavailable = append(unavailable, spec.Name)
+		}
+	}
+	return unavailable
+}
+
+// applyToolAvailability rewrites calls to tools whose availability conditions
+// do not hold to the runtime-owned tool_unavailable tool. Conditions are
+// evaluated against the outputs recorded before the batch, so a call cannot
+// satisfy a condition of another call in the same batch.
+func (r *Runtime) applyToolAvailability(
+	ctx context.Context,
+	labels map[string]string,
+	outputs []*planner.ToolOutput,
+	candidates []planner.ToolRequest,
+) ([]planner.ToolRequest, error) {
+	avail := newToolAvailability(labels, outputs)
+	rewritten := make([]planner.ToolRequest, 0, len(candidates))
+	for _, call := range candidates {
+		spec, ok := r.toolSpec(call.Name)
+		if !ok || avail.allows(spec.Availability) {
+			rewritten = append(rewritten, call)
+			continue
+		}
+		r.logger.Info(ctx, "Tool rewritten by availability conditions", "tool", call.Name)
+		unavailable, err := r.rewriteToolCallUnavailable(call)
+		if err != nil {
+			return nil, err
+		}
+		rewritten = append(rewritten, unavailable)
+	}
+	return rewritten, nil
+}
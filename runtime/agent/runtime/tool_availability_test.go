@@ -0,0 +1,64 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+func availabilityTestRuntime() *Runtime {
+	validate := newAnyJSONSpec("svc.checkout.validate_cart", "svc.checkout")
+	submit := newAnyJSONSpec("svc.checkout.submit_order", "svc.checkout")
+	submit.Availability = &tools.AvailabilitySpec{
+		ToolSucceeded: []tools.Ident{validate.Name},
+		Labels:        map[string]string{"tier": "admin"},
+	}
+	rt := New()
+	rt.toolSpecs[validate.Name] = validate
+	rt.toolSpecs[submit.Name] = submit
+	rt.agentToolSpecs["svc.agent"] = []tools.ToolSpec{validate, submit}
+	return rt
+}
+
+func TestConditionallyUnavailableTools(t *testing.T) {
+	rt := availabilityTestRuntime()
+	admin := map[string]string{"tier": "admin"}
+	succeeded := []*planner.ToolOutput{{Name: "svc.checkout.validate_cart"}}
+	failed := []*planner.ToolOutput{{
+		Name:    "svc.checkout.validate_cart",
+		Failure: &planner.ToolFailure{Kind: planner.FailureDomainRejection},
+	}}
+
+	submit := []tools.Ident{"svc.checkout.submit_order"}
+	assert.Equal(t, submit, rt.conditionallyUnavailableTools("svc.agent", admin, nil))
+	assert.Equal(t, submit, rt.conditionallyUnavailableTools("svc.agent", admin, failed))
+	assert.Equal(t, submit, rt.conditionallyUnavailableTools("svc.agent", map[string]string{"tier": "basic"}, succeeded))
+	assert.Empty(t, rt.conditionallyUnavailableTools("svc.agent", admin, succeeded))
+	assert.Empty(t, rt.conditionallyUnavailableTools("svc.other", nil, nil))
+}
+
+func TestApplyToolAvailabilityRewritesUnavailableCalls(t *testing.T) {
+	rt := availabilityTestRuntime()
+	calls := []planner.ToolRequest{
+		{Name: "svc.checkout.validate_cart", ToolCallID: "call-1", Payload: rawjson.Message(`{}`)},
+		{Name: "svc.checkout.submit_order", ToolCallID: "call-2", Payload: rawjson.Message(`{"cart":"c-1"}`)},
+	}
+
+	out, err := rt.applyToolAvailability(context.Background(), map[string]string{"tier": "admin"}, nil, calls)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+	assert.Equal(t, calls[0], out[0])
+	assert.Equal(t, tools.ToolUnavailable, out[1].Name)
+	assert.Equal(t, "call-2", out[1].ToolCallID)
+	assert.Equal(t, tools.Ident("svc.checkout.submit_order"), out[1].ModelName)
+
+	outputs := []*planner.ToolOutput{{Name: "svc.checkout.validate_cart"}}
+	out, err = rt.applyToolAvailability(context.Background(), map[string]string{"tier": "admin"}, outputs, calls)
+	require.NoError(t, err)
+	assert.Equal(t, calls, out)
+}
@@ -0,0 +1,88 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/toolcache"
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+// validateToolCache checks the cache policy declared by a tool spec. Only
+// idempotent, non-destructive tools may be cached: replaying the result of any
+// other tool would skip an effect the caller expects.
+func validateToolCache(spec tools.ToolSpec) error {
+	c := spec.Cache
+	if c == nil {
+		return nil
+	}
+	if !spec.Idempotent || spec.Destructive {
+		return fmt.Errorf("tool %q declares a result cache but is not idempotent", spec.Name)
+	}
+	if c.TTL <= 0 {
+		return fmt.Errorf("tool %q cache TTL must be positive", spec.Name)
+	}
+	switch c.Scope {
+	case tools.CacheScopeRun, tools.CacheScopeSession, tools.CacheScopeGlobal:
+	default:
+		return fmt.Errorf("tool %q has unknown cache scope %q", spec.Name, c.Scope)
+	}
+	return nil
+}
+
+// toolResultCacheKey returns the cache key of the tool call described by req
+// and whether its result may be cached at all.
+func (r *Runtime) toolResultCacheKey(req *ToolInput) (string, bool) {
+	if r.ToolResultCache == nil {
+		return "", false
+	}
+	spec, ok := r.toolSpec(req.ToolName)
+	if !ok || spec.Cache == nil || !spec.Idempotent || spec.Destructive {
+		return "", false
+	}
+	key, err := toolcache.Key(req.ToolName, req.Payload, req.Labels, spec.Cache.Scope, req.RunID, req.SessionID)
+	if err != nil {
+		return "", false
+	}
+	return key, true
+}
+
+// cachedToolOutput returns the cached output stored under key. Cache failures
+// are logged and treated as misses so the tool still executes.
+func (r *Runtime) cachedToolOutput(ctx context.Context, req *ToolInput, key string) (*ToolOutput, bool) {
+	entry, ok, err := r.ToolResultCache.Get(ctx, key)
+	if err != nil {
+		r.logger.Warn(ctx, "tool result cache lookup failed", "tool", req.ToolName, "tool_call_id", req.ToolCallID, "err", err)
+		return nil, false
+	}
+	if !ok {
+		return nil, false
+	}
+	return &ToolOutput{
+		Payload:    append(rawjson.Message(nil), entry.Result...),
+		ServerData: append(rawjson.Message(nil), entry.ServerData...),
+		Bounds:     entry.Bounds,
+		Cached:     true,
+	}, true
+}
+
+// cacheToolOutput stores a successful tool output under key. Failures and
+// outputs requesting clarification are never cached.
+func (r *Runtime) cacheToolOutput(ctx context.Context, req *ToolInput, key string, out *ToolOutput) {
+	if out.Failure != nil || out.Clarification != nil {
+		return
+	}
+	spec, ok := r.toolSpec(req.ToolName)
+	if !ok || spec.Cache == nil {
+		return
+	}
+	entry := &toolcache.Entry{
+		Result:     out.Payload,
+		ServerData: out.ServerData,
+		Bounds:     out.Bounds,
+	}
+	if err := r.ToolResultCache.Set(ctx, key, entry, spec.Cache.TTL); err != nil {
+		r.logger.Warn(ctx, "tool result cache store failed", "tool", req.ToolName, "tool_call_id", req.ToolCallID, "err", err)
+	}
+}
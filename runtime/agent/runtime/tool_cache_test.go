@@ -0,0 +1,71 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+func TestExecuteToolActivityServesCachedResults(t *testing.T) {
+	cached := newAnyJSONSpec("svc.ts.search", "svc.ts")
+	cached.ReadOnly = true
+	cached.Idempotent = true
+	cached.Cache = &tools.CacheSpec{TTL: time.Minute, Scope: tools.CacheScopeSession}
+	plain := newAnyJSONSpec("svc.ts.update", "svc.ts")
+
+	rt := New()
+	calls := map[tools.Ident]int{}
+	require.NoError(t, rt.RegisterToolset(ToolsetRegistration{
+		Name: "svc.ts",
+		Execute: wrapExecute(func(_ context.Context, call *planner.ToolRequest) (*planner.ToolResult, error) {
+			calls[call.Name]++
+			return &planner.ToolResult{Name: call.Name, Result: map[string]any{"n": calls[call.Name]}}, nil
+		}),
+		Specs: []tools.ToolSpec{cached, plain},
+	}))
+
+	run := func(tool tools.Ident, runID, sessionID, payload string) *ToolOutput {
+		out, err := rt.ExecuteToolActivity(context.Background(), &ToolInput{
+			ToolsetName: "svc.ts",
+			ToolName:    tool,
+			RunID:       runID,
+			SessionID:   sessionID,
+			Payload:     rawjson.Message(payload),
+		})
+		require.NoError(t, err)
+		return out
+	}
+
+	first := run("svc.ts.search", "run-1", "sess-1", `{"q":"x","limit":1}`)
+	require.False(t, first.Cached)
+	hit := run("svc.ts.search", "run-2", "sess-1", `{"limit":1, "q":"x"}`)
+	require.True(t, hit.Cached)
+	require.JSONEq(t, string(first.Payload), string(hit.Payload))
+	require.Equal(t, 1, calls["svc.ts.search"])
+
+	other := run("svc.ts.search", "run-3", "sess-2", `{"q":"x","limit":1}`)
+	require.False(t, other.Cached)
+	require.Equal(t, 2, calls["svc.ts.search"])
+
+	run("svc.ts.update", "run-1", "sess-1", `{}`)
+	again := run("svc.ts.update", "run-1", "sess-1", `{}`)
+	require.False(t, again.Cached)
+	require.Equal(t, 2, calls["svc.ts.update"])
+}
+
+func TestValidateToolCacheRequiresIdempotentTool(t *testing.T) {
+	spec := newAnyJSONSpec("svc.ts.update", "svc.ts")
+	spec.Cache = &tools.CacheSpec{TTL: time.Minute, Scope: tools.CacheScopeRun}
+	require.ErrorContains(t, validateToolCache(spec), "not idempotent")
+
+	spec.Idempotent = true
+	require.NoError(t, validateToolCache(spec))
+
+	spec.Cache.Scope = "tenant"
+	require.ErrorContains(t, validateToolCache(spec), "unknown cache scope")
+}
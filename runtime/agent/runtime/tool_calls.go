@@ -29,6 +29,12 @@ type (
 		call planner.ToolRequest
 		// startTime records when the activity was scheduled, used to calculate tool duration.
 		startTime time.Time
+		// executions counts the activities started for call under its retry
+		// policy, including the current one.
+		executions int
+		// retryTimer, when set, delays the next execution of call by the retry
+		// backoff declared by the tool. future is nil until that execution starts.
+		retryTimer engine.Future[time.Time]
 	}
 
 	// agentChildFutureInfo bundles a child workflow handle with its associated
@@ -80,6 +86,10 @@ type (
 
 		activityName   string
 		toolActOptions engine.ActivityOptions
+		// timeoutOverride reports that toolActOptions carries a run policy
+		// PerToolTimeout override, which takes precedence over the timeouts
+		// declared by tool specs.
+		timeoutOverride bool
 
 		runID     string
 		agentID   agent.Ident
@@ -294,6 +304,7 @@ func (e *toolBatchExec) publishToolResultReceived(
 		tr.Telemetry,
 		tr.Failure,
 	)
+	ev.Cached = tr.Cached
 	record, err := prepareHookRecordInput(ctx, ev, e.turnID)
 	if err != nil {
 		return nil, err
@@ -361,6 +372,25 @@ func computeToolActivityOptions(wfCtx engine.WorkflowContext, base engine.Activi
 	return callOpts
 }
 
+// toolActivityRetryPolicy derives the retry policy of one tool activity from
+// the side effects and retry policy declared by the tool. Destructive tools
+// run a single attempt: a failed attempt may already have applied the effect,
+// so its outcome is unknown and repeating it is unsafe. Tools that declare a
+// retry policy also run a single attempt per activity: the workflow schedules
+// their retries itself (see nextToolAttempt) so each attempt gets its own
+// timeout and MaxAttempts is enforced in one place. Idempotent tools always
+// retry, using the runtime's standard policy when the agent configures none.
+func toolActivityRetryPolicy(spec tools.ToolSpec, base engine.RetryPolicy) engine.RetryPolicy {
+	switch {
+	case spec.Destructive, spec.Retry != nil:
+		return engine.RetryPolicy{MaxAttempts: 1}
+	case spec.Idempotent && isZeroRetryPolicy(base):
+		return defaultRetriedActivityPolicy()
+	default:
+		return base
+	}
+}
+
 func (e *toolBatchExec) dispatchToolCalls(wfCtx engine.WorkflowContext, calls []planner.ToolRequest) (*toolCallBatch, error) {
 	ctx := wfCtx.Context()
 
@@ -537,38 +567,16 @@ func (e *toolBatchExec) dispatchToolCalls(wfCtx engine.WorkflowContext, calls []
 		}
 
 		// Activity path (service-backed tools).
-		toolInput := ToolInput{
-			AgentID:          e.agentID,
-			RunID:            e.runID,
-			ToolsetName:      spec.Toolset,
-			ToolName:         call.Name,
-			ToolCallID:       call.ToolCallID,
-			Payload:          call.Payload,
-			SessionID:        call.SessionID,
-			Labels:           cloneLabels(call.Labels),
-			TurnID:           call.TurnID,
-			ParentToolCallID: call.ParentToolCallID,
-		}
-		callOpts := computeToolActivityOptions(wfCtx, e.toolActOptions, e.finishBy)
-		if callOpts.Queue == "" && hasTS && !ts.Inline && ts.TaskQueue != "" {
-			callOpts.Queue = ts.TaskQueue
-		}
-		future, err := wfCtx.ExecuteToolActivityAsync(engine.ToolActivityCall{
-			Name:    e.activityName,
-			Input:   &toolInput,
-			Options: callOpts,
-		})
+		future, err := e.startToolActivity(wfCtx, call, spec)
 		if err != nil {
-			executionErr = errors.Join(
-				executionErr,
-				fmt.Errorf("failed to schedule tool %q: %w", call.Name, err),
-			)
+			executionErr = errors.Join(executionErr, err)
 			continue
 		}
 		b.futures = append(b.futures, futureInfo{
-			future:    future,
-			call:      call,
-			startTime: wfCtx.Now(),
+			future:     future,
+			call:       call,
+			startTime:  wfCtx.Now(),
+			executions: 1,
 		})
 		if e.parentTracker != nil {
 			b.discoveredIDs = append(b.discoveredIDs, call.ToolCallID)
@@ -578,6 +586,46 @@ func (e *toolBatchExec) dispatchToolCalls(wfCtx engine.WorkflowContext, calls []
 	return b, executionErr
 }
 
+// startToolActivity schedules one execution of a service-backed tool call.
+func (e *toolBatchExec) startToolActivity(wfCtx engine.WorkflowContext, call planner.ToolRequest, spec tools.ToolSpec) (engine.Future[*ToolOutput], error) {
+	toolInput := ToolInput{
+		AgentID:          e.agentID,
+		RunID:            e.runID,
+		ToolsetName:      spec.Toolset,
+		ToolName:         call.Name,
+		ToolCallID:       call.ToolCallID,
+		Payload:          call.Payload,
+		SessionID:        call.SessionID,
+		Labels:           cloneLabels(call.Labels),
+		TurnID:           call.TurnID,
+		ParentToolCallID: call.ParentToolCallID,
+		Attempt:          call.Attempt,
+	}
+	opts := e.toolActOptions
+	if spec.Timeout > 0 && !e.timeoutOverride {
+		opts.StartToCloseTimeout = spec.Timeout
+	}
+	callOpts := computeToolActivityOptions(wfCtx, opts, e.finishBy)
+	callOpts.RetryPolicy = toolActivityRetryPolicy(spec, callOpts.RetryPolicy)
+	if callOpts.Queue == "" {
+		e.r.mu.RLock()
+		ts, hasTS := e.r.toolsets[spec.Toolset]
+		e.r.mu.RUnlock()
+		if hasTS && !ts.Inline && ts.TaskQueue != "" {
+			callOpts.Queue = ts.TaskQueue
+		}
+	}
+	future, err := wfCtx.ExecuteToolActivityAsync(engine.ToolActivityCall{
+		Name:    e.activityName,
+		Input:   &toolInput,
+		Options: callOpts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule tool %q: %w", call.Name, err)
+	}
+	return future, nil
+}
+
 func (e *toolBatchExec) maybePublishChildTrackerUpdate(ctx context.Context, discoveredIDs []string) error {
 	if e.parentTracker == nil || !e.parentTracker.registerDiscovered(discoveredIDs) || !e.parentTracker.needsUpdate() {
 		return nil
@@ -593,7 +641,10 @@ func (e *toolBatchExec) maybePublishChildTrackerUpdate(ctx context.Context, disc
 	return nil
 }
 
-func (e *toolBatchExec) collectActivityResultsAsComplete(wfCtx engine.WorkflowContext, futures []futureInfo, finalizeTimer engine.Future[time.Time]) (map[string]*ToolExecutionResult, []futureInfo, bool, error) {
+// collectActivityResultsAsComplete waits for tool activities in completion
+// order. Retries declared by tool specs are scheduled on execWfCtx so they are
+// canceled together with the rest of the batch.
+func (e *toolBatchExec) collectActivityResultsAsComplete(wfCtx, execWfCtx engine.WorkflowContext, futures []futureInfo, finalizeTimer engine.Future[time.Time]) (map[string]*ToolExecutionResult, []futureInfo, bool, error) {
 	ctx := wfCtx.Context()
 	activityByID := make(map[string]*ToolExecutionResult, len(futures))
 	pending := append([]futureInfo(nil), futures...)
@@ -604,7 +655,7 @@ func (e *toolBatchExec) collectActivityResultsAsComplete(wfCtx engine.WorkflowCo
 				return true
 			}
 			for _, info := range pending {
-				if info.future.IsReady() {
+				if info.ready() {
 					return true
 				}
 			}
@@ -616,14 +667,36 @@ func (e *toolBatchExec) collectActivityResultsAsComplete(wfCtx engine.WorkflowCo
 		i := 0
 		for i < len(pending) {
 			info := pending[i]
-			if !info.future.IsReady() {
+			if !info.ready() {
 				i++
 				continue
 			}
+			if info.retryTimer != nil {
+				next, err := e.startToolRetry(ctx, execWfCtx, info)
+				if err == nil {
+					pending[i] = next
+					i++
+					continue
+				}
+				result, synthErr := e.synthesizeToolError(ctx, info.call, err, "tool retry failed", wfCtx.Now().Sub(info.startTime))
+				if result != nil {
+					activityByID[info.call.ToolCallID] = result
+				}
+				if synthErr != nil {
+					executionErr = errors.Join(executionErr, synthErr)
+				}
+				pending[i] = pending[len(pending)-1]
+				pending = pending[:len(pending)-1]
+				continue
+			}
 			pending[i] = pending[len(pending)-1]
 			pending = pending[:len(pending)-1]
 
 			out, err := info.future.Get(ctx)
+			if retry, ok := e.scheduleToolRetry(ctx, execWfCtx, info, out, err); ok {
+				pending = append(pending, retry)
+				continue
+			}
 			if err != nil {
 				duration := wfCtx.Now().Sub(info.startTime)
 				result, synthErr := e.synthesizeToolError(ctx, info.call, err, "tool activity failed", duration)
@@ -683,6 +756,7 @@ func (e *toolBatchExec) executionFromActivityOutput(ctx context.Context, info fu
 		ServerData: out.ServerData,
 		ToolCallID: info.call.ToolCallID,
 		Telemetry:  out.Telemetry,
+		Cached:     out.Cached,
 	}
 	toolRes.Failure = out.Failure
 	if err := e.r.enforceToolResultContracts(spec, info.call, toolRes); err != nil {
@@ -846,8 +920,10 @@ func availableToolExecutionsInCallOrder(calls []planner.ToolRequest, activityByI
 // original call order so downstream planner/finalizer behavior remains stable.
 //
 // expectedChildren indicates how many child tools are expected to be discovered dynamically
-// by the tools in this batch (0 if not tracked).
-func (r *Runtime) executeToolCalls(wfCtx engine.WorkflowContext, activityName string, toolActOptions engine.ActivityOptions, agentID agent.Ident, runCtx *run.Context, messages []*model.Message, calls []planner.ToolRequest, expectedChildren int, parentTracker *childTracker, finishBy time.Time) ([]*ToolExecutionResult, bool, error) {
+// by the tools in this batch (0 if not tracked). timeoutOverride reports that
+// toolActOptions carries a run policy timeout override that replaces the
+// timeouts declared by tool specs.
+func (r *Runtime) executeToolCalls(wfCtx engine.WorkflowContext, activityName string, toolActOptions engine.ActivityOptions, timeoutOverride bool, agentID agent.Ident, runCtx *run.Context, messages []*model.Message, calls []planner.ToolRequest, expectedChildren int, parentTracker *childTracker, finishBy time.Time) ([]*ToolExecutionResult, bool, error) {
 	if runCtx == nil {
 		return nil, false, fmt.Errorf("missing run context")
 	}
@@ -855,6 +931,7 @@ func (r *Runtime) executeToolCalls(wfCtx engine.WorkflowContext, activityName st
 		r:                r,
 		activityName:     activityName,
 		toolActOptions:   toolActOptions,
+		timeoutOverride:  timeoutOverride,
 		runID:            runCtx.RunID,
 		agentID:          agentID,
 		sessionID:        runCtx.SessionID,
@@ -933,7 +1010,7 @@ func (r *Runtime) executeToolCalls(wfCtx engine.WorkflowContext, activityName st
 		executionErr = errors.Join(executionErr, err)
 	}
 
-	activityByID, pendingActs, timedOutActs, err := exec.collectActivityResultsAsComplete(wfCtx, batch.futures, finalizeTimer)
+	activityByID, pendingActs, timedOutActs, err := exec.collectActivityResultsAsComplete(wfCtx, execWfCtx, batch.futures, finalizeTimer)
 	if err != nil {
 		executionErr = errors.Join(executionErr, err)
 	}
@@ -4,8 +4,10 @@ package runtime
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"goa.design/goa-ai/runtime/agent/engine"
 	"goa.design/goa-ai/runtime/agent/planner"
 	"goa.design/goa-ai/runtime/agent/rawjson"
 	"goa.design/goa-ai/runtime/agent/run"
@@ -73,3 +75,111 @@ func TestDispatchToolCallsPropagatesLabelsToActivityInput(t *testing.T) {
 		"kind":            "brief",
 	}, wfCtx.lastToolCall.Input.Labels)
 }
+
+func TestDispatchToolCallsDerivesRetryPolicyFromSideEffects(t *testing.T) {
+	idempotent := newAnyJSONSpec("lookup", "svc.tools")
+	idempotent.ReadOnly = true
+	idempotent.Idempotent = true
+	destructive := newAnyJSONSpec("delete", "svc.tools")
+	destructive.Destructive = true
+	plain := newAnyJSONSpec("update", "svc.tools")
+	retried := newAnyJSONSpec("fetch", "svc.tools")
+	retried.Retry = &tools.RetrySpec{MaxAttempts: 4, Backoff: 2 * time.Second}
+
+	configured := engine.RetryPolicy{MaxAttempts: 5, InitialInterval: time.Second}
+	cases := []struct {
+		name string
+		tool tools.Ident
+		base engine.RetryPolicy
+		want engine.RetryPolicy
+	}{
+		{"destructive runs once", "delete", configured, engine.RetryPolicy{MaxAttempts: 1}},
+		{"idempotent keeps configured policy", "lookup", configured, configured},
+		{"idempotent defaults to standard policy", "lookup", engine.RetryPolicy{}, defaultRetriedActivityPolicy()},
+		{"plain keeps configured policy", "update", configured, configured},
+		{"plain keeps engine default", "update", engine.RetryPolicy{}, engine.RetryPolicy{}},
+		{"declared policy leaves retries to the workflow", "fetch", configured, engine.RetryPolicy{MaxAttempts: 1}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wfCtx := &testWorkflowContext{ctx: context.Background()}
+			exec := &toolBatchExec{
+				r: &Runtime{
+					toolsets: map[string]ToolsetRegistration{
+						"svc.tools": {},
+					},
+					toolSpecs: map[tools.Ident]tools.ToolSpec{
+						"lookup": idempotent,
+						"delete": destructive,
+						"update": plain,
+						"fetch":  retried,
+					},
+				},
+				activityName:   "execute",
+				runID:          "run-1",
+				agentID:        "svc.agent",
+				sessionID:      "sess-1",
+				turnID:         "turn-1",
+				toolActOptions: engine.ActivityOptions{RetryPolicy: tc.base},
+			}
+
+			_, err := exec.dispatchToolCalls(wfCtx, []planner.ToolRequest{{
+				Name:    tc.tool,
+				Payload: rawjson.Message([]byte(`{}`)),
+			}})
+			require.NoError(t, err)
+			require.Equal(t, tc.want, wfCtx.lastToolCall.Options.RetryPolicy)
+		})
+	}
+}
+
+func TestDispatchToolCallsAppliesDeclaredTimeoutPerCall(t *testing.T) {
+	slow := newAnyJSONSpec("slow", "svc.tools")
+	slow.Timeout = time.Minute
+	rt := &Runtime{
+		toolsets: map[string]ToolsetRegistration{
+			"svc.tools": {},
+		},
+		toolSpecs: map[tools.Ident]tools.ToolSpec{
+			"slow": slow,
+			"fast": newAnyJSONSpec("fast", "svc.tools"),
+		},
+	}
+	calls := []planner.ToolRequest{
+		{Name: "slow", Payload: rawjson.Message([]byte(`{}`))},
+		{Name: "fast", Payload: rawjson.Message([]byte(`{}`))},
+	}
+
+	grouped, timeouts := rt.groupToolCallsByTimeout(calls, &RunInput{})
+	require.Equal(t, [][]planner.ToolRequest{calls}, grouped, "declared timeouts keep the batch parallel")
+	require.Equal(t, []time.Duration{0}, timeouts)
+
+	cases := []struct {
+		name     string
+		override bool
+		want     map[tools.Ident]time.Duration
+	}{
+		{"declared timeout replaces agent timeout", false, map[tools.Ident]time.Duration{"slow": time.Minute, "fast": 10 * time.Second}},
+		{"run policy override wins", true, map[tools.Ident]time.Duration{"slow": 10 * time.Second, "fast": 10 * time.Second}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wfCtx := &testWorkflowContext{ctx: context.Background()}
+			exec := &toolBatchExec{
+				r:               rt,
+				activityName:    "execute",
+				runID:           "run-1",
+				agentID:         "svc.agent",
+				sessionID:       "sess-1",
+				turnID:          "turn-1",
+				toolActOptions:  engine.ActivityOptions{StartToCloseTimeout: 10 * time.Second},
+				timeoutOverride: tc.override,
+			}
+			for _, call := range calls {
+				_, err := exec.dispatchToolCalls(wfCtx, []planner.ToolRequest{call})
+				require.NoError(t, err)
+				require.Equal(t, tc.want[call.Name], wfCtx.lastToolCall.Options.StartToCloseTimeout)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package runtime
+
+// tool_progress.go lets local tool implementations stream typed partial results
+// declared with the Progress DSL (tools.ToolSpec.Progress).
+//
+// Contract:
+//   - ExecuteToolActivity carries a progress reporter in the tool call context.
+//     Tools report progress with ReportToolProgress; the runtime encodes and
+//     validates each value with the generated progress codec and publishes it as
+//     a streaming-only ToolCallProgress hook (tool_progress stream event).
+//   - Progress is best-effort UX: it is not recorded in the run log, is never
+//     sent to model providers, and does not replace the final tool result.
+//   - Calls outside an activity-executed tool call (inline toolsets, registry
+//     providers, tests) are no-ops.
+
+import (
+	"context"
+	"fmt"
+
+	"goa.design/goa-ai/runtime/agent/hooks"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+type (
+	// toolProgressReporter publishes progress for one tool call.
+	toolProgressReporter struct {
+		rt       *Runtime
+		call     planner.ToolRequest
+		progress *tools.TypeSpec
+	}
+
+	toolProgressKey struct{}
+)
+
+// ReportToolProgress publishes progress, a partial result of the tool call
+// executing in ctx, to the run stream as a tool_progress event. progress must be
+// a value of the tool's generated progress type (see the Progress DSL); it is
+// validated against the declared schema before it is published.
+//
+// ReportToolProgress returns nil without publishing when ctx does not carry a
+// tool call executed by the runtime. It returns an error when the tool declares
+// no progress type, when progress does not match the schema, or when the event
+// cannot be published. Progress is best-effort: tools may ignore publish errors.
+func ReportToolProgress(ctx context.Context, progress any) error {
+	rep, ok := ctx.Value(toolProgressKey{}).(*toolProgressReporter)
+	if !ok {
+		return nil
+	}
+	return rep.report(ctx, progress)
+}
+
+// withToolProgress returns a context that lets the implementation of call
+// report progress described by spec.
+func (r *Runtime) withToolProgress(ctx context.Context, call planner.ToolRequest, spec tools.ToolSpec) context.Context {
+	return context.WithValue(ctx, toolProgressKey{}, &toolProgressReporter{
+		rt:       r,
+		call:     call,
+		progress: spec.Progress,
+	})
+}
+
+// report encodes, validates, and publishes one progress value.
+func (p *toolProgressReporter) report(ctx context.Context, progress any) error {
+	if p.progress == nil {
+		return fmt.Errorf("tool %q declares no progress type", p.call.Name)
+	}
+	if p.progress.Codec.ToJSON == nil || p.progress.Codec.FromJSON == nil {
+		return fmt.Errorf("tool %q has no progress codec", p.call.Name)
+	}
+	data, err := p.progress.Codec.ToJSON(progress)
+	if err != nil {
+		return fmt.Errorf("encode progress for tool %q: %w", p.call.Name, err)
+	}
+	if _, err := p.progress.Codec.FromJSON(data); err != nil {
+		return fmt.Errorf("progress for tool %q does not match its schema: %w", p.call.Name, err)
+	}
+	evt := hooks.NewToolCallProgressEvent(
+		p.call.RunID,
+		p.call.AgentID,
+		p.call.SessionID,
+		p.call.Name,
+		p.call.ToolCallID,
+		p.call.ParentToolCallID,
+		rawjson.Message(data),
+	)
+	return p.rt.publishHookErr(ctx, evt, p.call.TurnID)
+}
@@ -0,0 +1,105 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goa.design/goa-ai/runtime/agent/hooks"
+	"goa.design/goa-ai/runtime/agent/planner"
+	sessioninmem "goa.design/goa-ai/runtime/agent/session/inmem"
+	"goa.design/goa-ai/runtime/agent/stream"
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+type exportProgress struct {
+	Processed *int `json:"processed"`
+}
+
+func progressTestRuntime(t *testing.T) (*Runtime, *recordingRunlog, *recordingStreamSink) {
+	t.Helper()
+	rl := &recordingRunlog{}
+	sink := &recordingStreamSink{}
+	sub, err := stream.NewSubscriber(sink)
+	require.NoError(t, err)
+	store := sessioninmem.New()
+	_, err = store.CreateSession(context.Background(), "sess-1", time.Now().UTC())
+	require.NoError(t, err)
+	return &Runtime{
+		RunEventStore:    rl,
+		Bus:              hooks.NewBus(),
+		SessionStore:     store,
+		streamSubscriber: sub,
+	}, rl, sink
+}
+
+func progressTestSpec() tools.ToolSpec {
+	spec := newAnyJSONSpec("svc.reports.export_rows", "svc.reports")
+	spec.Progress = &tools.TypeSpec{
+		Name: "ExportRowsProgress",
+		Codec: tools.JSONCodec[any]{
+			ToJSON: json.Marshal,
+			FromJSON: func(data []byte) (any, error) {
+				var v exportProgress
+				if err := json.Unmarshal(data, &v); err != nil {
+					return nil, err
+				}
+				if v.Processed == nil {
+					return nil, errors.New("processed is required")
+				}
+				return &v, nil
+			},
+		},
+	}
+	return spec
+}
+
+var progressTestCall = planner.ToolRequest{
+	Name:       "svc.reports.export_rows",
+	RunID:      "run-1",
+	AgentID:    "svc.agent",
+	SessionID:  "sess-1",
+	TurnID:     "turn-1",
+	ToolCallID: "call-1",
+}
+
+func TestReportToolProgressStreamsToolProgress(t *testing.T) {
+	rt, rl, sink := progressTestRuntime(t)
+	ctx := rt.withToolProgress(context.Background(), progressTestCall, progressTestSpec())
+
+	processed := 40
+	require.NoError(t, ReportToolProgress(ctx, &exportProgress{Processed: &processed}))
+
+	events := sink.snapshot()
+	require.Len(t, events, 1)
+	progress, ok := events[0].(stream.ToolProgress)
+	require.True(t, ok)
+	assert.Equal(t, stream.EventToolProgress, progress.Type())
+	assert.Equal(t, "call-1", progress.Data.ToolCallID)
+	assert.Equal(t, "svc.reports.export_rows", progress.Data.ToolName)
+	assert.JSONEq(t, `{"processed":40}`, string(progress.Data.Progress))
+	assert.Empty(t, rl.events, "progress must not be recorded in the run log")
+}
+
+func TestReportToolProgressRejectsInvalidProgress(t *testing.T) {
+	rt, _, sink := progressTestRuntime(t)
+	ctx := rt.withToolProgress(context.Background(), progressTestCall, progressTestSpec())
+
+	err := ReportToolProgress(ctx, map[string]any{"total": 10})
+	require.ErrorContains(t, err, "does not match its schema")
+	assert.Empty(t, sink.snapshot())
+}
+
+func TestReportToolProgressWithoutProgressType(t *testing.T) {
+	rt, _, sink := progressTestRuntime(t)
+	spec := newAnyJSONSpec("svc.reports.export_rows", "svc.reports")
+	ctx := rt.withToolProgress(context.Background(), progressTestCall, spec)
+
+	require.ErrorContains(t, ReportToolProgress(ctx, map[string]any{"processed": 1}), "declares no progress type")
+	require.NoError(t, ReportToolProgress(context.Background(), map[string]any{"processed": 1}))
+	assert.Empty(t, sink.snapshot())
+}
@@ -0,0 +1,171 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+
+	"goa.design/goa-ai/runtime/agent/engine"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+// nonRetryableToolErrorType is the application error type of tool activity
+// failures that executing the call again cannot fix.
+const nonRetryableToolErrorType = "goa_ai.tool.non_retryable"
+
+// validateToolRetry checks the timeout and retry policy declared by a tool
+// spec. Destructive tools may only retry on failure kinds that prove the call
+// had no effect.
+func validateToolRetry(spec tools.ToolSpec) error {
+	if spec.Timeout < 0 {
+		return fmt.Errorf("tool %q timeout must be non-negative", spec.Name)
+	}
+	retry := spec.Retry
+	if retry == nil {
+		return nil
+	}
+	if retry.MaxAttempts < 1 {
+		return fmt.Errorf("tool %q retry max attempts must be at least 1", spec.Name)
+	}
+	if retry.Backoff < 0 {
+		return fmt.Errorf("tool %q retry backoff must be non-negative", spec.Name)
+	}
+	for _, kind := range retry.RetryOn {
+		switch planner.FailureKind(kind) {
+		case planner.FailureUnavailable, planner.FailureRateLimited:
+		case planner.FailureTimeout, planner.FailureMalformedResult, planner.FailureInternal:
+			if spec.Destructive {
+				return fmt.Errorf("destructive tool %q cannot retry on failure kind %q", spec.Name, kind)
+			}
+		default:
+			return fmt.Errorf("tool %q cannot retry on failure kind %q", spec.Name, kind)
+		}
+	}
+	return nil
+}
+
+// ready reports whether info can make progress: its activity completed or the
+// backoff before its next attempt elapsed.
+func (info futureInfo) ready() bool {
+	if info.retryTimer != nil {
+		return info.retryTimer.IsReady()
+	}
+	return info.future.IsReady()
+}
+
+// scheduleToolRetry decides whether the execution tracked by info must run
+// again given its activity outcome and, if so, starts the backoff timer of the
+// next attempt. It returns false when the outcome is final.
+func (e *toolBatchExec) scheduleToolRetry(ctx context.Context, wfCtx engine.WorkflowContext, info futureInfo, out *ToolOutput, err error) (futureInfo, bool) {
+	spec, ok := e.r.toolSpec(info.call.Name)
+	if !ok {
+		return futureInfo{}, false
+	}
+	next, ok := nextToolAttempt(spec, info.call, info.executions, out, err)
+	if !ok {
+		return futureInfo{}, false
+	}
+	timer, terr := wfCtx.NewTimer(ctx, toolRetryBackoff(spec.Retry, info.executions))
+	if terr != nil {
+		return futureInfo{}, false
+	}
+	kind := ""
+	if out != nil && out.Failure != nil {
+		kind = string(out.Failure.Kind)
+	}
+	e.r.logger.Warn(ctx, "retrying failed tool call",
+		"tool", info.call.Name,
+		"tool_call_id", info.call.ToolCallID,
+		"execution", info.executions,
+		"attempt", next.Attempt,
+		"failure_kind", kind,
+		"err", err,
+	)
+	return futureInfo{
+		call:       next,
+		startTime:  info.startTime,
+		executions: info.executions,
+		retryTimer: timer,
+	}, true
+}
+
+// startToolRetry starts the next execution of info once its backoff elapsed.
+// Each execution is a separate activity computed with fresh options so it is
+// bounded by its own timeout.
+func (e *toolBatchExec) startToolRetry(ctx context.Context, wfCtx engine.WorkflowContext, info futureInfo) (futureInfo, error) {
+	if _, err := info.retryTimer.Get(ctx); err != nil {
+		return futureInfo{}, err
+	}
+	spec, ok := e.r.toolSpec(info.call.Name)
+	if !ok {
+		return futureInfo{}, fmt.Errorf("unknown tool %q", info.call.Name)
+	}
+	future, err := e.startToolActivity(wfCtx, info.call, spec)
+	if err != nil {
+		return futureInfo{}, err
+	}
+	return futureInfo{
+		future:     future,
+		call:       info.call,
+		startTime:  info.startTime,
+		executions: info.executions + 1,
+	}, nil
+}
+
+// nextToolAttempt returns the request to execute after call completed its
+// executions-th execution with out or err, and false when the tool retry
+// policy does not allow another execution. Activity errors are retried with
+// the same attempt so executors that deduplicate calls re-attach to the
+// original execution; destructive tools never retry them because the effect
+// may already be applied, and non-retryable application errors (invalid
+// payloads, unknown tools or toolsets) are final. Failures whose kind is
+// listed in RetryOn are retried as a new attempt so executors run the call
+// again, unless their recovery directive finishes the run.
+func nextToolAttempt(spec tools.ToolSpec, call planner.ToolRequest, executions int, out *ToolOutput, err error) (planner.ToolRequest, bool) {
+	retry := spec.Retry
+	if retry == nil || executions >= retry.MaxAttempts {
+		return call, false
+	}
+	if err != nil {
+		if spec.Destructive || isRunCancellationError(err) || isNonRetryableToolError(err) {
+			return call, false
+		}
+		return call, true
+	}
+	if out == nil || out.Failure == nil || !slices.Contains(retry.RetryOn, string(out.Failure.Kind)) {
+		return call, false
+	}
+	if out.Failure.Recovery.Action == planner.RecoveryFinish {
+		return call, false
+	}
+	call.Attempt = max(call.Attempt, 1) + 1
+	return call, true
+}
+
+// nonRetryableToolError marks err as a tool activity failure that executing
+// the call again cannot fix, so neither the engine activity retry policy nor
+// the tool RetryPolicy runs it again.
+func nonRetryableToolError(err error) error {
+	return temporal.NewNonRetryableApplicationError(err.Error(), nonRetryableToolErrorType, nil)
+}
+
+// isNonRetryableToolError reports whether err, or the activity error wrapping
+// it, is a non-retryable application error.
+func isNonRetryableToolError(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.NonRetryable()
+}
+
+// toolRetryBackoff returns the delay before the execution that follows the
+// executions-th one: the declared backoff, doubled after each execution.
+func toolRetryBackoff(retry *tools.RetrySpec, executions int) time.Duration {
+	if retry == nil || retry.Backoff <= 0 || executions < 1 {
+		return 0
+	}
+	return retry.Backoff << min(executions-1, 16)
+}
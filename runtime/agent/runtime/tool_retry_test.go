@@ -0,0 +1,149 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+	agent "goa.design/goa-ai/runtime/agent"
+	"goa.design/goa-ai/runtime/agent/engine"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/run"
+	runloginmem "goa.design/goa-ai/runtime/agent/runlog/inmem"
+	"goa.design/goa-ai/runtime/agent/telemetry"
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+func TestExecuteToolCallsRetriesDeclaredFailureKindsAsNewAttempts(t *testing.T) {
+	cases := []struct {
+		name     string
+		failures []planner.FailureKind
+		want     []int
+		wantOK   bool
+	}{
+		{"recovers after retryable failure", []planner.FailureKind{planner.FailureUnavailable}, []int{0, 2}, true},
+		{"stops at max attempts", []planner.FailureKind{planner.FailureRateLimited, planner.FailureUnavailable, planner.FailureUnavailable}, []int{0, 2, 3}, false},
+		{"does not retry undeclared kind", []planner.FailureKind{planner.FailureInternal}, []int{0}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := newAnyJSONSpec("svc.tools.fetch", "svc.tools")
+			spec.Retry = &tools.RetrySpec{
+				MaxAttempts: 3,
+				Backoff:     time.Millisecond,
+				RetryOn:     []string{string(planner.FailureUnavailable), string(planner.FailureRateLimited)},
+			}
+			var attempts []int
+			rt := &Runtime{
+				Bus:           &recordingHooks{},
+				RunEventStore: runloginmem.New(),
+				logger:        telemetry.NoopLogger{},
+				metrics:       telemetry.NoopMetrics{},
+				tracer:        telemetry.NoopTracer{},
+				toolsets: map[string]ToolsetRegistration{
+					"svc.tools": {
+						Name: "svc.tools",
+						Execute: func(_ context.Context, call *planner.ToolRequest) (*ToolExecutionResult, error) {
+							res := &planner.ToolResult{Name: call.Name, ToolCallID: call.ToolCallID}
+							if len(attempts) < len(tc.failures) {
+								res.Failure = testToolFailure(tc.failures[len(attempts)], planner.RecoveryReplan, "failed")
+							} else {
+								res.Result = map[string]any{"ok": true}
+							}
+							attempts = append(attempts, call.Attempt)
+							return &ToolExecutionResult{ToolResult: res}, nil
+						},
+					},
+				},
+			}
+			seedTestToolSpecs(rt, spec)
+			wfCtx := &testWorkflowContext{ctx: context.Background(), hookRuntime: rt, runtime: rt}
+			call := planner.ToolRequest{
+				Name:       "svc.tools.fetch",
+				Payload:    []byte(`{}`),
+				RunID:      "run-1",
+				SessionID:  "sess-1",
+				TurnID:     "turn-1",
+				ToolCallID: "call-1",
+			}
+
+			results, _, err := rt.executeToolCalls(wfCtx, "execute", engine.ActivityOptions{}, false, agent.Ident("agent-1"), &run.Context{RunID: "run-1", SessionID: "sess-1", TurnID: "turn-1"}, nil, []planner.ToolRequest{call}, 0, nil, time.Time{})
+			require.NoError(t, err)
+			require.Equal(t, tc.want, attempts)
+			require.Len(t, results, 1)
+			require.Equal(t, "call-1", results[0].ToolResult.ToolCallID)
+			require.Equal(t, tc.wantOK, results[0].ToolResult.Failure == nil)
+			require.Equal(t, engine.RetryPolicy{MaxAttempts: 1}, wfCtx.lastToolCall.Options.RetryPolicy)
+		})
+	}
+}
+
+func TestNextToolAttemptRetriesActivityErrorsWithSameAttempt(t *testing.T) {
+	retried := newAnyJSONSpec("fetch", "svc.tools")
+	retried.Retry = &tools.RetrySpec{MaxAttempts: 2}
+	destructive := retried
+	destructive.Destructive = true
+	call := planner.ToolRequest{Name: "fetch", ToolCallID: "call-1", Attempt: 2}
+	activityErr := errors.New("activity start-to-close timeout")
+
+	next, ok := nextToolAttempt(retried, call, 1, nil, activityErr)
+	require.True(t, ok)
+	require.Equal(t, 2, next.Attempt)
+
+	_, ok = nextToolAttempt(retried, call, 2, nil, activityErr)
+	require.False(t, ok, "max attempts reached")
+
+	_, ok = nextToolAttempt(destructive, call, 1, nil, activityErr)
+	require.False(t, ok, "destructive tools do not retry activity errors")
+
+	_, ok = nextToolAttempt(retried, call, 1, nil, context.Canceled)
+	require.False(t, ok, "canceled executions do not retry")
+}
+
+func TestNextToolAttemptStopsOnPermanentFailures(t *testing.T) {
+	spec := newAnyJSONSpec("fetch", "svc.tools")
+	spec.Retry = &tools.RetrySpec{MaxAttempts: 3, RetryOn: []string{string(planner.FailureUnavailable)}}
+	call := planner.ToolRequest{Name: "fetch", ToolCallID: "call-1"}
+
+	rt := &Runtime{}
+	_, unknownToolset := rt.ExecuteToolActivity(context.Background(), &ToolInput{
+		ToolName:    "fetch",
+		ToolsetName: "svc.missing",
+		Payload:     []byte(`{}`),
+	})
+	require.ErrorContains(t, unknownToolset, `toolset "svc.missing" is not registered`)
+	_, invalidPayload := rt.ExecuteToolActivity(context.Background(), &ToolInput{ToolName: "fetch"})
+	require.ErrorContains(t, invalidPayload, "tool payload is invalid")
+
+	for name, err := range map[string]error{
+		"unknown toolset":              unknownToolset,
+		"invalid payload":              invalidPayload,
+		"wrapped by the engine":        fmt.Errorf("activity error: %w", unknownToolset),
+		"non-retryable executor error": temporal.NewNonRetryableApplicationError("bad request", "executor", nil),
+	} {
+		_, ok := nextToolAttempt(spec, call, 1, nil, err)
+		require.False(t, ok, name)
+	}
+	_, ok := nextToolAttempt(spec, call, 1, nil, temporal.NewApplicationError("flaky", "executor"))
+	require.True(t, ok, "retryable application errors are retried")
+
+	finish := &ToolOutput{Failure: testToolFailure(planner.FailureUnavailable, planner.RecoveryFinish, "gone")}
+	_, ok = nextToolAttempt(spec, call, 1, finish, nil)
+	require.False(t, ok, "failures that finish the run are permanent")
+	replan := &ToolOutput{Failure: testToolFailure(planner.FailureUnavailable, planner.RecoveryReplan, "down")}
+	next, ok := nextToolAttempt(spec, call, 1, replan, nil)
+	require.True(t, ok)
+	require.Equal(t, 2, next.Attempt)
+}
+
+func TestToolRetryBackoffDoublesPerExecution(t *testing.T) {
+	retry := &tools.RetrySpec{MaxAttempts: 4, Backoff: time.Second}
+	require.Equal(t, time.Second, toolRetryBackoff(retry, 1))
+	require.Equal(t, 2*time.Second, toolRetryBackoff(retry, 2))
+	require.Equal(t, 4*time.Second, toolRetryBackoff(retry, 3))
+	require.Zero(t, toolRetryBackoff(&tools.RetrySpec{MaxAttempts: 2}, 1))
+}
@@ -50,6 +50,10 @@ type (
 	// and planner notes for callers.
 	RunOutput = api.RunOutput
 
+	// RunHandoff describes a planner-requested transfer of conversation
+	// ownership to another agent.
+	RunHandoff = api.RunHandoff
+
 	// ActivityToolExecutor implements ToolActivityExecutor for regular tools that execute via
 	// workflow activities. It uses ExecuteActivityAsync for parallel execution with other
 	// tools in the same batch.
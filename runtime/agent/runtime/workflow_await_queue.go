@@ -146,7 +146,7 @@ func (r *Runtime) resolveConfirmationDecision(
 		call.ToolCallID = generateDeterministicToolCallID(base.RunContext.RunID, call.TurnID, base.RunContext.Attempt, call.Name, 0)
 	}
 
-	grouped, timeouts := r.groupToolCallsByTimeout([]planner.ToolRequest{call}, input, toolOpts.StartToCloseTimeout)
+	grouped, timeouts := r.groupToolCallsByTimeout([]planner.ToolRequest{call}, input)
 	finishBy := deadlines.Budget
 	if r.isBookkeeping(call.Name) {
 		finishBy = deadlines.Hard
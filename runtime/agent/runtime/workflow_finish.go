@@ -74,6 +74,10 @@ func (r *Runtime) materializeTerminalPlannerResult(
 		)
 	}
 
+	if err := r.validatePlannerHandoff(input.AgentID, base.RunContext, result.Handoff); err != nil {
+		return nil, err
+	}
+
 	var finalMsg *model.Message
 	if result.FinalResponse != nil {
 		finalMsg = result.FinalResponse.Message
@@ -118,6 +122,29 @@ func (r *Runtime) materializeTerminalPlannerResult(
 		return nil, err
 	}
 
+	var handoff *api.RunHandoff
+	if result.Handoff != nil {
+		handoff = &api.RunHandoff{
+			TargetAgentID: result.Handoff.Target,
+			TargetRunID:   HandoffRunID(base.RunContext.RunID, result.Handoff.Target),
+			Reason:        result.Handoff.Reason,
+		}
+		if err := r.publishHook(
+			ctx,
+			hooks.NewAgentHandoffEvent(
+				base.RunContext.RunID,
+				input.AgentID,
+				base.RunContext.SessionID,
+				handoff.TargetAgentID,
+				handoff.TargetRunID,
+				handoff.Reason,
+			),
+			turnID,
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	finalToolResult := finalToolResultEvent(base.RunContext.Tool, result.FinalToolResult)
 	return &RunOutput{
 		AgentID:         input.AgentID,
@@ -127,6 +154,7 @@ func (r *Runtime) materializeTerminalPlannerResult(
 		ToolEvents:      toolEvents,
 		Notes:           notes,
 		Usage:           &state.usage,
+		Handoff:         handoff,
 	}, nil
 }
 
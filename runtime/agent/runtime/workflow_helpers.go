@@ -18,14 +18,15 @@ import (
 )
 
 // groupToolCallsByTimeout buckets calls by per-tool timeout override (with `*`
-// suffix prefix-match support) or falls back to the default timeout when no
-// override applies.
+// suffix prefix-match support). Calls without an override share a group with a
+// zero timeout; they keep the timeout declared by their tool spec or the agent
+// tool timeout, applied per call when their activity is scheduled.
 //
 // The bucketing is deterministic for workflow replay:
 //   - Exact tool-name matches take precedence over prefix matches.
 //   - Among prefix matches, the longest prefix wins.
 //   - Group ordering follows first appearance in the allowed slice.
-func (r *Runtime) groupToolCallsByTimeout(allowed []planner.ToolRequest, input *RunInput, defaultTimeout time.Duration) ([][]planner.ToolRequest, []time.Duration) {
+func (r *Runtime) groupToolCallsByTimeout(allowed []planner.ToolRequest, input *RunInput) ([][]planner.ToolRequest, []time.Duration) {
 	var grouped [][]planner.ToolRequest
 	var timeouts []time.Duration
 	if input != nil && input.Policy != nil && len(input.Policy.PerToolTimeout) > 0 {
@@ -68,7 +69,7 @@ func (r *Runtime) groupToolCallsByTimeout(allowed []planner.ToolRequest, input *
 
 		groupIndexByTimeout := make(map[time.Duration]int)
 		for _, call := range allowed {
-			to := defaultTimeout
+			var to time.Duration
 			if override, ok := resolve(call.Name); ok && override > 0 {
 				to = override
 			}
@@ -83,13 +84,13 @@ func (r *Runtime) groupToolCallsByTimeout(allowed []planner.ToolRequest, input *
 		}
 	} else {
 		grouped = [][]planner.ToolRequest{allowed}
-		timeouts = []time.Duration{defaultTimeout}
+		timeouts = []time.Duration{0}
 	}
 	return grouped, timeouts
 }
 
 // executeGroupedToolCalls runs groups of tool calls with their respective
-// timeouts and returns all results in the original group order.
+// timeout overrides and returns all results in the original group order.
 func (r *Runtime) executeGroupedToolCalls(
 	wfCtx engine.WorkflowContext,
 	reg AgentRegistration,
@@ -107,10 +108,11 @@ func (r *Runtime) executeGroupedToolCalls(
 	var executionErr error
 	for i := range grouped {
 		opt := toolOpts
-		if timeouts[i] > 0 {
+		overridden := timeouts[i] > 0
+		if overridden {
 			opt.StartToCloseTimeout = timeouts[i]
 		}
-		sub, timedOut, err := r.executeToolCalls(wfCtx, reg.ExecuteToolActivity, opt, agentID, &base.RunContext, base.Messages, grouped[i], expectedChildren, parentTracker, finishBy)
+		sub, timedOut, err := r.executeToolCalls(wfCtx, reg.ExecuteToolActivity, opt, overridden, agentID, &base.RunContext, base.Messages, grouped[i], expectedChildren, parentTracker, finishBy)
 		out = append(out, sub...)
 		if timedOut {
 			timedOutAny = true
@@ -143,6 +143,9 @@ func (r *Runtime) normalizeStep(result *planner.PlanResult) (stepProgram, error)
 	if hasTerminal && hasAwait {
 		return stepProgram{}, errors.New("workflow step cannot combine terminal payload and await")
 	}
+	if result.Handoff != nil && (result.FinalResponse == nil || hasCalls || hasAwait) {
+		return stepProgram{}, errors.New("workflow step handoff requires a FinalResponse without tool calls or await")
+	}
 	if hasTerminal && !hasCalls {
 		return stepProgram{
 			result: result,
@@ -144,6 +144,10 @@ func (l *workflowLoop) prepareToolStep(program *stepProgram) error {
 	if err != nil {
 		return err
 	}
+	candidates, err = l.r.applyToolAvailability(ctx, l.base.RunContext.Labels, l.st.ToolOutputs, candidates)
+	if err != nil {
+		return err
+	}
 	allowed, nextCaps, err := l.r.applyRuntimePolicy(
 		ctx,
 		l.base,
@@ -322,7 +326,7 @@ func (l *workflowLoop) executeImmediateToolClass(
 	if len(calls) == 0 {
 		return nil, false, nil
 	}
-	grouped, timeouts := l.r.groupToolCallsByTimeout(calls, l.input, l.toolOpts.StartToCloseTimeout)
+	grouped, timeouts := l.r.groupToolCallsByTimeout(calls, l.input)
 	return l.r.executeGroupedToolCalls(
 		l.wfCtx,
 		l.reg,
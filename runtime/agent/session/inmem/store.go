@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -151,6 +153,9 @@ func (s *Store) UpsertRun(_ context.Context, run session.RunMeta) error {
 	} else if run.StartedAt.IsZero() {
 		run.StartedAt = now
 	}
+	if ok {
+		preserveHandoffLineage(&run, existing)
+	}
 	run.UpdatedAt = now
 
 	s.runs[run.RunID] = cloneRunMeta(run)
@@ -196,6 +201,46 @@ func (s *Store) LinkChildRun(_ context.Context, parentRunID string, child sessio
 	return nil
 }
 
+// LinkHandoffRun implements session.Store.
+func (s *Store) LinkHandoffRun(_ context.Context, fromRunID string, target session.RunMeta) error {
+	if err := session.ValidateHandoffRunLink(fromRunID, target); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	from, ok := s.runs[fromRunID]
+	if !ok {
+		return session.ErrRunNotFound
+	}
+	if from.SessionID != target.SessionID {
+		return session.ErrRunSessionMismatch
+	}
+	if from.HandoffToRunID != "" && from.HandoffToRunID != target.RunID {
+		return session.ErrHandoffConflict
+	}
+
+	now := time.Now().UTC()
+	existing, exists := s.runs[target.RunID]
+	if exists {
+		if existing.SessionID != from.SessionID {
+			return session.ErrRunSessionMismatch
+		}
+		target = existing
+	} else if target.StartedAt.IsZero() {
+		target.StartedAt = now
+	}
+	target.HandoffFromRunID = fromRunID
+	target.UpdatedAt = now
+	s.runs[target.RunID] = cloneRunMeta(target)
+
+	from.HandoffToRunID = target.RunID
+	from.UpdatedAt = now
+	s.runs[fromRunID] = cloneRunMeta(from)
+	return nil
+}
+
 // LoadRun implements session.Store.
 func (s *Store) LoadRun(_ context.Context, runID string) (session.RunMeta, error) {
 	if runID == "" {
@@ -276,6 +321,29 @@ func (s *Store) ListRunsBySession(_ context.Context, sessionID string, statuses
 	return out, nil
 }
 
+// ListSessions implements session.Lister.
+func (s *Store) ListSessions(_ context.Context, statuses []session.SessionStatus, limit int) ([]session.Session, error) {
+	s.mu.RLock()
+	out := make([]session.Session, 0, len(s.sessions))
+	for _, sess := range s.sessions {
+		if len(statuses) > 0 && !slices.Contains(statuses, sess.Status) {
+			continue
+		}
+		out = append(out, cloneSession(sess))
+	}
+	s.mu.RUnlock()
+	slices.SortFunc(out, func(a, b session.Session) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
 func cloneSession(in session.Session) session.Session {
 	out := in
 	if in.EndedAt != nil {
@@ -315,6 +383,17 @@ func cloneRunSuspension(in session.RunSuspension) session.RunSuspension {
 	return session.RunSuspension{ID: in.ID, Data: append([]byte(nil), in.Data...)}
 }
 
+// preserveHandoffLineage keeps write-once handoff links when an upsert leaves
+// them empty.
+func preserveHandoffLineage(run *session.RunMeta, existing session.RunMeta) {
+	if run.HandoffFromRunID == "" {
+		run.HandoffFromRunID = existing.HandoffFromRunID
+	}
+	if run.HandoffToRunID == "" {
+		run.HandoffToRunID = existing.HandoffToRunID
+	}
+}
+
 func appendUniqueRunID(runIDs []string, runID string) []string {
 	for _, current := range runIDs {
 		if current == runID {
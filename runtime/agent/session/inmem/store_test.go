@@ -51,6 +51,42 @@ func TestLinkChildRunReturnsSessionMismatchError(t *testing.T) {
 	require.ErrorIs(t, err, session.ErrRunSessionMismatch)
 }
 
+func TestLinkHandoffRunRecordsLineage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := New()
+	_, err := store.CreateSession(ctx, "sess-1", time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, store.UpsertRun(ctx, session.RunMeta{
+		RunID:     "run-triage",
+		AgentID:   "svc.triage",
+		SessionID: "sess-1",
+		Status:    session.RunStatusCompleted,
+	}))
+	target := session.RunMeta{
+		RunID:     "run-billing",
+		AgentID:   "svc.billing",
+		SessionID: "sess-1",
+		Status:    session.RunStatusPending,
+	}
+	require.NoError(t, store.LinkHandoffRun(ctx, "run-triage", target))
+	require.NoError(t, store.LinkHandoffRun(ctx, "run-triage", target), "linking is idempotent")
+
+	// Lifecycle upserts that carry no lineage must not clear it.
+	require.NoError(t, store.UpsertRun(ctx, target))
+
+	from, err := store.LoadRun(ctx, "run-triage")
+	require.NoError(t, err)
+	require.Equal(t, "run-billing", from.HandoffToRunID)
+	to, err := store.LoadRun(ctx, "run-billing")
+	require.NoError(t, err)
+	require.Equal(t, "run-triage", to.HandoffFromRunID)
+
+	target.RunID = "run-other"
+	require.ErrorIs(t, store.LinkHandoffRun(ctx, "run-triage", target), session.ErrHandoffConflict)
+}
+
 func TestRunSuspensionIsImmutableAndIdempotent(t *testing.T) {
 	t.Parallel()
 
@@ -119,3 +155,31 @@ func TestPurgeSessionRemovesOnlyOwnedRunsAndSuspensions(t *testing.T) {
 	_, err = store.LoadRunSuspension(context.Background(), "run-session-2")
 	require.NoError(t, err)
 }
+
+func TestListSessionsFiltersAndOrdersNewestFirst(t *testing.T) {
+	ctx := context.Background()
+	store := New()
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, id := range []string{"s1", "s2", "s3"} {
+		_, err := store.CreateSession(ctx, id, base.Add(time.Duration(i)*time.Minute))
+		require.NoError(t, err)
+	}
+	_, err := store.EndSession(ctx, "s2", base.Add(time.Hour))
+	require.NoError(t, err)
+
+	all, err := store.ListSessions(ctx, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"s3", "s2", "s1"}, sessionIDs(all))
+
+	active, err := store.ListSessions(ctx, []session.SessionStatus{session.StatusActive}, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"s3"}, sessionIDs(active))
+}
+
+func sessionIDs(sessions []session.Session) []string {
+	ids := make([]string, len(sessions))
+	for i, s := range sessions {
+		ids[i] = s.ID
+	}
+	return ids
+}
@@ -59,6 +59,15 @@ type (
 		// Child runs are produced by agent-as-tool execution. Consumers that need
 		// full prompt attribution should walk this graph to include descendants.
 		ChildRunIDs []string
+		// HandoffFromRunID identifies the run that handed the conversation off
+		// to this run. Empty when the run was not started by a handoff.
+		HandoffFromRunID string
+		// HandoffToRunID identifies the run that took over the conversation
+		// when this run ended with a handoff.
+		//
+		// Handoff lineage is write-once: it is set by LinkHandoffRun and
+		// preserved by UpsertRun calls that leave it empty.
+		HandoffToRunID string
 		// Metadata stores implementation-specific metadata (e.g., error codes).
 		Metadata map[string]any
 	}
@@ -107,6 +116,17 @@ type (
 		// - The implementation must ensure no observer can observe a linked child ID
 		//   without a corresponding child run record.
 		LinkChildRun(ctx context.Context, parentRunID string, child RunMeta) error
+		// LinkHandoffRun records that fromRunID handed the conversation off to
+		// the target run.
+		//
+		// Contract:
+		// - fromRunID and target identifiers must be non-empty.
+		// - The source run must already exist, otherwise ErrRunNotFound is returned.
+		// - Source and target runs must belong to the same session.
+		// - The target run record is created when missing.
+		// - Linkage is idempotent; linking the source to a different target
+		//   returns ErrHandoffConflict.
+		LinkHandoffRun(ctx context.Context, fromRunID string, target RunMeta) error
 		// LoadRun loads run metadata. Returns ErrRunNotFound when missing.
 		LoadRun(ctx context.Context, runID string) (RunMeta, error)
 		// SaveRunSuspension durably stores the one suspension produced by runID.
@@ -123,6 +143,17 @@ type (
 		ListRunsBySession(ctx context.Context, sessionID string, statuses []RunStatus) ([]RunMeta, error)
 	}
 
+	// Lister is implemented by stores that can enumerate sessions. It is used
+	// by operator tooling such as the run inspector; the runtime itself never
+	// lists sessions.
+	Lister interface {
+		// ListSessions lists sessions ordered by creation time, most recent
+		// first. When statuses is non-empty, only sessions whose status matches
+		// one of the provided values are returned. When limit is greater than
+		// zero, at most limit sessions are returned.
+		ListSessions(ctx context.Context, statuses []SessionStatus, limit int) ([]Session, error)
+	}
+
 	// SessionStatus represents the lifecycle state of a session.
 	SessionStatus string
 
@@ -176,8 +207,17 @@ var (
 	ErrChildStatusRequired = errors.New("child status is required")
 	// ErrRunSessionMismatch indicates parent and child runs belong to different sessions.
 	ErrRunSessionMismatch = errors.New("parent and child runs must belong to the same session")
+	// ErrHandoffConflict indicates a run is already linked to a different handoff target.
+	ErrHandoffConflict = errors.New("run already handed off to a different run")
 )
 
+// ValidateHandoffRunLink validates required identifiers for
+// Store.LinkHandoffRun input. Handoff links require the same identifiers as
+// child links: the source plays the parent role and the target the child.
+func ValidateHandoffRunLink(fromRunID string, target RunMeta) error {
+	return ValidateChildRunLink(fromRunID, target)
+}
+
 // ValidateChildRunLink validates required identifiers for Store.LinkChildRun input.
 func ValidateChildRunLink(parentRunID string, child RunMeta) error {
 	switch {
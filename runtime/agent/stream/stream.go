@@ -160,6 +160,13 @@ type (
 		Data ToolUpdatePayload
 	}
 
+	// ToolProgress streams a typed partial result reported by a running tool
+	// that declares a Progress type.
+	ToolProgress struct {
+		Base
+		Data ToolProgressPayload
+	}
+
 	// ToolCallArgsDelta streams an incremental tool-call argument fragment as the
 	// provider constructs the final tool input JSON.
 	//
@@ -222,6 +229,14 @@ type (
 		Data ChildRunLinkedPayload
 	}
 
+	// AgentHandoff reports that a run transferred the conversation to another
+	// agent. The target run belongs to the same session, so session-scoped
+	// consumers observe the target agent's events on the same stream.
+	AgentHandoff struct {
+		Base
+		Data AgentHandoffPayload
+	}
+
 	// SessionStreamStarted is emitted when a session-scoped stream is created and
 	// ready to accept events. It exists to materialize the underlying stream so
 	// consumers can subscribe immediately without racing stream creation.
@@ -443,6 +458,10 @@ type (
 		// Failure contains the stable failure classification and recovery action.
 		// Nil on success.
 		Failure *planner.ToolFailure `json:"failure,omitempty"`
+		// Cached reports that the result was served from the runtime tool result
+		// cache instead of executing the tool. Clients can label such results as
+		// reused and exclude them from execution cost reports.
+		Cached bool `json:"cached,omitempty"`
 		// Extra carries optional extension data for clients that need to attach
 		// transport- or domain-specific fields without breaking the wire contract.
 		// The runtime ignores its contents; sinks may include it when present.
@@ -552,6 +571,21 @@ type (
 		ExpectedChildrenTotal int `json:"expected_children_total"`
 	}
 
+	// ToolProgressPayload describes one typed partial result reported by a
+	// running tool.
+	ToolProgressPayload struct {
+		// ToolCallID identifies the tool call reporting progress.
+		ToolCallID string `json:"tool_call_id"`
+		// ParentToolCallID optionally identifies the parent tool call when the tool
+		// was invoked as part of an agent-as-tool run.
+		ParentToolCallID string `json:"parent_tool_call_id,omitempty"`
+		// ToolName is the canonical tool identifier.
+		ToolName string `json:"tool_name"`
+		// Progress is the canonical JSON progress value, validated against the
+		// tool's progress schema.
+		Progress rawjson.Message `json:"progress"`
+	}
+
 	// ToolCallArgsDeltaPayload describes a streamed tool-call argument fragment.
 	ToolCallArgsDeltaPayload struct {
 		// ToolCallID identifies the tool call being streamed.
@@ -640,6 +674,18 @@ type (
 		ChildAgentID agent.Ident `json:"child_agent_id"`
 	}
 
+	// AgentHandoffPayload describes a transfer of conversation ownership.
+	AgentHandoffPayload struct {
+		// FromAgentID is the identifier of the agent handing off.
+		FromAgentID agent.Ident `json:"from_agent_id"`
+		// TargetAgentID is the identifier of the agent taking over.
+		TargetAgentID agent.Ident `json:"target_agent_id"`
+		// TargetRunID is the run identifier reserved for the target agent.
+		TargetRunID string `json:"target_run_id"`
+		// Reason is the optional planner-provided explanation.
+		Reason string `json:"reason,omitempty"`
+	}
+
 	// StreamProfile describes which event kinds are emitted for a particular
 	// audience. Profiles are applied by the Subscriber when mapping hook events
 	// → stream events.
@@ -656,6 +702,8 @@ type (
 		ToolStart bool
 		// ToolUpdate controls emission of tool_update events.
 		ToolUpdate bool
+		// ToolProgress controls emission of tool_progress events.
+		ToolProgress bool
 		// ToolCallArgsDelta controls emission of tool_call_args_delta events.
 		ToolCallArgsDelta bool
 		// ToolEnd controls emission of tool_end events.
@@ -676,6 +724,8 @@ type (
 		Workflow bool
 		// ChildRuns controls emission of child_run_linked events.
 		ChildRuns bool
+		// Handoffs controls emission of agent_handoff events.
+		Handoffs bool
 	}
 )
 
@@ -690,6 +740,7 @@ func DefaultProfile() StreamProfile {
 		PromptRendered:     true,
 		ToolStart:          true,
 		ToolUpdate:         true,
+		ToolProgress:       true,
 		ToolCallArgsDelta:  true,
 		ToolEnd:            true,
 		AwaitClarification: true,
@@ -700,6 +751,7 @@ func DefaultProfile() StreamProfile {
 		Usage:              true,
 		Workflow:           true,
 		ChildRuns:          true,
+		Handoffs:           true,
 	}
 }
 
@@ -759,6 +811,12 @@ const (
 	// count for progress tracking.
 	EventToolUpdate EventType = "tool_update"
 
+	// EventToolProgress streams a typed partial result reported by a running tool
+	// that declares a Progress type. Emitted by StreamSubscriber when
+	// ToolCallProgressEvent hooks fire. The payload carries the progress value
+	// validated against the tool's progress schema.
+	EventToolProgress EventType = "tool_progress"
+
 	// EventToolCallArgsDelta streams an incremental tool-call argument fragment as
 	// the model provider streams tool input JSON.
 	//
@@ -808,6 +866,10 @@ const (
 	// EventChildRunLinked links a parent tool call to a spawned child agent run.
 	EventChildRunLinked EventType = "child_run_linked"
 
+	// EventAgentHandoff streams when a planner transfers the conversation to
+	// another agent.
+	EventAgentHandoff EventType = "agent_handoff"
+
 	// EventSessionStreamStarted marks that a session stream has been created.
 	EventSessionStreamStarted EventType = "session_stream_started"
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"goa.design/goa-ai/runtime/agent"
 	"goa.design/goa-ai/runtime/agent/hooks"
 	"goa.design/goa-ai/runtime/agent/rawjson"
 	"goa.design/goa-ai/runtime/agent/run"
@@ -27,6 +28,7 @@ type (
 	//   - ToolCallArgsDelta     → EventToolCallArgsDelta (optional)
 	//   - ToolCallScheduled     → EventToolStart
 	//   - ToolCallUpdated       → EventToolUpdate
+	//   - ToolCallProgress      → EventToolProgress
 	//   - ToolResultReceived    → EventToolEnd
 	//
 	// All other internal events are ignored and not sent to clients.
@@ -81,6 +83,7 @@ func NewSubscriberWithProfile(sink Sink, profile StreamProfile) (*Subscriber, er
 //   - ToolCallArgsDelta → EventToolCallArgsDelta (optional)
 //   - ToolCallScheduled → EventToolStart
 //   - ToolCallUpdated → EventToolUpdate
+//   - ToolCallProgress → EventToolProgress
 //   - ToolResultReceived → EventToolEnd
 //   - All other event types are ignored (return nil)
 //
@@ -326,6 +329,7 @@ func (s *Subscriber) HandleEvent(ctx context.Context, event hooks.Event) error {
 			Duration:            evt.Duration,
 			Telemetry:           evt.Telemetry,
 			Failure:             evt.Failure,
+			Cached:              evt.Cached,
 		}
 		if preview := clampPreview(evt.ResultPreview); preview != "" {
 			payload.ResultPreview = preview
@@ -347,6 +351,20 @@ func (s *Subscriber) HandleEvent(ctx context.Context, event hooks.Event) error {
 			Base: newBaseFromHook(evt, EventToolUpdate, up),
 			Data: up,
 		})
+	case *hooks.ToolCallProgressEvent:
+		if !s.profile.ToolProgress {
+			return nil
+		}
+		payload := ToolProgressPayload{
+			ToolCallID:       evt.ToolCallID,
+			ParentToolCallID: evt.ParentToolCallID,
+			ToolName:         string(evt.ToolName),
+			Progress:         append(rawjson.Message(nil), evt.Progress...),
+		}
+		return s.sink.Send(ctx, ToolProgress{
+			Base: newBaseFromHook(evt, EventToolProgress, payload),
+			Data: payload,
+		})
 	case *hooks.ChildRunLinkedEvent:
 		if !s.profile.ChildRuns {
 			return nil
@@ -361,6 +379,20 @@ func (s *Subscriber) HandleEvent(ctx context.Context, event hooks.Event) error {
 			Base: newBaseFromHook(evt, EventChildRunLinked, payload),
 			Data: payload,
 		})
+	case *hooks.AgentHandoffEvent:
+		if !s.profile.Handoffs {
+			return nil
+		}
+		payload := AgentHandoffPayload{
+			FromAgentID:   agent.Ident(evt.AgentID()),
+			TargetAgentID: evt.TargetAgentID,
+			TargetRunID:   evt.TargetRunID,
+			Reason:        evt.Reason,
+		}
+		return s.sink.Send(ctx, AgentHandoff{
+			Base: newBaseFromHook(evt, EventAgentHandoff, payload),
+			Data: payload,
+		})
 	case *hooks.RunCompletedEvent:
 		if !s.profile.Workflow {
 			return nil
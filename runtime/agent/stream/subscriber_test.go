@@ -114,6 +114,7 @@ func TestStreamSubscriber_ToolEnd_EmitsServerData(t *testing.T) {
 		nil,
 		nil,
 	)
+	evt.Cached = true
 	require.NoError(t, sub.HandleEvent(ctx, evt))
 	require.Len(t, sink.events, 1)
 	require.Equal(t, EventToolEnd, sink.events[0].Type())
@@ -121,6 +122,7 @@ func TestStreamSubscriber_ToolEnd_EmitsServerData(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, "result-run", end.RunID())
 	require.Equal(t, "call-run", end.Data.CallRunID)
+	require.True(t, end.Data.Cached)
 	require.JSONEq(t, string(server), string(end.ServerData))
 }
 
@@ -202,6 +204,23 @@ func TestStreamSubscriber_ToolUpdate(t *testing.T) {
 	require.Equal(t, 3, upd.Data.ExpectedChildrenTotal)
 }
 
+func TestStreamSubscriber_ToolProgress(t *testing.T) {
+	sink := &mockSink{}
+	sub, err := NewSubscriber(sink)
+	require.NoError(t, err)
+	ctx := context.Background()
+	evt := hooks.NewToolCallProgressEvent("r1", agent.Ident("agent1"), "session-1", tools.Ident("reports.export_rows"), "call-1", "parent-1", rawjson.Message(`{"processed":40}`))
+	require.NoError(t, sub.HandleEvent(ctx, evt))
+	require.Len(t, sink.events, 1)
+	require.Equal(t, EventToolProgress, sink.events[0].Type())
+	progress, ok := sink.events[0].(ToolProgress)
+	require.True(t, ok)
+	require.Equal(t, "call-1", progress.Data.ToolCallID)
+	require.Equal(t, "parent-1", progress.Data.ParentToolCallID)
+	require.Equal(t, "reports.export_rows", progress.Data.ToolName)
+	require.JSONEq(t, `{"processed":40}`, string(progress.Data.Progress))
+}
+
 func TestStreamSubscriber_PromptRendered(t *testing.T) {
 	sink := &mockSink{}
 	sub, err := NewSubscriber(sink)
@@ -431,6 +450,25 @@ func TestStreamSubscriber_ChildRunLinked(t *testing.T) {
 	require.Equal(t, agent.Ident("child.agent"), ar.Data.ChildAgentID)
 }
 
+func TestStreamSubscriber_AgentHandoff(t *testing.T) {
+	sink := &mockSink{}
+	sub, err := NewSubscriber(sink)
+	require.NoError(t, err)
+
+	evt := hooks.NewAgentHandoffEvent("run-1", "svc.triage", "session-1", "svc.billing", "run-1/handoff/svc.billing", "billing question")
+	require.NoError(t, sub.HandleEvent(context.Background(), evt))
+
+	require.Len(t, sink.events, 1)
+	ho, ok := sink.events[0].(AgentHandoff)
+	require.True(t, ok)
+	require.Equal(t, EventAgentHandoff, ho.Type())
+	require.Equal(t, "session-1", ho.SessionID())
+	require.Equal(t, agent.Ident("svc.triage"), ho.Data.FromAgentID)
+	require.Equal(t, agent.Ident("svc.billing"), ho.Data.TargetAgentID)
+	require.Equal(t, "run-1/handoff/svc.billing", ho.Data.TargetRunID)
+	require.Equal(t, "billing question", ho.Data.Reason)
+}
+
 func TestStreamSubscriber_MultipleRunsPreserveRunID(t *testing.T) {
 	sink := &mockSink{}
 	sub, err := NewSubscriber(sink)
@@ -0,0 +1,167 @@
+package telemetry
+
+// Standard metric catalogue. The runtime and the registry emit these metrics
+// through the Metrics interface using the names and tag keys below so every
+// backend (Clue/OTEL, Prometheus, custom recorders) observes the same series.
+// Names use dotted lower-case segments; exporters translate them to their own
+// naming rules (see PrometheusMetrics).
+
+type (
+	// MetricKind identifies the Metrics method used to record a metric.
+	MetricKind string
+
+	// MetricDefinition documents one metric of the standard catalogue.
+	MetricDefinition struct {
+		// Name is the metric name passed to the Metrics interface.
+		Name string
+		// Kind is the recording method: counter, timer, or gauge.
+		Kind MetricKind
+		// Help is a one-line description exporters surface as metric help.
+		Help string
+		// Tags lists the tag keys recorded with the metric.
+		Tags []string
+	}
+)
+
+const (
+	// MetricKindCounter metrics are recorded with Metrics.IncCounter.
+	MetricKindCounter MetricKind = "counter"
+	// MetricKindTimer metrics are recorded with Metrics.RecordTimer.
+	MetricKindTimer MetricKind = "timer"
+	// MetricKindGauge metrics are recorded with Metrics.RecordGauge.
+	MetricKindGauge MetricKind = "gauge"
+)
+
+// Runtime metric names.
+const (
+	// MetricRunStarted counts started runs. Tags: agent.
+	MetricRunStarted = "agent.run.started"
+	// MetricRunCompleted counts terminal runs. Tags: agent, status.
+	MetricRunCompleted = "agent.run.completed"
+	// MetricPlannerDuration times planner invocations. Tags: agent, operation,
+	// outcome.
+	MetricPlannerDuration = "agent.planner.duration"
+	// MetricToolDuration times tool executions. Tags: toolset, tool, outcome.
+	MetricToolDuration = "agent.tool.duration"
+	// MetricModelTokens counts model tokens. Tags: model_class, kind.
+	MetricModelTokens = "agent.model.tokens"
+	// MetricConfirmationWait times how long tool calls waited for operator
+	// confirmation. Tags: tool, decision.
+	MetricConfirmationWait = "agent.confirmation.wait"
+)
+
+// Registry metric names.
+const (
+	// MetricRegistryCallAdmission counts routed tool call admission outcomes.
+	// Tags: toolset (the admitted registration, "unknown" for rejected calls),
+	// outcome.
+	MetricRegistryCallAdmission = "registry.call.admission"
+	// MetricRegistryQuotaDecision counts quota checks of new tool calls. Tags:
+	// toolset, caller, outcome ("allowed" or "exceeded").
+	MetricRegistryQuotaDecision = "registry.quota.decision"
+	// MetricRegistryQuotaRemaining records the calls left in a caller's quota
+	// bucket after each check. Tags: toolset, caller.
+	MetricRegistryQuotaRemaining = "registry.quota.remaining"
+	// MetricRegistryOperationDuration times registry client operations. Tags:
+	// operation, outcome, registry.
+	MetricRegistryOperationDuration = "registry.operation.duration"
+	// MetricRegistryOperationSuccess counts successful registry client
+	// operations. Tags: operation, outcome, registry.
+	MetricRegistryOperationSuccess = "registry.operation.success"
+	// MetricRegistryOperationError counts failed registry client operations.
+	// Tags: operation, outcome, registry.
+	MetricRegistryOperationError = "registry.operation.error"
+	// MetricRegistryOperationFallback counts registry client operations served
+	// from cached data after a failure. Tags: operation, outcome, registry.
+	MetricRegistryOperationFallback = "registry.operation.fallback"
+	// MetricRegistryOperationResultCount records the number of results
+	// returned by registry client operations. Tags: operation, outcome,
+	// registry.
+	MetricRegistryOperationResultCount = "registry.operation.result_count"
+	// MetricRegistryCacheHit counts registry client cache hits. Tags:
+	// operation, outcome, registry.
+	MetricRegistryCacheHit = "registry.cache.hit"
+	// MetricRegistryCacheMiss counts registry client cache misses. Tags:
+	// operation, outcome, registry.
+	MetricRegistryCacheMiss = "registry.cache.miss"
+	// MetricRegistryCacheHitRatio records the registry client cache hit ratio.
+	// Tags: registry.
+	MetricRegistryCacheHitRatio = "registry.cache.hit_ratio"
+	// MetricRegistryCacheHitsTotal records cumulative registry client cache
+	// hits. Tags: registry.
+	MetricRegistryCacheHitsTotal = "registry.cache.hits_total"
+	// MetricRegistryCacheMissesTotal records cumulative registry client cache
+	// misses. Tags: registry.
+	MetricRegistryCacheMissesTotal = "registry.cache.misses_total"
+	// MetricRegistryFederationImported records the toolsets a federation
+	// worker currently mirrors from an upstream registry. Tags: registry.
+	MetricRegistryFederationImported = "registry.federation.imported"
+	// MetricRegistryFederationStaleness records the seconds elapsed since the
+	// last successful sync of an upstream registry. Tags: registry.
+	MetricRegistryFederationStaleness = "registry.federation.staleness_seconds"
+	// MetricRegistryFederationStale records 1 when an upstream registry has
+	// not synced within its cache TTL and its toolsets were withdrawn, 0
+	// otherwise. Tags: registry.
+	MetricRegistryFederationStale = "registry.federation.stale"
+)
+
+// Standard tag keys.
+const (
+	// TagAgent is the agent identifier ("service.agent").
+	TagAgent = "agent"
+	// TagStatus is the terminal run status ("success", "failed", "canceled").
+	TagStatus = "status"
+	// TagOperation names the measured operation.
+	TagOperation = "operation"
+	// TagOutcome is the operation outcome.
+	TagOutcome = "outcome"
+	// TagToolset is the toolset name.
+	TagToolset = "toolset"
+	// TagTool is the tool name.
+	TagTool = "tool"
+	// TagModelClass is the requested model class ("default", "small", ...).
+	TagModelClass = "model_class"
+	// TagTokenKind is the token kind ("input", "output", "cache_read",
+	// "cache_write").
+	TagTokenKind = "kind"
+	// TagDecision is the confirmation decision ("approved", "denied").
+	TagDecision = "decision"
+	// TagRegistry is the registry name.
+	TagRegistry = "registry"
+	// TagCaller is the authenticated registry caller subject.
+	TagCaller = "caller"
+)
+
+// Standard tag values.
+const (
+	// OutcomeSuccess tags operations that completed without error.
+	OutcomeSuccess = "success"
+	// OutcomeError tags operations that returned an error.
+	OutcomeError = "error"
+)
+
+// MetricCatalog lists every metric of the standard catalogue.
+var MetricCatalog = []MetricDefinition{
+	{MetricRunStarted, MetricKindCounter, "Runs started.", []string{TagAgent}},
+	{MetricRunCompleted, MetricKindCounter, "Runs completed, by terminal status.", []string{TagAgent, TagStatus}},
+	{MetricPlannerDuration, MetricKindTimer, "Planner invocation latency.", []string{TagAgent, TagOperation, TagOutcome}},
+	{MetricToolDuration, MetricKindTimer, "Tool execution latency.", []string{TagToolset, TagTool, TagOutcome}},
+	{MetricModelTokens, MetricKindCounter, "Model tokens, by model class and kind.", []string{TagModelClass, TagTokenKind}},
+	{MetricConfirmationWait, MetricKindTimer, "Time tool calls waited for operator confirmation.", []string{TagTool, TagDecision}},
+	{MetricRegistryCallAdmission, MetricKindCounter, "Registry tool call admission outcomes.", []string{TagToolset, TagOutcome}},
+	{MetricRegistryQuotaDecision, MetricKindCounter, "Registry call quota checks, by outcome.", []string{TagToolset, TagCaller, TagOutcome}},
+	{MetricRegistryQuotaRemaining, MetricKindGauge, "Calls left in registry caller quota buckets.", []string{TagToolset, TagCaller}},
+	{MetricRegistryOperationDuration, MetricKindTimer, "Registry client operation latency.", []string{TagOperation, TagOutcome, TagRegistry}},
+	{MetricRegistryOperationSuccess, MetricKindCounter, "Successful registry client operations.", []string{TagOperation, TagOutcome, TagRegistry}},
+	{MetricRegistryOperationError, MetricKindCounter, "Failed registry client operations.", []string{TagOperation, TagOutcome, TagRegistry}},
+	{MetricRegistryOperationFallback, MetricKindCounter, "Registry client operations served from cache after a failure.", []string{TagOperation, TagOutcome, TagRegistry}},
+	{MetricRegistryOperationResultCount, MetricKindGauge, "Results returned by registry client operations.", []string{TagOperation, TagOutcome, TagRegistry}},
+	{MetricRegistryCacheHit, MetricKindCounter, "Registry client cache hits.", []string{TagOperation, TagOutcome, TagRegistry}},
+	{MetricRegistryCacheMiss, MetricKindCounter, "Registry client cache misses.", []string{TagOperation, TagOutcome, TagRegistry}},
+	{MetricRegistryCacheHitRatio, MetricKindGauge, "Registry client cache hit ratio.", []string{TagRegistry}},
+	{MetricRegistryCacheHitsTotal, MetricKindGauge, "Cumulative registry client cache hits.", []string{TagRegistry}},
+	{MetricRegistryCacheMissesTotal, MetricKindGauge, "Cumulative registry client cache misses.", []string{TagRegistry}},
+	{MetricRegistryFederationImported, MetricKindGauge, "Toolsets mirrored from an upstream registry.", []string{TagRegistry}},
+	{MetricRegistryFederationStaleness, MetricKindGauge, "Seconds since the last successful upstream registry sync.", []string{TagRegistry}},
+	{MetricRegistryFederationStale, MetricKindGauge, "Whether an upstream registry exceeded its cache TTL (1) or not (0).", []string{TagRegistry}},
+}
@@ -0,0 +1,336 @@
+package telemetry
+
+import (
+	"bytes"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// PrometheusMetrics is a Metrics recorder that keeps metrics in memory and
+	// serves them in the Prometheus text exposition format. It implements
+	// http.Handler so it can be mounted directly as the scrape endpoint and
+	// does not require an OTEL collector:
+	//
+	//	metrics := telemetry.NewPrometheusMetrics()
+	//	rt := runtime.New(runtime.WithMetrics(metrics))
+	//	http.Handle("/metrics", metrics)
+	//
+	// Metric names are translated to Prometheus conventions: non-alphanumeric
+	// characters become underscores, counters get a "_total" suffix, and timers
+	// become histograms in seconds with a "_seconds" suffix. Tags become
+	// labels. Help text comes from MetricCatalog for standard metrics.
+	PrometheusMetrics struct {
+		namespace string
+		buckets   []float64
+
+		mu       sync.Mutex
+		families map[string]*promFamily
+	}
+
+	// PrometheusOption configures a PrometheusMetrics recorder.
+	PrometheusOption func(*PrometheusMetrics)
+
+	// promFamily holds every series of one exported metric.
+	promFamily struct {
+		name   string
+		kind   MetricKind
+		help   string
+		series map[string]*promSeries
+	}
+
+	// promSeries holds the state of one labeled series.
+	promSeries struct {
+		labels string
+		value  float64
+		counts []uint64
+		sum    float64
+		count  uint64
+	}
+)
+
+var (
+	_ Metrics      = (*PrometheusMetrics)(nil)
+	_ http.Handler = (*PrometheusMetrics)(nil)
+)
+
+// DefaultPrometheusBuckets are the histogram upper bounds, in seconds, used
+// for timers. They extend the Prometheus client defaults to cover model and
+// tool latencies of several minutes.
+var DefaultPrometheusBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+// NewPrometheusMetrics constructs an in-memory Prometheus metrics recorder.
+func NewPrometheusMetrics(opts ...PrometheusOption) *PrometheusMetrics {
+	m := &PrometheusMetrics{
+		buckets:  DefaultPrometheusBuckets,
+		families: make(map[string]*promFamily),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithPrometheusNamespace prefixes every exported metric name with namespace
+// followed by an underscore.
+func WithPrometheusNamespace(namespace string) PrometheusOption {
+	return func(m *PrometheusMetrics) {
+		m.namespace = promName(namespace)
+	}
+}
+
+// WithPrometheusBuckets overrides the histogram upper bounds, in seconds, used
+// for timers. Bounds are sorted; an empty list keeps the defaults.
+func WithPrometheusBuckets(buckets ...float64) PrometheusOption {
+	return func(m *PrometheusMetrics) {
+		if len(buckets) == 0 {
+			return
+		}
+		m.buckets = slices.Clone(buckets)
+		slices.Sort(m.buckets)
+	}
+}
+
+// IncCounter increments a counter metric by the given value. Negative values
+// are ignored because Prometheus counters are monotonic.
+func (m *PrometheusMetrics) IncCounter(name string, value float64, tags ...string) {
+	if value < 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s := m.seriesLocked(name, MetricKindCounter, tags); s != nil {
+		s.value += value
+	}
+}
+
+// RecordTimer observes a duration in the histogram of the timer metric.
+func (m *PrometheusMetrics) RecordTimer(name string, duration time.Duration, tags ...string) {
+	seconds := duration.Seconds()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := m.seriesLocked(name, MetricKindTimer, tags)
+	if s == nil {
+		return
+	}
+	if s.counts == nil {
+		s.counts = make([]uint64, len(m.buckets))
+	}
+	for i, bound := range m.buckets {
+		if seconds <= bound {
+			s.counts[i]++
+		}
+	}
+	s.sum += seconds
+	s.count++
+}
+
+// RecordGauge sets a gauge metric to the given value.
+func (m *PrometheusMetrics) RecordGauge(name string, value float64, tags ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s := m.seriesLocked(name, MetricKindGauge, tags); s != nil {
+		s.value = value
+	}
+}
+
+// ServeHTTP writes all recorded metrics in the Prometheus text exposition
+// format.
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = w.Write(m.Expose())
+}
+
+// Expose returns all recorded metrics in the Prometheus text exposition
+// format. Families and series are sorted for stable output.
+func (m *PrometheusMetrics) Expose() []byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var buf bytes.Buffer
+	for _, name := range sortedKeys(m.families) {
+		f := m.families[name]
+		if f.help != "" {
+			buf.WriteString("# HELP " + f.name + " " + escapeHelp(f.help) + "\n")
+		}
+		buf.WriteString("# TYPE " + f.name + " " + promType(f.kind) + "\n")
+		for _, key := range sortedKeys(f.series) {
+			s := f.series[key]
+			if f.kind != MetricKindTimer {
+				writeSample(&buf, f.name, s.labels, "", s.value)
+				continue
+			}
+			for i, bound := range m.buckets {
+				writeSample(&buf, f.name+"_bucket", s.labels, formatFloat(bound), float64(s.counts[i]))
+			}
+			writeSample(&buf, f.name+"_bucket", s.labels, "+Inf", float64(s.count))
+			writeSample(&buf, f.name+"_sum", s.labels, "", s.sum)
+			writeSample(&buf, f.name+"_count", s.labels, "", float64(s.count))
+		}
+	}
+	return buf.Bytes()
+}
+
+// seriesLocked returns the series for the metric and tags, creating it on
+// first use. It returns nil when the exported name is already used by a
+// metric of a different kind.
+func (m *PrometheusMetrics) seriesLocked(name string, kind MetricKind, tags []string) *promSeries {
+	exported := m.exportedName(name, kind)
+	f, ok := m.families[exported]
+	if !ok {
+		f = &promFamily{
+			name:   exported,
+			kind:   kind,
+			help:   catalogHelp(name),
+			series: make(map[string]*promSeries),
+		}
+		m.families[exported] = f
+	}
+	if f.kind != kind {
+		return nil
+	}
+	labels := promLabels(tags)
+	s, ok := f.series[labels]
+	if !ok {
+		s = &promSeries{labels: labels}
+		f.series[labels] = s
+	}
+	return s
+}
+
+// exportedName translates a catalogue metric name to a Prometheus name.
+func (m *PrometheusMetrics) exportedName(name string, kind MetricKind) string {
+	n := promName(name)
+	if m.namespace != "" {
+		n = m.namespace + "_" + n
+	}
+	switch kind {
+	case MetricKindCounter:
+		if !strings.HasSuffix(n, "_total") {
+			n += "_total"
+		}
+	case MetricKindTimer:
+		if !strings.HasSuffix(n, "_seconds") {
+			n += "_seconds"
+		}
+	case MetricKindGauge:
+	}
+	return n
+}
+
+// catalogHelp returns the catalogue help text for a metric name.
+func catalogHelp(name string) string {
+	for _, def := range MetricCatalog {
+		if def.Name == name {
+			return def.Help
+		}
+	}
+	return ""
+}
+
+// promType maps a metric kind to its Prometheus type.
+func promType(kind MetricKind) string {
+	switch kind {
+	case MetricKindCounter:
+		return "counter"
+	case MetricKindTimer:
+		return "histogram"
+	default:
+		return "gauge"
+	}
+}
+
+// promName replaces characters Prometheus does not allow in metric and label
+// names with underscores.
+func promName(name string) string {
+	var b strings.Builder
+	b.Grow(len(name))
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+// promLabels renders key/value tag pairs as a sorted Prometheus label set
+// without the surrounding braces. An odd trailing key gets an empty value.
+func promLabels(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	pairs := make([][2]string, 0, (len(tags)+1)/2)
+	for i := 0; i < len(tags); i += 2 {
+		v := ""
+		if i+1 < len(tags) {
+			v = tags[i+1]
+		}
+		pairs = append(pairs, [2]string{promName(tags[i]), v})
+	}
+	slices.SortStableFunc(pairs, func(a, b [2]string) int { return strings.Compare(a[0], b[0]) })
+	parts := make([]string, len(pairs))
+	for i, p := range pairs {
+		parts[i] = p[0] + `="` + escapeLabelValue(p[1]) + `"`
+	}
+	return strings.Join(parts, ",")
+}
+
+// writeSample writes one sample line, adding the "le" label when le is set.
+func writeSample(buf *bytes.Buffer, name, labels, le string, value float64) {
+	buf.WriteString(name)
+	if le != "" {
+		if labels != "" {
+			labels += ","
+		}
+		labels += `le="` + le + `"`
+	}
+	if labels != "" {
+		buf.WriteString("{" + labels + "}")
+	}
+	buf.WriteString(" " + formatFloat(value) + "\n")
+}
+
+// formatFloat formats a sample value as Prometheus expects.
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// escapeLabelValue escapes backslashes, double quotes, and newlines.
+func escapeLabelValue(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
+
+// escapeHelp escapes backslashes and newlines.
+func escapeHelp(v string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(v)
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
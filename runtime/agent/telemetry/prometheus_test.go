@@ -0,0 +1,61 @@
+package telemetry_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"goa.design/goa-ai/runtime/agent/telemetry"
+)
+
+func TestPrometheusMetricsExposition(t *testing.T) {
+	m := telemetry.NewPrometheusMetrics(
+		telemetry.WithPrometheusNamespace("goa-ai"),
+		telemetry.WithPrometheusBuckets(1, 0.1),
+	)
+	m.IncCounter(telemetry.MetricRunCompleted, 1, telemetry.TagStatus, "success", telemetry.TagAgent, "svc.chat")
+	m.IncCounter(telemetry.MetricRunCompleted, 2, telemetry.TagAgent, "svc.chat", telemetry.TagStatus, "success")
+	m.IncCounter(telemetry.MetricRunCompleted, -1, telemetry.TagAgent, "svc.chat", telemetry.TagStatus, "success")
+	m.RecordTimer(telemetry.MetricToolDuration, 50*time.Millisecond, telemetry.TagTool, `say "hi"`)
+	m.RecordTimer(telemetry.MetricToolDuration, 2*time.Second, telemetry.TagTool, `say "hi"`)
+	m.RecordGauge("custom.queue-depth", 3)
+	m.RecordGauge("custom.queue-depth", 7)
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+
+	want := `# HELP goa_ai_agent_run_completed_total Runs completed, by terminal status.
+# TYPE goa_ai_agent_run_completed_total counter
+goa_ai_agent_run_completed_total{agent="svc.chat",status="success"} 3
+# HELP goa_ai_agent_tool_duration_seconds Tool execution latency.
+# TYPE goa_ai_agent_tool_duration_seconds histogram
+goa_ai_agent_tool_duration_seconds_bucket{tool="say \"hi\"",le="0.1"} 1
+goa_ai_agent_tool_duration_seconds_bucket{tool="say \"hi\"",le="1"} 1
+goa_ai_agent_tool_duration_seconds_bucket{tool="say \"hi\"",le="+Inf"} 2
+goa_ai_agent_tool_duration_seconds_sum{tool="say \"hi\""} 2.05
+goa_ai_agent_tool_duration_seconds_count{tool="say \"hi\""} 2
+# TYPE goa_ai_custom_queue_depth gauge
+goa_ai_custom_queue_depth 7
+`
+	require.Equal(t, want, rec.Body.String())
+}
+
+func TestPrometheusMetricsKindConflict(t *testing.T) {
+	m := telemetry.NewPrometheusMetrics()
+	m.RecordGauge("jobs_total", 5)
+	m.IncCounter("jobs", 1)
+
+	require.Equal(t, "# TYPE jobs_total gauge\njobs_total 5\n", string(m.Expose()))
+}
+
+func TestMetricCatalogNamesAreUnique(t *testing.T) {
+	seen := make(map[string]struct{}, len(telemetry.MetricCatalog))
+	for _, def := range telemetry.MetricCatalog {
+		require.NotEmpty(t, def.Help, def.Name)
+		require.NotContains(t, seen, def.Name)
+		seen[def.Name] = struct{}{}
+	}
+}
@@ -0,0 +1,83 @@
+// Package inmem provides an in-memory implementation of toolcache.Store.
+//
+// The in-memory store is local to one process: workers do not share cached
+// results. It suits tests, local development and single-worker deployments.
+package inmem
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"goa.design/goa-ai/runtime/agent/toolcache"
+)
+
+type (
+	// Store implements toolcache.Store in memory.
+	Store struct {
+		mu      sync.Mutex
+		entries map[string]entry
+		// lastSweep records when expired entries were last evicted.
+		lastSweep time.Time
+		now       func() time.Time
+	}
+
+	entry struct {
+		value     toolcache.Entry
+		expiresAt time.Time
+	}
+)
+
+// sweepInterval bounds how often Set evicts expired entries that were never
+// read again.
+const sweepInterval = time.Minute
+
+// New returns an empty in-memory tool result cache.
+func New() *Store {
+	return &Store{
+		entries: make(map[string]entry),
+		now:     time.Now,
+	}
+}
+
+// Get implements toolcache.Store.
+func (s *Store) Get(_ context.Context, key string) (*toolcache.Entry, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !s.now().Before(e.expiresAt) {
+		delete(s.entries, key)
+		return nil, false, nil
+	}
+	value := e.value
+	return &value, true, nil
+}
+
+// Set implements toolcache.Store.
+func (s *Store) Set(_ context.Context, key string, value *toolcache.Entry, ttl time.Duration) error {
+	if value == nil {
+		return errors.New("entry is required")
+	}
+	if ttl <= 0 {
+		return errors.New("ttl must be positive")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		for k, e := range s.entries {
+			if !now.Before(e.expiresAt) {
+				delete(s.entries, k)
+			}
+		}
+		s.lastSweep = now
+	}
+	s.entries[key] = entry{value: *value, expiresAt: now.Add(ttl)}
+	return nil
+}
@@ -0,0 +1,49 @@
+package inmem
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/toolcache"
+)
+
+func TestStoreExpiresEntries(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(0, 0)
+	s := New()
+	s.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	require.NoError(t, s.Set(ctx, "k", &toolcache.Entry{Result: rawjson.Message(`{"ok":true}`)}, time.Minute))
+
+	got, ok, err := s.Get(ctx, "k")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.JSONEq(t, `{"ok":true}`, string(got.Result))
+
+	now = now.Add(time.Minute)
+	_, ok, err = s.Get(ctx, "k")
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Empty(t, s.entries)
+}
+
+func TestStoreSweepsUnreadEntries(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(0, 0)
+	s := New()
+	s.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	require.NoError(t, s.Set(ctx, "stale", &toolcache.Entry{}, time.Second))
+	now = now.Add(sweepInterval)
+	require.NoError(t, s.Set(ctx, "fresh", &toolcache.Entry{}, time.Minute))
+
+	require.Len(t, s.entries, 1)
+	require.Contains(t, s.entries, "fresh")
+}
@@ -0,0 +1,111 @@
+// Package toolcache defines the storage contract for cached tool results.
+//
+// The runtime caches successful results of tools whose spec declares a
+// tools.CacheSpec. Entries are keyed on the tool identifier, the canonical JSON
+// payload, the run labels and the cache scope, so repeated calls with the same
+// arguments reuse the first result until its TTL expires. Labels are part of
+// the key because label-backed Inject fields are populated after the lookup:
+// runs of different tenants must never share a result.
+package toolcache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"goa.design/goa-ai/runtime/agent"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+type (
+	// Store persists cached tool results. Implementations must be safe for
+	// concurrent use and must never return an entry after its TTL expired.
+	Store interface {
+		// Get returns the entry stored under key. It returns (nil, false, nil)
+		// when no live entry exists.
+		Get(ctx context.Context, key string) (*Entry, bool, error)
+		// Set stores entry under key for ttl, replacing any existing entry.
+		Set(ctx context.Context, key string, entry *Entry, ttl time.Duration) error
+	}
+
+	// Entry is one cached tool result.
+	Entry struct {
+		// Result is the canonical JSON result produced by the tool result codec.
+		Result rawjson.Message `json:"result,omitempty"`
+		// ServerData is the server-only data emitted alongside the result.
+		ServerData rawjson.Message `json:"server_data,omitempty"`
+		// Bounds describes how the result was bounded, when applicable.
+		Bounds *agent.Bounds `json:"bounds,omitempty"`
+	}
+)
+
+// Key returns the cache key of a call to tool with payload and run labels in
+// scope. Payloads are canonicalized first so calls that differ only in object
+// key order or whitespace share one entry. Session-scoped calls without a
+// session use run scope.
+func Key(tool tools.Ident, payload rawjson.Message, labels map[string]string, scope tools.CacheScope, runID, sessionID string) (string, error) {
+	var owner string
+	switch scope {
+	case tools.CacheScopeRun:
+		owner = "run:" + runID
+	case tools.CacheScopeSession:
+		owner = "session:" + sessionID
+		if sessionID == "" {
+			owner = "run:" + runID
+		}
+	case tools.CacheScopeGlobal:
+		owner = "global"
+	default:
+		return "", fmt.Errorf("unknown cache scope %q", scope)
+	}
+	canonical, err := Canonicalize(payload)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write(canonical)
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		h.Write([]byte{0})
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(labels[k]))
+	}
+	return owner + ":" + string(tool) + ":" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Canonicalize re-encodes a JSON document with sorted object keys and no
+// insignificant whitespace. Numbers keep their original literal.
+func Canonicalize(payload rawjson.Message) ([]byte, error) {
+	if len(bytes.TrimSpace(payload)) == 0 {
+		return []byte("null"), nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("canonicalize tool payload: %w", err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("canonicalize tool payload: trailing data after JSON document")
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("canonicalize tool payload: %w", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,60 @@
+package toolcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/tools"
+)
+
+func TestKeyIgnoresKeyOrderAndWhitespace(t *testing.T) {
+	a, err := Key("svc.search", rawjson.Message(`{"q":"x","limit":10}`), nil, tools.CacheScopeRun, "run-1", "")
+	require.NoError(t, err)
+	b, err := Key("svc.search", rawjson.Message(` { "limit": 10, "q": "x" } `), nil, tools.CacheScopeRun, "run-1", "")
+	require.NoError(t, err)
+	require.Equal(t, a, b)
+
+	c, err := Key("svc.search", rawjson.Message(`{"q":"x","limit":10.0}`), nil, tools.CacheScopeRun, "run-1", "")
+	require.NoError(t, err)
+	require.NotEqual(t, a, c)
+}
+
+func TestKeyScopes(t *testing.T) {
+	payload := rawjson.Message(`{"q":"x"}`)
+	key := func(scope tools.CacheScope, runID, sessionID string) string {
+		k, err := Key("svc.search", payload, nil, scope, runID, sessionID)
+		require.NoError(t, err)
+		return k
+	}
+
+	require.NotEqual(t, key(tools.CacheScopeRun, "run-1", "sess-1"), key(tools.CacheScopeRun, "run-2", "sess-1"))
+	require.Equal(t, key(tools.CacheScopeSession, "run-1", "sess-1"), key(tools.CacheScopeSession, "run-2", "sess-1"))
+	require.Equal(t, key(tools.CacheScopeRun, "run-1", ""), key(tools.CacheScopeSession, "run-1", ""))
+	require.Equal(t, key(tools.CacheScopeGlobal, "run-1", "sess-1"), key(tools.CacheScopeGlobal, "run-2", "sess-2"))
+
+	_, err := Key("svc.search", payload, nil, "tenant", "run-1", "")
+	require.Error(t, err)
+}
+
+func TestKeyIncludesLabels(t *testing.T) {
+	payload := rawjson.Message(`{"q":"x"}`)
+	key := func(labels map[string]string) string {
+		k, err := Key("svc.search", payload, labels, tools.CacheScopeGlobal, "run-1", "")
+		require.NoError(t, err)
+		return k
+	}
+
+	require.NotEqual(t, key(map[string]string{"household_id": "h1"}), key(map[string]string{"household_id": "h2"}))
+	require.NotEqual(t, key(nil), key(map[string]string{"household_id": "h1"}))
+	require.NotEqual(t, key(map[string]string{"a": "b=c"}), key(map[string]string{"a=b": "c"}))
+	require.Equal(t,
+		key(map[string]string{"household_id": "h1", "tenant": "acme"}),
+		key(map[string]string{"tenant": "acme", "household_id": "h1"}),
+	)
+}
+
+func TestCanonicalizeRejectsTrailingData(t *testing.T) {
+	_, err := Canonicalize(rawjson.Message(`{"a":1} {"b":2}`))
+	require.Error(t, err)
+}
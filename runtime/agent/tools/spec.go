@@ -2,6 +2,7 @@ package tools
 
 import (
 	"encoding/json"
+	"time"
 
 	"goa.design/goa-ai/runtime/agent/rawjson"
 )
@@ -83,6 +84,18 @@ type (
 		// not independently schedule another planner turn. Calls and results
 		// still remain in the exact provider transcript.
 		Bookkeeping bool
+		// ReadOnly indicates the tool does not modify its environment. Read-only
+		// tools must also declare Idempotent.
+		ReadOnly bool
+		// Idempotent indicates that repeating a call with the same arguments has
+		// no additional effect. The runtime retries failed activity attempts of
+		// idempotent tools, and registry executors surface ambiguous outcomes as
+		// retryable activity failures instead of terminal tool results.
+		Idempotent bool
+		// Destructive indicates the tool may perform irreversible updates to its
+		// environment. The runtime schedules destructive tools with a single
+		// activity attempt so a call whose outcome is unknown is never repeated.
+		Destructive bool
 		// IsAgentTool indicates this tool is implemented by an agent (agent-as-tool).
 		// When true, the runtime executes the tool by starting the provider agent as a
 		// child workflow from within the parent workflow loop. Set by codegen when
@@ -117,10 +130,26 @@ type (
 		// When set, runtimes may request explicit out-of-band user confirmation before
 		// executing the tool. Runtime configuration can override or extend this policy.
 		Confirmation *ConfirmationSpec
+		// Timeout bounds one execution attempt of the tool. Zero keeps the agent
+		// tool timeout; run policy PerToolTimeout overrides take precedence.
+		Timeout time.Duration
+		// Retry declares how the runtime retries failed executions of the tool.
+		// Nil keeps the agent ExecuteTool retry policy and returns every failure
+		// to the planner.
+		Retry *RetrySpec
+		// Cache enables result caching for the tool. Nil disables caching. Only
+		// idempotent, non-destructive tools may be cached.
+		Cache *CacheSpec
+		// Availability gates the tool on run state. Nil keeps the tool
+		// available whenever run policy allows it.
+		Availability *AvailabilitySpec
 		// Payload describes the request schema for the tool.
 		Payload TypeSpec
 		// Result describes the response schema for the tool.
 		Result TypeSpec
+		// Progress describes the partial results the tool reports while it
+		// executes. Nil when the tool reports no progress.
+		Progress *TypeSpec
 	}
 
 	// ServerDataSpec describes one server-only payload emitted alongside a tool
@@ -188,6 +217,50 @@ type (
 		DeniedResultTemplate string
 	}
 
+	// RetrySpec declares the retry policy of a tool. It is emitted by goa-ai
+	// codegen when a tool uses RetryPolicy in the DSL.
+	//
+	// The workflow enforces the policy: each execution is a separate activity
+	// bounded by the tool timeout, and the runtime schedules the next one after
+	// the backoff when the activity fails (non-destructive tools only) or its
+	// result fails with a kind listed in RetryOn. RetryOn executions carry a new
+	// planner.ToolRequest Attempt so executors that deduplicate calls by tool
+	// call ID, such as the tool registry executor, run the call again.
+	RetrySpec struct {
+		// MaxAttempts caps the total number of execution attempts, including the
+		// first one.
+		MaxAttempts int
+		// Backoff is the delay before the first retry. Later retries double it.
+		Backoff time.Duration
+		// RetryOn lists the planner.FailureKind values that trigger a retry.
+		RetryOn []string
+	}
+
+	// CacheSpec declares how the runtime caches successful results of a tool.
+	// Results are keyed on the tool identifier and the canonical JSON payload,
+	// so calls with the same arguments in the same scope share one result.
+	CacheSpec struct {
+		// TTL is how long a cached result remains valid.
+		TTL time.Duration
+		// Scope limits which calls may observe a cached result.
+		Scope CacheScope
+	}
+
+	// CacheScope identifies the calls sharing cached tool results.
+	CacheScope string
+
+	// AvailabilitySpec declares the run state a tool requires before it is
+	// advertised to planners or executed. It is emitted by goa-ai codegen when
+	// a tool uses AvailableWhen or RequiresLabel in the DSL. All conditions
+	// must hold.
+	AvailabilitySpec struct {
+		// ToolSucceeded lists tools that must have returned a successful result
+		// earlier in the run.
+		ToolSucceeded []Ident
+		// Labels maps run label keys to the values they must hold.
+		Labels map[string]string
+	}
+
 	// TypeSpec describes the payload or result schema for a tool.
 	TypeSpec struct {
 		// Name is the Go identifier associated with the type.
@@ -246,3 +319,13 @@ const (
 	// AudienceEvidence indicates the payload carries provenance references.
 	AudienceEvidence ServerDataAudience = "evidence"
 )
+
+const (
+	// CacheScopeRun shares cached results between calls of the same run.
+	CacheScopeRun CacheScope = "run"
+	// CacheScopeSession shares cached results between runs of the same
+	// session. Runs without a session fall back to run scope.
+	CacheScopeSession CacheScope = "session"
+	// CacheScopeGlobal shares cached results between all runs.
+	CacheScopeGlobal CacheScope = "global"
+)
@@ -24,10 +24,16 @@ type Caller interface {
 	CallTool(ctx context.Context, req CallRequest) (CallResponse, error)
 }
 
+// ToolLister lists the tools exposed by an MCP server. The stdio, HTTP, and SSE
+// callers implement it.
+type ToolLister interface {
+	ListTools(ctx context.Context) ([]Tool, error)
+}
+
 // Error represents a JSON-RPC error returned by the MCP server.
 type Error struct {
-	Code    int
-	Message string
+	Code    int    `json:"code"`
+	Message string `json:"message"`
 }
 
 // Error implements the error interface.
@@ -108,6 +108,43 @@ func TestHTTPCallerCallTool(t *testing.T) {
 	require.Equal(t, expectedTrace, metaTrace)
 }
 
+func TestHTTPCallerListToolsPages(t *testing.T) {
+	t.Parallel()
+	var cursors []any
+	client := &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			var req rpcRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				return nil, err
+			}
+			result := `{"capabilities":{}}`
+			if req.Method == MethodToolsList {
+				params, _ := req.Params.(map[string]any)
+				cursors = append(cursors, params["cursor"])
+				result = `{"tools":[{"name":"search","inputSchema":{"type":"object"}}],"nextCursor":"page-2"}`
+				if params["cursor"] == "page-2" {
+					result = `{"tools":[{"name":"fetch","inputSchema":{"type":"object"},"outputSchema":{"type":"string"}}]}`
+				}
+			}
+			data, err := json.Marshal(rpcResponse{JSONRPC: "2.0", ID: req.ID, Result: json.RawMessage(result)})
+			if err != nil {
+				return nil, err
+			}
+			return httpResponse(http.StatusOK, nil, data), nil
+		}),
+	}
+
+	caller, err := NewHTTPCaller(context.Background(), HTTPOptions{Endpoint: "http://mcp.test/rpc", Client: client})
+	require.NoError(t, err)
+	tools, err := caller.ListTools(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tools, 2)
+	assert.Equal(t, "search", tools[0].Name)
+	assert.Equal(t, "fetch", tools[1].Name)
+	assert.JSONEq(t, `{"type":"string"}`, string(tools[1].OutputSchema))
+	assert.Equal(t, []any{nil, "page-2"}, cursors)
+}
+
 func TestSSECallerCallTool(t *testing.T) {
 	t.Parallel()
 	var traceHeader string
@@ -206,8 +243,8 @@ func TestNormalizeToolResultReturnsTypedExecutionError(t *testing.T) {
 	t.Parallel()
 
 	message := "device alias does not exist"
-	_, err := normalizeToolResult(toolsCallResult{
-		Content: []contentItem{{Type: "text", Text: &message}},
+	_, err := normalizeToolResult(CallToolResult{
+		Content: []ContentItem{{Type: "text", Text: &message}},
 		IsError: true,
 	})
 
@@ -262,7 +299,7 @@ func runStdioHelper() {
 				writeFrame(writer, errResp)
 				continue
 			}
-			result := toolsCallResult{Content: []contentItem{{Type: "text", Text: &traceVal}}}
+			result := CallToolResult{Content: []ContentItem{{Type: "text", Text: &traceVal}}}
 			data, _ := json.Marshal(result)
 			resp := rpcResponse{JSONRPC: "2.0", ID: req.ID, Result: data}
 			writeFrame(writer, resp)
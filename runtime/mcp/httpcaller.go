@@ -45,13 +45,18 @@ func (c *HTTPCaller) CallTool(ctx context.Context, req CallRequest) (CallRespons
 		"arguments": req.Payload,
 	}
 	addTraceMeta(ctx, params)
-	var result toolsCallResult
+	var result CallToolResult
 	if err := c.transport.call(ctx, "tools/call", params, &result); err != nil {
 		return CallResponse{}, err
 	}
 	return normalizeToolResult(result)
 }
 
+// ListTools invokes tools/list over HTTP and returns every page of tools.
+func (c *HTTPCaller) ListTools(ctx context.Context) ([]Tool, error) {
+	return listTools(ctx, c.transport.call)
+}
+
 // httpTransport shares JSON-RPC HTTP plumbing across different callers (HTTP, SSE).
 type httpTransport struct {
 	endpoint string
@@ -0,0 +1,104 @@
+package mcp
+
+import "encoding/json"
+
+// MCP method names used by servers and bridges.
+const (
+	// MethodInitialize opens an MCP session.
+	MethodInitialize = "initialize"
+	// MethodInitialized is the client notification acknowledging initialize.
+	MethodInitialized = "notifications/initialized"
+	// MethodPing checks that the peer is alive.
+	MethodPing = "ping"
+	// MethodToolsList lists the tools a server exposes.
+	MethodToolsList = "tools/list"
+	// MethodToolsCall invokes one tool.
+	MethodToolsCall = "tools/call"
+	// MethodProgress is the notification reporting progress of a request that
+	// carried a progress token.
+	MethodProgress = "notifications/progress"
+)
+
+type (
+	// RPCRequest is a JSON-RPC 2.0 request received by an MCP server. A request
+	// without ID is a notification and receives no response.
+	RPCRequest struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id,omitempty"`
+		Method  string          `json:"method"`
+		Params  json.RawMessage `json:"params,omitempty"`
+	}
+
+	// RPCResponse is a JSON-RPC 2.0 response sent by an MCP server. Exactly
+	// one of Result and Error is set.
+	RPCResponse struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Result  any             `json:"result,omitempty"`
+		Error   *Error          `json:"error,omitempty"`
+	}
+
+	// RPCNotification is a JSON-RPC 2.0 notification sent by an MCP server.
+	RPCNotification struct {
+		JSONRPC string `json:"jsonrpc"`
+		Method  string `json:"method"`
+		Params  any    `json:"params,omitempty"`
+	}
+
+	// Implementation names an MCP client or server.
+	Implementation struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	// InitializeResult is the server response to initialize.
+	InitializeResult struct {
+		ProtocolVersion string         `json:"protocolVersion"` //nolint:tagliatelle // MCP protocol field.
+		Capabilities    map[string]any `json:"capabilities"`
+		ServerInfo      Implementation `json:"serverInfo"` //nolint:tagliatelle // MCP protocol field.
+	}
+
+	// Tool describes one tool in a tools/list result.
+	Tool struct {
+		Name        string          `json:"name"`
+		Description string          `json:"description,omitempty"`
+		InputSchema json.RawMessage `json:"inputSchema"` //nolint:tagliatelle // MCP protocol field.
+		// OutputSchema describes the structured result, when the server
+		// declares one.
+		OutputSchema json.RawMessage `json:"outputSchema,omitempty"` //nolint:tagliatelle // MCP protocol field.
+	}
+
+	// ListToolsResult is the result of a tools/list request.
+	ListToolsResult struct {
+		Tools      []Tool `json:"tools"`
+		NextCursor string `json:"nextCursor,omitempty"` //nolint:tagliatelle // MCP protocol field.
+	}
+
+	// CallToolParams are the parameters of a tools/call request.
+	CallToolParams struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments,omitempty"`
+		Meta      *RequestMeta    `json:"_meta,omitempty"` //nolint:tagliatelle // MCP protocol field.
+	}
+
+	// RequestMeta carries MCP request metadata.
+	RequestMeta struct {
+		// ProgressToken asks the server to report progress with
+		// notifications/progress. It is a string or a number.
+		ProgressToken json.RawMessage `json:"progressToken,omitempty"` //nolint:tagliatelle // MCP protocol field.
+	}
+
+	// ProgressParams are the parameters of a notifications/progress
+	// notification.
+	ProgressParams struct {
+		ProgressToken json.RawMessage `json:"progressToken"` //nolint:tagliatelle // MCP protocol field.
+		Progress      float64         `json:"progress"`
+		Total         *float64        `json:"total,omitempty"`
+		Message       string          `json:"message,omitempty"`
+	}
+)
+
+// NewTextContent returns a text content block.
+func NewTextContent(text string) ContentItem {
+	return ContentItem{Type: "text", Text: &text}
+}
@@ -1,6 +1,7 @@
 package mcp
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -39,18 +40,20 @@ func (e *rpcError) callerError() *Error {
 	return &Error{Code: e.Code, Message: e.Message}
 }
 
-type toolsCallResult struct {
-	Content []contentItem `json:"content"`
+// CallToolResult is the result of an MCP tools/call request.
+type CallToolResult struct {
+	Content []ContentItem `json:"content"`
 	IsError bool          `json:"isError"` //nolint:tagliatelle // MCP protocol field.
 }
 
-type contentItem struct {
+// ContentItem is one content block of an MCP tool result.
+type ContentItem struct {
 	Type     string  `json:"type"`
-	Text     *string `json:"text"`
-	MimeType *string `json:"mimeType"` //nolint:tagliatelle // MCP protocol field.
+	Text     *string `json:"text,omitempty"`
+	MimeType *string `json:"mimeType,omitempty"` //nolint:tagliatelle // MCP protocol field.
 }
 
-func (c contentItem) text() string {
+func (c ContentItem) text() string {
 	if c.Text == nil {
 		return ""
 	}
@@ -58,14 +61,14 @@ func (c contentItem) text() string {
 }
 
 func decodeToolCallResult(raw json.RawMessage) (CallResponse, error) {
-	var result toolsCallResult
+	var result CallToolResult
 	if err := json.Unmarshal(raw, &result); err != nil {
 		return CallResponse{}, NewMalformedResponseError(err)
 	}
 	return normalizeToolResult(result)
 }
 
-func normalizeToolResult(result toolsCallResult) (CallResponse, error) {
+func normalizeToolResult(result CallToolResult) (CallResponse, error) {
 	if len(result.Content) == 0 {
 		return CallResponse{}, NewMalformedResponseError(errors.New("empty tool response"))
 	}
@@ -107,3 +110,29 @@ func normalizeToolResult(result toolsCallResult) (CallResponse, error) {
 	}
 	return response, nil
 }
+
+// listTools pages through tools/list using call and returns every tool.
+func listTools(ctx context.Context, call func(ctx context.Context, method string, params any, result any) error) ([]Tool, error) {
+	var (
+		tools  []Tool
+		cursor string
+	)
+	for {
+		params := map[string]any{}
+		if cursor != "" {
+			params["cursor"] = cursor
+		}
+		var page ListToolsResult
+		if err := call(ctx, MethodToolsList, params, &page); err != nil {
+			return nil, err
+		}
+		tools = append(tools, page.Tools...)
+		if page.NextCursor == "" {
+			return tools, nil
+		}
+		if page.NextCursor == cursor {
+			return nil, NewMalformedResponseError(fmt.Errorf("tools/list repeated cursor %q", cursor))
+		}
+		cursor = page.NextCursor
+	}
+}
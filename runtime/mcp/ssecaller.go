@@ -24,6 +24,12 @@ func NewSSECaller(ctx context.Context, opts HTTPOptions) (*SSECaller, error) {
 	return &SSECaller{transport: transport}, nil
 }
 
+// ListTools invokes tools/list as a plain JSON-RPC request and returns every
+// page of tools.
+func (c *SSECaller) ListTools(ctx context.Context) ([]Tool, error) {
+	return listTools(ctx, c.transport.call)
+}
+
 // CallTool invokes tools/call via SSE and normalizes the final response.
 func (c *SSECaller) CallTool(ctx context.Context, req CallRequest) (CallResponse, error) {
 	params := map[string]any{
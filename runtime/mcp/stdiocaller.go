@@ -107,13 +107,19 @@ func (c *StdioCaller) Close() error {
 func (c *StdioCaller) CallTool(ctx context.Context, req CallRequest) (CallResponse, error) {
 	params := map[string]any{"name": req.Tool, "arguments": req.Payload}
 	addTraceMeta(ctx, params)
-	var result toolsCallResult
+	var result CallToolResult
 	if err := c.call(ctx, "tools/call", params, &result); err != nil {
 		return CallResponse{}, err
 	}
 	return normalizeToolResult(result)
 }
 
+// ListTools invokes tools/list over the stdio transport and returns every page
+// of tools.
+func (c *StdioCaller) ListTools(ctx context.Context) ([]Tool, error) {
+	return listTools(ctx, c.call)
+}
+
 func (c *StdioCaller) initialize(ctx context.Context, opts StdioOptions) error {
 	protocol := opts.ProtocolVersion
 	if protocol == "" {
@@ -5,6 +5,7 @@
 //
 //   - RegistryClient interface — abstraction for registry communication
 //   - GRPCClientAdapter — wraps generated gRPC client to implement RegistryClient
+//   - HTTPClientAdapter — wraps generated HTTP client to implement RegistryClient
 //   - Manager — coordinates multiple registry connections and tool discovery
 //
 // For the server-side registry implementation that runs as a standalone
@@ -77,6 +77,38 @@ func (m *mockGRPCRegistryClient) ClaimToolCall(_ context.Context, _ *registrypb.
 	return nil, nil
 }
 
+func (m *mockGRPCRegistryClient) ListProviders(_ context.Context, _ *registrypb.ListProvidersRequest, _ ...grpc.CallOption) (*registrypb.ListProvidersResponse, error) {
+	return nil, nil
+}
+
+func (m *mockGRPCRegistryClient) ForceDrainProvider(_ context.Context, _ *registrypb.ForceDrainProviderRequest, _ ...grpc.CallOption) (*registrypb.ForceDrainProviderResponse, error) {
+	return nil, nil
+}
+
+func (m *mockGRPCRegistryClient) RetireRegistration(_ context.Context, _ *registrypb.RetireRegistrationRequest, _ ...grpc.CallOption) (*registrypb.RetireRegistrationResponse, error) {
+	return nil, nil
+}
+
+func (m *mockGRPCRegistryClient) ListToolCalls(_ context.Context, _ *registrypb.ListToolCallsRequest, _ ...grpc.CallOption) (*registrypb.ListToolCallsResponse, error) {
+	return nil, nil
+}
+
+func (m *mockGRPCRegistryClient) InspectToolCall(_ context.Context, _ *registrypb.InspectToolCallRequest, _ ...grpc.CallOption) (*registrypb.InspectToolCallResponse, error) {
+	return nil, nil
+}
+
+func (m *mockGRPCRegistryClient) ListHealthTransitions(_ context.Context, _ *registrypb.ListHealthTransitionsRequest, _ ...grpc.CallOption) (*registrypb.ListHealthTransitionsResponse, error) {
+	return nil, nil
+}
+
+func (m *mockGRPCRegistryClient) GetToolsetRoutes(_ context.Context, _ *registrypb.GetToolsetRoutesRequest, _ ...grpc.CallOption) (*registrypb.GetToolsetRoutesResponse, error) {
+	return nil, nil
+}
+
+func (m *mockGRPCRegistryClient) SetToolsetRoutes(_ context.Context, _ *registrypb.SetToolsetRoutesRequest, _ ...grpc.CallOption) (*registrypb.SetToolsetRoutesResponse, error) {
+	return nil, nil
+}
+
 // TestGRPCClientAdapter_ListToolsets tests the ListToolsets method.
 // **Validates: Requirements 11.1**
 func TestGRPCClientAdapter_ListToolsets(t *testing.T) {
@@ -0,0 +1,131 @@
+package registry
+
+import (
+	"context"
+
+	httpclient "goa.design/goa-ai/registry/gen/http/registry/client"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// HTTPClientAdapter wraps a generated HTTP registry client and implements the
+// RegistryClient interface for use with the runtime Manager. It lets agents
+// reach registries exposed only through the HTTP/JSON transport.
+type HTTPClientAdapter struct {
+	listToolsets goa.Endpoint
+	getToolset   goa.Endpoint
+	search       goa.Endpoint
+}
+
+// NewHTTPClientAdapter creates a new adapter that wraps the generated HTTP
+// client and implements the RegistryClient interface. Configure credentials
+// on the client Doer, for example an http.Client whose transport presents a
+// TLS client certificate or sets the Authorization header.
+func NewHTTPClientAdapter(client *httpclient.Client) *HTTPClientAdapter {
+	return &HTTPClientAdapter{
+		listToolsets: client.ListToolsets(),
+		getToolset:   client.GetToolset(),
+		search:       client.Search(),
+	}
+}
+
+// ListToolsets returns all available toolsets from the registry.
+func (a *HTTPClientAdapter) ListToolsets(ctx context.Context) ([]*ToolsetInfo, error) {
+	res, err := a.listToolsets(ctx, &genregistry.ListToolsetsPayload{})
+	if err != nil {
+		return nil, err
+	}
+	return convertHTTPToolsetInfoList(res.(*genregistry.ListToolsetsResult).Toolsets), nil
+}
+
+// GetToolset retrieves the full schema for a specific toolset.
+func (a *HTTPClientAdapter) GetToolset(ctx context.Context, name string) (*ToolsetSchema, error) {
+	res, err := a.getToolset(ctx, &genregistry.GetToolsetPayload{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return convertHTTPToolset(res.(*genregistry.Toolset)), nil
+}
+
+// Search performs a keyword search on the registry.
+func (a *HTTPClientAdapter) Search(ctx context.Context, query string) ([]*SearchResult, error) {
+	res, err := a.search(ctx, &genregistry.SearchPayload{Query: query})
+	if err != nil {
+		return nil, err
+	}
+	return convertHTTPSearchResults(res.(*genregistry.SearchResult).Toolsets), nil
+}
+
+// convertHTTPToolsetInfoList converts service ToolsetInfo list to runtime ToolsetInfo list.
+func convertHTTPToolsetInfoList(toolsets []*genregistry.ToolsetInfo) []*ToolsetInfo {
+	if len(toolsets) == 0 {
+		return nil
+	}
+	result := make([]*ToolsetInfo, len(toolsets))
+	for i, ts := range toolsets {
+		result[i] = &ToolsetInfo{
+			Name:        ts.Name,
+			Description: stringValue(ts.Description),
+			Version:     versionValue(ts.Version),
+			Tags:        ts.Tags,
+		}
+	}
+	return result
+}
+
+// convertHTTPToolset converts a service Toolset to runtime ToolsetSchema.
+func convertHTTPToolset(ts *genregistry.Toolset) *ToolsetSchema {
+	tools := make([]*ToolSchema, len(ts.Tools))
+	for i, t := range ts.Tools {
+		tools[i] = &ToolSchema{
+			Name:          t.Name,
+			Description:   stringValue(t.Description),
+			Tags:          t.Tags,
+			PayloadSchema: t.PayloadSchema,
+			ResultSchema:  t.ResultSchema,
+			SidecarSchema: t.SidecarSchema,
+		}
+	}
+	return &ToolsetSchema{
+		Name:        ts.Name,
+		Description: stringValue(ts.Description),
+		Version:     versionValue(ts.Version),
+		Tools:       tools,
+	}
+}
+
+// convertHTTPSearchResults converts service ToolsetInfo list to runtime SearchResult list.
+func convertHTTPSearchResults(toolsets []*genregistry.ToolsetInfo) []*SearchResult {
+	if len(toolsets) == 0 {
+		return nil
+	}
+	result := make([]*SearchResult, len(toolsets))
+	for i, ts := range toolsets {
+		result[i] = &SearchResult{
+			Name:        ts.Name,
+			Description: stringValue(ts.Description),
+			Type:        "toolset",
+			Tags:        ts.Tags,
+		}
+	}
+	return result
+}
+
+// stringValue returns the value of s, or "" when s is nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// versionValue returns the value of v, or "" when v is nil.
+func versionValue(v *genregistry.SemVer) string {
+	if v == nil {
+		return ""
+	}
+	return string(*v)
+}
+
+// Compile-time assertion that HTTPClientAdapter implements RegistryClient.
+var _ RegistryClient = (*HTTPClientAdapter)(nil)
@@ -0,0 +1,87 @@
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	httpclient "goa.design/goa-ai/registry/gen/http/registry/client"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// newTestHTTPClientAdapter serves responses from handler and returns an
+// adapter connected to it.
+func newTestHTTPClientAdapter(t *testing.T, handler http.HandlerFunc) *HTTPClientAdapter {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+	client := httpclient.NewClient(u.Scheme, u.Host, srv.Client(), goahttp.RequestEncoder, goahttp.ResponseDecoder, false)
+	return NewHTTPClientAdapter(client)
+}
+
+// TestHTTPClientAdapter tests discovery over the HTTP/JSON transport.
+func TestHTTPClientAdapter(t *testing.T) {
+	ctx := context.Background()
+	adapter := newTestHTTPClientAdapter(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v1/toolsets":
+			if got := r.URL.Query()["tags"]; len(got) != 0 {
+				t.Errorf("tags: got %v, want none", got)
+			}
+			_, _ = w.Write([]byte(`{"toolsets":[{"name":"test-toolset","description":"Test toolset","version":"1.0.0","tags":["tag1","tag2"],"tool_count":3,"registered_at":"2026-01-02T03:04:05Z"}]}`))
+		case r.URL.Path == "/v1/toolsets/test-toolset":
+			// payload_schema is base64 for {"type":"object"}.
+			_, _ = w.Write([]byte(`{"name":"test-toolset","version":"1.0.0","tools":[{"name":"test-tool","payload_schema":"eyJ0eXBlIjoib2JqZWN0In0=","result_schema":"eyJ0eXBlIjoib2JqZWN0In0="}],"registered_at":"2026-01-02T03:04:05Z"}`))
+		case r.URL.Path == "/v1/search" && r.URL.Query().Get("query") == "test":
+			_, _ = w.Write([]byte(`{"toolsets":[{"name":"test-toolset","tool_count":1,"registered_at":"2026-01-02T03:04:05Z"}]}`))
+		default:
+			w.Header().Set("goa-error", "not_found")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"name":"not_found","id":"1","message":"toolset not found","temporary":false,"timeout":false,"fault":false}`))
+		}
+	})
+
+	toolsets, err := adapter.ListToolsets(ctx)
+	if err != nil {
+		t.Fatalf("ListToolsets failed: %v", err)
+	}
+	if len(toolsets) != 1 {
+		t.Fatalf("expected 1 toolset, got %d", len(toolsets))
+	}
+	if toolsets[0].Name != testToolsetName || toolsets[0].Description != "Test toolset" || toolsets[0].Version != "1.0.0" {
+		t.Errorf("toolset: got %+v", toolsets[0])
+	}
+	if len(toolsets[0].Tags) != 2 {
+		t.Errorf("Tags: got %d, want 2", len(toolsets[0].Tags))
+	}
+
+	schema, err := adapter.GetToolset(ctx, testToolsetName)
+	if err != nil {
+		t.Fatalf("GetToolset failed: %v", err)
+	}
+	if schema.Version != "1.0.0" || len(schema.Tools) != 1 {
+		t.Fatalf("schema: got %+v", schema)
+	}
+	if string(schema.Tools[0].PayloadSchema) != `{"type":"object"}` {
+		t.Errorf("Tool PayloadSchema: got %q", string(schema.Tools[0].PayloadSchema))
+	}
+
+	results, err := adapter.Search(ctx, "test")
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Name != testToolsetName || results[0].Type != "toolset" {
+		t.Errorf("results: got %+v", results)
+	}
+
+	if _, err := adapter.GetToolset(ctx, "missing"); err == nil {
+		t.Fatal("expected not_found error")
+	}
+}
@@ -607,20 +607,32 @@ func (m *Manager) filterFederated(toolsets []*ToolsetInfo, cfg *FederationConfig
 
 // shouldInclude determines if a toolset should be included based on federation config.
 func (m *Manager) shouldInclude(name string, cfg *FederationConfig) bool {
+	return cfg.Allows(name)
+}
+
+// Allows reports whether the federation settings import the named toolset.
+// Exclude patterns take precedence over Include patterns; when Include is
+// empty, every toolset that is not excluded is imported. A nil config
+// imports everything.
+func (c *FederationConfig) Allows(name string) bool {
+	if c == nil {
+		return true
+	}
+
 	// Check exclude patterns first
-	for _, pattern := range cfg.Exclude {
+	for _, pattern := range c.Exclude {
 		if matchGlob(pattern, name) {
 			return false
 		}
 	}
 
 	// If no include patterns, include everything not excluded
-	if len(cfg.Include) == 0 {
+	if len(c.Include) == 0 {
 		return true
 	}
 
 	// Check include patterns
-	for _, pattern := range cfg.Include {
+	for _, pattern := range c.Include {
 		if matchGlob(pattern, name) {
 			return true
 		}
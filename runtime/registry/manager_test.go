@@ -595,6 +595,30 @@ func TestMatchGlob(t *testing.T) {
 	}
 }
 
+// TestFederationConfigAllows tests include/exclude precedence.
+func TestFederationConfigAllows(t *testing.T) {
+	var unset *FederationConfig
+	if !unset.Allows("anything") {
+		t.Error("nil config should allow every toolset")
+	}
+
+	cfg := &FederationConfig{
+		Include: []string{"web-*", "data-*"},
+		Exclude: []string{"data-internal"},
+	}
+	tests := map[string]bool{
+		"web-search":    true,
+		"data-series":   true,
+		"data-internal": false,
+		"code-search":   false,
+	}
+	for name, want := range tests {
+		if got := cfg.Allows(name); got != want {
+			t.Errorf("Allows(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
 // TestCacheKey tests cache key generation.
 func TestCacheKey(t *testing.T) {
 	key := cacheKey("my-registry", "my-toolset")
@@ -29,6 +29,9 @@ const (
 	OpGetToolset OperationType = "get_toolset"
 	// OpSync is the operation type for registry synchronization.
 	OpSync OperationType = "sync"
+	// OpFederationSync is the operation type for mirroring an upstream
+	// registry into a local one.
+	OpFederationSync OperationType = "federation_sync"
 	// OpRegister is the operation type for adding a registry to the manager.
 	OpRegister OperationType = "register"
 	// OpCacheGet is the operation type for cache get operations.
@@ -75,6 +78,31 @@ type OperationEvent struct {
 	CacheKey string
 }
 
+// FederationStatus reports the sync state of one upstream registry mirrored
+// by a federation worker.
+type FederationStatus struct {
+	// Registry is the name of the upstream registry.
+	Registry string
+	// Imported is the number of upstream toolsets currently mirrored.
+	Imported int
+	// LastSync is when the last sync attempt completed.
+	LastSync time.Time
+	// LastSuccess is when the upstream last synced without error. Zero when
+	// it never did.
+	LastSuccess time.Time
+	// Staleness is the time elapsed since LastSuccess.
+	Staleness time.Duration
+	// Stale reports that the upstream did not sync within its cache TTL and
+	// its toolsets were withdrawn.
+	Stale bool
+	// Error is the error of the last sync attempt, if any.
+	Error string
+	// ToolsetErrors maps the listed upstream toolsets that could not be read
+	// during the last sync to their error. These toolsets keep their current
+	// provider and do not make the upstream stale.
+	ToolsetErrors map[string]string
+}
+
 // Observability provides structured logging, metrics, and tracing for registry operations.
 type Observability struct {
 	logger  telemetry.Logger
@@ -152,53 +180,82 @@ func (o *Observability) LogOperation(ctx context.Context, event OperationEvent)
 //   - registry.cache.hit_ratio: Gauge of cache hit ratio (computed)
 func (o *Observability) RecordOperationMetrics(event OperationEvent) {
 	tags := []string{
-		"operation", string(event.Operation),
-		"outcome", string(event.Outcome),
+		telemetry.TagOperation, string(event.Operation),
+		telemetry.TagOutcome, string(event.Outcome),
 	}
 	if event.Registry != "" {
-		tags = append(tags, "registry", event.Registry)
+		tags = append(tags, telemetry.TagRegistry, event.Registry)
 	}
 
 	// Record latency
-	o.metrics.RecordTimer("registry.operation.duration", event.Duration, tags...)
+	o.metrics.RecordTimer(telemetry.MetricRegistryOperationDuration, event.Duration, tags...)
 
 	// Record success/error counters
 	switch event.Outcome {
 	case OutcomeSuccess:
-		o.metrics.IncCounter("registry.operation.success", 1, tags...)
+		o.metrics.IncCounter(telemetry.MetricRegistryOperationSuccess, 1, tags...)
 	case OutcomeError:
-		o.metrics.IncCounter("registry.operation.error", 1, tags...)
+		o.metrics.IncCounter(telemetry.MetricRegistryOperationError, 1, tags...)
 	case OutcomeCacheHit:
-		o.metrics.IncCounter("registry.cache.hit", 1, tags...)
+		o.metrics.IncCounter(telemetry.MetricRegistryCacheHit, 1, tags...)
 		// Also count as success for overall success rate
-		o.metrics.IncCounter("registry.operation.success", 1, tags...)
+		o.metrics.IncCounter(telemetry.MetricRegistryOperationSuccess, 1, tags...)
 	case OutcomeCacheMiss:
-		o.metrics.IncCounter("registry.cache.miss", 1, tags...)
+		o.metrics.IncCounter(telemetry.MetricRegistryCacheMiss, 1, tags...)
 	case OutcomeFallback:
-		o.metrics.IncCounter("registry.operation.fallback", 1, tags...)
+		o.metrics.IncCounter(telemetry.MetricRegistryOperationFallback, 1, tags...)
 		// Fallback is a degraded success
-		o.metrics.IncCounter("registry.operation.success", 1, tags...)
+		o.metrics.IncCounter(telemetry.MetricRegistryOperationSuccess, 1, tags...)
 	}
 
 	// Record result count if applicable
 	if event.ResultCount > 0 {
-		o.metrics.RecordGauge("registry.operation.result_count", float64(event.ResultCount), tags...)
+		o.metrics.RecordGauge(telemetry.MetricRegistryOperationResultCount, float64(event.ResultCount), tags...)
 	}
 }
 
 // RecordCacheMetrics records cache-specific metrics.
 // This should be called periodically to track cache statistics.
 func (o *Observability) RecordCacheMetrics(registry string, hits, misses int64) {
-	tags := []string{"registry", registry}
+	tags := []string{telemetry.TagRegistry, registry}
 
 	total := hits + misses
 	if total > 0 {
 		hitRatio := float64(hits) / float64(total)
-		o.metrics.RecordGauge("registry.cache.hit_ratio", hitRatio, tags...)
+		o.metrics.RecordGauge(telemetry.MetricRegistryCacheHitRatio, hitRatio, tags...)
 	}
 
-	o.metrics.RecordGauge("registry.cache.hits_total", float64(hits), tags...)
-	o.metrics.RecordGauge("registry.cache.misses_total", float64(misses), tags...)
+	o.metrics.RecordGauge(telemetry.MetricRegistryCacheHitsTotal, float64(hits), tags...)
+	o.metrics.RecordGauge(telemetry.MetricRegistryCacheMissesTotal, float64(misses), tags...)
+}
+
+// RecordFederationStatus records the sync state of an upstream registry.
+// Metrics recorded:
+//   - registry.federation.imported: Gauge of mirrored toolsets
+//   - registry.federation.staleness_seconds: Gauge of time since last success
+//   - registry.federation.stale: Gauge set to 1 once the cache TTL elapsed
+//
+// Stale upstreams are also logged as warnings.
+func (o *Observability) RecordFederationStatus(ctx context.Context, status FederationStatus) {
+	tags := []string{telemetry.TagRegistry, status.Registry}
+	stale := 0.0
+	if status.Stale {
+		stale = 1
+	}
+	o.metrics.RecordGauge(telemetry.MetricRegistryFederationImported, float64(status.Imported), tags...)
+	o.metrics.RecordGauge(telemetry.MetricRegistryFederationStaleness, status.Staleness.Seconds(), tags...)
+	o.metrics.RecordGauge(telemetry.MetricRegistryFederationStale, stale, tags...)
+
+	if status.Stale {
+		keyvals := []any{
+			"registry", status.Registry,
+			"staleness_ms", status.Staleness.Milliseconds(),
+		}
+		if status.Error != "" {
+			keyvals = append(keyvals, "error", status.Error)
+		}
+		o.logger.Warn(ctx, "federated registry is stale", keyvals...)
+	}
 }
 
 // StartSpan starts a new trace span for a registry operation.
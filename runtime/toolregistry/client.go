@@ -0,0 +1,80 @@
+package toolregistry
+
+import (
+	"crypto/tls"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	grpcclient "goa.design/goa-ai/registry/gen/grpc/registry/client"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+)
+
+// DialOptions returns the gRPC dial options of a registry client connection.
+//
+// A client certificate (certFile and keyFile) enables mutual TLS verified
+// against caFile, or the system roots when caFile is empty. caFile alone
+// enables server-authenticated TLS. A non-empty token is presented as a
+// bearer credential on every call and enables TLS with the system roots when
+// no other TLS setting is given, since bearer tokens never cross the network
+// in clear text. Without any of them the connection is insecure.
+func DialOptions(certFile, keyFile, caFile, token string) ([]grpc.DialOption, error) {
+	var tlsCfg *tls.Config
+	switch {
+	case certFile != "" || keyFile != "":
+		cfg, err := ClientTLSConfig(certFile, keyFile, caFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg = cfg
+	case caFile != "":
+		pool, err := LoadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	case token != "":
+		tlsCfg = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	if tlsCfg == nil {
+		return []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, nil
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg))}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(BearerCredentials(StaticToken(token))))
+	}
+	return opts, nil
+}
+
+// NewClient returns the registry service client served over conn. Every
+// registry method is bound to its gRPC endpoint except StreamToolCall, which
+// the registry serves over HTTP only.
+func NewClient(conn *grpc.ClientConn, opts ...grpc.CallOption) *genregistry.Client {
+	c := grpcclient.NewClient(conn, opts...)
+	return genregistry.NewClient(
+		c.Register(),
+		c.ReleaseProvider(),
+		c.DrainProvider(),
+		c.Unregister(),
+		c.Pong(),
+		c.ListToolsets(),
+		c.GetToolset(),
+		c.Search(),
+		c.CallTool(),
+		c.RetryTool(),
+		nil,
+		c.CompleteToolCall(),
+		c.PublishToolOutputDelta(),
+		c.ReportToolCallOverload(),
+		c.ClaimToolCall(),
+		c.ListProviders(),
+		c.ForceDrainProvider(),
+		c.RetireRegistration(),
+		c.ListToolCalls(),
+		c.InspectToolCall(),
+		c.ListHealthTransitions(),
+		c.GetToolsetRoutes(),
+		c.SetToolsetRoutes(),
+	)
+}
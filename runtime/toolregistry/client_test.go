@@ -0,0 +1,27 @@
+package toolregistry
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialOptions(t *testing.T) {
+	t.Parallel()
+
+	insecureOpts, err := DialOptions("", "", "", "")
+	require.NoError(t, err)
+	assert.Len(t, insecureOpts, 1, "insecure transport only")
+
+	tokenOpts, err := DialOptions("", "", "", "token-1")
+	require.NoError(t, err)
+	assert.Len(t, tokenOpts, 2, "TLS transport and bearer credentials")
+
+	missing := filepath.Join(t.TempDir(), "missing.pem")
+	_, err = DialOptions("", "", missing, "")
+	require.ErrorContains(t, err, "read CA bundle")
+	_, err = DialOptions(missing, missing, "", "token-1")
+	require.ErrorContains(t, err, "load registry client certificate")
+}
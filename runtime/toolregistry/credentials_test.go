@@ -0,0 +1,43 @@
+package toolregistry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestBearerCredentials(t *testing.T) {
+	t.Parallel()
+
+	creds := BearerCredentials(StaticToken("token-1"))
+	assert.True(t, creds.RequireTransportSecurity())
+	md, err := creds.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{AuthorizationMetadataKey: "Bearer token-1"}, md)
+
+	_, err = BearerCredentials(StaticToken("")).GetRequestMetadata(context.Background())
+	require.Error(t, err)
+}
+
+func TestWithBearerTokenReplacesOutgoingAuthorization(t *testing.T) {
+	t.Parallel()
+
+	parent := metadata.AppendToOutgoingContext(
+		context.Background(),
+		AuthorizationMetadataKey, "Bearer stale",
+		"x-request-id", "req-1",
+	)
+	ctx, err := WithBearerToken(parent, StaticToken("token-2"))
+	require.NoError(t, err)
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, []string{"Bearer token-2"}, md.Get(AuthorizationMetadataKey))
+	assert.Equal(t, []string{"req-1"}, md.Get("x-request-id"))
+
+	parentMD, _ := metadata.FromOutgoingContext(parent)
+	assert.Equal(t, []string{"Bearer stale"}, parentMD.Get(AuthorizationMetadataKey))
+}
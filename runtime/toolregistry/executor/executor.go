@@ -62,6 +62,8 @@ type (
 
 		outputDeltaKey string
 		streamSink     aistream.Sink
+		toolsetRefs    map[string]string
+		tokens         toolregistry.TokenSource
 
 		logger telemetry.Logger
 		tracer telemetry.Tracer
@@ -99,6 +101,33 @@ func WithStreamSink(sink aistream.Sink) Option {
 	}
 }
 
+// WithToolsetRef routes calls for toolset through the registry toolset
+// reference ref instead of the bare toolset name. Use it to pin a
+// registry-backed toolset to a version or version constraint, for example
+// "data-tools@1.2.3" or "data-tools@^1.2"; the registry then selects among the
+// side-by-side versions it has admitted. It overrides the reference that
+// generated registrations of pinned FromRegistry toolsets set on the call
+// context with toolregistry.WithToolsetRef.
+func WithToolsetRef(toolset, ref string) Option {
+	return func(e *Executor) {
+		if e.toolsetRefs == nil {
+			e.toolsetRefs = make(map[string]string)
+		}
+		e.toolsetRefs[toolset] = ref
+	}
+}
+
+// WithTokenSource presents the bearer token from src on every CallTool and
+// RetryTool request, so a registry that enforces per-caller authorization
+// sees this executor's identity even when the registry connection is shared.
+// The Client implementation must forward the call context to its gRPC
+// transport for the token to reach the registry.
+func WithTokenSource(src toolregistry.TokenSource) Option {
+	return func(e *Executor) {
+		e.tokens = src
+	}
+}
+
 // WithLogger configures the executor logger. When nil, the executor uses a noop
 // logger.
 func WithLogger(logger telemetry.Logger) Option {
@@ -164,6 +193,11 @@ func (e *Executor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call
 			fmt.Sprintf("tool %q missing toolset routing id", call.Name),
 		)), nil
 	}
+	if ref, ok := e.toolsetRefs[toolsetID]; ok {
+		toolsetID = ref
+	} else if ref, ok := toolregistry.ToolsetRefFromContext(ctx, toolsetID); ok {
+		toolsetID = ref
+	}
 	ctx, span := e.tracer.Start(
 		ctx,
 		"toolregistry.execute",
@@ -186,13 +220,20 @@ func (e *Executor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call
 		RunID:            meta.RunID,
 		SessionID:        meta.SessionID,
 		TurnID:           meta.TurnID,
-		ToolCallID:       meta.ToolCallID,
+		ToolCallID:       registryToolCallID(meta.ToolCallID, call.Attempt),
 		ParentToolCallID: meta.ParentToolCallID,
 	}
 	admissionCtx, cancelAdmission := context.WithTimeout(
 		ctx,
 		toolregistry.MaxToolCallWait+toolregistry.ResultStreamTransportBudget,
 	)
+	admissionCtx, err := e.withCredentials(admissionCtx)
+	if err != nil {
+		cancelAdmission()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "resolve registry credentials failed")
+		return runtime.Executed(internalFailureResult(call.Name, meta.ToolCallID, err.Error())), nil
+	}
 	callRef, err := e.client.CallTool(admissionCtx, toolsetID, call.Name, call.Payload, tmeta)
 	cancelAdmission()
 	if err != nil {
@@ -201,16 +242,17 @@ func (e *Executor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call
 		if result, classified := preAdmissionFailureResult(call, meta.ToolCallID, err); classified {
 			return runtime.Executed(result), nil
 		}
-		return runtime.Executed(e.outcomeUnknownResult(call, meta, err)), nil
+		return e.outcomeUnknown(spec, call, meta, err)
 	}
 	if err := toolregistry.ValidateToolCallRef(callRef); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "call tool returned invalid reference")
-		return runtime.Executed(e.outcomeUnknownResult(
+		return e.outcomeUnknown(
+			spec,
 			call,
 			meta,
 			fmt.Errorf("call tool returned invalid reference: %w", err),
-		)), nil
+		)
 	}
 	executionCtx, cancelExecution := context.WithDeadline(ctx, callRef.ExecutionDeadline)
 	defer cancelExecution()
@@ -230,11 +272,12 @@ func (e *Executor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "open tool result stream failed")
-		return runtime.Executed(e.outcomeUnknownResult(
+		return e.outcomeUnknown(
+			spec,
 			call,
 			meta,
 			fmt.Errorf("open tool result stream %q: %w", resultStreamID, err),
-		)), nil
+		)
 	}
 	// Result streams are per-tool-call and short-lived. Providers can publish the
 	// result very quickly after the registry returns from CallTool, so we must
@@ -289,7 +332,7 @@ func (e *Executor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call
 		)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "create reader for tool result stream failed")
-		return runtime.Executed(e.outcomeUnknownResult(call, meta, err)), nil
+		return e.outcomeUnknown(spec, call, meta, err)
 	}
 	defer reader.Close()
 	span.AddEvent("toolregistry.result_subscribed", "toolregistry.result_stream_id", resultStreamID)
@@ -303,17 +346,18 @@ func (e *Executor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call
 			if ctx.Err() != nil {
 				return nil, ctx.Err()
 			}
-			return runtime.Executed(e.outcomeUnknownResult(
+			return e.outcomeUnknown(
+				spec,
 				call,
 				meta,
 				fmt.Errorf("tool execution deadline elapsed: %w", executionCtx.Err()),
-			)), nil
+			)
 		case ev, ok := <-events:
 			if !ok {
 				err := fmt.Errorf("tool result stream subscription closed")
 				span.RecordError(err)
 				span.SetStatus(codes.Error, "tool result stream subscription closed")
-				return runtime.Executed(e.outcomeUnknownResult(call, meta, err)), nil
+				return e.outcomeUnknown(spec, call, meta, err)
 			}
 			if ev.EventName == e.outputDeltaKey {
 				var msg toolregistry.ToolOutputDeltaMessage
@@ -382,8 +426,13 @@ func (e *Executor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call
 				)
 			}
 			if msg.Retry != nil {
+				retryCtx, err := e.withCredentials(executionCtx)
+				if err != nil {
+					span.RecordError(err)
+					return e.outcomeUnknown(spec, call, meta, err)
+				}
 				retryRef, err := e.client.RetryTool(
-					executionCtx,
+					retryCtx,
 					toolsetID,
 					call.Name,
 					call.Payload,
@@ -392,15 +441,16 @@ func (e *Executor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call
 				)
 				if err != nil {
 					span.RecordError(err)
-					return runtime.Executed(e.outcomeUnknownResult(call, meta, err)), nil
+					return e.outcomeUnknown(spec, call, meta, err)
 				}
 				if err := toolregistry.ValidateToolCallRef(retryRef); err != nil {
 					span.RecordError(err)
-					return runtime.Executed(e.outcomeUnknownResult(
+					return e.outcomeUnknown(
+						spec,
 						call,
 						meta,
 						fmt.Errorf("retry tool returned invalid reference: %w", err),
-					)), nil
+					)
 				}
 				if retryRef.ToolUseID != callRef.ToolUseID ||
 					retryRef.RegistrationToken != callRef.RegistrationToken ||
@@ -412,7 +462,7 @@ func (e *Executor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call
 						retryRef,
 					)
 					span.RecordError(err)
-					return runtime.Executed(e.outcomeUnknownResult(call, meta, err)), nil
+					return e.outcomeUnknown(spec, call, meta, err)
 				}
 				continue
 			}
@@ -430,9 +480,10 @@ func (e *Executor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call
 
 // preAdmissionFailureResult converts errors that prove provider admission did
 // not occur: transport validation rejected the request before service dispatch,
-// or the registry replayed a durable rejected decision. Other failures remain
-// ambiguous because the registry may have admitted the call before the response
-// was lost.
+// the registry replayed a durable rejected decision, or the caller's quota was
+// empty. Quota failures are rate limits the planner may retry on a later turn.
+// Other failures remain ambiguous because the registry may have admitted the
+// call before the response was lost.
 func preAdmissionFailureResult(
 	call *planner.ToolRequest,
 	toolCallID string,
@@ -443,7 +494,7 @@ func preAdmissionFailureResult(
 		return nil, false
 	}
 	switch serviceErr.Name {
-	case "call_not_admitted", "not_found":
+	case "call_not_admitted", "not_found", "unauthenticated", "permission_denied":
 		return &planner.ToolResult{
 			Name:       call.Name,
 			ToolCallID: toolCallID,
@@ -455,6 +506,18 @@ func preAdmissionFailureResult(
 				},
 			},
 		}, true
+	case "quota_exceeded":
+		return &planner.ToolResult{
+			Name:       call.Name,
+			ToolCallID: toolCallID,
+			Failure: &planner.ToolFailure{
+				Kind:  planner.FailureRateLimited,
+				Error: planner.ToolErrorFromError(err),
+				Recovery: planner.RecoveryDirective{
+					Action: planner.RecoveryReplan,
+				},
+			},
+		}, true
 	case "validation_error",
 		goa.InvalidFieldType,
 		goa.MissingField,
@@ -476,6 +539,32 @@ func preAdmissionFailureResult(
 	}
 }
 
+// withCredentials attaches the configured bearer token to ctx. It returns ctx
+// unchanged when the executor has no token source.
+func (e *Executor) withCredentials(ctx context.Context) (context.Context, error) {
+	if e.tokens == nil {
+		return ctx, nil
+	}
+	return toolregistry.WithBearerToken(ctx, e.tokens)
+}
+
+// outcomeUnknown reports an invocation that may have been admitted. Calls to
+// idempotent tools fail the activity attempt so the engine retry policy repeats
+// them: the registry replays the admitted call to exact retries, and repeating
+// an idempotent tool is safe either way. Other calls complete with a terminal
+// outcome-unknown result.
+func (e *Executor) outcomeUnknown(
+	spec *tools.ToolSpec,
+	call *planner.ToolRequest,
+	meta *runtime.ToolCallMeta,
+	err error,
+) (*runtime.ToolExecutionResult, error) {
+	if spec.Idempotent && !spec.Destructive {
+		return nil, fmt.Errorf("%s: %w", toolregistry.ToolErrorCodeOutcomeUnknown, err)
+	}
+	return runtime.Executed(e.outcomeUnknownResult(call, meta, err)), nil
+}
+
 // outcomeUnknownResult terminates planning after an invocation may have been
 // admitted. A replacement call could repeat an external side effect.
 func (e *Executor) outcomeUnknownResult(
@@ -640,3 +729,14 @@ func firstNonEmpty(values ...string) string {
 	}
 	return ""
 }
+
+// registryToolCallID returns the call ID under which the registry tracks one
+// attempt of a tool call. The registry deduplicates calls by ID, so attempts
+// started by the tool retry policy use a distinct ID to run again while the
+// first attempt keeps the planner call ID.
+func registryToolCallID(toolCallID string, attempt int) string {
+	if attempt <= 1 {
+		return toolCallID
+	}
+	return fmt.Sprintf("%s#%d", toolCallID, attempt)
+}
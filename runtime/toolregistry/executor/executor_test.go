@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"goa.design/goa-ai/features/stream/pulse/clients/pulse"
+	genregistry "goa.design/goa-ai/registry/gen/registry"
 	"goa.design/goa-ai/runtime/agent"
 	"goa.design/goa-ai/runtime/agent/planner"
 	"goa.design/goa-ai/runtime/agent/rawjson"
@@ -28,6 +29,7 @@ import (
 	goa "goa.design/goa/v3/pkg"
 	"goa.design/pulse/streaming"
 	streamopts "goa.design/pulse/streaming/options"
+	"google.golang.org/grpc/metadata"
 )
 
 type correctableServiceError struct {
@@ -111,6 +113,148 @@ func TestExecutorUsesOldestStartForResultStreamReader(t *testing.T) {
 	assert.False(t, stream.destroyed)
 }
 
+func TestExecutorRoutesThroughConfiguredToolsetRef(t *testing.T) {
+	t.Parallel()
+
+	const toolUseID = "tooluse-ref"
+	specs := fakeSpecs{
+		spec: &tools.ToolSpec{
+			Name:    "todos.update_todos",
+			Toolset: "todos.todos",
+		},
+	}
+	stream := &fakeStream{
+		t:             t,
+		requiredStart: "0",
+		events: []*streaming.Event{
+			{
+				ID:        "1-0",
+				EventName: toolregistry.ResultEventKey,
+				Payload: mustJSON(t, toolregistry.ToolResultMessage{
+					RegistrationToken: testRegistrationTokenA,
+					ToolUseID:         toolUseID,
+					Result:            json.RawMessage(`{}`),
+				}),
+			},
+		},
+	}
+	var toolset string
+	exec := New(
+		fakeRegistryClient{toolUseID: toolUseID, toolset: &toolset},
+		fakePulseClient{streamID: "result:" + toolUseID, stream: stream},
+		specs,
+		WithToolsetRef("todos.todos", "todos.todos@^1.2"),
+	)
+
+	_, err := exec.Execute(context.Background(), &agentsruntime.ToolCallMeta{
+		RunID:     "run",
+		SessionID: "sess",
+	}, &planner.ToolRequest{
+		Name:    "todos.update_todos",
+		Payload: []byte(`{}`),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "todos.todos@^1.2", toolset)
+}
+
+func TestExecutorRunsRetryAttemptsAsNewRegistryCalls(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		attempt int
+		want    string
+	}{
+		{name: "first execution", attempt: 0, want: "call-1"},
+		{name: "first attempt", attempt: 1, want: "call-1"},
+		{name: "retry attempt", attempt: 3, want: "call-1#3"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			const toolUseID = "tooluse-attempt"
+			stream := &fakeStream{
+				t:             t,
+				requiredStart: "0",
+				events: []*streaming.Event{
+					{
+						ID:        "1-0",
+						EventName: toolregistry.ResultEventKey,
+						Payload: mustJSON(t, toolregistry.ToolResultMessage{
+							RegistrationToken: testRegistrationTokenA,
+							ToolUseID:         toolUseID,
+							Result:            json.RawMessage(`{}`),
+						}),
+					},
+				},
+			}
+			var toolCallID string
+			exec := New(
+				fakeRegistryClient{toolUseID: toolUseID, toolCallID: &toolCallID},
+				fakePulseClient{streamID: "result:" + toolUseID, stream: stream},
+				fakeSpecs{spec: &tools.ToolSpec{Name: "todos.update_todos", Toolset: "todos.todos"}},
+			)
+
+			res, err := exec.Execute(context.Background(), &agentsruntime.ToolCallMeta{
+				RunID:      "run",
+				SessionID:  "sess",
+				ToolCallID: "call-1",
+			}, &planner.ToolRequest{
+				Name:       "todos.update_todos",
+				Payload:    []byte(`{}`),
+				ToolCallID: "call-1",
+				Attempt:    tc.attempt,
+			})
+
+			require.NoError(t, err)
+			require.NotNil(t, res.ToolResult)
+			assert.Equal(t, tc.want, toolCallID)
+			assert.Equal(t, "call-1", res.ToolResult.ToolCallID)
+		})
+	}
+}
+
+func TestExecutorPresentsConfiguredBearerToken(t *testing.T) {
+	t.Parallel()
+
+	const toolUseID = "tooluse-auth"
+	stream := &fakeStream{
+		t:             t,
+		requiredStart: "0",
+		events: []*streaming.Event{
+			{
+				ID:        "1-0",
+				EventName: toolregistry.ResultEventKey,
+				Payload: mustJSON(t, toolregistry.ToolResultMessage{
+					RegistrationToken: testRegistrationTokenA,
+					ToolUseID:         toolUseID,
+					Result:            json.RawMessage(`{}`),
+				}),
+			},
+		},
+	}
+	var authorization []string
+	exec := New(
+		fakeRegistryClient{toolUseID: toolUseID, authorization: &authorization},
+		fakePulseClient{streamID: "result:" + toolUseID, stream: stream},
+		fakeSpecs{spec: &tools.ToolSpec{Name: "todos.update_todos", Toolset: "todos.todos"}},
+		WithTokenSource(toolregistry.StaticToken("agent-token")),
+	)
+
+	_, err := exec.Execute(context.Background(), &agentsruntime.ToolCallMeta{
+		RunID:     "run",
+		SessionID: "sess",
+	}, &planner.ToolRequest{
+		Name:    "todos.update_todos",
+		Payload: []byte(`{}`),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer agent-token"}, authorization)
+}
+
 func TestExecutorSequentialAndConcurrentWaitersReplayTerminalHistory(t *testing.T) {
 	t.Parallel()
 
@@ -590,6 +734,35 @@ func TestExecutorTransportFailureClassifiesToolUnavailable(t *testing.T) {
 	assert.Equal(t, "toolcall-transport", res.ToolResult.ToolCallID)
 }
 
+func TestExecutorFailsIdempotentCallAttemptOnUnknownOutcome(t *testing.T) {
+	t.Parallel()
+
+	spec := &tools.ToolSpec{
+		Name:       "atlas.read.get_time_series",
+		Toolset:    "atlas.read",
+		ReadOnly:   true,
+		Idempotent: true,
+	}
+	exec := New(
+		fakeRegistryClient{err: errors.New("dial registry gateway: connection refused")},
+		fakePulseClient{},
+		fakeSpecs{spec: spec},
+	)
+
+	res, err := exec.Execute(context.Background(), &agentsruntime.ToolCallMeta{
+		RunID:      "run",
+		SessionID:  "sess",
+		ToolCallID: "toolcall-transport",
+	}, &planner.ToolRequest{
+		Name:    "atlas.read.get_time_series",
+		Payload: []byte(`{}`),
+	})
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.Contains(t, err.Error(), toolregistry.ToolErrorCodeOutcomeUnknown)
+	assert.Contains(t, err.Error(), "connection refused")
+}
+
 func TestExecutorAllowsRegistryAdmissionDecisionToReachCaller(t *testing.T) {
 	t.Parallel()
 
@@ -646,7 +819,7 @@ func TestExecutorClassifiesTypedPreAdmissionFailures(t *testing.T) {
 		goa.DecodePayload,
 		goa.MissingPayload,
 	}
-	tests := make([]classificationTest, 0, 3+len(transportValidationNames))
+	tests := make([]classificationTest, 0, 5+len(transportValidationNames))
 	tests = append(tests,
 		classificationTest{
 			name:       "not admitted replans",
@@ -660,6 +833,18 @@ func TestExecutorClassifiesTypedPreAdmissionFailures(t *testing.T) {
 			wantKind:   planner.FailureUnavailable,
 			wantAction: planner.RecoveryReplan,
 		},
+		classificationTest{
+			name:       "unauthorized caller replans",
+			registry:   "permission_denied",
+			wantKind:   planner.FailureUnavailable,
+			wantAction: planner.RecoveryReplan,
+		},
+		classificationTest{
+			name:       "exhausted quota is rate limited",
+			registry:   "quota_exceeded",
+			wantKind:   planner.FailureRateLimited,
+			wantAction: planner.RecoveryReplan,
+		},
 		classificationTest{
 			name:       "registry validation is internal",
 			registry:   "validation_error",
@@ -1479,18 +1664,31 @@ type fakeRegistryClient struct {
 	callDeadline       *time.Time
 	calls              *atomic.Int64
 	retryExpectedToken *string
+	toolset            *string
+	toolCallID         *string
+	authorization      *[]string
 }
 
 func (c fakeRegistryClient) CallTool(
 	ctx context.Context,
-	_ string,
+	toolset string,
 	_ tools.Ident,
 	_ []byte,
-	_ toolregistry.ToolCallMeta,
+	meta toolregistry.ToolCallMeta,
 ) (toolregistry.ToolCallRef, error) {
+	if c.toolCallID != nil {
+		*c.toolCallID = meta.ToolCallID
+	}
 	if c.callDeadline != nil {
 		*c.callDeadline, _ = ctx.Deadline()
 	}
+	if c.toolset != nil {
+		*c.toolset = toolset
+	}
+	if c.authorization != nil {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		*c.authorization = md.Get(toolregistry.AuthorizationMetadataKey)
+	}
 	if c.calls != nil {
 		c.calls.Add(1)
 	}
@@ -1756,3 +1954,56 @@ func attrsByKey(attrs []attribute.KeyValue) map[attribute.Key]attribute.Value {
 	}
 	return out
 }
+
+type recordingGateway struct {
+	call  *genregistry.CallToolPayload
+	retry *genregistry.RetryToolPayload
+	res   *genregistry.CallToolResult
+}
+
+func (g *recordingGateway) CallTool(_ context.Context, p *genregistry.CallToolPayload) (*genregistry.CallToolResult, error) {
+	g.call = p
+	return g.res, nil
+}
+
+func (g *recordingGateway) RetryTool(_ context.Context, p *genregistry.RetryToolPayload) (*genregistry.CallToolResult, error) {
+	g.retry = p
+	return g.res, nil
+}
+
+func TestRegistryClientAdaptsGeneratedClient(t *testing.T) {
+	deadline := time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC)
+	gateway := &recordingGateway{res: &genregistry.CallToolResult{
+		ToolUseID:             "tool-use",
+		RegistrationToken:     testRegistrationTokenA,
+		ExecutionDeadline:     deadline.Format(time.RFC3339Nano),
+		ResultStreamExpiresAt: deadline.Add(time.Hour).Format(time.RFC3339Nano),
+	}}
+	client := NewRegistryClient(gateway)
+	meta := toolregistry.ToolCallMeta{RunID: "run", SessionID: "session", ToolCallID: "call", TurnID: "turn"}
+
+	ref, err := client.CallTool(context.Background(), "docs@^1.2", "docs.search", []byte(`{}`), meta)
+	require.NoError(t, err)
+	assert.Equal(t, toolregistry.ToolCallRef{
+		ToolUseID:             "tool-use",
+		RegistrationToken:     testRegistrationTokenA,
+		ExecutionDeadline:     deadline,
+		ResultStreamExpiresAt: deadline.Add(time.Hour),
+	}, ref)
+	require.NotNil(t, gateway.call)
+	assert.Equal(t, "docs@^1.2", gateway.call.Toolset)
+	assert.Equal(t, "docs.search", gateway.call.Tool)
+	assert.Equal(t, toolregistry.WireProtocolVersion, gateway.call.WireProtocolVersion)
+	require.NotNil(t, gateway.call.Meta.TurnID)
+	assert.Equal(t, "turn", *gateway.call.Meta.TurnID)
+	assert.Nil(t, gateway.call.Meta.ParentToolCallID)
+
+	_, err = client.RetryTool(context.Background(), "docs", "docs.search", []byte(`{}`), meta, testRegistrationTokenA)
+	require.NoError(t, err)
+	require.NotNil(t, gateway.retry)
+	assert.Equal(t, testRegistrationTokenA, gateway.retry.ExpectedRegistrationToken)
+
+	gateway.res.ExecutionDeadline = "soon"
+	_, err = client.CallTool(context.Background(), "docs", "docs.search", []byte(`{}`), meta)
+	require.ErrorContains(t, err, "parse execution deadline")
+}
@@ -0,0 +1,110 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	genregistry "goa.design/goa-ai/registry/gen/registry"
+	"goa.design/goa-ai/runtime/agent/tools"
+	"goa.design/goa-ai/runtime/toolregistry"
+)
+
+type (
+	// GatewayClient is the subset of the generated registry client used to
+	// route tool calls. *genregistry.Client implements it.
+	GatewayClient interface {
+		CallTool(ctx context.Context, p *genregistry.CallToolPayload) (*genregistry.CallToolResult, error)
+		RetryTool(ctx context.Context, p *genregistry.RetryToolPayload) (*genregistry.CallToolResult, error)
+	}
+
+	// RegistryClient adapts a generated registry client to Client. It stamps
+	// the runtime wire protocol version on every request and parses the
+	// admitted call reference.
+	RegistryClient struct {
+		gateway GatewayClient
+	}
+)
+
+// NewRegistryClient returns a Client that routes calls through gateway.
+func NewRegistryClient(gateway GatewayClient) *RegistryClient {
+	return &RegistryClient{gateway: gateway}
+}
+
+// CallTool implements Client.
+func (c *RegistryClient) CallTool(
+	ctx context.Context,
+	toolset string,
+	tool tools.Ident,
+	payload []byte,
+	meta toolregistry.ToolCallMeta,
+) (toolregistry.ToolCallRef, error) {
+	res, err := c.gateway.CallTool(ctx, &genregistry.CallToolPayload{
+		Toolset:             toolset,
+		Tool:                tool.String(),
+		PayloadJSON:         payload,
+		Meta:                gatewayToolCallMeta(meta),
+		WireProtocolVersion: toolregistry.WireProtocolVersion,
+	})
+	if err != nil {
+		return toolregistry.ToolCallRef{}, err
+	}
+	return toolCallRef(res)
+}
+
+// RetryTool implements Client.
+func (c *RegistryClient) RetryTool(
+	ctx context.Context,
+	toolset string,
+	tool tools.Ident,
+	payload []byte,
+	meta toolregistry.ToolCallMeta,
+	expectedRegistrationToken string,
+) (toolregistry.ToolCallRef, error) {
+	res, err := c.gateway.RetryTool(ctx, &genregistry.RetryToolPayload{
+		ExpectedRegistrationToken: expectedRegistrationToken,
+		Toolset:                   toolset,
+		Tool:                      tool.String(),
+		PayloadJSON:               payload,
+		Meta:                      gatewayToolCallMeta(meta),
+		WireProtocolVersion:       toolregistry.WireProtocolVersion,
+	})
+	if err != nil {
+		return toolregistry.ToolCallRef{}, err
+	}
+	return toolCallRef(res)
+}
+
+// gatewayToolCallMeta converts call metadata to the generated registry type.
+func gatewayToolCallMeta(meta toolregistry.ToolCallMeta) *genregistry.ToolCallMeta {
+	out := &genregistry.ToolCallMeta{
+		RunID:      meta.RunID,
+		SessionID:  meta.SessionID,
+		ToolCallID: meta.ToolCallID,
+	}
+	if meta.TurnID != "" {
+		out.TurnID = &meta.TurnID
+	}
+	if meta.ParentToolCallID != "" {
+		out.ParentToolCallID = &meta.ParentToolCallID
+	}
+	return out
+}
+
+// toolCallRef parses the admitted call reference returned by the registry.
+func toolCallRef(res *genregistry.CallToolResult) (toolregistry.ToolCallRef, error) {
+	deadline, err := time.Parse(time.RFC3339Nano, res.ExecutionDeadline)
+	if err != nil {
+		return toolregistry.ToolCallRef{}, fmt.Errorf("parse execution deadline: %w", err)
+	}
+	expiresAt, err := time.Parse(time.RFC3339Nano, res.ResultStreamExpiresAt)
+	if err != nil {
+		return toolregistry.ToolCallRef{}, fmt.Errorf("parse result stream expiration: %w", err)
+	}
+	return toolregistry.ToolCallRef{
+		ToolUseID:             res.ToolUseID,
+		RegistrationToken:     res.RegistrationToken,
+		ExecutionDeadline:     deadline,
+		ResultStreamExpiresAt: expiresAt,
+	}, nil
+}
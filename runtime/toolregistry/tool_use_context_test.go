@@ -16,3 +16,15 @@ func TestToolUseIDContextRoundTrip(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, toolUseID, got)
 }
+
+func TestToolsetRefContextRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := WithToolsetRef(context.Background(), "data-tools", "data-tools@1.2.3")
+	got, ok := ToolsetRefFromContext(ctx, "data-tools")
+	assert.True(t, ok)
+	assert.Equal(t, "data-tools@1.2.3", got)
+
+	_, ok = ToolsetRefFromContext(ctx, "other-tools")
+	assert.False(t, ok)
+}
@@ -0,0 +1,32 @@
+package toolregistry
+
+import "context"
+
+type (
+	toolsetRefContextKey struct{}
+
+	// toolsetRefValue binds a registry toolset reference to the toolset whose
+	// calls it routes.
+	toolsetRefValue struct {
+		toolset string
+		ref     string
+	}
+)
+
+// WithToolsetRef returns a context that routes registry calls for toolset
+// through the reference ref, for example "data-tools@1.2.3". Generated
+// registrations of FromRegistry toolsets that pin a version call it before
+// executing a tool so calls reach the same version DiscoverAndPopulate did.
+func WithToolsetRef(ctx context.Context, toolset, ref string) context.Context {
+	return context.WithValue(ctx, toolsetRefContextKey{}, toolsetRefValue{toolset: toolset, ref: ref})
+}
+
+// ToolsetRefFromContext returns the registry reference set by WithToolsetRef
+// for toolset.
+func ToolsetRefFromContext(ctx context.Context, toolset string) (string, bool) {
+	v, ok := ctx.Value(toolsetRefContextKey{}).(toolsetRefValue)
+	if !ok || v.toolset != toolset || v.ref == "" {
+		return "", false
+	}
+	return v.ref, true
+}
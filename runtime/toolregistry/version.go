@@ -0,0 +1,428 @@
+package toolregistry
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// SemVer is a parsed semantic version in the form accepted by the registry
+	// SemVer design type: MAJOR.MINOR.PATCH with an optional leading "v" and an
+	// optional dot-separated prerelease suffix.
+	SemVer struct {
+		Major      uint64
+		Minor      uint64
+		Patch      uint64
+		Prerelease string
+	}
+
+	// VersionConstraint is a parsed toolset version constraint. The zero value
+	// allows every release version.
+	VersionConstraint struct {
+		raw     string
+		clauses []versionClause
+	}
+
+	// ToolsetRoutes assigns relative traffic weights to concurrently active
+	// versions of one toolset, keyed by canonical version string (see
+	// SemVer.String). Versions absent from a non-empty route table receive no
+	// unpinned traffic.
+	ToolsetRoutes map[string]int
+
+	// versionClause is one comparison in a comma-separated constraint.
+	versionClause struct {
+		op      string
+		version SemVer
+	}
+)
+
+const (
+	// ToolsetVersionSeparator separates a toolset name from its version or
+	// version constraint in registration names and CallTool toolset references
+	// (for example "data-tools@1.2.0" or "data-tools@^1.2").
+	ToolsetVersionSeparator = "@"
+
+	// MaxToolsetRouteWeight bounds a single version weight so route tables
+	// remain readable percentages or per-mille shares.
+	MaxToolsetRouteWeight = 10000
+)
+
+// ParseSemVer parses a semantic version such as "1.2.3", "v1.2.3", or
+// "1.2.3-rc.1".
+func ParseSemVer(s string) (SemVer, error) {
+	v, parts, err := parseVersionParts(s)
+	if err != nil {
+		return SemVer{}, err
+	}
+	if parts != 3 {
+		return SemVer{}, fmt.Errorf("version %q must have MAJOR.MINOR.PATCH components", s)
+	}
+	return v, nil
+}
+
+// String returns the canonical form of v without a leading "v".
+func (v SemVer) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// Compare returns -1, 0, or 1 when v orders before, equal to, or after o
+// using semantic version precedence.
+func (v SemVer) Compare(o SemVer) int {
+	for _, d := range [][2]uint64{{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Patch, o.Patch}} {
+		if d[0] != d[1] {
+			if d[0] < d[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return comparePrerelease(v.Prerelease, o.Prerelease)
+}
+
+// ParseVersionConstraint parses a toolset version constraint. A constraint is
+// a comma-separated list of clauses that must all hold. Each clause is one of:
+//
+//   - "*" or "": any release version
+//   - "1.2.3" or "=1.2.3": exactly that version
+//   - "1" or "1.2": any version with that prefix
+//   - "^1.2.3": compatible versions (>=1.2.3 <2.0.0; <0.3.0 for 0.2.x)
+//   - "~1.2.3": patch releases (>=1.2.3 <1.3.0)
+//   - ">1.2.3", ">=1.2.3", "<2", "<=2.1": ordered comparisons
+//
+// Missing minor or patch components default to zero. Prerelease versions
+// satisfy a constraint only when one of its clauses names a prerelease of the
+// same MAJOR.MINOR.PATCH.
+func ParseVersionConstraint(s string) (VersionConstraint, error) {
+	c := VersionConstraint{raw: strings.TrimSpace(s)}
+	if c.raw == "" {
+		return c, nil
+	}
+	for _, part := range strings.Split(c.raw, ",") {
+		part = strings.TrimSpace(part)
+		switch part {
+		case "":
+			return VersionConstraint{}, fmt.Errorf("version constraint %q has an empty clause", s)
+		case "*":
+			continue
+		}
+		clauses, err := parseVersionClause(part)
+		if err != nil {
+			return VersionConstraint{}, fmt.Errorf("version constraint %q: %w", s, err)
+		}
+		c.clauses = append(c.clauses, clauses...)
+	}
+	return c, nil
+}
+
+// String returns the constraint as written.
+func (c VersionConstraint) String() string {
+	return c.raw
+}
+
+// Exact returns the single version allowed by c when c pins one exact
+// version.
+func (c VersionConstraint) Exact() (SemVer, bool) {
+	if len(c.clauses) != 1 || c.clauses[0].op != "=" {
+		return SemVer{}, false
+	}
+	return c.clauses[0].version, true
+}
+
+// Allows reports whether v satisfies every clause of c.
+func (c VersionConstraint) Allows(v SemVer) bool {
+	if v.Prerelease != "" {
+		named := false
+		for _, cl := range c.clauses {
+			if cl.version.Prerelease != "" &&
+				cl.version.Major == v.Major && cl.version.Minor == v.Minor && cl.version.Patch == v.Patch {
+				named = true
+				break
+			}
+		}
+		if !named {
+			return false
+		}
+	}
+	for _, cl := range c.clauses {
+		cmp := v.Compare(cl.version)
+		var ok bool
+		switch cl.op {
+		case "=":
+			ok = cmp == 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// VersionedToolset returns the registration name a provider uses to admit
+// one version of a toolset side by side with its other versions.
+func VersionedToolset(name string, version SemVer) string {
+	return name + ToolsetVersionSeparator + version.String()
+}
+
+// SplitToolsetRef splits a CallTool or GetToolset toolset reference into the
+// toolset name and its version constraint. References without a separator
+// return an empty constraint.
+func SplitToolsetRef(ref string) (name, constraint string) {
+	i := strings.LastIndex(ref, ToolsetVersionSeparator)
+	if i < 0 {
+		return ref, ""
+	}
+	return ref[:i], ref[i+len(ToolsetVersionSeparator):]
+}
+
+// ValidateToolsetRoutes rejects route tables with non-canonical versions or
+// out-of-range weights.
+func ValidateToolsetRoutes(routes ToolsetRoutes) error {
+	for version, weight := range routes {
+		v, err := ParseSemVer(version)
+		if err != nil {
+			return fmt.Errorf("route version: %w", err)
+		}
+		if v.String() != version {
+			return fmt.Errorf("route version %q must be canonical (%s)", version, v)
+		}
+		if weight < 0 || weight > MaxToolsetRouteWeight {
+			return fmt.Errorf("route weight for %s must be between 0 and %d", version, MaxToolsetRouteWeight)
+		}
+	}
+	return nil
+}
+
+// SelectToolsetVersion picks the version that serves one call among the
+// active versions of a toolset.
+//
+// Exact constraints select their version when it is active. Otherwise, when
+// routes assign a positive weight to at least one version allowed by the
+// constraint, the version is chosen by weighted rendezvous hashing of key so
+// every retry of the same call selects the same version and reweighting only
+// moves the share of calls that must move. When no allowed version carries
+// weight, the highest allowed version is selected so callers whose constraint
+// excludes every weighted version keep working. SelectToolsetVersion returns
+// false when no active version satisfies the constraint.
+func SelectToolsetVersion(
+	active []SemVer,
+	constraint VersionConstraint,
+	routes ToolsetRoutes,
+	key string,
+) (SemVer, bool) {
+	allowed := make([]SemVer, 0, len(active))
+	for _, v := range active {
+		if constraint.Allows(v) {
+			allowed = append(allowed, v)
+		}
+	}
+	if len(allowed) == 0 {
+		return SemVer{}, false
+	}
+	sort.Slice(allowed, func(i, j int) bool { return allowed[i].Compare(allowed[j]) > 0 })
+	if exact, ok := constraint.Exact(); ok {
+		return exact, allowed[0].Compare(exact) == 0
+	}
+	var (
+		best      SemVer
+		bestScore = -1.0
+	)
+	for _, v := range allowed {
+		weight := routes[v.String()]
+		if weight <= 0 {
+			continue
+		}
+		if score := rendezvousScore(key, v.String(), weight); score > bestScore {
+			best, bestScore = v, score
+		}
+	}
+	if bestScore >= 0 {
+		return best, true
+	}
+	return allowed[0], true
+}
+
+// rendezvousScore is the weighted highest-random-weight score of version for
+// key.
+func rendezvousScore(key, version string, weight int) float64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(version))
+	// FNV spreads short, similar inputs poorly across its high bits; apply the
+	// SplitMix64 finalizer before mapping onto (0, 1).
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	u := (float64(x>>11) + 0.5) / (1 << 53)
+	return float64(weight) / -math.Log(u)
+}
+
+// parseVersionClause parses one constraint clause into comparisons.
+func parseVersionClause(s string) ([]versionClause, error) {
+	op := ""
+	for _, candidate := range []string{">=", "<=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(s, candidate) {
+			op = candidate
+			break
+		}
+	}
+	v, parts, err := parseVersionParts(strings.TrimSpace(strings.TrimPrefix(s, op)))
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	case "", "=":
+		if parts == 3 {
+			return []versionClause{{op: "=", version: v}}, nil
+		}
+		return []versionClause{{op: ">=", version: v}, {op: "<", version: bumpVersion(v, parts-1)}}, nil
+	case "^":
+		switch {
+		case v.Major > 0 || parts == 1:
+			return []versionClause{{op: ">=", version: v}, {op: "<", version: bumpVersion(v, 0)}}, nil
+		case v.Minor > 0 || parts == 2:
+			return []versionClause{{op: ">=", version: v}, {op: "<", version: bumpVersion(v, 1)}}, nil
+		default:
+			return []versionClause{{op: ">=", version: v}, {op: "<", version: bumpVersion(v, 2)}}, nil
+		}
+	case "~":
+		if parts == 1 {
+			return []versionClause{{op: ">=", version: v}, {op: "<", version: bumpVersion(v, 0)}}, nil
+		}
+		return []versionClause{{op: ">=", version: v}, {op: "<", version: bumpVersion(v, 1)}}, nil
+	default:
+		return []versionClause{{op: op, version: v}}, nil
+	}
+}
+
+// parseVersionParts parses a full or partial version and returns how many
+// numeric components were present.
+func parseVersionParts(s string) (SemVer, int, error) {
+	body := strings.TrimPrefix(s, "v")
+	if body == "" {
+		return SemVer{}, 0, fmt.Errorf("invalid version %q", s)
+	}
+	var v SemVer
+	if i := strings.IndexByte(body, '-'); i >= 0 {
+		v.Prerelease = body[i+1:]
+		body = body[:i]
+		if err := validatePrerelease(v.Prerelease); err != nil {
+			return SemVer{}, 0, fmt.Errorf("invalid version %q: %w", s, err)
+		}
+	}
+	fields := strings.Split(body, ".")
+	if len(fields) > 3 {
+		return SemVer{}, 0, fmt.Errorf("invalid version %q", s)
+	}
+	if v.Prerelease != "" && len(fields) != 3 {
+		return SemVer{}, 0, fmt.Errorf("invalid version %q: prerelease requires MAJOR.MINOR.PATCH", s)
+	}
+	nums := [3]*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, f := range fields {
+		n, err := parseNumericIdentifier(f)
+		if err != nil {
+			return SemVer{}, 0, fmt.Errorf("invalid version %q: %w", s, err)
+		}
+		*nums[i] = n
+	}
+	return v, len(fields), nil
+}
+
+// parseNumericIdentifier parses a version component without leading zeros.
+func parseNumericIdentifier(s string) (uint64, error) {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return 0, fmt.Errorf("invalid numeric component %q", s)
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// validatePrerelease checks the dot-separated alphanumeric identifiers of a
+// prerelease suffix.
+func validatePrerelease(s string) error {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return fmt.Errorf("empty prerelease identifier")
+		}
+		for _, r := range id {
+			if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+				return fmt.Errorf("invalid prerelease identifier %q", id)
+			}
+		}
+	}
+	return nil
+}
+
+// bumpVersion returns the lowest release after every version sharing v's
+// first component+1 components.
+func bumpVersion(v SemVer, component int) SemVer {
+	switch component {
+	case 0:
+		return SemVer{Major: v.Major + 1}
+	case 1:
+		return SemVer{Major: v.Major, Minor: v.Minor + 1}
+	default:
+		return SemVer{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}
+	}
+}
+
+// comparePrerelease orders prerelease suffixes: a release sorts after any
+// prerelease, numeric identifiers compare numerically and sort before
+// alphanumeric ones.
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.ParseUint(as[i], 10, 64)
+		bn, bErr := strconv.ParseUint(bs[i], 10, 64)
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				if an < bn {
+					return -1
+				}
+				return 1
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+	}
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	}
+	return 0
+}
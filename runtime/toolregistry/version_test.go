@@ -0,0 +1,158 @@
+package toolregistry
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSemVer(t *testing.T) {
+	t.Parallel()
+
+	v, err := ParseSemVer("v1.2.3-rc.1")
+	require.NoError(t, err)
+	require.Equal(t, SemVer{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"}, v)
+	require.Equal(t, "1.2.3-rc.1", v.String())
+
+	for _, invalid := range []string{"", "1.2", "1.2.3.4", "01.2.3", "1.2.x", "1.2.3-", "1.2.3-rc..1"} {
+		_, err := ParseSemVer(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestSemVerCompare(t *testing.T) {
+	t.Parallel()
+
+	ordered := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0", "1.0.1", "1.2.0", "2.0.0"}
+	for i := 1; i < len(ordered); i++ {
+		a, err := ParseSemVer(ordered[i-1])
+		require.NoError(t, err)
+		b, err := ParseSemVer(ordered[i])
+		require.NoError(t, err)
+		require.Equal(t, -1, a.Compare(b), "%s < %s", a, b)
+		require.Equal(t, 1, b.Compare(a), "%s > %s", b, a)
+	}
+}
+
+func TestVersionConstraintAllows(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		constraint string
+		allowed    []string
+		rejected   []string
+	}{
+		{constraint: "", allowed: []string{"0.1.0", "3.4.5"}, rejected: []string{"1.0.0-rc.1"}},
+		{constraint: "*", allowed: []string{"1.0.0"}},
+		{constraint: "1.2.3", allowed: []string{"1.2.3"}, rejected: []string{"1.2.4"}},
+		{constraint: "1", allowed: []string{"1.0.0", "1.9.9"}, rejected: []string{"2.0.0", "0.9.0"}},
+		{constraint: "^1.2", allowed: []string{"1.2.0", "1.9.0"}, rejected: []string{"1.1.9", "2.0.0"}},
+		{constraint: "^0.2.1", allowed: []string{"0.2.1", "0.2.9"}, rejected: []string{"0.3.0"}},
+		{constraint: "^0.0.3", allowed: []string{"0.0.3"}, rejected: []string{"0.0.4"}},
+		{constraint: "~1.2.3", allowed: []string{"1.2.3", "1.2.9"}, rejected: []string{"1.3.0"}},
+		{constraint: ">=1.2, <2", allowed: []string{"1.2.0", "1.99.0"}, rejected: []string{"1.1.0", "2.0.0"}},
+		{constraint: ">=2.0.0-rc.1", allowed: []string{"2.0.0-rc.2", "2.0.0", "2.1.0"}, rejected: []string{"2.1.0-rc.1"}},
+	} {
+		c, err := ParseVersionConstraint(tc.constraint)
+		require.NoError(t, err, tc.constraint)
+		for _, s := range tc.allowed {
+			require.True(t, c.Allows(mustSemVer(t, s)), "%q allows %s", tc.constraint, s)
+		}
+		for _, s := range tc.rejected {
+			require.False(t, c.Allows(mustSemVer(t, s)), "%q rejects %s", tc.constraint, s)
+		}
+	}
+
+	for _, invalid := range []string{"^", ">=1.x", "1.2,", "=>1.0.0"} {
+		_, err := ParseVersionConstraint(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestSplitToolsetRef(t *testing.T) {
+	t.Parallel()
+
+	name, constraint := SplitToolsetRef("data-tools@^1.2")
+	require.Equal(t, "data-tools", name)
+	require.Equal(t, "^1.2", constraint)
+
+	name, constraint = SplitToolsetRef("data-tools")
+	require.Equal(t, "data-tools", name)
+	require.Empty(t, constraint)
+
+	require.Equal(t, "data-tools@1.2.0", VersionedToolset("data-tools", mustSemVer(t, "v1.2.0")))
+}
+
+func TestSelectToolsetVersion(t *testing.T) {
+	t.Parallel()
+
+	active := []SemVer{mustSemVer(t, "1.2.0"), mustSemVer(t, "1.3.0"), mustSemVer(t, "2.0.0")}
+	anyVersion := mustConstraint(t, "")
+
+	t.Run("highest without routes", func(t *testing.T) {
+		v, ok := SelectToolsetVersion(active, anyVersion, nil, "call-1")
+		require.True(t, ok)
+		require.Equal(t, "2.0.0", v.String())
+
+		v, ok = SelectToolsetVersion(active, mustConstraint(t, "^1"), nil, "call-1")
+		require.True(t, ok)
+		require.Equal(t, "1.3.0", v.String())
+	})
+
+	t.Run("exact pins ignore routes", func(t *testing.T) {
+		routes := ToolsetRoutes{"1.3.0": 100}
+		v, ok := SelectToolsetVersion(active, mustConstraint(t, "1.2.0"), routes, "call-1")
+		require.True(t, ok)
+		require.Equal(t, "1.2.0", v.String())
+
+		_, ok = SelectToolsetVersion(active, mustConstraint(t, "1.4.0"), routes, "call-1")
+		require.False(t, ok)
+	})
+
+	t.Run("weighted split is sticky", func(t *testing.T) {
+		routes := ToolsetRoutes{"1.2.0": 90, "1.3.0": 10}
+		counts := map[string]int{}
+		for i := range 2000 {
+			key := fmt.Sprintf("call-%d", i)
+			v, ok := SelectToolsetVersion(active, anyVersion, routes, key)
+			require.True(t, ok)
+			again, _ := SelectToolsetVersion(active, anyVersion, routes, key)
+			require.Equal(t, v, again)
+			counts[v.String()]++
+		}
+		require.Zero(t, counts["2.0.0"], "unweighted versions receive no unpinned traffic")
+		require.InDelta(t, 200, counts["1.3.0"], 60)
+	})
+
+	t.Run("constraint outside weighted versions falls back to highest", func(t *testing.T) {
+		routes := ToolsetRoutes{"1.2.0": 100}
+		v, ok := SelectToolsetVersion(active, mustConstraint(t, "^2"), routes, "call-1")
+		require.True(t, ok)
+		require.Equal(t, "2.0.0", v.String())
+	})
+}
+
+func TestValidateToolsetRoutes(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateToolsetRoutes(ToolsetRoutes{"1.2.0": 0, "1.3.0": MaxToolsetRouteWeight}))
+	require.Error(t, ValidateToolsetRoutes(ToolsetRoutes{"v1.2.0": 10}))
+	require.Error(t, ValidateToolsetRoutes(ToolsetRoutes{"1.2": 10}))
+	require.Error(t, ValidateToolsetRoutes(ToolsetRoutes{"1.2.0": -1}))
+	require.Error(t, ValidateToolsetRoutes(ToolsetRoutes{"1.2.0": MaxToolsetRouteWeight + 1}))
+}
+
+func mustSemVer(t *testing.T, s string) SemVer {
+	t.Helper()
+	v, err := ParseSemVer(s)
+	require.NoError(t, err)
+	return v
+}
+
+func mustConstraint(t *testing.T, s string) VersionConstraint {
+	t.Helper()
+	c, err := ParseVersionConstraint(s)
+	require.NoError(t, err)
+	return c
+}
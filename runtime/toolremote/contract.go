@@ -0,0 +1,133 @@
+package toolremote
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"goa.design/goa-ai/runtime/agent/catalog"
+)
+
+type (
+	// contract is the OpenAPI executor contract of one toolset: a catalogue
+	// document (see package catalog) extended with the HTTP binding of each
+	// tool.
+	contract struct {
+		*catalog.Document
+		Paths map[string]*contractPath `json:"paths"`
+	}
+
+	contractPath struct {
+		Post *contractOperation `json:"post"`
+	}
+
+	contractOperation struct {
+		OperationID string                       `json:"operationId"`
+		Summary     string                       `json:"summary,omitempty"`
+		RequestBody *contractBody                `json:"requestBody"`
+		Responses   map[string]*contractResponse `json:"responses"`
+	}
+
+	contractBody struct {
+		Required bool                      `json:"required"`
+		Content  map[string]map[string]any `json:"content"`
+	}
+
+	contractResponse struct {
+		Description string                    `json:"description"`
+		Content     map[string]map[string]any `json:"content,omitempty"`
+	}
+)
+
+// Component names of the schemas shared by every executor contract.
+const (
+	callMetaSchema = "goa-ai.toolremote.CallMeta"
+	boundsSchema   = "goa-ai.toolremote.Bounds"
+	errorSchema    = "goa-ai.toolremote.Error"
+)
+
+// sharedSchemas are the JSON schemas of CallMeta, Bounds, and Error.
+var sharedSchemas = map[string]string{
+	callMetaSchema: `{"type":"object","required":["run_id","tool_call_id"],"properties":{` +
+		`"run_id":{"type":"string"},"session_id":{"type":"string"},"turn_id":{"type":"string"},` +
+		`"tool_call_id":{"type":"string"},"parent_tool_call_id":{"type":"string"}}}`,
+	boundsSchema: `{"type":"object","required":["returned"],"properties":{` +
+		`"returned":{"type":"integer","minimum":0},"total":{"type":"integer","minimum":0},` +
+		`"truncated":{"type":"boolean"},"next_cursor":{"type":"string"},"refinement_hint":{"type":"string"}}}`,
+	errorSchema: `{"type":"object","required":["kind","message"],"properties":{` +
+		`"kind":{"enum":["invalid_call","domain_rejection","unavailable","rate_limited","timeout","malformed_result","internal"]},` +
+		`"message":{"type":"string"},"recovery":{"enum":["correct_call","replan","finish"]}}}`,
+}
+
+// Contract returns the OpenAPI 3.1 executor contract of a toolset. The
+// document is the catalogue of the toolset extended with one
+// `POST /tools/{tool}` operation per tool whose request and response bodies
+// are the JSON forms of Request and Response with the tool payload and
+// result schemas inlined by reference. goa gen emits the contract of every
+// local toolset to `remote/openapi.json` next to its specs package.
+func Contract(src catalog.ToolsetSource) ([]byte, error) {
+	doc := catalog.New(catalog.Info{
+		Title:       src.ID + " executor",
+		Version:     "1.0.0",
+		Description: src.Description,
+	})
+	if err := doc.AddToolset(src); err != nil {
+		return nil, fmt.Errorf("toolremote: contract for %q: %w", src.ID, err)
+	}
+	for name, schema := range sharedSchemas {
+		doc.Components.Schemas[name] = json.RawMessage(schema)
+	}
+	out := contract{Document: doc, Paths: make(map[string]*contractPath)}
+	for _, ts := range doc.Toolsets {
+		for _, tool := range ts.Tools {
+			req := map[string]any{
+				"toolset": map[string]any{"const": ts.ID},
+				"tool":    map[string]any{"const": tool.ID},
+				"meta":    schemaRef(callMetaSchema),
+			}
+			if tool.Payload != nil {
+				req["payload"] = schemaRef(catalog.SchemaKey(tool.Payload.Ref))
+			}
+			resp := map[string]any{
+				"bounds": schemaRef(boundsSchema),
+				"error":  schemaRef(errorSchema),
+			}
+			if tool.Result != nil {
+				resp["result"] = schemaRef(catalog.SchemaKey(tool.Result.Ref))
+			}
+			out.Paths["/tools/"+tool.ID] = &contractPath{Post: &contractOperation{
+				OperationID: tool.ID,
+				Summary:     tool.Description,
+				RequestBody: &contractBody{
+					Required: true,
+					Content: jsonContent(map[string]any{
+						"type":       "object",
+						"required":   []string{"toolset", "tool", "meta"},
+						"properties": req,
+					}),
+				},
+				Responses: map[string]*contractResponse{
+					"200": {
+						Description: "Tool result or classified tool failure.",
+						Content: jsonContent(map[string]any{
+							"type":       "object",
+							"properties": resp,
+						}),
+					},
+					"400": {Description: "Malformed request; the tool did not run."},
+					"404": {Description: "Tool not served; the tool did not run."},
+					"429": {Description: "Call throttled; the tool did not run."},
+					"503": {Description: "Executor unavailable; the tool did not run."},
+				},
+			}}
+		}
+	}
+	return json.MarshalIndent(out, "", "  ")
+}
+
+func schemaRef(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+func jsonContent(schema map[string]any) map[string]map[string]any {
+	return map[string]map[string]any{"application/json": {"schema": schema}}
+}
@@ -0,0 +1,243 @@
+// Package executor adapts a toolremote.Transport to a runtime.ToolCallExecutor
+// so local toolsets can be implemented by out-of-process executors.
+//
+// The executor validates each payload with the generated codecs before it
+// leaves the process, decodes and validates the returned result the same way,
+// and maps executor and transport failures onto planner.ToolFailure.
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"goa.design/goa-ai/runtime/agent"
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/runtime"
+	"goa.design/goa-ai/runtime/agent/tools"
+	"goa.design/goa-ai/runtime/toolremote"
+)
+
+type (
+	// SpecLookup resolves tool specifications for payload and result codecs.
+	// Generated specs packages implement it with their Spec function (see
+	// SpecFunc).
+	SpecLookup interface {
+		Spec(name tools.Ident) (*tools.ToolSpec, bool)
+	}
+
+	// SpecFunc adapts a function to the SpecLookup interface.
+	SpecFunc func(name tools.Ident) (*tools.ToolSpec, bool)
+
+	// Executor forwards tool calls to an out-of-process executor.
+	Executor struct {
+		transport toolremote.Transport
+		specs     SpecLookup
+	}
+)
+
+// Spec calls f(name).
+func (f SpecFunc) Spec(name tools.Ident) (*tools.ToolSpec, bool) {
+	return f(name)
+}
+
+// New returns an executor forwarding the tools described by specs over
+// transport. Register it with the generated toolset registration, for example:
+//
+//	exec := executor.New(toolremote.NewHTTPTransport(url), executor.SpecFunc(orders.Spec))
+//	reg := agent.NewOrdersToolsetRegistration(exec)
+func New(transport toolremote.Transport, specs SpecLookup) *Executor {
+	return &Executor{transport: transport, specs: specs}
+}
+
+// Execute implements runtime.ToolCallExecutor.
+func (e *Executor) Execute(ctx context.Context, meta *runtime.ToolCallMeta, call *planner.ToolRequest) (*runtime.ToolExecutionResult, error) {
+	if call == nil {
+		return runtime.Executed(failureResult("", "", planner.FailureInternal, planner.RecoveryFinish, errors.New("tool request is nil"))), nil
+	}
+	if meta == nil {
+		return runtime.Executed(failureResult(call.Name, "", planner.FailureInternal, planner.RecoveryFinish, errors.New("tool call meta is nil"))), nil
+	}
+	if e.transport == nil || e.specs == nil {
+		return runtime.Executed(failureResult(call.Name, meta.ToolCallID, planner.FailureInternal, planner.RecoveryFinish, errors.New("remote executor is not configured"))), nil
+	}
+	spec, ok := e.specs.Spec(call.Name)
+	if !ok {
+		return runtime.Executed(failureResult(call.Name, meta.ToolCallID, planner.FailureInvalidCall, planner.RecoveryReplan, fmt.Errorf("unknown tool %q", call.Name))), nil
+	}
+	if dec := spec.Payload.Codec.FromJSON; dec != nil {
+		if _, err := dec(call.Payload); err != nil {
+			return runtime.Executed(correctCallResult(spec, call, meta.ToolCallID, err)), nil
+		}
+	}
+	resp, err := e.transport.Execute(ctx, &toolremote.Request{
+		Toolset: spec.Toolset,
+		Tool:    call.Name.String(),
+		Payload: append([]byte(nil), call.Payload...),
+		Meta: toolremote.CallMeta{
+			RunID:            meta.RunID,
+			SessionID:        meta.SessionID,
+			TurnID:           meta.TurnID,
+			ToolCallID:       meta.ToolCallID,
+			ParentToolCallID: meta.ParentToolCallID,
+		},
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return transportFailure(spec, call, meta.ToolCallID, err)
+	}
+	if resp == nil {
+		return transportFailure(spec, call, meta.ToolCallID, errors.New("remote executor returned no response"))
+	}
+	if resp.Error != nil {
+		return runtime.Executed(&planner.ToolResult{
+			Name:       call.Name,
+			ToolCallID: meta.ToolCallID,
+			Failure:    remoteFailure(spec, call, resp.Error),
+		}), nil
+	}
+	out := &planner.ToolResult{
+		Name:       call.Name,
+		ToolCallID: meta.ToolCallID,
+		Bounds:     bounds(resp.Bounds),
+	}
+	if dec := spec.Result.Codec.FromJSON; dec != nil {
+		res, err := dec(resp.Result)
+		if err != nil {
+			return runtime.Executed(failureResult(call.Name, meta.ToolCallID, planner.FailureMalformedResult, planner.RecoveryFinish,
+				fmt.Errorf("remote result for %q did not match the tool result schema: %w", call.Name, err))), nil
+		}
+		out.Result = res
+	}
+	return runtime.Executed(out), nil
+}
+
+// transportFailure classifies a call that produced no executor response.
+// Rejected calls never ran the tool. Other failures leave the outcome
+// unknown: calls to idempotent tools fail the activity attempt so the engine
+// retry policy repeats them, and other calls finish the run rather than risk
+// repeating a side effect.
+func transportFailure(spec *tools.ToolSpec, call *planner.ToolRequest, toolCallID string, err error) (*runtime.ToolExecutionResult, error) {
+	var rejected *toolremote.RejectedError
+	if errors.As(err, &rejected) {
+		switch rejected.Reason {
+		case toolremote.RejectRateLimited:
+			return runtime.Executed(failureResult(call.Name, toolCallID, planner.FailureRateLimited, planner.RecoveryReplan, err)), nil
+		case toolremote.RejectInvalid:
+			return runtime.Executed(failureResult(call.Name, toolCallID, planner.FailureInternal, planner.RecoveryFinish,
+				fmt.Errorf("remote executor rejected a codec-validated tool call: %w", err))), nil
+		default:
+			return runtime.Executed(failureResult(call.Name, toolCallID, planner.FailureUnavailable, planner.RecoveryReplan, err)), nil
+		}
+	}
+	if spec.Idempotent && !spec.Destructive {
+		return nil, fmt.Errorf("remote tool %q: %w", call.Name, err)
+	}
+	kind := planner.FailureInternal
+	if errors.Is(err, context.DeadlineExceeded) {
+		kind = planner.FailureTimeout
+	}
+	return runtime.Executed(failureResult(call.Name, toolCallID, kind, planner.RecoveryFinish,
+		fmt.Errorf("tool execution outcome is unknown; do not retry or issue a replacement call because the effect may have occurred: %w", err))), nil
+}
+
+// remoteFailure converts a failure reported by the executor. Unknown kinds or
+// recoveries violate the contract and finish the run.
+func remoteFailure(spec *tools.ToolSpec, call *planner.ToolRequest, e *toolremote.Error) *planner.ToolFailure {
+	kind := planner.FailureKind(e.Kind)
+	action, ok := defaultRecovery(kind)
+	if !ok {
+		return &planner.ToolFailure{
+			Kind:     planner.FailureMalformedResult,
+			Error:    planner.NewToolError(fmt.Sprintf("remote executor reported unknown failure kind %q: %s", e.Kind, e.Message)),
+			Recovery: planner.RecoveryDirective{Action: planner.RecoveryFinish},
+		}
+	}
+	if e.Recovery != "" {
+		switch a := planner.RecoveryAction(e.Recovery); a {
+		case planner.RecoveryCorrectCall, planner.RecoveryReplan, planner.RecoveryFinish:
+			action = a
+		default:
+			return &planner.ToolFailure{
+				Kind:     planner.FailureMalformedResult,
+				Error:    planner.NewToolError(fmt.Sprintf("remote executor reported unknown recovery %q: %s", e.Recovery, e.Message)),
+				Recovery: planner.RecoveryDirective{Action: planner.RecoveryFinish},
+			}
+		}
+	}
+	failure := &planner.ToolFailure{
+		Kind:     kind,
+		Error:    planner.NewToolError(e.Message),
+		Recovery: planner.RecoveryDirective{Action: action},
+	}
+	if action == planner.RecoveryCorrectCall {
+		failure.Recovery.PriorInput = append(rawjson.Message(nil), call.Payload...)
+		failure.Recovery.ExampleJSON = append(rawjson.Message(nil), spec.Payload.ExampleJSON...)
+	}
+	return failure
+}
+
+// defaultRecovery returns the recovery applied to kind when the executor does
+// not override it. It reports false for unknown kinds.
+func defaultRecovery(kind planner.FailureKind) (planner.RecoveryAction, bool) {
+	switch kind {
+	case planner.FailureInvalidCall:
+		return planner.RecoveryCorrectCall, true
+	case planner.FailureDomainRejection, planner.FailureUnavailable, planner.FailureRateLimited:
+		return planner.RecoveryReplan, true
+	case planner.FailureTimeout, planner.FailureMalformedResult, planner.FailureInternal:
+		return planner.RecoveryFinish, true
+	default:
+		return "", false
+	}
+}
+
+// correctCallResult rejects a payload that fails generated validation before
+// it is forwarded.
+func correctCallResult(spec *tools.ToolSpec, call *planner.ToolRequest, toolCallID string, err error) *planner.ToolResult {
+	return &planner.ToolResult{
+		Name:       call.Name,
+		ToolCallID: toolCallID,
+		Failure: &planner.ToolFailure{
+			Kind:  planner.FailureInvalidCall,
+			Error: planner.ToolErrorFromError(err),
+			Recovery: planner.RecoveryDirective{
+				Action:      planner.RecoveryCorrectCall,
+				PriorInput:  append(rawjson.Message(nil), call.Payload...),
+				ExampleJSON: append(rawjson.Message(nil), spec.Payload.ExampleJSON...),
+			},
+		},
+	}
+}
+
+// failureResult constructs a classified tool failure.
+func failureResult(name tools.Ident, toolCallID string, kind planner.FailureKind, action planner.RecoveryAction, err error) *planner.ToolResult {
+	return &planner.ToolResult{
+		Name:       name,
+		ToolCallID: toolCallID,
+		Failure: &planner.ToolFailure{
+			Kind:  kind,
+			Error: planner.ToolErrorFromError(err),
+			Recovery: planner.RecoveryDirective{
+				Action: action,
+			},
+		},
+	}
+}
+
+// bounds converts wire bounds into the runtime representation.
+func bounds(b *toolremote.Bounds) *agent.Bounds {
+	if b == nil {
+		return nil
+	}
+	return agent.CloneBounds(&agent.Bounds{
+		Returned:       b.Returned,
+		Total:          b.Total,
+		Truncated:      b.Truncated,
+		NextCursor:     b.NextCursor,
+		RefinementHint: b.RefinementHint,
+	})
+}
@@ -0,0 +1,186 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"goa.design/goa-ai/runtime/agent/planner"
+	"goa.design/goa-ai/runtime/agent/rawjson"
+	"goa.design/goa-ai/runtime/agent/runtime"
+	"goa.design/goa-ai/runtime/agent/tools"
+	"goa.design/goa-ai/runtime/toolremote"
+)
+
+type transportFunc func(ctx context.Context, req *toolremote.Request) (*toolremote.Response, error)
+
+func (f transportFunc) Execute(ctx context.Context, req *toolremote.Request) (*toolremote.Response, error) {
+	return f(ctx, req)
+}
+
+type submitResult struct {
+	Status string `json:"status"`
+}
+
+func testSpec(idempotent bool) *tools.ToolSpec {
+	return &tools.ToolSpec{
+		Name:       "orders.submit",
+		Toolset:    "orders",
+		Idempotent: idempotent,
+		Payload: tools.TypeSpec{
+			Name:        "SubmitPayload",
+			ExampleJSON: tools.RawJSON(`{"id":"o-1"}`),
+			Codec: tools.JSONCodec[any]{FromJSON: func(data []byte) (any, error) {
+				var v struct {
+					ID string `json:"id"`
+				}
+				if err := json.Unmarshal(data, &v); err != nil {
+					return nil, err
+				}
+				if v.ID == "" {
+					return nil, errors.New("id is required")
+				}
+				return &v, nil
+			}},
+		},
+		Result: tools.TypeSpec{
+			Name: "SubmitResult",
+			Codec: tools.JSONCodec[any]{FromJSON: func(data []byte) (any, error) {
+				var v submitResult
+				if err := json.Unmarshal(data, &v); err != nil {
+					return nil, err
+				}
+				if v.Status == "" {
+					return nil, errors.New("status is required")
+				}
+				return &v, nil
+			}},
+		},
+	}
+}
+
+func newTestExecutor(spec *tools.ToolSpec, tr toolremote.Transport) *Executor {
+	return New(tr, SpecFunc(func(name tools.Ident) (*tools.ToolSpec, bool) {
+		if name != spec.Name {
+			return nil, false
+		}
+		return spec, true
+	}))
+}
+
+func execute(t *testing.T, e *Executor, payload string) (*runtime.ToolExecutionResult, error) {
+	t.Helper()
+	return e.Execute(context.Background(),
+		&runtime.ToolCallMeta{RunID: "run-1", SessionID: "sess-1", ToolCallID: "call-1"},
+		&planner.ToolRequest{Name: "orders.submit", Payload: rawjson.Message(payload)},
+	)
+}
+
+func TestExecuteForwardsCallAndDecodesResult(t *testing.T) {
+	var got *toolremote.Request
+	total := 4
+	e := newTestExecutor(testSpec(false), transportFunc(func(_ context.Context, req *toolremote.Request) (*toolremote.Response, error) {
+		got = req
+		return &toolremote.Response{
+			Result: json.RawMessage(`{"status":"ok"}`),
+			Bounds: &toolremote.Bounds{Returned: 1, Total: &total, Truncated: true},
+		}, nil
+	}))
+
+	out, err := execute(t, e, `{"id":"o-1"}`)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "orders", got.Toolset)
+	assert.Equal(t, "orders.submit", got.Tool)
+	assert.JSONEq(t, `{"id":"o-1"}`, string(got.Payload))
+	assert.Equal(t, toolremote.CallMeta{RunID: "run-1", SessionID: "sess-1", ToolCallID: "call-1"}, got.Meta)
+	require.Nil(t, out.ToolResult.Failure)
+	assert.Equal(t, &submitResult{Status: "ok"}, out.ToolResult.Result)
+	assert.Equal(t, "call-1", out.ToolResult.ToolCallID)
+	require.NotNil(t, out.ToolResult.Bounds)
+	assert.Equal(t, 4, *out.ToolResult.Bounds.Total)
+}
+
+func TestExecuteValidatesPayloadBeforeForwarding(t *testing.T) {
+	e := newTestExecutor(testSpec(false), transportFunc(func(context.Context, *toolremote.Request) (*toolremote.Response, error) {
+		t.Fatal("invalid payload must not be forwarded")
+		return nil, nil
+	}))
+
+	out, err := execute(t, e, `{}`)
+	require.NoError(t, err)
+	failure := out.ToolResult.Failure
+	require.NotNil(t, failure)
+	assert.Equal(t, planner.FailureInvalidCall, failure.Kind)
+	assert.Equal(t, planner.RecoveryCorrectCall, failure.Recovery.Action)
+	assert.JSONEq(t, `{}`, string(failure.Recovery.PriorInput))
+	assert.JSONEq(t, `{"id":"o-1"}`, string(failure.Recovery.ExampleJSON))
+}
+
+func TestExecuteMapsRemoteFailures(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    *toolremote.Error
+		kind   planner.FailureKind
+		action planner.RecoveryAction
+	}{
+		{"default recovery", &toolremote.Error{Kind: "domain_rejection", Message: "cart empty"}, planner.FailureDomainRejection, planner.RecoveryReplan},
+		{"override", &toolremote.Error{Kind: "domain_rejection", Message: "cart empty", Recovery: "finish"}, planner.FailureDomainRejection, planner.RecoveryFinish},
+		{"correct call", &toolremote.Error{Kind: "invalid_call", Message: "bad sku"}, planner.FailureInvalidCall, planner.RecoveryCorrectCall},
+		{"unknown kind", &toolremote.Error{Kind: "oops", Message: "?"}, planner.FailureMalformedResult, planner.RecoveryFinish},
+		{"unknown recovery", &toolremote.Error{Kind: "internal", Message: "?", Recovery: "retry"}, planner.FailureMalformedResult, planner.RecoveryFinish},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := newTestExecutor(testSpec(false), transportFunc(func(context.Context, *toolremote.Request) (*toolremote.Response, error) {
+				return &toolremote.Response{Error: c.err}, nil
+			}))
+			out, err := execute(t, e, `{"id":"o-1"}`)
+			require.NoError(t, err)
+			failure := out.ToolResult.Failure
+			require.NotNil(t, failure)
+			assert.Equal(t, c.kind, failure.Kind)
+			assert.Equal(t, c.action, failure.Recovery.Action)
+			if c.action == planner.RecoveryCorrectCall {
+				assert.JSONEq(t, `{"id":"o-1"}`, string(failure.Recovery.PriorInput))
+			}
+		})
+	}
+}
+
+func TestExecuteRejectsMalformedResult(t *testing.T) {
+	e := newTestExecutor(testSpec(false), transportFunc(func(context.Context, *toolremote.Request) (*toolremote.Response, error) {
+		return &toolremote.Response{Result: json.RawMessage(`{}`)}, nil
+	}))
+
+	out, err := execute(t, e, `{"id":"o-1"}`)
+	require.NoError(t, err)
+	require.NotNil(t, out.ToolResult.Failure)
+	assert.Equal(t, planner.FailureMalformedResult, out.ToolResult.Failure.Kind)
+	assert.Nil(t, out.ToolResult.Result)
+}
+
+func TestExecuteClassifiesTransportFailures(t *testing.T) {
+	fail := func(err error) toolremote.Transport {
+		return transportFunc(func(context.Context, *toolremote.Request) (*toolremote.Response, error) {
+			return nil, err
+		})
+	}
+
+	out, err := execute(t, newTestExecutor(testSpec(false), fail(&toolremote.RejectedError{Reason: toolremote.RejectRateLimited, Err: errors.New("429")})), `{"id":"o-1"}`)
+	require.NoError(t, err)
+	assert.Equal(t, planner.FailureRateLimited, out.ToolResult.Failure.Kind)
+	assert.Equal(t, planner.RecoveryReplan, out.ToolResult.Failure.Recovery.Action)
+
+	out, err = execute(t, newTestExecutor(testSpec(false), fail(errors.New("connection reset"))), `{"id":"o-1"}`)
+	require.NoError(t, err)
+	assert.Equal(t, planner.FailureInternal, out.ToolResult.Failure.Kind)
+	assert.Equal(t, planner.RecoveryFinish, out.ToolResult.Failure.Recovery.Action)
+
+	_, err = execute(t, newTestExecutor(testSpec(true), fail(errors.New("connection reset"))), `{"id":"o-1"}`)
+	require.ErrorContains(t, err, "connection reset")
+}
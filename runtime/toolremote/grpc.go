@@ -0,0 +1,107 @@
+package toolremote
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goa.design/goa-ai/runtime/toolremote/toolremotepb"
+)
+
+type (
+	// GRPCTransport forwards tool calls to an executor implementing the
+	// ToolExecutor service of toolremote.proto. Go executors serve it with
+	// toolremotepb.RegisterToolExecutorServer.
+	GRPCTransport struct {
+		client toolremotepb.ToolExecutorClient
+	}
+)
+
+// GRPCExecuteMethod is the full gRPC method name of ToolExecutor.Execute.
+const GRPCExecuteMethod = toolremotepb.ToolExecutor_Execute_FullMethodName
+
+// NewGRPCTransport returns a transport that calls ToolExecutor.Execute on
+// conn. Configure TLS and per-RPC credentials on the connection.
+func NewGRPCTransport(conn grpc.ClientConnInterface) *GRPCTransport {
+	return &GRPCTransport{client: toolremotepb.NewToolExecutorClient(conn)}
+}
+
+// Execute implements Transport.
+func (t *GRPCTransport) Execute(ctx context.Context, req *Request) (*Response, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	resp, err := t.client.Execute(ctx, requestToProto(req))
+	if err != nil {
+		code := status.Code(err)
+		err = fmt.Errorf("toolremote: %s: %w", GRPCExecuteMethod, err)
+		if reason, ok := grpcRejectReason(code); ok {
+			return nil, &RejectedError{Reason: reason, Err: err}
+		}
+		return nil, err
+	}
+	return responseFromProto(resp), nil
+}
+
+// grpcRejectReason classifies the status codes the contract reserves for
+// calls refused before the tool runs.
+func grpcRejectReason(code codes.Code) (RejectReason, bool) {
+	switch code {
+	case codes.Unimplemented, codes.NotFound:
+		return RejectUnavailable, true
+	case codes.ResourceExhausted:
+		return RejectRateLimited, true
+	case codes.InvalidArgument:
+		return RejectInvalid, true
+	default:
+		return "", false
+	}
+}
+
+// requestToProto converts req to its toolremote.proto message.
+func requestToProto(req *Request) *toolremotepb.ExecuteRequest {
+	return &toolremotepb.ExecuteRequest{
+		Toolset: req.Toolset,
+		Tool:    req.Tool,
+		Payload: req.Payload,
+		Meta: &toolremotepb.CallMeta{
+			RunId:            req.Meta.RunID,
+			SessionId:        req.Meta.SessionID,
+			TurnId:           req.Meta.TurnID,
+			ToolCallId:       req.Meta.ToolCallID,
+			ParentToolCallId: req.Meta.ParentToolCallID,
+		},
+	}
+}
+
+// responseFromProto converts a toolremote.proto response message to a
+// Response.
+func responseFromProto(m *toolremotepb.ExecuteResponse) *Response {
+	resp := &Response{}
+	if len(m.GetResult()) > 0 {
+		resp.Result = m.GetResult()
+	}
+	if b := m.GetBounds(); b != nil {
+		resp.Bounds = &Bounds{
+			Returned:       int(b.GetReturned()),
+			Truncated:      b.GetTruncated(),
+			NextCursor:     b.NextCursor,
+			RefinementHint: b.GetRefinementHint(),
+		}
+		if b.Total != nil {
+			total := int(*b.Total)
+			resp.Bounds.Total = &total
+		}
+	}
+	if e := m.GetError(); e != nil {
+		resp.Error = &Error{
+			Kind:     e.GetKind(),
+			Message:  e.GetMessage(),
+			Recovery: e.GetRecovery(),
+		}
+	}
+	return resp
+}
@@ -0,0 +1,123 @@
+package toolremote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type (
+	// HTTPTransport forwards tool calls to an executor exposing the HTTP/JSON
+	// binding of the contract: each call is a POST of the JSON Request to
+	// `<base>/tools/<tool>` answered by a 200 response with the JSON Response.
+	HTTPTransport struct {
+		base   string
+		client Doer
+		header http.Header
+	}
+
+	// Doer is the HTTP client interface used by HTTPTransport. *http.Client
+	// implements it.
+	Doer interface {
+		Do(*http.Request) (*http.Response, error)
+	}
+
+	// HTTPOption configures an HTTPTransport.
+	HTTPOption func(*HTTPTransport)
+)
+
+// maxErrorBody bounds how much of a non-200 response body is kept in errors.
+const maxErrorBody = 4 << 10
+
+// WithHTTPClient sets the client used to send requests. It defaults to
+// http.DefaultClient. Configure TLS and authentication on the client.
+func WithHTTPClient(client Doer) HTTPOption {
+	return func(t *HTTPTransport) {
+		t.client = client
+	}
+}
+
+// WithHeader adds a header sent with every request.
+func WithHeader(key, value string) HTTPOption {
+	return func(t *HTTPTransport) {
+		t.header.Add(key, value)
+	}
+}
+
+// NewHTTPTransport returns a transport that posts tool calls to the executor
+// rooted at baseURL, for example "http://tools.internal:8080/v1".
+func NewHTTPTransport(baseURL string, opts ...HTTPOption) *HTTPTransport {
+	t := &HTTPTransport{
+		base:   strings.TrimRight(baseURL, "/"),
+		client: http.DefaultClient,
+		header: make(http.Header),
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(t)
+		}
+	}
+	return t
+}
+
+// Execute implements Transport.
+func (t *HTTPTransport) Execute(ctx context.Context, req *Request) (*Response, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("toolremote: encode request: %w", err)
+	}
+	endpoint := t.base + "/tools/" + url.PathEscape(req.Tool)
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("toolremote: build request: %w", err)
+	}
+	for k, vs := range t.header {
+		for _, v := range vs {
+			hreq.Header.Add(k, v)
+		}
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+	hreq.Header.Set("Accept", "application/json")
+	hresp, err := t.client.Do(hreq)
+	if err != nil {
+		return nil, fmt.Errorf("toolremote: POST %s: %w", endpoint, err)
+	}
+	defer func() { _ = hresp.Body.Close() }()
+	if hresp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(hresp.Body, maxErrorBody))
+		err := fmt.Errorf("toolremote: POST %s: %s: %s", endpoint, hresp.Status, bytes.TrimSpace(msg))
+		if reason, ok := httpRejectReason(hresp.StatusCode); ok {
+			return nil, &RejectedError{Reason: reason, Err: err}
+		}
+		return nil, err
+	}
+	var resp Response
+	if err := json.NewDecoder(hresp.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("toolremote: decode response of %s: %w", endpoint, err)
+	}
+	return &resp, nil
+}
+
+// httpRejectReason classifies the status codes that prove the executor did
+// not run the tool. Other failures, including most 5xx responses, may follow
+// tool execution.
+func httpRejectReason(status int) (RejectReason, bool) {
+	switch status {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented, http.StatusServiceUnavailable:
+		return RejectUnavailable, true
+	case http.StatusTooManyRequests:
+		return RejectRateLimited, true
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity:
+		return RejectInvalid, true
+	default:
+		return "", false
+	}
+}
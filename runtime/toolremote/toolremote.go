@@ -0,0 +1,147 @@
+// Package toolremote defines the language-neutral contract used to run tool
+// implementations out of process, for example in a Python service.
+//
+// The runtime forwards each tool call as a Request and expects a Response
+// carrying either the tool result JSON or a classified Error. Payload and
+// result documents are the canonical JSON of the generated tool types; the
+// per-toolset OpenAPI contract emitted by goa gen (see Contract) describes
+// their schemas. Requests travel over HTTP/JSON (HTTPTransport) or gRPC
+// (GRPCTransport, see toolremote.proto and its generated stubs in package
+// toolremotepb). Package executor adapts a Transport to a
+// runtime.ToolCallExecutor.
+package toolremote
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+type (
+	// Transport delivers tool calls to an out-of-process executor.
+	//
+	// Execute returns the executor Response, or an error when no response was
+	// received. Transports return a *RejectedError when the executor
+	// definitively did not run the tool; any other error leaves the outcome of
+	// the call unknown.
+	Transport interface {
+		Execute(ctx context.Context, req *Request) (*Response, error)
+	}
+
+	// Request is one tool invocation forwarded to an executor.
+	Request struct {
+		// Toolset is the qualified toolset name.
+		Toolset string `json:"toolset"`
+		// Tool is the globally unique tool identifier (`toolset.tool`).
+		Tool string `json:"tool"`
+		// Payload is the canonical JSON payload, validated against the tool
+		// payload schema before it is sent.
+		Payload json.RawMessage `json:"payload,omitempty"`
+		// Meta carries the run-scoped identifiers of the call.
+		Meta CallMeta `json:"meta"`
+	}
+
+	// CallMeta carries the run-scoped identifiers of a tool call.
+	CallMeta struct {
+		// RunID identifies the run that owns the call.
+		RunID string `json:"run_id"`
+		// SessionID identifies the session of the run.
+		SessionID string `json:"session_id,omitempty"`
+		// TurnID identifies the conversational turn of the call.
+		TurnID string `json:"turn_id,omitempty"`
+		// ToolCallID uniquely identifies the call.
+		ToolCallID string `json:"tool_call_id"`
+		// ParentToolCallID identifies the parent call of nested calls.
+		ParentToolCallID string `json:"parent_tool_call_id,omitempty"`
+	}
+
+	// Response is the outcome of a tool invocation. Exactly one of Result or
+	// Error is meaningful: a non-nil Error reports a failed call.
+	Response struct {
+		// Result is the canonical JSON result. It is validated against the
+		// tool result schema by the caller.
+		Result json.RawMessage `json:"result,omitempty"`
+		// Bounds describes how the result was bounded, if at all.
+		Bounds *Bounds `json:"bounds,omitempty"`
+		// Error reports a failed call.
+		Error *Error `json:"error,omitempty"`
+	}
+
+	// Bounds is the wire form of bounded-result metadata.
+	Bounds struct {
+		// Returned is the number of items in the bounded view.
+		Returned int `json:"returned"`
+		// Total is the total number of items before truncation, if known.
+		Total *int `json:"total,omitempty"`
+		// Truncated reports whether any cap was applied.
+		Truncated bool `json:"truncated,omitempty"`
+		// NextCursor is the opaque cursor of the next page, if any.
+		NextCursor *string `json:"next_cursor,omitempty"`
+		// RefinementHint suggests how to narrow the query.
+		RefinementHint string `json:"refinement_hint,omitempty"`
+	}
+
+	// Error is a classified tool failure reported by an executor.
+	Error struct {
+		// Kind classifies the failure. It must be one of the planner failure
+		// kinds: invalid_call, domain_rejection, unavailable, rate_limited,
+		// timeout, malformed_result, or internal.
+		Kind string `json:"kind"`
+		// Message describes the failure to the planner.
+		Message string `json:"message"`
+		// Recovery optionally overrides the default recovery of Kind: one of
+		// correct_call, replan, or finish.
+		Recovery string `json:"recovery,omitempty"`
+	}
+
+	// RejectedError reports a call the executor endpoint refused before
+	// running the tool, for example because it does not serve the tool or is
+	// shedding load. Repeating such a call cannot duplicate a side effect.
+	RejectedError struct {
+		// Reason classifies the rejection.
+		Reason RejectReason
+		// Err is the underlying transport error.
+		Err error
+	}
+
+	// RejectReason classifies a RejectedError.
+	RejectReason string
+)
+
+const (
+	// RejectUnavailable means the endpoint does not serve the tool or is
+	// unavailable.
+	RejectUnavailable RejectReason = "unavailable"
+	// RejectRateLimited means the endpoint throttled the call.
+	RejectRateLimited RejectReason = "rate_limited"
+	// RejectInvalid means the endpoint rejected the request as malformed.
+	RejectInvalid RejectReason = "invalid"
+)
+
+// Validate reports an error if req is missing routing information.
+func (req *Request) Validate() error {
+	if req == nil {
+		return errors.New("toolremote: request is nil")
+	}
+	if req.Toolset == "" {
+		return errors.New("toolremote: request toolset is required")
+	}
+	if req.Tool == "" {
+		return errors.New("toolremote: request tool is required")
+	}
+	if req.Meta.ToolCallID == "" {
+		return errors.New("toolremote: request tool call id is required")
+	}
+	return nil
+}
+
+// Error implements error.
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("toolremote: call rejected (%s): %v", e.Reason, e.Err)
+}
+
+// Unwrap returns the underlying transport error.
+func (e *RejectedError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,228 @@
+package toolremote
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"goa.design/goa-ai/runtime/agent/catalog"
+	"goa.design/goa-ai/runtime/agent/tools"
+	"goa.design/goa-ai/runtime/toolremote/toolremotepb"
+)
+
+func testRequest() *Request {
+	return &Request{
+		Toolset: "orders",
+		Tool:    "orders.submit",
+		Payload: json.RawMessage(`{"id":"o-1"}`),
+		Meta: CallMeta{
+			RunID:      "run-1",
+			SessionID:  "sess-1",
+			TurnID:     "turn-1",
+			ToolCallID: "call-1",
+		},
+	}
+}
+
+func TestHTTPTransportRoundTrip(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/tools/orders.submit", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("X-Token"))
+		var req Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, *testRequest(), req)
+		_, _ = w.Write([]byte(`{"result":{"status":"ok"},"bounds":{"returned":2,"truncated":true}}`))
+	}))
+	defer srv.Close()
+
+	tr := NewHTTPTransport(srv.URL+"/v1/", WithHeader("X-Token", "secret"))
+	resp, err := tr.Execute(context.Background(), testRequest())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"ok"}`, string(resp.Result))
+	require.NotNil(t, resp.Bounds)
+	assert.Equal(t, 2, resp.Bounds.Returned)
+	assert.True(t, resp.Bounds.Truncated)
+	assert.Nil(t, resp.Error)
+}
+
+func TestHTTPTransportClassifiesStatus(t *testing.T) {
+	cases := []struct {
+		status   int
+		reason   RejectReason
+		rejected bool
+	}{
+		{http.StatusNotFound, RejectUnavailable, true},
+		{http.StatusServiceUnavailable, RejectUnavailable, true},
+		{http.StatusTooManyRequests, RejectRateLimited, true},
+		{http.StatusUnprocessableEntity, RejectInvalid, true},
+		{http.StatusInternalServerError, "", false},
+		{http.StatusBadGateway, "", false},
+	}
+	for _, c := range cases {
+		t.Run(http.StatusText(c.status), func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, "nope", c.status)
+			}))
+			defer srv.Close()
+
+			_, err := NewHTTPTransport(srv.URL).Execute(context.Background(), testRequest())
+			require.Error(t, err)
+			var rejected *RejectedError
+			assert.Equal(t, c.rejected, errors.As(err, &rejected))
+			if c.rejected {
+				assert.Equal(t, c.reason, rejected.Reason)
+			}
+		})
+	}
+}
+
+func TestTransportsValidateRequest(t *testing.T) {
+	req := testRequest()
+	req.Meta.ToolCallID = ""
+	_, err := NewHTTPTransport("http://unused").Execute(context.Background(), req)
+	require.ErrorContains(t, err, "tool call id is required")
+	_, err = NewGRPCTransport(nil).Execute(context.Background(), req)
+	require.ErrorContains(t, err, "tool call id is required")
+}
+
+// testExecutor is a ToolExecutor served with the generated stubs.
+type testExecutor struct {
+	toolremotepb.UnimplementedToolExecutorServer
+
+	t *testing.T
+}
+
+func (e *testExecutor) Execute(_ context.Context, req *toolremotepb.ExecuteRequest) (*toolremotepb.ExecuteResponse, error) {
+	switch req.GetTool() {
+	case "orders.submit":
+		assert.Equal(e.t, "orders", req.GetToolset())
+		assert.JSONEq(e.t, `{"id":"o-1"}`, string(req.GetPayload()))
+		meta := req.GetMeta()
+		assert.Equal(e.t, "run-1", meta.GetRunId())
+		assert.Equal(e.t, "sess-1", meta.GetSessionId())
+		assert.Equal(e.t, "turn-1", meta.GetTurnId())
+		assert.Equal(e.t, "call-1", meta.GetToolCallId())
+		assert.Equal(e.t, "call-0", meta.GetParentToolCallId())
+		total, cursor := int64(7), "c2"
+		return &toolremotepb.ExecuteResponse{
+			Result: []byte(`{"echo":` + string(req.GetPayload()) + `}`),
+			Bounds: &toolremotepb.Bounds{Returned: 3, Total: &total, Truncated: true, NextCursor: &cursor, RefinementHint: "narrow"},
+		}, nil
+	case "orders.reject":
+		return &toolremotepb.ExecuteResponse{
+			Error: &toolremotepb.ToolError{Kind: "domain_rejection", Message: "cart empty", Recovery: "replan"},
+		}, nil
+	case "orders.busy":
+		return nil, status.Error(codes.ResourceExhausted, "slow down")
+	case "orders.fail":
+		return nil, status.Error(codes.Internal, "boom")
+	default:
+		return nil, status.Error(codes.Unimplemented, "unknown tool")
+	}
+}
+
+func TestGRPCTransportRoundTrip(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	toolremotepb.RegisterToolExecutorServer(srv, &testExecutor{t: t})
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	tr := NewGRPCTransport(conn)
+
+	req := testRequest()
+	req.Meta.ParentToolCallID = "call-0"
+	resp, err := tr.Execute(context.Background(), req)
+	require.NoError(t, err)
+	total, cursor := 7, "c2"
+	assert.JSONEq(t, `{"echo":{"id":"o-1"}}`, string(resp.Result))
+	assert.Equal(t, &Bounds{Returned: 3, Total: &total, Truncated: true, NextCursor: &cursor, RefinementHint: "narrow"}, resp.Bounds)
+	assert.Nil(t, resp.Error)
+
+	req.Tool = "orders.reject"
+	resp, err = tr.Execute(context.Background(), req)
+	require.NoError(t, err)
+	assert.Nil(t, resp.Result)
+	assert.Nil(t, resp.Bounds)
+	assert.Equal(t, &Error{Kind: "domain_rejection", Message: "cart empty", Recovery: "replan"}, resp.Error)
+
+	var rejected *RejectedError
+	req.Tool = "orders.busy"
+	_, err = tr.Execute(context.Background(), req)
+	require.ErrorAs(t, err, &rejected)
+	assert.Equal(t, RejectRateLimited, rejected.Reason)
+
+	req.Tool = "orders.unknown"
+	_, err = tr.Execute(context.Background(), req)
+	require.ErrorAs(t, err, &rejected)
+	assert.Equal(t, RejectUnavailable, rejected.Reason)
+
+	req.Tool = "orders.fail"
+	_, err = tr.Execute(context.Background(), req)
+	require.Error(t, err)
+	assert.False(t, errors.As(err, &rejected))
+}
+
+func TestContract(t *testing.T) {
+	raw, err := Contract(catalog.ToolsetSource{
+		ID:      "orders",
+		Service: "shop",
+		Package: "example.com/gen/shop/toolsets/orders",
+		Specs: []tools.ToolSpec{{
+			Name:    "orders.submit",
+			Toolset: "orders",
+			Payload: tools.TypeSpec{Name: "SubmitPayload", Schema: tools.RawJSON(`{"type":"object"}`)},
+			Result:  tools.TypeSpec{Name: "SubmitResult", Schema: tools.RawJSON(`{"type":"object"}`)},
+		}},
+	})
+	require.NoError(t, err)
+	var doc struct {
+		OpenAPI    string `json:"openapi"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+		Paths map[string]struct {
+			Post struct {
+				OperationID string `json:"operationId"`
+				RequestBody struct {
+					Content map[string]struct {
+						Schema struct {
+							Properties map[string]map[string]any `json:"properties"`
+						} `json:"schema"`
+					} `json:"content"`
+				} `json:"requestBody"`
+			} `json:"post"`
+		} `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &doc))
+	assert.Equal(t, catalog.OpenAPIVersion, doc.OpenAPI)
+	op, ok := doc.Paths["/tools/orders.submit"]
+	require.True(t, ok)
+	assert.Equal(t, "orders.submit", op.Post.OperationID)
+	props := op.Post.RequestBody.Content["application/json"].Schema.Properties
+	payloadKey := catalog.SchemaKey("urn:goa-ai:tool:orders.submit:payload")
+	assert.Equal(t, "#/components/schemas/"+payloadKey, props["payload"]["$ref"])
+	assert.Contains(t, doc.Components.Schemas, payloadKey)
+	assert.Contains(t, doc.Components.Schemas, callMetaSchema)
+	assert.Contains(t, doc.Components.Schemas, errorSchema)
+}